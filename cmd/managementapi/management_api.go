@@ -25,7 +25,9 @@ package managementapi
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/prometheus/client_golang/prometheus"
@@ -38,6 +40,7 @@ import (
 	"github.com/topfreegames/maestro/internal/config"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 )
 
 var (
@@ -76,11 +79,12 @@ func runManagementApi() {
 		zap.L().With(zap.Error(err)).Fatal("failed to configure tracer")
 	}
 
-	mux, err := initializeManagementMux(ctx, config)
+	managementAPI, err := initializeManagementAPI(ctx, config)
 	if err != nil {
-		zap.L().With(zap.Error(err)).Fatal("failed to initialize management mux")
+		zap.L().With(zap.Error(err)).Fatal("failed to initialize management api")
 	}
-	shutdownManagementServerFn := runManagementServer(ctx, config, mux)
+	shutdownManagementServerFn := runManagementServer(ctx, config, managementAPI.mux)
+	shutdownManagementGrpcServerFn := runManagementGrpcServer(config, managementAPI.grpcServer)
 
 	<-ctx.Done()
 
@@ -98,6 +102,8 @@ func runManagementApi() {
 	if err != nil {
 		zap.L().With(zap.Error(err)).Fatal("failed to shutdown management server")
 	}
+
+	shutdownManagementGrpcServerFn()
 }
 
 // runManagementServer starts HTTP server in other goroutine, and returns a
@@ -139,6 +145,41 @@ func runManagementServer(ctx context.Context, configs config.Config, mux *runtim
 	}
 }
 
+// runManagementGrpcServer starts the gRPC server in other goroutine, and
+// returns a shutdown function. Unlike the HTTP server, it also serves the
+// streaming RPCs.
+func runManagementGrpcServer(configs config.Config, grpcServer *grpc.Server) func() {
+	port := configs.GetString("api.grpcPort")
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%s", port))
+	if err != nil {
+		zap.L().With(zap.Error(err)).Fatal("failed to listen on gRPC management server port")
+	}
+
+	go func() {
+		zap.L().Info(fmt.Sprintf("started gRPC management server at :%s", port))
+		if err := grpcServer.Serve(listener); err != nil {
+			zap.L().With(zap.Error(err)).Fatal("failed to start gRPC management server")
+		}
+	}()
+
+	return func() {
+		zap.L().Info("stopping gRPC management server")
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+
+		// the watch streams only end with their operations, so they're
+		// closed once the graceful shutdown timeout passes.
+		select {
+		case <-stopped:
+		case <-time.After(configs.GetDuration("api.gracefulShutdownTimeout")):
+			grpcServer.Stop()
+		}
+	}
+}
+
 func buildMuxWithMetricsMdlw(mdlw middleware.Middleware, mux *runtime.ServeMux) http.Handler {
 	muxHandlerWithMetricsMdlw := http.NewServeMux()
 
@@ -163,6 +204,14 @@ func buildMuxWithMetricsMdlw(mdlw middleware.Middleware, mux *runtime.ServeMux)
 		// Operations handler
 		case commom.MatchPath(path, fmt.Sprintf("^/schedulers/%s/operations$", anyWordRegex)):
 			handler = std.Handler("/schedulers/:schedulerName/operations", mdlw, mux)
+		case commom.MatchPath(path, fmt.Sprintf("^/schedulers/%s/operations/watch$", anyWordRegex)):
+			handler = std.Handler("/schedulers/:schedulerName/operations/watch", mdlw, mux)
+		case commom.MatchPath(path, fmt.Sprintf("^/schedulers/%s/operations/pause$", anyWordRegex)):
+			handler = std.Handler("/schedulers/:schedulerName/operations/pause", mdlw, mux)
+		case commom.MatchPath(path, fmt.Sprintf("^/schedulers/%s/operations/resume$", anyWordRegex)):
+			handler = std.Handler("/schedulers/:schedulerName/operations/resume", mdlw, mux)
+		case commom.MatchPath(path, fmt.Sprintf("^/schedulers/%s/operations/%s/watch$", anyWordRegex, anyWordRegex)):
+			handler = std.Handler("/schedulers/:schedulerName/operations/:operationID/watch", mdlw, mux)
 		case commom.MatchPath(path, fmt.Sprintf("^/schedulers/%s/operations/%s", anyWordRegex, anyWordRegex)):
 			handler = std.Handler("/schedulers/:schedulerName/operations/:operationID", mdlw, mux)
 		case commom.MatchPath(path, fmt.Sprintf("^/schedulers/%s/operations/%s/cancel$", anyWordRegex, anyWordRegex)):
@@ -197,6 +246,14 @@ func buildMuxWithTracing(hndl http.Handler) http.Handler {
 		// Operations handler
 		case commom.MatchPath(path, fmt.Sprintf("^/schedulers/%s/operations$", anyWordRegex)):
 			handler = otelhttp.NewHandler(hndl, "/schedulers/:schedulerName/operations")
+		case commom.MatchPath(path, fmt.Sprintf("^/schedulers/%s/operations/watch$", anyWordRegex)):
+			handler = otelhttp.NewHandler(hndl, "/schedulers/:schedulerName/operations/watch")
+		case commom.MatchPath(path, fmt.Sprintf("^/schedulers/%s/operations/pause$", anyWordRegex)):
+			handler = otelhttp.NewHandler(hndl, "/schedulers/:schedulerName/operations/pause")
+		case commom.MatchPath(path, fmt.Sprintf("^/schedulers/%s/operations/resume$", anyWordRegex)):
+			handler = otelhttp.NewHandler(hndl, "/schedulers/:schedulerName/operations/resume")
+		case commom.MatchPath(path, fmt.Sprintf("^/schedulers/%s/operations/%s/watch$", anyWordRegex, anyWordRegex)):
+			handler = otelhttp.NewHandler(hndl, "/schedulers/:schedulerName/operations/:operationID/watch")
 		case commom.MatchPath(path, fmt.Sprintf("^/schedulers/%s/operations/%s", anyWordRegex, anyWordRegex)):
 			handler = otelhttp.NewHandler(hndl, "/schedulers/:schedulerName/operations/:operationID")
 		case commom.MatchPath(path, fmt.Sprintf("^/schedulers/%s/operations/%s/cancel$", anyWordRegex, anyWordRegex)):
@@ -27,51 +27,119 @@ package managementapi
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/topfreegames/maestro/internal/core/operations/providers"
+	"github.com/topfreegames/maestro/internal/core/services/events"
 
 	"github.com/google/wire"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/topfreegames/maestro/internal/adapters/tracing"
 	"github.com/topfreegames/maestro/internal/api/handlers"
 	"github.com/topfreegames/maestro/internal/config"
 	"github.com/topfreegames/maestro/internal/service"
 	api "github.com/topfreegames/maestro/pkg/api/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
 )
 
-func initializeManagementMux(ctx context.Context, conf config.Config) (*runtime.ServeMux, error) {
+func initializeManagementAPI(ctx context.Context, conf config.Config) (*managementAPI, error) {
 	wire.Build(
 		// ports + adapters
+		service.NewOptionalRuntimeKubernetes,
 		service.NewClockTime,
 		service.NewOperationFlowRedis,
 		service.NewOperationStorageRedis,
 		service.NewOperationLeaseStorageRedis,
 		service.NewSchedulerStoragePg,
+		service.NewSchedulerTemplateStoragePg,
 		service.NewRoomStorageRedis,
 		service.NewSchedulerCacheRedis,
+		service.NewEventsOutboxRedis,
+		service.NewForwarderHealthStorageRedis,
+		service.NewForwarderTestClient,
+		service.NewRoomTimelineStorageRedis,
 
 		// scheduler operations
 		providers.ProvideDefinitionConstructors,
 
 		// services
 		service.NewSchedulerManager,
+		service.NewSchedulerTemplateManager,
 		service.NewOperationManager,
+		events.NewDeadLetterEventsManager,
+		events.NewForwardersHealthManager,
+		events.NewForwardersTester,
+		service.NewForwardersTesterConfig,
+		service.NewRoomTimelineManager,
 
 		// api handlers
 		handlers.ProvideSchedulersHandler,
 		handlers.ProvideOperationsHandler,
+		handlers.ProvideSchedulerTemplatesHandler,
+		handlers.ProvideEventsHandler,
+		handlers.ProvideRoomTimelineHandler,
 		provideManagementMux,
+		provideManagementGrpcServer,
+		provideManagementAPI,
 
 		// config
 		service.NewOperationManagerConfig,
+		service.NewRoomTimelineConfig,
 	)
 
-	return &runtime.ServeMux{}, nil
+	return &managementAPI{}, nil
 }
 
-func provideManagementMux(ctx context.Context, schedulersHandler *handlers.SchedulersHandler, operationsHandler *handlers.OperationsHandler) *runtime.ServeMux {
-	mux := runtime.NewServeMux()
+func provideManagementMux(ctx context.Context, schedulersHandler *handlers.SchedulersHandler, operationsHandler *handlers.OperationsHandler, schedulerTemplatesHandler *handlers.SchedulerTemplatesHandler, eventsHandler *handlers.EventsHandler, roomTimelineHandler *handlers.RoomTimelineHandler) *runtime.ServeMux {
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(handlers.IncomingHeaderMatcher))
 	_ = api.RegisterSchedulersServiceHandlerServer(ctx, mux, schedulersHandler)
 	_ = api.RegisterOperationsServiceHandlerServer(ctx, mux, operationsHandler)
+	_ = api.RegisterSchedulerTemplatesServiceHandlerServer(ctx, mux, schedulerTemplatesHandler)
+	_ = api.RegisterEventsServiceHandlerServer(ctx, mux, eventsHandler)
+	_ = api.RegisterRoomTimelineServiceHandlerServer(ctx, mux, roomTimelineHandler)
+	_ = mux.HandlePath(http.MethodGet, "/schedulers/{schedulerName}/operations/watch", operationsHandler.WatchSchedulerOperationsHTTP)
+	_ = mux.HandlePath(http.MethodGet, "/schedulers/{schedulerName}/operations/{operationId}/watch", operationsHandler.WatchOperationHTTP)
 
 	return mux
 }
+
+// provideManagementGrpcServer serves the same handlers as the management mux
+// over gRPC, including the streaming RPCs that the gateway can't serve. Like
+// the HTTP server, its calls are measured and, when enabled, traced.
+func provideManagementGrpcServer(conf config.Config, schedulersHandler *handlers.SchedulersHandler, operationsHandler *handlers.OperationsHandler, schedulerTemplatesHandler *handlers.SchedulerTemplatesHandler, eventsHandler *handlers.EventsHandler, roomTimelineHandler *handlers.RoomTimelineHandler) *grpc.Server {
+	unaryInterceptors := []grpc.UnaryServerInterceptor{grpc_prometheus.UnaryServerInterceptor}
+	streamInterceptors := []grpc.StreamServerInterceptor{grpc_prometheus.StreamServerInterceptor}
+	if tracing.IsTracingEnabled(conf) {
+		zap.L().Info("adding tracing interceptors for the gRPC API")
+		unaryInterceptors = append(unaryInterceptors, tracing.UnaryServerInterceptor())
+		streamInterceptors = append(streamInterceptors, tracing.StreamServerInterceptor())
+	}
+
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
+	api.RegisterSchedulersServiceServer(server, schedulersHandler)
+	api.RegisterOperationsServiceServer(server, operationsHandler)
+	api.RegisterSchedulerTemplatesServiceServer(server, schedulerTemplatesHandler)
+	api.RegisterEventsServiceServer(server, eventsHandler)
+	api.RegisterRoomTimelineServiceServer(server, roomTimelineHandler)
+
+	grpc_prometheus.EnableHandlingTimeHistogram(grpc_prometheus.WithHistogramBuckets(prometheus.DefBuckets))
+	grpc_prometheus.Register(server)
+
+	return server
+}
+
+// managementAPI has the HTTP mux and the gRPC server of the management API.
+type managementAPI struct {
+	mux        *runtime.ServeMux
+	grpcServer *grpc.Server
+}
+
+func provideManagementAPI(mux *runtime.ServeMux, grpcServer *grpc.Server) *managementAPI {
+	return &managementAPI{mux: mux, grpcServer: grpcServer}
+}
@@ -8,17 +8,24 @@ package managementapi
 
 import (
 	"context"
+	"github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/topfreegames/maestro/internal/adapters/tracing"
 	"github.com/topfreegames/maestro/internal/api/handlers"
 	"github.com/topfreegames/maestro/internal/config"
 	"github.com/topfreegames/maestro/internal/core/operations/providers"
+	"github.com/topfreegames/maestro/internal/core/services/events"
 	"github.com/topfreegames/maestro/internal/service"
 	"github.com/topfreegames/maestro/pkg/api/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"net/http"
 )
 
 // Injectors from wire.go:
 
-func initializeManagementMux(ctx context.Context, conf config.Config) (*runtime.ServeMux, error) {
+func initializeManagementAPI(ctx context.Context, conf config.Config) (*managementAPI, error) {
 	schedulerStorage, err := service.NewSchedulerStoragePg(conf)
 	if err != nil {
 		return nil, err
@@ -50,19 +57,92 @@ func initializeManagementMux(ctx context.Context, conf config.Config) (*runtime.
 	if err != nil {
 		return nil, err
 	}
-	schedulerManager := service.NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+	portsRuntime := service.NewOptionalRuntimeKubernetes(conf)
+	schedulerManager := service.NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, portsRuntime)
 	schedulersHandler := handlers.ProvideSchedulersHandler(schedulerManager)
 	operationsHandler := handlers.ProvideOperationsHandler(operationManager)
-	serveMux := provideManagementMux(ctx, schedulersHandler, operationsHandler)
-	return serveMux, nil
+	schedulerTemplateStorage, err := service.NewSchedulerTemplateStoragePg(conf)
+	if err != nil {
+		return nil, err
+	}
+	schedulerTemplateManager := service.NewSchedulerTemplateManager(schedulerTemplateStorage, schedulerManager)
+	schedulerTemplatesHandler := handlers.ProvideSchedulerTemplatesHandler(schedulerTemplateManager)
+	eventsOutbox, err := service.NewEventsOutboxRedis(conf)
+	if err != nil {
+		return nil, err
+	}
+	deadLetterEventsManager := events.NewDeadLetterEventsManager(eventsOutbox, schedulerStorage)
+	forwarderHealthStorage, err := service.NewForwarderHealthStorageRedis(conf)
+	if err != nil {
+		return nil, err
+	}
+	forwardersHealthManager := events.NewForwardersHealthManager(forwarderHealthStorage, schedulerStorage)
+	forwarderClient := service.NewForwarderTestClient(conf)
+	forwardersTesterConfig := service.NewForwardersTesterConfig(conf)
+	forwardersTester := events.NewForwardersTester(forwarderClient, schedulerStorage, clock, forwardersTesterConfig)
+	eventsHandler := handlers.ProvideEventsHandler(deadLetterEventsManager, forwardersHealthManager, forwardersTester)
+	roomTimelineStorage, err := service.NewRoomTimelineStorageRedis(conf)
+	if err != nil {
+		return nil, err
+	}
+	roomTimelineConfig := service.NewRoomTimelineConfig(conf)
+	roomTimelineManager := service.NewRoomTimelineManager(roomTimelineStorage, schedulerStorage, clock, roomTimelineConfig)
+	roomTimelineHandler := handlers.ProvideRoomTimelineHandler(roomTimelineManager)
+	serveMux := provideManagementMux(ctx, schedulersHandler, operationsHandler, schedulerTemplatesHandler, eventsHandler, roomTimelineHandler)
+	server := provideManagementGrpcServer(conf, schedulersHandler, operationsHandler, schedulerTemplatesHandler, eventsHandler, roomTimelineHandler)
+	managementapiManagementAPI := provideManagementAPI(serveMux, server)
+	return managementapiManagementAPI, nil
 }
 
 // wire.go:
 
-func provideManagementMux(ctx context.Context, schedulersHandler *handlers.SchedulersHandler, operationsHandler *handlers.OperationsHandler) *runtime.ServeMux {
-	mux := runtime.NewServeMux()
+func provideManagementMux(ctx context.Context, schedulersHandler *handlers.SchedulersHandler, operationsHandler *handlers.OperationsHandler, schedulerTemplatesHandler *handlers.SchedulerTemplatesHandler, eventsHandler *handlers.EventsHandler, roomTimelineHandler *handlers.RoomTimelineHandler) *runtime.ServeMux {
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(handlers.IncomingHeaderMatcher))
 	_ = v1.RegisterSchedulersServiceHandlerServer(ctx, mux, schedulersHandler)
 	_ = v1.RegisterOperationsServiceHandlerServer(ctx, mux, operationsHandler)
+	_ = v1.RegisterSchedulerTemplatesServiceHandlerServer(ctx, mux, schedulerTemplatesHandler)
+	_ = v1.RegisterEventsServiceHandlerServer(ctx, mux, eventsHandler)
+	_ = v1.RegisterRoomTimelineServiceHandlerServer(ctx, mux, roomTimelineHandler)
+	_ = mux.HandlePath(http.MethodGet, "/schedulers/{schedulerName}/operations/watch", operationsHandler.WatchSchedulerOperationsHTTP)
+	_ = mux.HandlePath(http.MethodGet, "/schedulers/{schedulerName}/operations/{operationId}/watch", operationsHandler.WatchOperationHTTP)
 
 	return mux
 }
+
+// provideManagementGrpcServer serves the same handlers as the management mux
+// over gRPC, including the streaming RPCs that the gateway can't serve. Like
+// the HTTP server, its calls are measured and, when enabled, traced.
+func provideManagementGrpcServer(conf config.Config, schedulersHandler *handlers.SchedulersHandler, operationsHandler *handlers.OperationsHandler, schedulerTemplatesHandler *handlers.SchedulerTemplatesHandler, eventsHandler *handlers.EventsHandler, roomTimelineHandler *handlers.RoomTimelineHandler) *grpc.Server {
+	unaryInterceptors := []grpc.UnaryServerInterceptor{grpc_prometheus.UnaryServerInterceptor}
+	streamInterceptors := []grpc.StreamServerInterceptor{grpc_prometheus.StreamServerInterceptor}
+	if tracing.IsTracingEnabled(conf) {
+		zap.L().Info("adding tracing interceptors for the gRPC API")
+		unaryInterceptors = append(unaryInterceptors, tracing.UnaryServerInterceptor())
+		streamInterceptors = append(streamInterceptors, tracing.StreamServerInterceptor())
+	}
+
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
+	v1.RegisterSchedulersServiceServer(server, schedulersHandler)
+	v1.RegisterOperationsServiceServer(server, operationsHandler)
+	v1.RegisterSchedulerTemplatesServiceServer(server, schedulerTemplatesHandler)
+	v1.RegisterEventsServiceServer(server, eventsHandler)
+	v1.RegisterRoomTimelineServiceServer(server, roomTimelineHandler)
+
+	grpc_prometheus.EnableHandlingTimeHistogram(grpc_prometheus.WithHistogramBuckets(prometheus.DefBuckets))
+	grpc_prometheus.Register(server)
+
+	return server
+}
+
+// managementAPI has the HTTP mux and the gRPC server of the management API.
+type managementAPI struct {
+	mux        *runtime.ServeMux
+	grpcServer *grpc.Server
+}
+
+func provideManagementAPI(mux *runtime.ServeMux, grpcServer *grpc.Server) *managementAPI {
+	return &managementAPI{mux: mux, grpcServer: grpcServer}
+}
@@ -49,7 +49,11 @@ func initializeRoomsMux(ctx context.Context, conf config.Config) (*runtime.Serve
 		service.NewRuntimeKubernetes,
 		service.NewRoomManagerConfig,
 		service.NewRoomManager,
+		service.NewRoomTimelineStorageRedis,
+		service.NewRoomTimelineConfig,
+		service.NewRoomTimelineManager,
 		service.NewEventsForwarder,
+		service.NewEventsOutboxRedis,
 		service.NewSchedulerStoragePg,
 		service.NewEventsForwarderServiceConfig,
 
@@ -48,16 +48,26 @@ func initializeRoomsMux(ctx context.Context, conf config.Config) (*runtime.Serve
 	if err != nil {
 		return nil, err
 	}
+	eventsOutbox, err := service.NewEventsOutboxRedis(conf)
+	if err != nil {
+		return nil, err
+	}
 	eventsForwarderConfig, err := service.NewEventsForwarderServiceConfig(conf)
 	if err != nil {
 		return nil, err
 	}
-	eventsService := events.NewEventsForwarderService(eventsForwarder, schedulerStorage, gameRoomInstanceStorage, roomStorage, schedulerCache, eventsForwarderConfig)
+	roomTimelineStorage, err := service.NewRoomTimelineStorageRedis(conf)
+	if err != nil {
+		return nil, err
+	}
+	roomTimelineConfig := service.NewRoomTimelineConfig(conf)
+	roomTimelineManager := service.NewRoomTimelineManager(roomTimelineStorage, schedulerStorage, clock, roomTimelineConfig)
+	eventsService := events.NewEventsForwarderService(eventsForwarder, schedulerStorage, gameRoomInstanceStorage, roomStorage, schedulerCache, eventsOutbox, roomTimelineManager, eventsForwarderConfig)
 	roomManagerConfig, err := service.NewRoomManagerConfig(conf)
 	if err != nil {
 		return nil, err
 	}
-	roomManager := service.NewRoomManager(clock, portAllocator, roomStorage, gameRoomInstanceStorage, portsRuntime, eventsService, roomManagerConfig)
+	roomManager := service.NewRoomManager(clock, portAllocator, roomStorage, gameRoomInstanceStorage, portsRuntime, eventsService, roomTimelineManager, roomManagerConfig)
 	roomsHandler := handlers.ProvideRoomsHandler(roomManager, eventsService)
 	serveMux := provideRoomsMux(ctx, roomsHandler)
 	return serveMux, nil
@@ -65,7 +65,11 @@ var RoomManagerSet = wire.NewSet(
 	service.NewSchedulerCacheRedis,
 	service.NewRoomManagerConfig,
 	service.NewRoomManager,
+	service.NewRoomTimelineStorageRedis,
+	service.NewRoomTimelineConfig,
+	service.NewRoomTimelineManager,
 	service.NewEventsForwarder,
+	service.NewEventsOutboxRedis,
 	events.NewEventsForwarderService,
 	service.NewEventsForwarderServiceConfig,
 )
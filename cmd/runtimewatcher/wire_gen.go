@@ -50,16 +50,26 @@ func initializeRuntimeWatcher(c config.Config) (*workers.WorkersManager, error)
 	if err != nil {
 		return nil, err
 	}
+	eventsOutbox, err := service.NewEventsOutboxRedis(c)
+	if err != nil {
+		return nil, err
+	}
 	eventsForwarderConfig, err := service.NewEventsForwarderServiceConfig(c)
 	if err != nil {
 		return nil, err
 	}
-	eventsService := events.NewEventsForwarderService(eventsForwarder, schedulerStorage, gameRoomInstanceStorage, roomStorage, schedulerCache, eventsForwarderConfig)
+	roomTimelineStorage, err := service.NewRoomTimelineStorageRedis(c)
+	if err != nil {
+		return nil, err
+	}
+	roomTimelineConfig := service.NewRoomTimelineConfig(c)
+	roomTimelineManager := service.NewRoomTimelineManager(roomTimelineStorage, schedulerStorage, clock, roomTimelineConfig)
+	eventsService := events.NewEventsForwarderService(eventsForwarder, schedulerStorage, gameRoomInstanceStorage, roomStorage, schedulerCache, eventsOutbox, roomTimelineManager, eventsForwarderConfig)
 	roomManagerConfig, err := service.NewRoomManagerConfig(c)
 	if err != nil {
 		return nil, err
 	}
-	roomManager := service.NewRoomManager(clock, portAllocator, roomStorage, gameRoomInstanceStorage, runtime, eventsService, roomManagerConfig)
+	roomManager := service.NewRoomManager(clock, portAllocator, roomStorage, gameRoomInstanceStorage, runtime, eventsService, roomTimelineManager, roomManagerConfig)
 	runtimeWatcherConfig := provideRuntimeWatcherConfig(c)
 	workerOptions := &worker.WorkerOptions{
 		Runtime:              runtime,
@@ -90,4 +100,4 @@ func provideRuntimeWatcherConfig(c config.Config) *config2.RuntimeWatcherConfig
 var WorkerOptionsSet = wire.NewSet(service.NewRuntimeKubernetes, service.NewRoomStorageRedis, RoomManagerSet,
 	provideRuntimeWatcherConfig, wire.Struct(new(worker.WorkerOptions), "Runtime", "RoomStorage", "RoomManager", "RuntimeWatcherConfig"))
 
-var RoomManagerSet = wire.NewSet(service.NewSchedulerStoragePg, service.NewClockTime, service.NewPortAllocatorRandom, service.NewGameRoomInstanceStorageRedis, service.NewSchedulerCacheRedis, service.NewRoomManagerConfig, service.NewRoomManager, service.NewEventsForwarder, events.NewEventsForwarderService, service.NewEventsForwarderServiceConfig)
+var RoomManagerSet = wire.NewSet(service.NewSchedulerStoragePg, service.NewClockTime, service.NewPortAllocatorRandom, service.NewGameRoomInstanceStorageRedis, service.NewSchedulerCacheRedis, service.NewRoomManagerConfig, service.NewRoomManager, service.NewRoomTimelineStorageRedis, service.NewRoomTimelineConfig, service.NewRoomTimelineManager, service.NewEventsForwarder, service.NewEventsOutboxRedis, events.NewEventsForwarderService, service.NewEventsForwarderServiceConfig)
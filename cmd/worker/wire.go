@@ -29,6 +29,7 @@ import (
 	"github.com/google/wire"
 	"github.com/topfreegames/maestro/internal/config"
 	"github.com/topfreegames/maestro/internal/core/operations/providers"
+	"github.com/topfreegames/maestro/internal/core/ports"
 	"github.com/topfreegames/maestro/internal/core/services/events"
 	workersservice "github.com/topfreegames/maestro/internal/core/services/workers"
 	"github.com/topfreegames/maestro/internal/core/worker"
@@ -47,16 +48,24 @@ func initializeWorker(c config.Config, builder *worker.WorkerBuilder) (*workerss
 		service.NewSchedulerCacheRedis,
 		service.NewOperationLeaseStorageRedis,
 		service.NewPortAllocatorRandom,
+		service.NewRoomHealthChecker,
 		service.NewRoomStorageRedis,
 		service.NewGameRoomInstanceStorageRedis,
 		service.NewWorkersConfig,
 		service.NewCreateSchedulerVersionConfig,
 		service.NewHealthControllerConfig,
 		service.NewOperationRoomsAddConfig,
+		service.NewCanaryRolloutConfig,
+		service.NewBlueGreenSwitchConfig,
+		service.NewStorageCleanupConfig,
 		service.NewRoomManagerConfig,
 		service.NewRoomManager,
+		service.NewRoomTimelineStorageRedis,
+		service.NewRoomTimelineConfig,
+		service.NewRoomTimelineManager,
 		service.NewOperationManagerConfig,
 		service.NewEventsForwarder,
+		service.NewEventsOutboxRedis,
 		service.NewEventsForwarderServiceConfig,
 		service.NewPolicyMap,
 		service.NewAutoscaler,
@@ -74,3 +83,25 @@ func initializeWorker(c config.Config, builder *worker.WorkerBuilder) (*workerss
 
 	return &workersservice.WorkersManager{}, nil
 }
+
+func initializeEventsDispatcher(c config.Config) (ports.EventsDispatcher, error) {
+	wire.Build(
+		// ports + adapters
+		service.NewSchedulerStoragePg,
+		service.NewSchedulerCacheRedis,
+		service.NewRoomStorageRedis,
+		service.NewGameRoomInstanceStorageRedis,
+		service.NewEventsForwarder,
+		service.NewEventsOutboxRedis,
+		service.NewEventsForwarderServiceConfig,
+		service.NewClockTime,
+		service.NewRoomTimelineStorageRedis,
+		service.NewRoomTimelineConfig,
+		service.NewRoomTimelineManager,
+
+		// services
+		events.NewEventsDispatcher,
+	)
+
+	return nil, nil
+}
@@ -9,6 +9,7 @@ package worker
 import (
 	"github.com/topfreegames/maestro/internal/config"
 	"github.com/topfreegames/maestro/internal/core/operations/providers"
+	"github.com/topfreegames/maestro/internal/core/ports"
 	"github.com/topfreegames/maestro/internal/core/services/events"
 	"github.com/topfreegames/maestro/internal/core/services/workers"
 	"github.com/topfreegames/maestro/internal/core/worker"
@@ -65,23 +66,37 @@ func initializeWorker(c config.Config, builder *worker.WorkerBuilder) (*workers.
 	if err != nil {
 		return nil, err
 	}
+	eventsOutbox, err := service.NewEventsOutboxRedis(c)
+	if err != nil {
+		return nil, err
+	}
 	eventsForwarderConfig, err := service.NewEventsForwarderServiceConfig(c)
 	if err != nil {
 		return nil, err
 	}
-	eventsService := events.NewEventsForwarderService(eventsForwarder, schedulerStorage, gameRoomInstanceStorage, roomStorage, schedulerCache, eventsForwarderConfig)
+	roomTimelineStorage, err := service.NewRoomTimelineStorageRedis(c)
+	if err != nil {
+		return nil, err
+	}
+	roomTimelineConfig := service.NewRoomTimelineConfig(c)
+	roomTimelineManager := service.NewRoomTimelineManager(roomTimelineStorage, schedulerStorage, clock, roomTimelineConfig)
+	eventsService := events.NewEventsForwarderService(eventsForwarder, schedulerStorage, gameRoomInstanceStorage, roomStorage, schedulerCache, eventsOutbox, roomTimelineManager, eventsForwarderConfig)
 	roomManagerConfig, err := service.NewRoomManagerConfig(c)
 	if err != nil {
 		return nil, err
 	}
-	roomManager := service.NewRoomManager(clock, portAllocator, roomStorage, gameRoomInstanceStorage, runtime, eventsService, roomManagerConfig)
-	schedulerManager := service.NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+	roomManager := service.NewRoomManager(clock, portAllocator, roomStorage, gameRoomInstanceStorage, runtime, eventsService, roomTimelineManager, roomManagerConfig)
+	schedulerManager := service.NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, runtime)
 	policyMap := service.NewPolicyMap(roomStorage)
 	autoscaler := service.NewAutoscaler(policyMap)
+	roomHealthChecker := service.NewRoomHealthChecker()
 	newversionConfig := service.NewCreateSchedulerVersionConfig(c)
 	healthcontrollerConfig := service.NewHealthControllerConfig(c)
 	addConfig := service.NewOperationRoomsAddConfig(c)
-	v2 := providers.ProvideExecutors(runtime, schedulerStorage, roomManager, roomStorage, schedulerManager, gameRoomInstanceStorage, schedulerCache, operationStorage, operationManager, autoscaler, newversionConfig, healthcontrollerConfig, addConfig)
+	canaryConfig := service.NewCanaryRolloutConfig(c)
+	bluegreenConfig := service.NewBlueGreenSwitchConfig(c)
+	storagecleanupConfig := service.NewStorageCleanupConfig(c)
+	v2 := providers.ProvideExecutors(runtime, schedulerStorage, roomManager, roomStorage, schedulerManager, gameRoomInstanceStorage, schedulerCache, operationStorage, operationManager, autoscaler, roomHealthChecker, eventsForwarder, eventsOutbox, newversionConfig, healthcontrollerConfig, addConfig, canaryConfig, bluegreenConfig, storagecleanupConfig)
 	configuration, err := service.NewWorkersConfig(c)
 	if err != nil {
 		return nil, err
@@ -90,3 +105,43 @@ func initializeWorker(c config.Config, builder *worker.WorkerBuilder) (*workers.
 	workersManager := workers.NewWorkersManager(builder, c, schedulerStorage, workerOptions)
 	return workersManager, nil
 }
+
+func initializeEventsDispatcher(c config.Config) (ports.EventsDispatcher, error) {
+	eventsForwarder, err := service.NewEventsForwarder(c)
+	if err != nil {
+		return nil, err
+	}
+	schedulerStorage, err := service.NewSchedulerStoragePg(c)
+	if err != nil {
+		return nil, err
+	}
+	gameRoomInstanceStorage, err := service.NewGameRoomInstanceStorageRedis(c)
+	if err != nil {
+		return nil, err
+	}
+	roomStorage, err := service.NewRoomStorageRedis(c)
+	if err != nil {
+		return nil, err
+	}
+	schedulerCache, err := service.NewSchedulerCacheRedis(c)
+	if err != nil {
+		return nil, err
+	}
+	eventsOutbox, err := service.NewEventsOutboxRedis(c)
+	if err != nil {
+		return nil, err
+	}
+	eventsForwarderConfig, err := service.NewEventsForwarderServiceConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	clock := service.NewClockTime()
+	roomTimelineStorage, err := service.NewRoomTimelineStorageRedis(c)
+	if err != nil {
+		return nil, err
+	}
+	roomTimelineConfig := service.NewRoomTimelineConfig(c)
+	roomTimelineManager := service.NewRoomTimelineManager(roomTimelineStorage, schedulerStorage, clock, roomTimelineConfig)
+	eventsDispatcher := events.NewEventsDispatcher(eventsForwarder, schedulerStorage, gameRoomInstanceStorage, roomStorage, schedulerCache, eventsOutbox, roomTimelineManager, eventsForwarderConfig)
+	return eventsDispatcher, nil
+}
@@ -34,6 +34,8 @@ import (
 	"go.uber.org/zap"
 )
 
+const eventsOutboxEnabledConfigPath = "services.eventsForwarder.outbox.enabled"
+
 var (
 	logConfig  string
 	configPath string
@@ -98,6 +100,26 @@ func runWorker() {
 		zap.L().Info("operation execution worker manager stopped")
 	}()
 
+	if config.GetBool(eventsOutboxEnabledConfigPath) {
+		eventsDispatcher, err := initializeEventsDispatcher(config)
+		if err != nil {
+			zap.L().With(zap.Error(err)).Fatal("failed to initialize events dispatcher")
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			zap.L().Info("events dispatcher initialized, starting...")
+			err := eventsDispatcher.DispatchEvents(ctx)
+			if err != nil {
+				zap.L().With(zap.Error(err)).Info("events dispatcher stopped with error")
+				// enforce the cancellation
+				cancelFn()
+			}
+			zap.L().Info("events dispatcher stopped")
+		}()
+	}
+
 	<-ctx.Done()
 
 	err = shutdownInternalServerFn()
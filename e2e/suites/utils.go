@@ -281,7 +281,7 @@ func createTestOperation(ctx context.Context, t *testing.T, operationStorage por
 
 	err := operationStorage.CreateOperation(ctx, op)
 	require.NoError(t, err)
-	err = operationFlow.InsertOperationID(ctx, op.SchedulerName, op.ID)
+	err = operationFlow.InsertOperationID(ctx, op.SchedulerName, op.ID, op.Priority)
 	require.NoError(t, err)
 
 	return op
@@ -0,0 +1,134 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/topfreegames/maestro/internal/adapters/metrics"
+	"github.com/topfreegames/maestro/internal/core/entities/events"
+	entities "github.com/topfreegames/maestro/internal/core/entities/forwarder"
+	"github.com/topfreegames/maestro/internal/core/monitoring"
+	"github.com/topfreegames/maestro/internal/core/ports"
+	"github.com/topfreegames/maestro/internal/core/ports/errors"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	brokerForwarderServiceMetricLabel = "BrokerForwarder"
+
+	// BrokerContentTypeHeader has the content type of the CloudEvents
+	// published.
+	BrokerContentTypeHeader = "content-type"
+	// BrokerCloudEventsHeaderPrefix prefixes the headers with the CloudEvent
+	// attributes in the binary mode, e.g. ce_type.
+	BrokerCloudEventsHeaderPrefix = "ce_"
+)
+
+var (
+	_ ports.EventsForwarder = (*brokerEventsForwarder)(nil)
+)
+
+type brokerEventsForwarder struct {
+	publisher ports.EventsPublisher
+}
+
+// NewBrokerEventsForwarder instantiates an events forwarder that publishes
+// the events as JSON on the topic set as the forwarders address.
+func NewBrokerEventsForwarder(publisher ports.EventsPublisher) *brokerEventsForwarder {
+	return &brokerEventsForwarder{
+		publisher: publisher,
+	}
+}
+
+// ForwardRoomEvent forwards room events. It receives the room event attributes and forwarder configuration.
+func (f *brokerEventsForwarder) ForwardRoomEvent(ctx context.Context, eventAttributes events.RoomEventAttributes, forwarder entities.Forwarder) (codes.Code, error) {
+	event, code, err := buildJSONRoomEvent(eventAttributes, forwarder)
+	if err != nil {
+		return code, err
+	}
+
+	return f.publish(ctx, forwarder, messageKey(forwarder, eventAttributes.SchedulerID, eventAttributes.RoomId), event)
+}
+
+// ForwardPlayerEvent forwards a player events. It receives the player events attributes and forwarder configuration.
+func (f *brokerEventsForwarder) ForwardPlayerEvent(ctx context.Context, eventAttributes events.PlayerEventAttributes, forwarder entities.Forwarder) (codes.Code, error) {
+	event := buildJSONPlayerEvent(eventAttributes, forwarder)
+
+	return f.publish(ctx, forwarder, messageKey(forwarder, eventAttributes.SchedulerID, eventAttributes.RoomId), event)
+}
+
+// Name returns the forwarder name. This name should be unique among other events forwarders.
+func (*brokerEventsForwarder) Name() string {
+	return "broker_forwarder"
+}
+
+func (f *brokerEventsForwarder) publish(ctx context.Context, forwarder entities.Forwarder, key string, event jsonEvent) (codes.Code, error) {
+	encoded, err := encodeEvent(event, forwarder)
+	if err != nil {
+		return codes.Internal, errors.NewErrUnexpected("failed to encode event to \"%s\"", forwarder.Name).WithError(err)
+	}
+
+	if forwarder.Options != nil && forwarder.Options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, forwarder.Options.Timeout*time.Millisecond)
+		defer cancel()
+	}
+
+	start := time.Now()
+	err = f.publisher.Publish(ctx, forwarder.Address, key, encoded.payload, brokerHeaders(encoded))
+	code := codes.OK
+	if err != nil {
+		code = codes.Unavailable
+	}
+	monitoring.ReportLatencyMetricInMillis(metrics.BrokerLatencyMetric, start, brokerForwarderServiceMetricLabel, event.Type, code.String())
+	if err != nil {
+		return code, errors.NewErrUnexpected("failed to publish event at \"%s\"", forwarder.Name).WithError(err)
+	}
+
+	return codes.OK, nil
+}
+
+// messageKey returns the key of the published messages, the room ID unless
+// the forwarder is configured to use the scheduler name.
+func messageKey(forwarder entities.Forwarder, schedulerID string, roomID string) string {
+	if forwarder.Options != nil && forwarder.Options.Broker != nil && forwarder.Options.Broker.Key == entities.BrokerKeyScheduler {
+		return schedulerID
+	}
+	return roomID
+}
+
+// brokerHeaders returns the headers of the CloudEvents published, the
+// messages of forwarders without CloudEvents have no headers.
+func brokerHeaders(encoded encodedEvent) map[string]string {
+	if !encoded.cloudEvent {
+		return nil
+	}
+
+	headers := map[string]string{BrokerContentTypeHeader: encoded.contentType}
+	for name, value := range encoded.attributes {
+		headers[BrokerCloudEventsHeaderPrefix+name] = value
+	}
+	return headers
+}
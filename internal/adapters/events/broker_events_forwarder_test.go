@@ -0,0 +1,191 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build unit
+// +build unit
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	memorypublisher "github.com/topfreegames/maestro/internal/adapters/publisher/memory/events"
+	"github.com/topfreegames/maestro/internal/core/entities/events"
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
+	"github.com/topfreegames/maestro/internal/core/ports/mock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestBrokerEventsForwarder_ForwardRoomEvent(t *testing.T) {
+	t.Run("publishes room events keyed by the room", func(t *testing.T) {
+		publisher := memorypublisher.NewMemoryEventsPublisher()
+		roomEvent := newRoomEventAttributes(events.Status, nil)
+		roomEvent.SchedulerID = "scheduler-test"
+
+		code, err := NewBrokerEventsForwarder(publisher).ForwardRoomEvent(context.Background(), roomEvent, newBrokerForwarder(""))
+		require.NoError(t, err)
+		require.Equal(t, codes.OK, code)
+
+		messages := publisher.Messages("room-events")
+		require.Len(t, messages, 1)
+		require.Equal(t, "123", messages[0].Key)
+		require.JSONEq(t, `{
+			"type": "roomStatus",
+			"scheduler": "scheduler-test",
+			"statusType": "ready",
+			"room": {
+				"game": "game-test",
+				"roomId": "123",
+				"host": "host.com",
+				"port": 5050,
+				"roomType": "red",
+				"metadata": {"roomType": "red", "ping": "true", "roomEvent": "ready"}
+			}
+		}`, string(messages[0].Payload))
+	})
+
+	t.Run("publishes room events keyed by the scheduler", func(t *testing.T) {
+		publisher := memorypublisher.NewMemoryEventsPublisher()
+		roomEvent := newRoomEventAttributes(events.Arbitrary, nil)
+		roomEvent.SchedulerID = "scheduler-test"
+
+		code, err := NewBrokerEventsForwarder(publisher).ForwardRoomEvent(context.Background(), roomEvent, newBrokerForwarder(forwarder.BrokerKeyScheduler))
+		require.NoError(t, err)
+		require.Equal(t, codes.OK, code)
+
+		messages := publisher.Messages("room-events")
+		require.Len(t, messages, 1)
+		require.Equal(t, "scheduler-test", messages[0].Key)
+	})
+
+	t.Run("publishes CloudEvents in the structured mode", func(t *testing.T) {
+		publisher := memorypublisher.NewMemoryEventsPublisher()
+		brokerForwarder := newBrokerForwarder("")
+		brokerForwarder.Options.CloudEvents = &forwarder.CloudEventsOptions{}
+		roomEvent := newRoomEventAttributes(events.Ping, nil)
+		roomEvent.SchedulerID = "scheduler-test"
+
+		code, err := NewBrokerEventsForwarder(publisher).ForwardRoomEvent(context.Background(), roomEvent, brokerForwarder)
+		require.NoError(t, err)
+		require.Equal(t, codes.OK, code)
+
+		messages := publisher.Messages("room-events")
+		require.Len(t, messages, 1)
+		require.Equal(t, map[string]string{BrokerContentTypeHeader: CloudEventsContentType}, messages[0].Headers)
+
+		var event cloudEvent
+		require.NoError(t, json.Unmarshal(messages[0].Payload, &event))
+		require.Equal(t, "maestro/scheduler-test", event.Source)
+		require.Equal(t, "room.resync.ready", event.Type)
+		require.Equal(t, "123", event.Subject)
+		require.Equal(t, "roomResync", event.Data.Type)
+	})
+
+	t.Run("publishes CloudEvents in the binary mode", func(t *testing.T) {
+		publisher := memorypublisher.NewMemoryEventsPublisher()
+		brokerForwarder := newBrokerForwarder("")
+		brokerForwarder.Options.CloudEvents = &forwarder.CloudEventsOptions{Mode: forwarder.CloudEventsBinary}
+		roomEvent := newRoomEventAttributes(events.Status, nil)
+		roomEvent.SchedulerID = "scheduler-test"
+
+		code, err := NewBrokerEventsForwarder(publisher).ForwardRoomEvent(context.Background(), roomEvent, brokerForwarder)
+		require.NoError(t, err)
+		require.Equal(t, codes.OK, code)
+
+		messages := publisher.Messages("room-events")
+		require.Len(t, messages, 1)
+		headers := messages[0].Headers
+		require.Equal(t, "application/json", headers[BrokerContentTypeHeader])
+		require.Equal(t, "1.0", headers["ce_specversion"])
+		require.NotEmpty(t, headers["ce_id"])
+		require.Equal(t, "maestro/scheduler-test", headers["ce_source"])
+		require.Equal(t, "room.status.ready", headers["ce_type"])
+		require.Equal(t, "123", headers["ce_subject"])
+		require.NotEmpty(t, headers["ce_time"])
+
+		var event jsonEvent
+		require.NoError(t, json.Unmarshal(messages[0].Payload, &event))
+		require.Equal(t, "roomStatus", event.Type)
+	})
+
+	t.Run("fails when the event can't be built", func(t *testing.T) {
+		publisher := memorypublisher.NewMemoryEventsPublisher()
+
+		code, err := NewBrokerEventsForwarder(publisher).ForwardRoomEvent(context.Background(), newRoomEventAttributes(events.Arbitrary, map[string]interface{}{}), newBrokerForwarder(""))
+		require.Error(t, err)
+		require.Equal(t, codes.InvalidArgument, code)
+		require.Empty(t, publisher.Messages("room-events"))
+	})
+
+	t.Run("fails when the publisher returns error", func(t *testing.T) {
+		publisher := mock.NewMockEventsPublisher(gomock.NewController(t))
+		publisher.EXPECT().Publish(gomock.Any(), "room-events", "123", gomock.Any(), nil).Return(errors.New("broker unavailable"))
+
+		code, err := NewBrokerEventsForwarder(publisher).ForwardRoomEvent(context.Background(), newRoomEventAttributes(events.Arbitrary, nil), newBrokerForwarder(""))
+		require.Error(t, err)
+		require.Equal(t, codes.Unavailable, code)
+	})
+}
+
+func TestBrokerEventsForwarder_ForwardPlayerEvent(t *testing.T) {
+	t.Run("publishes player events keyed by the room", func(t *testing.T) {
+		publisher := memorypublisher.NewMemoryEventsPublisher()
+		playerEvent := newPlayerEventAttributes()
+		playerEvent.SchedulerID = "scheduler-test"
+
+		code, err := NewBrokerEventsForwarder(publisher).ForwardPlayerEvent(context.Background(), playerEvent, newBrokerForwarder(""))
+		require.NoError(t, err)
+		require.Equal(t, codes.OK, code)
+
+		messages := publisher.Messages("room-events")
+		require.Len(t, messages, 1)
+		require.Equal(t, "123", messages[0].Key)
+		require.Nil(t, messages[0].Headers)
+
+		var event jsonEvent
+		require.NoError(t, json.Unmarshal(messages[0].Payload, &event))
+		require.Equal(t, "playerEvent", event.Type)
+		require.Equal(t, "scheduler-test", event.Scheduler)
+		require.Equal(t, "PLAYER_LEFT", event.EventType)
+		require.Equal(t, "123", event.PlayerID)
+	})
+}
+
+func newBrokerForwarder(key forwarder.BrokerKey) forwarder.Forwarder {
+	return forwarder.Forwarder{
+		Name:        "analytics",
+		Enabled:     true,
+		ForwardType: forwarder.TypeBroker,
+		Address:     "room-events",
+		Options: &forwarder.ForwardOptions{
+			Timeout:  time.Duration(1000),
+			Metadata: map[string]interface{}{"roomType": "red"},
+			Broker:   &forwarder.BrokerOptions{Key: key},
+		},
+	}
+}
@@ -0,0 +1,154 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package events
+
+import (
+	"sync"
+	"time"
+
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
+)
+
+const (
+	DefaultCircuitBreakerFailureThreshold = 5
+	DefaultCircuitBreakerOpenTimeout      = 30 * time.Second
+	DefaultCircuitBreakerHalfOpenMaxCalls = 1
+)
+
+// CircuitBreakerConfig has the thresholds of the forwarders circuit breakers.
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive failures that opens the
+	// circuit.
+	FailureThreshold int
+	// OpenTimeout is how long the circuit stays open before letting events
+	// through to check if the forwarder recovered.
+	OpenTimeout time.Duration
+	// HalfOpenMaxCalls is the number of concurrent events let through while
+	// the circuit is half-open.
+	HalfOpenMaxCalls int
+}
+
+// circuitBreaker tracks the failures of a forwarder address. It is safe for
+// concurrent use.
+type circuitBreaker struct {
+	mu            sync.Mutex
+	config        CircuitBreakerConfig
+	health        forwarder.Health
+	openedAt      time.Time
+	halfOpenCalls int
+}
+
+func newCircuitBreaker(address string, config CircuitBreakerConfig) *circuitBreaker {
+	return &circuitBreaker{
+		config: config,
+		health: *forwarder.NewHealthyForwarderHealth(address),
+	}
+}
+
+// allow returns whether an event can be forwarded, moving an open circuit to
+// half-open once its timeout has passed. Rejected events can be retried at
+// the returned time. Every allowed call must be followed by a record call.
+func (b *circuitBreaker) allow(now time.Time) (allowed bool, retryAt time.Time, changed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.health.State {
+	case forwarder.CircuitOpen:
+		if now.Sub(b.openedAt) < b.config.OpenTimeout {
+			return false, b.openedAt.Add(b.config.OpenTimeout), false
+		}
+		b.setState(forwarder.CircuitHalfOpen, now)
+		b.halfOpenCalls = 1
+		return true, time.Time{}, true
+	case forwarder.CircuitHalfOpen:
+		if b.halfOpenCalls >= b.config.HalfOpenMaxCalls {
+			// The events let through decide whether the circuit closes or
+			// opens again, so the rejected ones wait as if it opened now.
+			return false, now.Add(b.config.OpenTimeout), false
+		}
+		b.halfOpenCalls++
+		return true, time.Time{}, false
+	}
+	return true, time.Time{}, false
+}
+
+// record updates the circuit with the result of a forwarded event, returning
+// whether the circuit state changed.
+func (b *circuitBreaker) record(err error, now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.health.State {
+	case forwarder.CircuitOpen:
+		// Results of events let through before the circuit opened.
+		return false
+	case forwarder.CircuitHalfOpen:
+		b.halfOpenCalls--
+	}
+
+	if err == nil {
+		b.health.ConsecutiveFailures = 0
+		return b.setState(forwarder.CircuitClosed, now)
+	}
+
+	b.health.ConsecutiveFailures++
+	b.health.LastError = err.Error()
+	b.health.LastFailureAt = now
+	if b.health.State == forwarder.CircuitHalfOpen || b.health.ConsecutiveFailures >= b.config.FailureThreshold {
+		b.openedAt = now
+		return b.setState(forwarder.CircuitOpen, now)
+	}
+	return false
+}
+
+// release ends an allowed call without recording its result, used when the
+// call failed before reaching the forwarder.
+func (b *circuitBreaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.health.State == forwarder.CircuitHalfOpen {
+		b.halfOpenCalls--
+	}
+}
+
+// snapshot returns a copy of the circuit health.
+func (b *circuitBreaker) snapshot() *forwarder.Health {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	health := b.health
+	return &health
+}
+
+// setState moves the circuit to the given state, returning whether it
+// changed.
+func (b *circuitBreaker) setState(state forwarder.CircuitState, now time.Time) bool {
+	if b.health.State == state {
+		return false
+	}
+	b.health.State = state
+	b.health.UpdatedAt = now
+	reportCircuitBreakerState(b.health.Address, state)
+	return true
+}
@@ -0,0 +1,142 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+
+	"github.com/topfreegames/maestro/internal/core/entities/events"
+	entities "github.com/topfreegames/maestro/internal/core/entities/forwarder"
+	"github.com/topfreegames/maestro/internal/core/ports"
+)
+
+var (
+	_ ports.EventsForwarder = (*circuitBreakerEventsForwarder)(nil)
+)
+
+// circuitBreakerEventsForwarder stops forwarding events to the forwarders
+// addresses that keep failing, keeping a circuit breaker per address. The
+// circuits are kept in memory, and their health is saved on the health
+// storage every time their state changes.
+type circuitBreakerEventsForwarder struct {
+	eventsForwarder ports.EventsForwarder
+	healthStorage   ports.ForwarderHealthStorage
+	clock           ports.Clock
+	config          CircuitBreakerConfig
+
+	mu       sync.Mutex
+	breakers map[string]*circuitBreaker
+}
+
+// NewCircuitBreakerEventsForwarder instantiates an events forwarder that
+// wraps the given one with a circuit breaker per forwarder address. Zero
+// config values are replaced by their defaults.
+func NewCircuitBreakerEventsForwarder(eventsForwarder ports.EventsForwarder, healthStorage ports.ForwarderHealthStorage, clock ports.Clock, config CircuitBreakerConfig) *circuitBreakerEventsForwarder {
+	if config.FailureThreshold <= 0 {
+		config.FailureThreshold = DefaultCircuitBreakerFailureThreshold
+	}
+	if config.OpenTimeout <= 0 {
+		config.OpenTimeout = DefaultCircuitBreakerOpenTimeout
+	}
+	if config.HalfOpenMaxCalls <= 0 {
+		config.HalfOpenMaxCalls = DefaultCircuitBreakerHalfOpenMaxCalls
+	}
+
+	return &circuitBreakerEventsForwarder{
+		eventsForwarder: eventsForwarder,
+		healthStorage:   healthStorage,
+		clock:           clock,
+		config:          config,
+		breakers:        map[string]*circuitBreaker{},
+	}
+}
+
+// ForwardRoomEvent forwards room events. It receives the room event attributes and forwarder configuration.
+func (f *circuitBreakerEventsForwarder) ForwardRoomEvent(ctx context.Context, eventAttributes events.RoomEventAttributes, forwarder entities.Forwarder) (codes.Code, error) {
+	return f.forward(ctx, forwarder, func() (codes.Code, error) {
+		return f.eventsForwarder.ForwardRoomEvent(ctx, eventAttributes, forwarder)
+	})
+}
+
+// ForwardPlayerEvent forwards a player events. It receives the player events attributes and forwarder configuration.
+func (f *circuitBreakerEventsForwarder) ForwardPlayerEvent(ctx context.Context, eventAttributes events.PlayerEventAttributes, forwarder entities.Forwarder) (codes.Code, error) {
+	return f.forward(ctx, forwarder, func() (codes.Code, error) {
+		return f.eventsForwarder.ForwardPlayerEvent(ctx, eventAttributes, forwarder)
+	})
+}
+
+// Name returns the forwarder name. This name should be unique among other events forwarders.
+func (*circuitBreakerEventsForwarder) Name() string {
+	return "circuit_breaker_forwarder"
+}
+
+func (f *circuitBreakerEventsForwarder) forward(ctx context.Context, forwarder entities.Forwarder, forwardFunc func() (codes.Code, error)) (codes.Code, error) {
+	breaker := f.breakerFor(forwarder.Address)
+
+	allowed, retryAt, changed := breaker.allow(f.clock.Now())
+	if changed {
+		f.saveHealth(ctx, breaker)
+	}
+	if !allowed {
+		reportCircuitBreakerRejectedEvent(forwarder.Address)
+		return codes.Unavailable, &entities.CircuitOpenError{ForwarderName: forwarder.Name, Address: forwarder.Address, RetryAt: retryAt}
+	}
+
+	code, err := forwardFunc()
+	// Invalid events fail before reaching the forwarder, so they say nothing
+	// about its health.
+	if err != nil && code == codes.InvalidArgument {
+		breaker.release()
+		return code, err
+	}
+
+	if breaker.record(err, f.clock.Now()) {
+		f.saveHealth(ctx, breaker)
+	}
+	return code, err
+}
+
+func (f *circuitBreakerEventsForwarder) breakerFor(address string) *circuitBreaker {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	breaker, ok := f.breakers[address]
+	if !ok {
+		breaker = newCircuitBreaker(address, f.config)
+		f.breakers[address] = breaker
+	}
+	return breaker
+}
+
+func (f *circuitBreakerEventsForwarder) saveHealth(ctx context.Context, breaker *circuitBreaker) {
+	health := breaker.snapshot()
+	err := f.healthStorage.SetForwarderHealth(ctx, health)
+	if err != nil {
+		zap.L().Error(fmt.Sprintf("failed to save health of forwarder at \"%s\"", health.Address), zap.Error(err))
+	}
+}
@@ -0,0 +1,207 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build unit
+// +build unit
+
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/topfreegames/maestro/internal/core/entities/events"
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
+	"github.com/topfreegames/maestro/internal/core/ports/mock"
+)
+
+// testClock is a clock that only moves when the test advances it.
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func TestCircuitBreakerEventsForwarder(t *testing.T) {
+	config := CircuitBreakerConfig{FailureThreshold: 2, OpenTimeout: time.Minute, HalfOpenMaxCalls: 1}
+	errUnavailable := errors.New("unavailable")
+
+	setup := func(t *testing.T) (*mock.MockEventsForwarder, *mock.MockForwarderHealthStorage, *testClock, *circuitBreakerEventsForwarder) {
+		mockCtrl := gomock.NewController(t)
+		eventsForwarder := mock.NewMockEventsForwarder(mockCtrl)
+		healthStorage := mock.NewMockForwarderHealthStorage(mockCtrl)
+		clock := &testClock{now: time.Now()}
+		return eventsForwarder, healthStorage, clock, NewCircuitBreakerEventsForwarder(eventsForwarder, healthStorage, clock, config)
+	}
+
+	openCircuit := func(t *testing.T, eventsForwarder *mock.MockEventsForwarder, healthStorage *mock.MockForwarderHealthStorage, breakerForwarder *circuitBreakerEventsForwarder) {
+		eventsForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), gomock.Any(), gomock.Any()).Return(codes.Unavailable, errUnavailable).Times(2)
+		healthStorage.EXPECT().SetForwarderHealth(gomock.Any(), gomock.Any()).Return(nil)
+
+		for i := 0; i < 2; i++ {
+			_, err := breakerForwarder.ForwardPlayerEvent(context.Background(), newPlayerEventAttributes(), newStaticForwarder())
+			require.ErrorIs(t, err, errUnavailable)
+		}
+	}
+
+	t.Run("forwards the events while the forwarder is healthy", func(t *testing.T) {
+		eventsForwarder, _, _, breakerForwarder := setup(t)
+
+		eventsForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), gomock.Any(), newStaticForwarder()).Return(codes.OK, nil)
+		eventsForwarder.EXPECT().ForwardRoomEvent(gomock.Any(), gomock.Any(), newStaticForwarder()).Return(codes.OK, nil)
+
+		code, err := breakerForwarder.ForwardPlayerEvent(context.Background(), newPlayerEventAttributes(), newStaticForwarder())
+		require.NoError(t, err)
+		require.Equal(t, codes.OK, code)
+
+		code, err = breakerForwarder.ForwardRoomEvent(context.Background(), newRoomEventAttributes(events.Arbitrary, nil), newStaticForwarder())
+		require.NoError(t, err)
+		require.Equal(t, codes.OK, code)
+	})
+
+	t.Run("opens the circuit after consecutive failures and rejects the events", func(t *testing.T) {
+		eventsForwarder, healthStorage, clock, breakerForwarder := setup(t)
+
+		eventsForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), gomock.Any(), gomock.Any()).Return(codes.Unavailable, errUnavailable).Times(2)
+		healthStorage.EXPECT().SetForwarderHealth(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, health *forwarder.Health) error {
+			require.Equal(t, "matchmaker-service:8080", health.Address)
+			require.Equal(t, forwarder.CircuitOpen, health.State)
+			require.Equal(t, 2, health.ConsecutiveFailures)
+			require.Equal(t, "unavailable", health.LastError)
+			return nil
+		})
+
+		for i := 0; i < 2; i++ {
+			_, err := breakerForwarder.ForwardPlayerEvent(context.Background(), newPlayerEventAttributes(), newStaticForwarder())
+			require.ErrorIs(t, err, errUnavailable)
+		}
+
+		code, err := breakerForwarder.ForwardPlayerEvent(context.Background(), newPlayerEventAttributes(), newStaticForwarder())
+		require.ErrorIs(t, err, forwarder.ErrCircuitOpen)
+		require.Equal(t, codes.Unavailable, code)
+		var circuitOpenErr *forwarder.CircuitOpenError
+		require.ErrorAs(t, err, &circuitOpenErr)
+		require.Equal(t, clock.now.Add(config.OpenTimeout), circuitOpenErr.RetryAt)
+	})
+
+	t.Run("keeps a circuit per forwarder address", func(t *testing.T) {
+		eventsForwarder, healthStorage, _, breakerForwarder := setup(t)
+		openCircuit(t, eventsForwarder, healthStorage, breakerForwarder)
+
+		otherForwarder := newStaticForwarder()
+		otherForwarder.Address = "other-service:8080"
+		eventsForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), gomock.Any(), otherForwarder).Return(codes.OK, nil)
+
+		_, err := breakerForwarder.ForwardPlayerEvent(context.Background(), newPlayerEventAttributes(), otherForwarder)
+		require.NoError(t, err)
+	})
+
+	t.Run("closes the circuit when an event succeeds after the open timeout", func(t *testing.T) {
+		eventsForwarder, healthStorage, clock, breakerForwarder := setup(t)
+		openCircuit(t, eventsForwarder, healthStorage, breakerForwarder)
+
+		clock.now = clock.now.Add(config.OpenTimeout)
+		eventsForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), gomock.Any(), gomock.Any()).Return(codes.OK, nil)
+		healthStorage.EXPECT().SetForwarderHealth(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, health *forwarder.Health) error {
+			require.Equal(t, forwarder.CircuitHalfOpen, health.State)
+			return nil
+		})
+		healthStorage.EXPECT().SetForwarderHealth(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, health *forwarder.Health) error {
+			require.Equal(t, forwarder.CircuitClosed, health.State)
+			require.Equal(t, 0, health.ConsecutiveFailures)
+			return nil
+		})
+
+		_, err := breakerForwarder.ForwardPlayerEvent(context.Background(), newPlayerEventAttributes(), newStaticForwarder())
+		require.NoError(t, err)
+	})
+
+	t.Run("opens the circuit again when an event fails after the open timeout", func(t *testing.T) {
+		eventsForwarder, healthStorage, clock, breakerForwarder := setup(t)
+		openCircuit(t, eventsForwarder, healthStorage, breakerForwarder)
+
+		clock.now = clock.now.Add(config.OpenTimeout)
+		eventsForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), gomock.Any(), gomock.Any()).Return(codes.Unavailable, errUnavailable)
+		healthStorage.EXPECT().SetForwarderHealth(gomock.Any(), gomock.Any()).Return(nil)
+		healthStorage.EXPECT().SetForwarderHealth(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, health *forwarder.Health) error {
+			require.Equal(t, forwarder.CircuitOpen, health.State)
+			return nil
+		})
+
+		_, err := breakerForwarder.ForwardPlayerEvent(context.Background(), newPlayerEventAttributes(), newStaticForwarder())
+		require.ErrorIs(t, err, errUnavailable)
+
+		code, err := breakerForwarder.ForwardPlayerEvent(context.Background(), newPlayerEventAttributes(), newStaticForwarder())
+		require.ErrorIs(t, err, forwarder.ErrCircuitOpen)
+		require.Equal(t, codes.Unavailable, code)
+	})
+
+	t.Run("doesn't count invalid events as failures", func(t *testing.T) {
+		eventsForwarder, _, _, breakerForwarder := setup(t)
+
+		eventsForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), gomock.Any(), gomock.Any()).Return(codes.InvalidArgument, errors.New("invalid")).Times(3)
+
+		for i := 0; i < 3; i++ {
+			code, err := breakerForwarder.ForwardPlayerEvent(context.Background(), newPlayerEventAttributes(), newStaticForwarder())
+			require.Error(t, err)
+			require.Equal(t, codes.InvalidArgument, code)
+		}
+	})
+
+	t.Run("only saves the health when the circuit state changes", func(t *testing.T) {
+		eventsForwarder, _, _, breakerForwarder := setup(t)
+
+		eventsForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), gomock.Any(), gomock.Any()).Return(codes.Unavailable, errUnavailable)
+		eventsForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), gomock.Any(), gomock.Any()).Return(codes.OK, nil)
+
+		_, err := breakerForwarder.ForwardPlayerEvent(context.Background(), newPlayerEventAttributes(), newStaticForwarder())
+		require.ErrorIs(t, err, errUnavailable)
+
+		_, err = breakerForwarder.ForwardPlayerEvent(context.Background(), newPlayerEventAttributes(), newStaticForwarder())
+		require.NoError(t, err)
+	})
+
+	t.Run("forwards the events even when the health can't be saved", func(t *testing.T) {
+		eventsForwarder, healthStorage, clock, breakerForwarder := setup(t)
+
+		eventsForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), gomock.Any(), gomock.Any()).Return(codes.Unavailable, errUnavailable).Times(2)
+		eventsForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), gomock.Any(), gomock.Any()).Return(codes.OK, nil)
+		healthStorage.EXPECT().SetForwarderHealth(gomock.Any(), gomock.Any()).Return(errors.New("error")).Times(3)
+
+		for i := 0; i < 2; i++ {
+			_, err := breakerForwarder.ForwardPlayerEvent(context.Background(), newPlayerEventAttributes(), newStaticForwarder())
+			require.ErrorIs(t, err, errUnavailable)
+		}
+
+		clock.now = clock.now.Add(config.OpenTimeout)
+		_, err := breakerForwarder.ForwardPlayerEvent(context.Background(), newPlayerEventAttributes(), newStaticForwarder())
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,141 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package events
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	entities "github.com/topfreegames/maestro/internal/core/entities/forwarder"
+)
+
+const (
+	cloudEventsSpecVersion = "1.0"
+	// CloudEventsContentType is the content type of the events sent in the
+	// CloudEvents structured mode.
+	CloudEventsContentType = "application/cloudevents+json"
+	jsonContentType        = "application/json"
+)
+
+// cloudEvent is the CloudEvents 1.0 envelope of the events sent by the
+// forwarders configured with CloudEvents. Its data is the same JSON event
+// sent by the forwarders without it.
+type cloudEvent struct {
+	SpecVersion     string    `json:"specversion"`
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	Type            string    `json:"type"`
+	Subject         string    `json:"subject,omitempty"`
+	Time            string    `json:"time"`
+	DataContentType string    `json:"datacontenttype"`
+	Data            jsonEvent `json:"data"`
+}
+
+// newCloudEvent wraps the event, identified by the ID and recording time of
+// the event so its retries can be deduplicated. Events without them, like the
+// forwarders test ones, get new ones.
+func newCloudEvent(event jsonEvent) cloudEvent {
+	id, createdAt := event.ID, event.CreatedAt
+	if id == "" {
+		id = uuid.NewString()
+	}
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
+	return cloudEvent{
+		SpecVersion:     cloudEventsSpecVersion,
+		ID:              id,
+		Source:          "maestro/" + event.Scheduler,
+		Type:            cloudEventType(event),
+		Subject:         event.Room.RoomID,
+		Time:            createdAt.UTC().Format(time.RFC3339Nano),
+		DataContentType: jsonContentType,
+		Data:            event,
+	}
+}
+
+// attributes returns the CloudEvent context attributes, which are sent as
+// headers in the binary mode.
+func (e cloudEvent) attributes() map[string]string {
+	attributes := map[string]string{
+		"specversion": e.SpecVersion,
+		"id":          e.ID,
+		"source":      e.Source,
+		"type":        e.Type,
+		"time":        e.Time,
+	}
+	if e.Subject != "" {
+		attributes["subject"] = e.Subject
+	}
+	return attributes
+}
+
+// cloudEventType returns the CloudEvent type of the event, e.g.
+// room.status.ready or player.joined.
+func cloudEventType(event jsonEvent) string {
+	switch event.Type {
+	case jsonEventRoomStatus:
+		return "room.status." + event.StatusType
+	case jsonEventRoomResync:
+		return "room.resync." + event.StatusType
+	case jsonEventRoomEvent:
+		return "room.event"
+	case jsonEventPlayerEvent:
+		// The player event types are PLAYER_JOINED and PLAYER_LEFT.
+		return "player." + strings.ToLower(strings.TrimPrefix(event.EventType, "PLAYER_"))
+	}
+	return event.Type
+}
+
+// encodedEvent is the payload of an event in the format configured on the
+// forwarder.
+type encodedEvent struct {
+	payload     []byte
+	contentType string
+	cloudEvent  bool
+	// attributes are the CloudEvent attributes sent as headers in the
+	// binary mode.
+	attributes map[string]string
+}
+
+// encodeEvent encodes the event as JSON, or as a CloudEvent when the
+// forwarder is configured with CloudEvents.
+func encodeEvent(event jsonEvent, forwarder entities.Forwarder) (encodedEvent, error) {
+	if forwarder.Options == nil || forwarder.Options.CloudEvents == nil {
+		payload, err := json.Marshal(event)
+		return encodedEvent{payload: payload, contentType: jsonContentType}, err
+	}
+
+	cloudEvent := newCloudEvent(event)
+	if forwarder.Options.CloudEvents.Mode == entities.CloudEventsBinary {
+		payload, err := json.Marshal(event)
+		return encodedEvent{payload: payload, contentType: jsonContentType, cloudEvent: true, attributes: cloudEvent.attributes()}, err
+	}
+
+	payload, err := json.Marshal(cloudEvent)
+	return encodedEvent{payload: payload, contentType: CloudEventsContentType, cloudEvent: true}, err
+}
@@ -0,0 +1,131 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build unit
+// +build unit
+
+package events
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
+)
+
+func TestCloudEventType(t *testing.T) {
+	eventTypes := map[string]jsonEvent{
+		"room.status.ready":       {Type: jsonEventRoomStatus, StatusType: "ready"},
+		"room.status.terminating": {Type: jsonEventRoomStatus, StatusType: "terminating"},
+		"room.resync.occupied":    {Type: jsonEventRoomResync, StatusType: "occupied"},
+		"room.event":              {Type: jsonEventRoomEvent, EventType: "ready"},
+		"player.joined":           {Type: jsonEventPlayerEvent, EventType: "PLAYER_JOINED"},
+		"player.left":             {Type: jsonEventPlayerEvent, EventType: "PLAYER_LEFT"},
+	}
+	for expectedType, event := range eventTypes {
+		require.Equal(t, expectedType, cloudEventType(event))
+	}
+}
+
+func TestEncodeEvent(t *testing.T) {
+	event := jsonEvent{
+		Type:      jsonEventPlayerEvent,
+		Scheduler: "scheduler-test",
+		Room:      jsonRoom{Game: "game-test", RoomID: "room-1"},
+		EventType: "PLAYER_JOINED",
+		PlayerID:  "player-1",
+	}
+	newForwarder := func(cloudEvents *forwarder.CloudEventsOptions) forwarder.Forwarder {
+		return forwarder.Forwarder{Name: "webhook", Options: &forwarder.ForwardOptions{CloudEvents: cloudEvents}}
+	}
+
+	t.Run("encodes the event as JSON when the forwarder has no CloudEvents", func(t *testing.T) {
+		encoded, err := encodeEvent(event, newForwarder(nil))
+		require.NoError(t, err)
+		require.Equal(t, "application/json", encoded.contentType)
+		require.False(t, encoded.cloudEvent)
+		require.Nil(t, encoded.attributes)
+
+		var decoded jsonEvent
+		require.NoError(t, json.Unmarshal(encoded.payload, &decoded))
+		require.Equal(t, event, decoded)
+	})
+
+	t.Run("encodes the event with its attributes in the structured mode", func(t *testing.T) {
+		encoded, err := encodeEvent(event, newForwarder(&forwarder.CloudEventsOptions{Mode: forwarder.CloudEventsStructured}))
+		require.NoError(t, err)
+		require.Equal(t, CloudEventsContentType, encoded.contentType)
+		require.True(t, encoded.cloudEvent)
+		require.Nil(t, encoded.attributes)
+
+		var decoded cloudEvent
+		require.NoError(t, json.Unmarshal(encoded.payload, &decoded))
+		require.Equal(t, "1.0", decoded.SpecVersion)
+		require.NotEmpty(t, decoded.ID)
+		require.Equal(t, "maestro/scheduler-test", decoded.Source)
+		require.Equal(t, "player.joined", decoded.Type)
+		require.Equal(t, "room-1", decoded.Subject)
+		require.NotEmpty(t, decoded.Time)
+		require.Equal(t, "application/json", decoded.DataContentType)
+		require.Equal(t, event, decoded.Data)
+	})
+
+	t.Run("encodes the event data with the attributes apart in the binary mode", func(t *testing.T) {
+		encoded, err := encodeEvent(event, newForwarder(&forwarder.CloudEventsOptions{Mode: forwarder.CloudEventsBinary}))
+		require.NoError(t, err)
+		require.Equal(t, "application/json", encoded.contentType)
+		require.True(t, encoded.cloudEvent)
+		require.Equal(t, "1.0", encoded.attributes["specversion"])
+		require.NotEmpty(t, encoded.attributes["id"])
+		require.Equal(t, "maestro/scheduler-test", encoded.attributes["source"])
+		require.Equal(t, "player.joined", encoded.attributes["type"])
+		require.Equal(t, "room-1", encoded.attributes["subject"])
+		require.NotEmpty(t, encoded.attributes["time"])
+
+		var decoded jsonEvent
+		require.NoError(t, json.Unmarshal(encoded.payload, &decoded))
+		require.Equal(t, event, decoded)
+	})
+
+	t.Run("identifies the CloudEvent by the event ID and recording time", func(t *testing.T) {
+		recordedEvent := event
+		recordedEvent.ID = "event-1"
+		recordedEvent.CreatedAt = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+		encoded, err := encodeEvent(recordedEvent, newForwarder(&forwarder.CloudEventsOptions{Mode: forwarder.CloudEventsStructured}))
+		require.NoError(t, err)
+
+		var decoded cloudEvent
+		require.NoError(t, json.Unmarshal(encoded.payload, &decoded))
+		require.Equal(t, "event-1", decoded.ID)
+		require.Equal(t, "2024-01-02T03:04:05Z", decoded.Time)
+		require.Equal(t, event, decoded.Data)
+
+		encoded, err = encodeEvent(recordedEvent, newForwarder(&forwarder.CloudEventsOptions{Mode: forwarder.CloudEventsBinary}))
+		require.NoError(t, err)
+		require.Equal(t, "event-1", encoded.attributes["id"])
+		require.Equal(t, "2024-01-02T03:04:05Z", encoded.attributes["time"])
+	})
+}
@@ -25,6 +25,7 @@ package events
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/topfreegames/maestro/internal/core/ports"
 
@@ -33,7 +34,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
 	"github.com/opentracing/opentracing-go"
 	"github.com/patrickmn/go-cache"
-	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
+	entities "github.com/topfreegames/maestro/internal/core/entities/forwarder"
 	"github.com/topfreegames/maestro/internal/core/ports/errors"
 	pb "github.com/topfreegames/protos/maestro/grpc/generated"
 	"go.uber.org/zap"
@@ -52,6 +53,11 @@ const (
 	DefaultKeepAliveTime                = 30 * time.Second
 	DefaultKeepAliveTimeout             = 10 * time.Second
 	DefaultKeepAlivePermitWithoutStream = true
+
+	// secretsCacheTTL is how long the resolved secrets are kept, so the
+	// rotated secrets are used by the requests and TLS handshakes made after
+	// a while.
+	secretsCacheTTL = time.Minute
 )
 
 type ForwarderClientConfig struct {
@@ -60,14 +66,21 @@ type ForwarderClientConfig struct {
 
 // ForwarderClient is a struct that holds grpc clients to be used by forwarders.
 type ForwarderClient struct {
-	c      *cache.Cache
-	config ForwarderClientConfig
+	c            *cache.Cache
+	config       ForwarderClientConfig
+	secrets      SecretResolver
+	secretsCache *cache.Cache
+	// uncached clients close the connection after each event, instead of
+	// keeping it on the cache.
+	uncached bool
 }
 
-// NewForwarderClient instantiate a new grpc forwarder client.
-func NewForwarderClient(keepAliveCfg keepalive.ClientParameters) *ForwarderClient {
-	cache := cache.New(24*time.Hour, 0)
-	cache.OnEvicted(func(_key string, clientFromCache interface{}) {
+// NewForwarderClient instantiate a new grpc forwarder client. The secrets
+// resolver loads the TLS certificates and credentials referenced by the
+// forwarders, it can be nil when they're not used.
+func NewForwarderClient(keepAliveCfg keepalive.ClientParameters, secrets SecretResolver) *ForwarderClient {
+	connections := cache.New(24*time.Hour, 0)
+	connections.OnEvicted(func(_key string, clientFromCache interface{}) {
 		ForwarderClient := clientFromCache.(*grpc.ClientConn)
 		ForwarderClient.Close()
 	})
@@ -85,17 +98,30 @@ func NewForwarderClient(keepAliveCfg keepalive.ClientParameters) *ForwarderClien
 		config.KeepAlive.Timeout = keepAliveCfg.Timeout
 	}
 	return &ForwarderClient{
-		c:      cache,
-		config: config,
+		c:            connections,
+		config:       config,
+		secrets:      secrets,
+		secretsCache: cache.New(secretsCacheTTL, secretsCacheTTL),
 	}
 }
 
+// NewUncachedForwarderClient instantiate a grpc forwarder client that
+// connects to the forwarder for every event, closing the connection once it
+// is sent. It is used by the connectivity tests, whose connections aren't
+// reused.
+func NewUncachedForwarderClient(keepAliveCfg keepalive.ClientParameters, secrets SecretResolver) *ForwarderClient {
+	client := NewForwarderClient(keepAliveCfg, secrets)
+	client.uncached = true
+	return client
+}
+
 // SendRoomEvent fetch or create a grpc client and send a room event to forwarder.
-func (f *ForwarderClient) SendRoomEvent(ctx context.Context, forwarder forwarder.Forwarder, in *pb.RoomEvent) (*pb.Response, error) {
-	client, err := f.getGrpcClient(Address(forwarder.Address))
+func (f *ForwarderClient) SendRoomEvent(ctx context.Context, forwarder entities.Forwarder, in *pb.RoomEvent) (*pb.Response, error) {
+	client, ctx, release, err := f.clientFor(ctx, in.GetRoom().GetGame(), forwarder)
 	if err != nil {
-		return nil, errors.NewErrUnexpected("failed to connect at %s", forwarder.Address).WithError(err)
+		return nil, err
 	}
+	defer release()
 
 	ctx, cancel := context.WithTimeout(ctx, forwarder.Options.Timeout*time.Millisecond)
 	defer cancel()
@@ -106,11 +132,12 @@ func (f *ForwarderClient) SendRoomEvent(ctx context.Context, forwarder forwarder
 }
 
 // SendRoomReSync fetch or create a grpc client and send a room resync to forwarder.
-func (f *ForwarderClient) SendRoomReSync(ctx context.Context, forwarder forwarder.Forwarder, in *pb.RoomStatus) (*pb.Response, error) {
-	client, err := f.getGrpcClient(Address(forwarder.Address))
+func (f *ForwarderClient) SendRoomReSync(ctx context.Context, forwarder entities.Forwarder, in *pb.RoomStatus) (*pb.Response, error) {
+	client, ctx, release, err := f.clientFor(ctx, in.GetRoom().GetGame(), forwarder)
 	if err != nil {
-		return nil, errors.NewErrUnexpected("failed to connect at %s", forwarder.Address).WithError(err)
+		return nil, err
 	}
+	defer release()
 
 	ctx, cancel := context.WithTimeout(ctx, forwarder.Options.Timeout*time.Millisecond)
 	defer cancel()
@@ -120,11 +147,12 @@ func (f *ForwarderClient) SendRoomReSync(ctx context.Context, forwarder forwarde
 }
 
 // SendRoomStatus fetch or create a grpc client and send a room status event to forwarder.
-func (f *ForwarderClient) SendRoomStatus(ctx context.Context, forwarder forwarder.Forwarder, in *pb.RoomStatus) (*pb.Response, error) {
-	client, err := f.getGrpcClient(Address(forwarder.Address))
+func (f *ForwarderClient) SendRoomStatus(ctx context.Context, forwarder entities.Forwarder, in *pb.RoomStatus) (*pb.Response, error) {
+	client, ctx, release, err := f.clientFor(ctx, in.GetRoom().GetGame(), forwarder)
 	if err != nil {
-		return nil, errors.NewErrUnexpected("failed to connect at %s", forwarder.Address).WithError(err)
+		return nil, err
 	}
+	defer release()
 
 	ctx, cancel := context.WithTimeout(ctx, forwarder.Options.Timeout*time.Millisecond)
 	defer cancel()
@@ -134,11 +162,12 @@ func (f *ForwarderClient) SendRoomStatus(ctx context.Context, forwarder forwarde
 }
 
 // SendPlayerEvent fetch or create a grpc client and send a player event to forwarder.
-func (f *ForwarderClient) SendPlayerEvent(ctx context.Context, forwarder forwarder.Forwarder, in *pb.PlayerEvent) (*pb.Response, error) {
-	client, err := f.getGrpcClient(Address(forwarder.Address))
+func (f *ForwarderClient) SendPlayerEvent(ctx context.Context, forwarder entities.Forwarder, in *pb.PlayerEvent) (*pb.Response, error) {
+	client, ctx, release, err := f.clientFor(ctx, in.GetRoom().GetGame(), forwarder)
 	if err != nil {
-		return nil, errors.NewErrUnexpected("failed to connect at %s", forwarder.Address).WithError(err)
+		return nil, err
 	}
+	defer release()
 
 	ctx, cancel := context.WithTimeout(ctx, forwarder.Options.Timeout*time.Millisecond)
 	defer cancel()
@@ -152,38 +181,92 @@ func (f *ForwarderClient) CacheFlush() {
 	f.c.Flush()
 }
 
-// CacheDelete delete a connection from the cache.
+// CacheDelete delete the connections to the address from the cache.
 func (f *ForwarderClient) CacheDelete(forwarderAddress string) error {
 	if forwarderAddress == "" {
 		return errors.NewErrInvalidArgument("no grpc server address informed")
 	}
-	_, found := f.c.Get(forwarderAddress)
+
+	found := false
+	for key := range f.c.Items() {
+		if key == forwarderAddress || strings.HasPrefix(key, forwarderAddress+"|") {
+			f.c.Delete(key)
+			found = true
+		}
+	}
 	if !found {
 		return errors.NewErrNotFound("could not found forwarder Address in cache %s", forwarderAddress)
 	}
-	f.c.Delete(forwarderAddress)
 	return nil
 }
 
-func (f *ForwarderClient) getGrpcClient(address Address) (pb.GRPCForwarderClient, error) {
+// clientFor returns the client of the forwarder, and the request context
+// with the forwarder credentials, loaded from the secrets of the game. The
+// release function must be called once the client is no longer used.
+func (f *ForwarderClient) clientFor(ctx context.Context, game string, forwarder entities.Forwarder) (pb.GRPCForwarderClient, context.Context, func(), error) {
+	var grpcOptions *entities.GRPCOptions
+	if forwarder.Options != nil && forwarder.Options.GRPC != nil {
+		grpcOptions = forwarder.Options.GRPC
+	}
+	// Credentials are never sent over plaintext connections.
+	if grpcOptions != nil && grpcOptions.Auth != nil && grpcOptions.TLS == nil {
+		return nil, nil, nil, errors.NewErrInvalidArgument("forwarder \"%s\" sends credentials without TLS", forwarder.Name)
+	}
+
+	if grpcOptions != nil && grpcOptions.Auth != nil {
+		var err error
+		ctx, err = withAuthMetadata(ctx, grpcOptions.Auth, f.gameSecret(game))
+		if err != nil {
+			return nil, nil, nil, errors.NewErrUnexpected("failed to load credentials of forwarder \"%s\"", forwarder.Name).WithError(err)
+		}
+	}
+
+	if f.uncached {
+		conn, err := f.createGRPCConnection(game, forwarder.Address, grpcOptions)
+		if err != nil {
+			return nil, nil, nil, errors.NewErrUnexpected("failed to connect at %s", forwarder.Address).WithError(err)
+		}
+		return pb.NewGRPCForwarderClient(conn), ctx, func() { conn.Close() }, nil
+	}
+
+	client, err := f.getGrpcClient(game, Address(forwarder.Address), grpcOptions)
+	if err != nil {
+		return nil, nil, nil, errors.NewErrUnexpected("failed to connect at %s", forwarder.Address).WithError(err)
+	}
+	return client, ctx, func() {}, nil
+}
+
+func (f *ForwarderClient) getGrpcClient(game string, address Address, options *entities.GRPCOptions) (pb.GRPCForwarderClient, error) {
 	if address == "" {
 		return nil, errors.NewErrInvalidArgument("no grpc server address informed")
 	}
 
-	clientFromCacheInterface, found := f.c.Get(string(address))
+	key := connectionKey(game, address, options)
+	clientFromCacheInterface, found := f.c.Get(key)
 	if !found {
-		client, err := f.createGRPCConnection(string(address))
+		client, err := f.createGRPCConnection(game, string(address), options)
 		if err != nil {
 			return nil, err
 		}
 		f.c.DeleteExpired()
-		f.c.Set(string(address), client, cache.DefaultExpiration)
+		f.c.Set(key, client, cache.DefaultExpiration)
 		return pb.NewGRPCForwarderClient(client), nil
 	}
 	return pb.NewGRPCForwarderClient((clientFromCacheInterface).(*grpc.ClientConn)), nil
 }
 
-func (f *ForwarderClient) createGRPCConnection(address string) (*grpc.ClientConn, error) {
+// connectionKey identifies the connections on the cache, the forwarders of the
+// same game with the same address and TLS configuration share their
+// connection.
+func connectionKey(game string, address Address, options *entities.GRPCOptions) string {
+	if options == nil || options.TLS == nil {
+		return string(address)
+	}
+	tlsOptions := options.TLS
+	return fmt.Sprintf("%s|tls|%s|%s|%s|%s|%s", address, game, tlsOptions.CASecretRef, tlsOptions.CertSecretRef, tlsOptions.KeySecretRef, tlsOptions.ServerName)
+}
+
+func (f *ForwarderClient) createGRPCConnection(game, address string, options *entities.GRPCOptions) (*grpc.ClientConn, error) {
 	if address == "" {
 		return nil, errors.NewErrInvalidArgument("no rpc server address informed")
 	}
@@ -192,6 +275,14 @@ func (f *ForwarderClient) createGRPCConnection(address string) (*grpc.ClientConn
 
 	tracer := opentracing.GlobalTracer()
 	dialOption := grpc.WithInsecure() //nolint:staticcheck // I want to use deprecated method.
+	if options != nil && options.TLS != nil {
+		transportCredentials, err := newTransportCredentials(options.TLS, f.gameSecret(game))
+		if err != nil {
+			zap.L().Error(fmt.Sprintf("failed to load TLS credentials of grpc server at: %s", address), zap.Error(err))
+			return nil, err
+		}
+		dialOption = grpc.WithTransportCredentials(transportCredentials)
+	}
 	conn, err := grpc.Dial(
 		address,
 		dialOption,
@@ -205,3 +296,30 @@ func (f *ForwarderClient) createGRPCConnection(address string) (*grpc.ClientConn
 	zap.L().Info(fmt.Sprintf("connected to grpc server at: %s with success", address))
 	return conn, nil
 }
+
+// gameSecret returns the function resolving the secrets of the game, keeping
+// them for a while.
+func (f *ForwarderClient) gameSecret(game string) secretFunc {
+	return func(ref string) ([]byte, error) {
+		return cachedSecret(f.secretsCache, f.secrets, game, ref)
+	}
+}
+
+// cachedSecret resolves the secret of the game, keeping it on the cache for a
+// while.
+func cachedSecret(secretsCache *cache.Cache, secrets SecretResolver, game, ref string) ([]byte, error) {
+	key := game + "|" + ref
+	if value, found := secretsCache.Get(key); found {
+		return value.([]byte), nil
+	}
+	if secrets == nil {
+		return nil, fmt.Errorf("secret \"%s\" not found, no secrets configured", ref)
+	}
+
+	value, err := secrets(game, ref)
+	if err != nil {
+		return nil, err
+	}
+	secretsCache.Set(key, value, cache.DefaultExpiration)
+	return value, nil
+}
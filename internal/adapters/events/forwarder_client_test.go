@@ -191,7 +191,7 @@ func TestForwarderClient_SendRoomStatus(t *testing.T) {
 				)
 				require.NoError(t, err)
 			}
-			f := NewForwarderClient(keepalive.ClientParameters{})
+			f := NewForwarderClient(keepalive.ClientParameters{}, nil)
 			got, err := f.SendRoomStatus(tt.args.ctx, tt.args.forwarder, &tt.args.in)
 			if !tt.wantErr(t, err, fmt.Sprintf("SendRoomStatus(%v, %v, %v)", tt.args.ctx, tt.args.forwarder, tt.args.in)) {
 				return
@@ -238,7 +238,7 @@ func TestSendPlayerEvent(t *testing.T) {
 }
 
 func basicArrangeForwarderClient(t *testing.T) {
-	forwarderClientAdapter = NewForwarderClient(keepalive.ClientParameters{})
+	forwarderClientAdapter = NewForwarderClient(keepalive.ClientParameters{}, nil)
 }
 
 func newRoomEvent(mockIdentifier string) pb.RoomEvent {
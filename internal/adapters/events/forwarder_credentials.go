@@ -0,0 +1,156 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package events
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+// SecretResolver returns the value of a secret referenced by the forwarders
+// options of the game schedulers, failing when the game isn't allowed to use
+// it.
+type SecretResolver func(game, ref string) ([]byte, error)
+
+// secretFunc returns the value of a secret available to a game.
+type secretFunc func(ref string) ([]byte, error)
+
+// newTransportCredentials returns the TLS credentials of the connection to a
+// forwarder.
+func newTransportCredentials(options *forwarder.TLSOptions, secret secretFunc) (credentials.TransportCredentials, error) {
+	config, err := newTLSConfig(options, secret)
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(config), nil
+}
+
+// newTLSConfig returns the TLS config of the connection to a forwarder. Its
+// CA and client certificate are loaded from the secrets on every handshake,
+// so the reconnections made after a secret is rotated use its new value.
+func newTLSConfig(options *forwarder.TLSOptions, secret secretFunc) (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: options.ServerName,
+	}
+
+	if options.CASecretRef != "" {
+		if _, err := loadCertPool(options.CASecretRef, secret); err != nil {
+			return nil, err
+		}
+		// The certificate is verified against the current CA by
+		// VerifyConnection instead of the CAs fixed on the config.
+		config.InsecureSkipVerify = true //nolint:gosec // verified by VerifyConnection.
+		config.VerifyConnection = func(state tls.ConnectionState) error {
+			return verifyPeerCertificate(state, options.CASecretRef, secret)
+		}
+	}
+
+	if options.CertSecretRef != "" {
+		if _, err := loadClientCertificate(options, secret); err != nil {
+			return nil, err
+		}
+		config.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return loadClientCertificate(options, secret)
+		}
+	}
+
+	return config, nil
+}
+
+func loadCertPool(ref string, secret secretFunc) (*x509.CertPool, error) {
+	ca, err := secret(ref)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("secret \"%s\" has no valid CA certificate", ref)
+	}
+	return pool, nil
+}
+
+func loadClientCertificate(options *forwarder.TLSOptions, secret secretFunc) (*tls.Certificate, error) {
+	cert, err := secret(options.CertSecretRef)
+	if err != nil {
+		return nil, err
+	}
+	key, err := secret(options.KeySecretRef)
+	if err != nil {
+		return nil, err
+	}
+	certificate, err := tls.X509KeyPair(cert, key)
+	if err != nil {
+		return nil, fmt.Errorf("secrets \"%s\" and \"%s\" have no valid client certificate: %w", options.CertSecretRef, options.KeySecretRef, err)
+	}
+	return &certificate, nil
+}
+
+// verifyPeerCertificate verifies the forwarder certificate chain and host
+// name against the CA secret.
+func verifyPeerCertificate(state tls.ConnectionState, caRef string, secret secretFunc) error {
+	if len(state.PeerCertificates) == 0 {
+		return fmt.Errorf("forwarder sent no certificate")
+	}
+	roots, err := loadCertPool(caRef, secret)
+	if err != nil {
+		return err
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, certificate := range state.PeerCertificates[1:] {
+		intermediates.AddCert(certificate)
+	}
+	_, err = state.PeerCertificates[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		DNSName:       state.ServerName,
+	})
+	return err
+}
+
+// withAuthMetadata adds the forwarder credentials to the request metadata.
+func withAuthMetadata(ctx context.Context, options *forwarder.AuthOptions, secret secretFunc) (context.Context, error) {
+	value, err := secret(options.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	switch options.Type {
+	case forwarder.AuthBearer:
+		return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+string(value)), nil
+	case forwarder.AuthAPIKey:
+		header := options.Header
+		if header == "" {
+			header = forwarder.DefaultAPIKeyHeader
+		}
+		return metadata.AppendToOutgoingContext(ctx, header, string(value)), nil
+	}
+	return nil, fmt.Errorf("unsupported auth type \"%s\"", options.Type)
+}
@@ -0,0 +1,314 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build unit
+// +build unit
+
+package events
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	pb "github.com/topfreegames/protos/maestro/grpc/generated"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
+)
+
+func TestForwarderClient_Credentials(t *testing.T) {
+	ca := newTestCertificateAuthority(t)
+	serverCert, serverKey := ca.issue(t, "localhost", x509.ExtKeyUsageServerAuth)
+	clientCert, clientKey := ca.issue(t, "maestro", x509.ExtKeyUsageClientAuth)
+	secrets := map[string][]byte{
+		"forwarderCA":   ca.certPEM,
+		"maestroCert":   clientCert,
+		"maestroKey":    clientKey,
+		"forwarderAuth": []byte("token"),
+	}
+	resolver := func(game, ref string) ([]byte, error) {
+		value, ok := secrets[ref]
+		if game != "game" || !ok {
+			return nil, fmt.Errorf("secret \"%s\" not configured", ref)
+		}
+		return value, nil
+	}
+
+	serverCertificate, err := tls.X509KeyPair(serverCert, serverKey)
+	require.NoError(t, err)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AppendCertsFromPEM(ca.certPEM)
+	forwarderServer := &credentialsForwarderServer{}
+	address := startTLSForwarderServer(t, forwarderServer, &tls.Config{
+		Certificates: []tls.Certificate{serverCertificate},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	})
+
+	newSecuredForwarder := func(grpcOptions *forwarder.GRPCOptions) forwarder.Forwarder {
+		return forwarder.Forwarder{
+			Name:        "secured",
+			Enabled:     true,
+			ForwardType: forwarder.TypeGrpc,
+			Address:     address,
+			Options:     &forwarder.ForwardOptions{Timeout: 1000, GRPC: grpcOptions},
+		}
+	}
+
+	t.Run("sends the events with mTLS and the auth metadata", func(t *testing.T) {
+		client := NewForwarderClient(keepalive.ClientParameters{}, resolver)
+		securedForwarder := newSecuredForwarder(&forwarder.GRPCOptions{
+			TLS:  &forwarder.TLSOptions{CASecretRef: "forwarderCA", CertSecretRef: "maestroCert", KeySecretRef: "maestroKey", ServerName: "localhost"},
+			Auth: &forwarder.AuthOptions{Type: forwarder.AuthBearer, SecretRef: "forwarderAuth"},
+		})
+
+		response, err := client.SendPlayerEvent(context.Background(), securedForwarder, &pb.PlayerEvent{PlayerId: "player", Room: &pb.Room{Game: "game"}})
+		require.NoError(t, err)
+		require.EqualValues(t, 200, response.Code)
+		require.Equal(t, []string{"Bearer token"}, forwarderServer.metadata.Get("authorization"))
+	})
+
+	t.Run("fails when the forwarder requires a client certificate", func(t *testing.T) {
+		client := NewForwarderClient(keepalive.ClientParameters{}, resolver)
+		securedForwarder := newSecuredForwarder(&forwarder.GRPCOptions{
+			TLS: &forwarder.TLSOptions{CASecretRef: "forwarderCA", ServerName: "localhost"},
+		})
+
+		_, err := client.SendPlayerEvent(context.Background(), securedForwarder, &pb.PlayerEvent{PlayerId: "player", Room: &pb.Room{Game: "game"}})
+		require.Error(t, err)
+	})
+
+	t.Run("fails when a secret isn't configured", func(t *testing.T) {
+		client := NewForwarderClient(keepalive.ClientParameters{}, resolver)
+		securedForwarder := newSecuredForwarder(&forwarder.GRPCOptions{
+			TLS: &forwarder.TLSOptions{CASecretRef: "unknownCA"},
+		})
+
+		_, err := client.SendPlayerEvent(context.Background(), securedForwarder, &pb.PlayerEvent{PlayerId: "player", Room: &pb.Room{Game: "game"}})
+		require.ErrorContains(t, err, "failed to connect")
+	})
+
+	t.Run("fails when the game isn't allowed to use the secrets", func(t *testing.T) {
+		client := NewForwarderClient(keepalive.ClientParameters{}, resolver)
+		securedForwarder := newSecuredForwarder(&forwarder.GRPCOptions{
+			TLS:  &forwarder.TLSOptions{CASecretRef: "forwarderCA", CertSecretRef: "maestroCert", KeySecretRef: "maestroKey", ServerName: "localhost"},
+			Auth: &forwarder.AuthOptions{Type: forwarder.AuthBearer, SecretRef: "forwarderAuth"},
+		})
+
+		_, err := client.SendPlayerEvent(context.Background(), securedForwarder, &pb.PlayerEvent{PlayerId: "player", Room: &pb.Room{Game: "other-game"}})
+		require.ErrorContains(t, err, "failed to load credentials")
+	})
+
+	t.Run("closes the connections of uncached clients", func(t *testing.T) {
+		client := NewUncachedForwarderClient(keepalive.ClientParameters{}, resolver)
+		securedForwarder := newSecuredForwarder(&forwarder.GRPCOptions{
+			TLS:  &forwarder.TLSOptions{CASecretRef: "forwarderCA", CertSecretRef: "maestroCert", KeySecretRef: "maestroKey", ServerName: "localhost"},
+			Auth: &forwarder.AuthOptions{Type: forwarder.AuthBearer, SecretRef: "forwarderAuth"},
+		})
+
+		response, err := client.SendPlayerEvent(context.Background(), securedForwarder, &pb.PlayerEvent{PlayerId: "player", Room: &pb.Room{Game: "game"}})
+		require.NoError(t, err)
+		require.EqualValues(t, 200, response.Code)
+		require.Zero(t, client.c.ItemCount())
+	})
+
+	t.Run("fails when there are no secrets configured", func(t *testing.T) {
+		client := NewForwarderClient(keepalive.ClientParameters{}, nil)
+		securedForwarder := newSecuredForwarder(&forwarder.GRPCOptions{
+			TLS:  &forwarder.TLSOptions{ServerName: "localhost"},
+			Auth: &forwarder.AuthOptions{Type: forwarder.AuthBearer, SecretRef: "forwarderAuth"},
+		})
+
+		_, err := client.SendPlayerEvent(context.Background(), securedForwarder, &pb.PlayerEvent{PlayerId: "player", Room: &pb.Room{Game: "game"}})
+		require.ErrorContains(t, err, "failed to load credentials")
+	})
+
+	t.Run("fails when the credentials are sent without TLS", func(t *testing.T) {
+		client := NewForwarderClient(keepalive.ClientParameters{}, resolver)
+		securedForwarder := newSecuredForwarder(&forwarder.GRPCOptions{
+			Auth: &forwarder.AuthOptions{Type: forwarder.AuthBearer, SecretRef: "forwarderAuth"},
+		})
+
+		_, err := client.SendPlayerEvent(context.Background(), securedForwarder, &pb.PlayerEvent{PlayerId: "player", Room: &pb.Room{Game: "game"}})
+		require.ErrorContains(t, err, "sends credentials without TLS")
+	})
+}
+
+func TestWithAuthMetadata(t *testing.T) {
+	resolver := func(ref string) ([]byte, error) {
+		return []byte("secret-" + ref), nil
+	}
+
+	t.Run("adds API keys with the default header", func(t *testing.T) {
+		ctx, err := withAuthMetadata(context.Background(), &forwarder.AuthOptions{Type: forwarder.AuthAPIKey, SecretRef: "key"}, resolver)
+		require.NoError(t, err)
+
+		md, _ := metadata.FromOutgoingContext(ctx)
+		require.Equal(t, []string{"secret-key"}, md.Get(forwarder.DefaultAPIKeyHeader))
+	})
+
+	t.Run("adds API keys with the forwarder header", func(t *testing.T) {
+		ctx, err := withAuthMetadata(context.Background(), &forwarder.AuthOptions{Type: forwarder.AuthAPIKey, SecretRef: "key", Header: "x-game-key"}, resolver)
+		require.NoError(t, err)
+
+		md, _ := metadata.FromOutgoingContext(ctx)
+		require.Equal(t, []string{"secret-key"}, md.Get("x-game-key"))
+	})
+}
+
+func TestNewTransportCredentials(t *testing.T) {
+	t.Run("fails when the CA secret has no certificate", func(t *testing.T) {
+		_, err := newTransportCredentials(&forwarder.TLSOptions{CASecretRef: "ca"}, func(string) ([]byte, error) {
+			return []byte("not a certificate"), nil
+		})
+		require.ErrorContains(t, err, "no valid CA certificate")
+	})
+
+	t.Run("loads the rotated secrets on the next handshake", func(t *testing.T) {
+		ca := newTestCertificateAuthority(t)
+		rotatedCA := newTestCertificateAuthority(t)
+		clientCert, clientKey := ca.issue(t, "maestro", x509.ExtKeyUsageClientAuth)
+		rotatedClientCert, rotatedClientKey := rotatedCA.issue(t, "maestro", x509.ExtKeyUsageClientAuth)
+		serverCert, _ := rotatedCA.issue(t, "localhost", x509.ExtKeyUsageServerAuth)
+		secrets := map[string][]byte{"ca": ca.certPEM, "cert": clientCert, "key": clientKey}
+		options := &forwarder.TLSOptions{CASecretRef: "ca", CertSecretRef: "cert", KeySecretRef: "key"}
+
+		config, err := newTLSConfig(options, func(ref string) ([]byte, error) {
+			return secrets[ref], nil
+		})
+		require.NoError(t, err)
+
+		serverState := tls.ConnectionState{ServerName: "localhost", PeerCertificates: []*x509.Certificate{parseCertificate(t, serverCert)}}
+		require.Error(t, config.VerifyConnection(serverState))
+		certificate, err := config.GetClientCertificate(&tls.CertificateRequestInfo{})
+		require.NoError(t, err)
+		require.Equal(t, parseCertificate(t, clientCert).Raw, certificate.Certificate[0])
+
+		secrets["ca"], secrets["cert"], secrets["key"] = rotatedCA.certPEM, rotatedClientCert, rotatedClientKey
+
+		require.NoError(t, config.VerifyConnection(serverState))
+		certificate, err = config.GetClientCertificate(&tls.CertificateRequestInfo{})
+		require.NoError(t, err)
+		require.Equal(t, parseCertificate(t, rotatedClientCert).Raw, certificate.Certificate[0])
+	})
+}
+
+func parseCertificate(t *testing.T, certPEM []byte) *x509.Certificate {
+	block, _ := pem.Decode(certPEM)
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	return certificate
+}
+
+// credentialsForwarderServer answers the player events, keeping the metadata
+// of the last request.
+type credentialsForwarderServer struct {
+	pb.GRPCForwarderServer
+	metadata metadata.MD
+}
+
+func (s *credentialsForwarderServer) SendPlayerEvent(ctx context.Context, _ *pb.PlayerEvent) (*pb.Response, error) {
+	s.metadata, _ = metadata.FromIncomingContext(ctx)
+	return &pb.Response{Code: 200}, nil
+}
+
+func startTLSForwarderServer(t *testing.T, forwarderServer pb.GRPCForwarderServer, config *tls.Config) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(config)))
+	pb.RegisterGRPCForwarderServer(server, forwarderServer)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	return listener.Addr().String()
+}
+
+type testCertificateAuthority struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+}
+
+func newTestCertificateAuthority(t *testing.T) *testCertificateAuthority {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCertificateAuthority{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// issue returns a certificate, and its key, signed by the CA.
+func (ca *testCertificateAuthority) issue(t *testing.T, name string, usage x509.ExtKeyUsage) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
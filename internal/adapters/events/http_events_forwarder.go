@@ -0,0 +1,190 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/topfreegames/maestro/internal/adapters/metrics"
+	"github.com/topfreegames/maestro/internal/core/entities/events"
+	entities "github.com/topfreegames/maestro/internal/core/entities/forwarder"
+	"github.com/topfreegames/maestro/internal/core/monitoring"
+	"github.com/topfreegames/maestro/internal/core/ports"
+	"github.com/topfreegames/maestro/internal/core/ports/errors"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	httpForwarderServiceMetricLabel = "HTTPForwarder"
+
+	// HTTPEventTypeHeader has the kind of event sent on the request body.
+	HTTPEventTypeHeader = "X-Maestro-Event"
+	// HTTPSignatureHeader has the HMAC-SHA256 of the request body, in the
+	// "sha256=<hex>" format, when the forwarder has a signing secret ref.
+	HTTPSignatureHeader = "X-Maestro-Signature"
+	// HTTPCloudEventsHeaderPrefix prefixes the headers with the CloudEvent
+	// attributes in the binary mode, e.g. ce-type.
+	HTTPCloudEventsHeaderPrefix = "ce-"
+)
+
+var (
+	_ ports.EventsForwarder = (*httpEventsForwarder)(nil)
+)
+
+type httpEventsForwarder struct {
+	client       *http.Client
+	secrets      SecretResolver
+	secretsCache *cache.Cache
+}
+
+// NewHTTPEventsForwarder instantiates an events forwarder that POSTs the
+// events as JSON to the forwarders address. The signing secrets are resolved
+// with the secrets resolver.
+func NewHTTPEventsForwarder(client *http.Client, secrets SecretResolver) *httpEventsForwarder {
+	return &httpEventsForwarder{
+		client:       client,
+		secrets:      secrets,
+		secretsCache: cache.New(secretsCacheTTL, secretsCacheTTL),
+	}
+}
+
+// ForwardRoomEvent forwards room events. It receives the room event attributes and forwarder configuration.
+func (f *httpEventsForwarder) ForwardRoomEvent(ctx context.Context, eventAttributes events.RoomEventAttributes, forwarder entities.Forwarder) (codes.Code, error) {
+	event, code, err := buildJSONRoomEvent(eventAttributes, forwarder)
+	if err != nil {
+		return code, err
+	}
+
+	return f.send(ctx, forwarder, event)
+}
+
+// ForwardPlayerEvent forwards a player events. It receives the player events attributes and forwarder configuration.
+func (f *httpEventsForwarder) ForwardPlayerEvent(ctx context.Context, eventAttributes events.PlayerEventAttributes, forwarder entities.Forwarder) (codes.Code, error) {
+	return f.send(ctx, forwarder, buildJSONPlayerEvent(eventAttributes, forwarder))
+}
+
+// Name returns the forwarder name. This name should be unique among other events forwarders.
+func (*httpEventsForwarder) Name() string {
+	return "http_forwarder"
+}
+
+func (f *httpEventsForwarder) send(ctx context.Context, forwarder entities.Forwarder, event jsonEvent) (codes.Code, error) {
+	encoded, err := encodeEvent(event, forwarder)
+	if err != nil {
+		return codes.Internal, errors.NewErrUnexpected("failed to encode event to \"%s\"", forwarder.Name).WithError(err)
+	}
+
+	if forwarder.Options != nil && forwarder.Options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, forwarder.Options.Timeout*time.Millisecond)
+		defer cancel()
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, forwarder.Address, bytes.NewReader(encoded.payload))
+	if err != nil {
+		return codes.InvalidArgument, errors.NewErrInvalidArgument("invalid address for forwarder \"%s\": %s", forwarder.Name, err)
+	}
+	request.Header.Set("Content-Type", encoded.contentType)
+	request.Header.Set(HTTPEventTypeHeader, event.Type)
+	for name, value := range encoded.attributes {
+		request.Header.Set(HTTPCloudEventsHeaderPrefix+name, value)
+	}
+	if forwarder.Options != nil && forwarder.Options.HTTP != nil {
+		for name, value := range forwarder.Options.HTTP.Headers {
+			request.Header.Set(name, value)
+		}
+		if secretRef := forwarder.Options.HTTP.SigningSecretRef; secretRef != "" {
+			secret, err := cachedSecret(f.secretsCache, f.secrets, event.Room.Game, secretRef)
+			if err != nil {
+				return codes.FailedPrecondition, errors.NewErrUnexpected("failed to load signing secret of forwarder \"%s\"", forwarder.Name).WithError(err)
+			}
+			request.Header.Set(HTTPSignatureHeader, SignHTTPEventBody(string(secret), encoded.payload))
+		}
+	}
+
+	start := time.Now()
+	response, err := f.client.Do(request)
+	if err != nil {
+		code := codes.Unavailable
+		if ctx.Err() == context.DeadlineExceeded {
+			code = codes.DeadlineExceeded
+		}
+		monitoring.ReportLatencyMetricInMillis(metrics.HTTPLatencyMetric, start, httpForwarderServiceMetricLabel, event.Type, code.String())
+		return code, errors.NewErrUnexpected("failed to forward event at \"%s\"", forwarder.Name).WithError(err)
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, response.Body)
+
+	code := fromHTTPStatusToCode(response.StatusCode)
+	monitoring.ReportLatencyMetricInMillis(metrics.HTTPLatencyMetric, start, httpForwarderServiceMetricLabel, event.Type, code.String())
+	if code != codes.OK {
+		return code, errors.NewErrUnexpected("failed to forward event at \"%s\" with status %d", forwarder.Name, response.StatusCode)
+	}
+
+	return codes.OK, nil
+}
+
+// SignHTTPEventBody returns the signature sent on the HTTPSignatureHeader of
+// the requests made by forwarders with a signing secret.
+func SignHTTPEventBody(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// fromHTTPStatusToCode normalizes the forwarder response status to grpc codes
+// for logs and metrics, any 2xx status means the event was forwarded.
+func fromHTTPStatusToCode(statusCode int) codes.Code {
+	switch {
+	case statusCode >= 200 && statusCode < 300:
+		return codes.OK
+	case statusCode == http.StatusBadRequest:
+		return codes.InvalidArgument
+	case statusCode == http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case statusCode == http.StatusForbidden:
+		return codes.PermissionDenied
+	case statusCode == http.StatusNotFound:
+		return codes.NotFound
+	case statusCode == http.StatusNotAcceptable: // Room does not exist
+		return codes.FailedPrecondition
+	case statusCode == http.StatusRequestTimeout, statusCode == http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	case statusCode == http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case statusCode == http.StatusServiceUnavailable, statusCode == http.StatusBadGateway:
+		return codes.Unavailable
+	case statusCode >= 500:
+		return codes.Internal
+	default:
+		return codes.Unknown
+	}
+}
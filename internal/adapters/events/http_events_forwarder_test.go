@@ -0,0 +1,274 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build unit
+// +build unit
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/topfreegames/maestro/internal/core/entities/events"
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
+	"google.golang.org/grpc/codes"
+)
+
+type receivedHTTPEvent struct {
+	header http.Header
+	body   []byte
+}
+
+func TestHTTPEventsForwarder_ForwardRoomEvent(t *testing.T) {
+	t.Run("posts arbitrary events as JSON with the forwarder headers", func(t *testing.T) {
+		received, server := newHTTPForwarderServer(t, http.StatusOK)
+		httpForwarder := newHTTPForwarder(server.URL, nil)
+
+		code, err := NewHTTPEventsForwarder(server.Client(), resolveHTTPSecret).ForwardRoomEvent(context.Background(), newRoomEventAttributes(events.Arbitrary, nil), httpForwarder)
+		require.NoError(t, err)
+		require.Equal(t, codes.OK, code)
+
+		request := <-received
+		require.Equal(t, "application/json", request.header.Get("Content-Type"))
+		require.Equal(t, "roomEvent", request.header.Get(HTTPEventTypeHeader))
+		require.Equal(t, "Bearer token", request.header.Get("Authorization"))
+		require.Empty(t, request.header.Get(HTTPSignatureHeader))
+		require.JSONEq(t, `{
+			"type": "roomEvent",
+			"eventType": "ready",
+			"room": {
+				"game": "game-test",
+				"roomId": "123",
+				"host": "host.com",
+				"port": 5050,
+				"metadata": {"roomType": "red", "ping": "true", "roomEvent": "ready"}
+			}
+		}`, string(request.body))
+	})
+
+	t.Run("posts status and resync events with the status type", func(t *testing.T) {
+		received, server := newHTTPForwarderServer(t, http.StatusOK)
+		httpForwarder := newHTTPForwarder(server.URL, nil)
+		eventsForwarder := NewHTTPEventsForwarder(server.Client(), resolveHTTPSecret)
+
+		code, err := eventsForwarder.ForwardRoomEvent(context.Background(), newRoomEventAttributes(events.Status, nil), httpForwarder)
+		require.NoError(t, err)
+		require.Equal(t, codes.OK, code)
+
+		event := decodeHTTPEvent(t, (<-received).body)
+		require.Equal(t, "roomStatus", event.Type)
+		require.Equal(t, "ready", event.StatusType)
+		require.Equal(t, "red", event.Room.RoomType)
+
+		code, err = eventsForwarder.ForwardRoomEvent(context.Background(), newRoomEventAttributes(events.Ping, nil), httpForwarder)
+		require.NoError(t, err)
+		require.Equal(t, codes.OK, code)
+
+		event = decodeHTTPEvent(t, (<-received).body)
+		require.Equal(t, "roomResync", event.Type)
+	})
+
+	t.Run("signs the body when the forwarder has a signing secret", func(t *testing.T) {
+		received, server := newHTTPForwarderServer(t, http.StatusNoContent)
+		httpForwarder := newHTTPForwarder(server.URL, &forwarder.HTTPOptions{SigningSecretRef: "signing"})
+
+		code, err := NewHTTPEventsForwarder(server.Client(), resolveHTTPSecret).ForwardRoomEvent(context.Background(), newRoomEventAttributes(events.Arbitrary, nil), httpForwarder)
+		require.NoError(t, err)
+		require.Equal(t, codes.OK, code)
+
+		request := <-received
+		require.Equal(t, SignHTTPEventBody("secret", request.body), request.header.Get(HTTPSignatureHeader))
+		require.NotEqual(t, SignHTTPEventBody("other-secret", request.body), request.header.Get(HTTPSignatureHeader))
+	})
+
+	t.Run("fails without sending the event when the signing secret isn't configured", func(t *testing.T) {
+		received, server := newHTTPForwarderServer(t, http.StatusNoContent)
+		httpForwarder := newHTTPForwarder(server.URL, &forwarder.HTTPOptions{SigningSecretRef: "unknown"})
+
+		code, err := NewHTTPEventsForwarder(server.Client(), resolveHTTPSecret).ForwardRoomEvent(context.Background(), newRoomEventAttributes(events.Arbitrary, nil), httpForwarder)
+		require.Error(t, err)
+		require.Equal(t, codes.FailedPrecondition, code)
+		require.Empty(t, received)
+	})
+
+	t.Run("posts CloudEvents in the structured mode", func(t *testing.T) {
+		received, server := newHTTPForwarderServer(t, http.StatusOK)
+		httpForwarder := newHTTPForwarder(server.URL, nil)
+		httpForwarder.Options.CloudEvents = &forwarder.CloudEventsOptions{Mode: forwarder.CloudEventsStructured}
+		roomEvent := newRoomEventAttributes(events.Status, nil)
+		roomEvent.SchedulerID = "scheduler-test"
+
+		code, err := NewHTTPEventsForwarder(server.Client(), resolveHTTPSecret).ForwardRoomEvent(context.Background(), roomEvent, httpForwarder)
+		require.NoError(t, err)
+		require.Equal(t, codes.OK, code)
+
+		request := <-received
+		require.Equal(t, CloudEventsContentType, request.header.Get("Content-Type"))
+		require.Empty(t, request.header.Get(HTTPCloudEventsHeaderPrefix+"type"))
+
+		var event cloudEvent
+		require.NoError(t, json.Unmarshal(request.body, &event))
+		require.Equal(t, "1.0", event.SpecVersion)
+		require.NotEmpty(t, event.ID)
+		require.Equal(t, "maestro/scheduler-test", event.Source)
+		require.Equal(t, "room.status.ready", event.Type)
+		require.Equal(t, "123", event.Subject)
+		require.NotEmpty(t, event.Time)
+		require.Equal(t, "application/json", event.DataContentType)
+		require.Equal(t, "roomStatus", event.Data.Type)
+		require.Equal(t, "123", event.Data.Room.RoomID)
+	})
+
+	t.Run("posts CloudEvents in the binary mode", func(t *testing.T) {
+		received, server := newHTTPForwarderServer(t, http.StatusOK)
+		httpForwarder := newHTTPForwarder(server.URL, &forwarder.HTTPOptions{SigningSecretRef: "signing"})
+		httpForwarder.Options.CloudEvents = &forwarder.CloudEventsOptions{Mode: forwarder.CloudEventsBinary}
+		roomEvent := newRoomEventAttributes(events.Status, nil)
+		roomEvent.SchedulerID = "scheduler-test"
+
+		code, err := NewHTTPEventsForwarder(server.Client(), resolveHTTPSecret).ForwardRoomEvent(context.Background(), roomEvent, httpForwarder)
+		require.NoError(t, err)
+		require.Equal(t, codes.OK, code)
+
+		request := <-received
+		require.Equal(t, "application/json", request.header.Get("Content-Type"))
+		require.Equal(t, "1.0", request.header.Get("ce-specversion"))
+		require.NotEmpty(t, request.header.Get("ce-id"))
+		require.Equal(t, "maestro/scheduler-test", request.header.Get("ce-source"))
+		require.Equal(t, "room.status.ready", request.header.Get("ce-type"))
+		require.Equal(t, "123", request.header.Get("ce-subject"))
+		require.NotEmpty(t, request.header.Get("ce-time"))
+		require.Equal(t, SignHTTPEventBody("secret", request.body), request.header.Get(HTTPSignatureHeader))
+
+		event := decodeHTTPEvent(t, request.body)
+		require.Equal(t, "roomStatus", event.Type)
+		require.Equal(t, "ready", event.StatusType)
+	})
+
+	t.Run("fails when the forwarder responds with an error status", func(t *testing.T) {
+		statusCodes := map[int]codes.Code{
+			http.StatusBadRequest:          codes.InvalidArgument,
+			http.StatusNotFound:            codes.NotFound,
+			http.StatusNotAcceptable:       codes.FailedPrecondition,
+			http.StatusTooManyRequests:     codes.ResourceExhausted,
+			http.StatusInternalServerError: codes.Internal,
+			http.StatusServiceUnavailable:  codes.Unavailable,
+		}
+		for statusCode, expectedCode := range statusCodes {
+			_, server := newHTTPForwarderServer(t, statusCode)
+			httpForwarder := newHTTPForwarder(server.URL, nil)
+
+			code, err := NewHTTPEventsForwarder(server.Client(), resolveHTTPSecret).ForwardRoomEvent(context.Background(), newRoomEventAttributes(events.Arbitrary, nil), httpForwarder)
+			require.Error(t, err)
+			require.Equal(t, expectedCode, code)
+		}
+	})
+
+	t.Run("fails when the forwarder is unreachable", func(t *testing.T) {
+		_, server := newHTTPForwarderServer(t, http.StatusOK)
+		server.Close()
+		httpForwarder := newHTTPForwarder(server.URL, nil)
+
+		code, err := NewHTTPEventsForwarder(server.Client(), resolveHTTPSecret).ForwardRoomEvent(context.Background(), newRoomEventAttributes(events.Arbitrary, nil), httpForwarder)
+		require.Error(t, err)
+		require.Equal(t, codes.Unavailable, code)
+	})
+
+	t.Run("fails when the event type is Arbitrary and roomEvent is not provided", func(t *testing.T) {
+		httpForwarder := newHTTPForwarder("http://localhost", nil)
+
+		code, err := NewHTTPEventsForwarder(http.DefaultClient, resolveHTTPSecret).ForwardRoomEvent(context.Background(), newRoomEventAttributes(events.Arbitrary, map[string]interface{}{"roomType": "red"}), httpForwarder)
+		require.Error(t, err)
+		require.Equal(t, codes.InvalidArgument, code)
+	})
+}
+
+func TestHTTPEventsForwarder_ForwardPlayerEvent(t *testing.T) {
+	t.Run("posts player events as JSON", func(t *testing.T) {
+		received, server := newHTTPForwarderServer(t, http.StatusOK)
+		httpForwarder := newHTTPForwarder(server.URL, nil)
+
+		code, err := NewHTTPEventsForwarder(server.Client(), resolveHTTPSecret).ForwardPlayerEvent(context.Background(), newPlayerEventAttributes(), httpForwarder)
+		require.NoError(t, err)
+		require.Equal(t, codes.OK, code)
+
+		request := <-received
+		require.Equal(t, "playerEvent", request.header.Get(HTTPEventTypeHeader))
+		require.JSONEq(t, `{
+			"type": "playerEvent",
+			"eventType": "PLAYER_LEFT",
+			"playerId": "123",
+			"room": {"game": "", "roomId": "123"},
+			"metadata": {"roomType": "red", "ping": "true"}
+		}`, string(request.body))
+	})
+}
+
+func newHTTPForwarderServer(t *testing.T, statusCode int) (chan receivedHTTPEvent, *httptest.Server) {
+	received := make(chan receivedHTTPEvent, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		received <- receivedHTTPEvent{header: r.Header, body: body}
+		w.WriteHeader(statusCode)
+	}))
+	t.Cleanup(server.Close)
+	return received, server
+}
+
+func newHTTPForwarder(address string, httpOptions *forwarder.HTTPOptions) forwarder.Forwarder {
+	if httpOptions == nil {
+		httpOptions = &forwarder.HTTPOptions{Headers: map[string]string{"Authorization": "Bearer token"}}
+	}
+	return forwarder.Forwarder{
+		Name:        "webhook",
+		Enabled:     true,
+		ForwardType: forwarder.TypeHTTP,
+		Address:     address,
+		Options: &forwarder.ForwardOptions{
+			Timeout:  time.Duration(1000),
+			Metadata: map[string]interface{}{"roomType": "red"},
+			HTTP:     httpOptions,
+		},
+	}
+}
+
+func decodeHTTPEvent(t *testing.T, body []byte) jsonEvent {
+	var event jsonEvent
+	require.NoError(t, json.Unmarshal(body, &event))
+	return event
+}
+
+func resolveHTTPSecret(game, ref string) ([]byte, error) {
+	if game != "game-test" || ref != "signing" {
+		return nil, fmt.Errorf("secret \"%s\" not configured", ref)
+	}
+	return []byte("secret"), nil
+}
@@ -0,0 +1,136 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package events
+
+import (
+	"time"
+
+	"github.com/topfreegames/maestro/internal/core/entities/events"
+	entities "github.com/topfreegames/maestro/internal/core/entities/forwarder"
+	"github.com/topfreegames/maestro/internal/core/ports/errors"
+	pb "github.com/topfreegames/protos/maestro/grpc/generated"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	jsonEventRoomEvent   = "roomEvent"
+	jsonEventRoomResync  = "roomResync"
+	jsonEventRoomStatus  = "roomStatus"
+	jsonEventPlayerEvent = "playerEvent"
+)
+
+// jsonEvent is the JSON representation of the events sent by http forwarders
+// and published by broker forwarders. It has the same information as the
+// messages sent to gRPC forwarders.
+type jsonEvent struct {
+	Type       string            `json:"type"`
+	Scheduler  string            `json:"scheduler,omitempty"`
+	Room       jsonRoom          `json:"room"`
+	EventType  string            `json:"eventType,omitempty"`
+	StatusType string            `json:"statusType,omitempty"`
+	PlayerID   string            `json:"playerId,omitempty"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+	// ID and CreatedAt are only sent as the CloudEvent id and time.
+	ID        string    `json:"-"`
+	CreatedAt time.Time `json:"-"`
+}
+
+type jsonRoom struct {
+	Game     string            `json:"game"`
+	RoomID   string            `json:"roomId"`
+	Host     string            `json:"host,omitempty"`
+	Port     int32             `json:"port,omitempty"`
+	RoomType string            `json:"roomType,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// buildJSONRoomEvent builds the same message sent to gRPC forwarders and
+// converts it to its JSON representation.
+func buildJSONRoomEvent(eventAttributes events.RoomEventAttributes, forwarder entities.Forwarder) (jsonEvent, codes.Code, error) {
+	messages := &eventsForwarder{}
+
+	switch eventAttributes.EventType {
+	case events.Arbitrary:
+		if roomEvent, ok := eventAttributes.Other["roomEvent"].(string); ok {
+			message := messages.buildRoomEventMessage(eventAttributes, forwarder, roomEvent)
+			return jsonEvent{
+				Type:      jsonEventRoomEvent,
+				Scheduler: eventAttributes.SchedulerID,
+				Room:      fromPbRoomToJSONRoom(message.Room),
+				EventType: message.EventType,
+				ID:        eventAttributes.EventID,
+				CreatedAt: eventAttributes.CreatedAt,
+			}, codes.OK, nil
+		}
+		return jsonEvent{}, codes.InvalidArgument, errors.NewErrInvalidArgument("invalid or missing eventAttributes.Other['roomEvent'] field")
+
+	case events.Ping, events.Status:
+		message, err := messages.buildRoomStatusMessage(eventAttributes, forwarder)
+		if err != nil {
+			return jsonEvent{}, codes.InvalidArgument, errors.NewErrInvalidArgument("failed to build room status message: %s", err)
+		}
+
+		eventType := jsonEventRoomStatus
+		if eventAttributes.EventType == events.Ping {
+			eventType = jsonEventRoomResync
+		}
+		return jsonEvent{
+			Type:       eventType,
+			Scheduler:  eventAttributes.SchedulerID,
+			Room:       fromPbRoomToJSONRoom(message.Room),
+			StatusType: message.StatusType.String(),
+			ID:         eventAttributes.EventID,
+			CreatedAt:  eventAttributes.CreatedAt,
+		}, codes.OK, nil
+	}
+
+	return jsonEvent{}, codes.Unknown, errors.NewErrUnexpected("failed to forward event room. event type doesn't exists \"%s\"", eventAttributes.EventType)
+}
+
+// buildJSONPlayerEvent builds the same message sent to gRPC forwarders and
+// converts it to its JSON representation.
+func buildJSONPlayerEvent(eventAttributes events.PlayerEventAttributes, forwarder entities.Forwarder) jsonEvent {
+	message := (&eventsForwarder{}).buildPlayerEventMessage(eventAttributes, forwarder)
+
+	return jsonEvent{
+		Type:      jsonEventPlayerEvent,
+		Scheduler: eventAttributes.SchedulerID,
+		Room:      fromPbRoomToJSONRoom(message.Room),
+		EventType: message.EventType.String(),
+		PlayerID:  message.PlayerId,
+		Metadata:  message.Metadata,
+		ID:        eventAttributes.EventID,
+		CreatedAt: eventAttributes.CreatedAt,
+	}
+}
+
+func fromPbRoomToJSONRoom(room *pb.Room) jsonRoom {
+	return jsonRoom{
+		Game:     room.Game,
+		RoomID:   room.RoomId,
+		Host:     room.Host,
+		Port:     room.Port,
+		RoomType: room.RoomType,
+		Metadata: room.Metadata,
+	}
+}
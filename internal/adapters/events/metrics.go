@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/topfreegames/maestro/internal/adapters/metrics"
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
 	"github.com/topfreegames/maestro/internal/core/monitoring"
 	pb "github.com/topfreegames/protos/maestro/grpc/generated"
 	"google.golang.org/grpc/status"
@@ -43,3 +44,40 @@ func runReportingLatencyMetrics(method string, executionFunction func() (*pb.Res
 	)
 	return response, responseErr
 }
+
+var (
+	circuitBreakerStateMetric = monitoring.CreateGaugeMetric(&monitoring.MetricOpts{
+		Namespace: monitoring.Namespace,
+		Subsystem: monitoring.SubsystemApi,
+		Name:      "forwarder_circuit_breaker_state",
+		Help:      "Circuit breaker state of the forwarders addresses, 1 for the current state",
+		Labels: []string{
+			monitoring.LabelAddress,
+			monitoring.LabelState,
+		},
+	})
+
+	circuitBreakerRejectedEventsMetric = monitoring.CreateCounterMetric(&monitoring.MetricOpts{
+		Namespace: monitoring.Namespace,
+		Subsystem: monitoring.SubsystemApi,
+		Name:      "forwarder_circuit_breaker_rejected_events",
+		Help:      "Number of events not forwarded because the forwarder circuit breaker is open",
+		Labels: []string{
+			monitoring.LabelAddress,
+		},
+	})
+)
+
+func reportCircuitBreakerState(address string, state forwarder.CircuitState) {
+	for _, circuitState := range []forwarder.CircuitState{forwarder.CircuitClosed, forwarder.CircuitOpen, forwarder.CircuitHalfOpen} {
+		value := 0.0
+		if circuitState == state {
+			value = 1
+		}
+		circuitBreakerStateMetric.WithLabelValues(address, string(circuitState)).Set(value)
+	}
+}
+
+func reportCircuitBreakerRejectedEvent(address string) {
+	circuitBreakerRejectedEventsMetric.WithLabelValues(address).Inc()
+}
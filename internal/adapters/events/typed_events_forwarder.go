@@ -0,0 +1,90 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package events
+
+import (
+	"context"
+
+	"github.com/topfreegames/maestro/internal/core/entities/events"
+	entities "github.com/topfreegames/maestro/internal/core/entities/forwarder"
+	"github.com/topfreegames/maestro/internal/core/ports"
+	"github.com/topfreegames/maestro/internal/core/ports/errors"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	_ ports.EventsForwarder = (*typedEventsForwarder)(nil)
+)
+
+// typedEventsForwarder forwards each event with the events forwarder of the
+// scheduler forwarder type.
+type typedEventsForwarder struct {
+	forwarders map[entities.ForwardType]ports.EventsForwarder
+}
+
+// NewTypedEventsForwarder instantiates an events forwarder that selects, for
+// every scheduler forwarder, the events forwarder registered to its type.
+// Forwarders without type are handled as gRPC ones.
+func NewTypedEventsForwarder(forwarders map[entities.ForwardType]ports.EventsForwarder) *typedEventsForwarder {
+	return &typedEventsForwarder{
+		forwarders: forwarders,
+	}
+}
+
+// ForwardRoomEvent forwards room events. It receives the room event attributes and forwarder configuration.
+func (f *typedEventsForwarder) ForwardRoomEvent(ctx context.Context, eventAttributes events.RoomEventAttributes, forwarder entities.Forwarder) (codes.Code, error) {
+	eventsForwarder, err := f.forwarderFor(forwarder)
+	if err != nil {
+		return codes.InvalidArgument, err
+	}
+
+	return eventsForwarder.ForwardRoomEvent(ctx, eventAttributes, forwarder)
+}
+
+// ForwardPlayerEvent forwards a player events. It receives the player events attributes and forwarder configuration.
+func (f *typedEventsForwarder) ForwardPlayerEvent(ctx context.Context, eventAttributes events.PlayerEventAttributes, forwarder entities.Forwarder) (codes.Code, error) {
+	eventsForwarder, err := f.forwarderFor(forwarder)
+	if err != nil {
+		return codes.InvalidArgument, err
+	}
+
+	return eventsForwarder.ForwardPlayerEvent(ctx, eventAttributes, forwarder)
+}
+
+// Name returns the forwarder name. This name should be unique among other events forwarders.
+func (*typedEventsForwarder) Name() string {
+	return "typed_forwarder"
+}
+
+func (f *typedEventsForwarder) forwarderFor(forwarder entities.Forwarder) (ports.EventsForwarder, error) {
+	forwardType := forwarder.ForwardType
+	if forwardType == "" {
+		forwardType = entities.TypeGrpc
+	}
+
+	eventsForwarder, ok := f.forwarders[forwardType]
+	if !ok {
+		return nil, errors.NewErrInvalidArgument("forwarder \"%s\" has unsupported type \"%s\"", forwarder.Name, forwarder.ForwardType)
+	}
+	return eventsForwarder, nil
+}
@@ -0,0 +1,90 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build unit
+// +build unit
+
+package events
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/topfreegames/maestro/internal/core/entities/events"
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
+	"github.com/topfreegames/maestro/internal/core/ports"
+	"github.com/topfreegames/maestro/internal/core/ports/mock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestTypedEventsForwarder(t *testing.T) {
+	t.Run("forwards the events with the forwarder of the same type", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		grpcForwarder := mock.NewMockEventsForwarder(mockCtrl)
+		httpForwarder := mock.NewMockEventsForwarder(mockCtrl)
+		typedForwarder := NewTypedEventsForwarder(map[forwarder.ForwardType]ports.EventsForwarder{
+			forwarder.TypeGrpc: grpcForwarder,
+			forwarder.TypeHTTP: httpForwarder,
+		})
+
+		webhook := newHTTPForwarder("http://localhost", nil)
+		roomEvent := newRoomEventAttributes(events.Arbitrary, nil)
+		playerEvent := newPlayerEventAttributes()
+		httpForwarder.EXPECT().ForwardRoomEvent(gomock.Any(), roomEvent, webhook).Return(codes.OK, nil)
+		httpForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), playerEvent, webhook).Return(codes.OK, nil)
+
+		code, err := typedForwarder.ForwardRoomEvent(context.Background(), roomEvent, webhook)
+		require.NoError(t, err)
+		require.Equal(t, codes.OK, code)
+
+		code, err = typedForwarder.ForwardPlayerEvent(context.Background(), playerEvent, webhook)
+		require.NoError(t, err)
+		require.Equal(t, codes.OK, code)
+	})
+
+	t.Run("forwards the events of forwarders without type with gRPC", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		grpcForwarder := mock.NewMockEventsForwarder(mockCtrl)
+		typedForwarder := NewTypedEventsForwarder(map[forwarder.ForwardType]ports.EventsForwarder{
+			forwarder.TypeGrpc: grpcForwarder,
+		})
+
+		untypedForwarder := newStaticForwarder()
+		untypedForwarder.ForwardType = ""
+		roomEvent := newRoomEventAttributes(events.Arbitrary, nil)
+		grpcForwarder.EXPECT().ForwardRoomEvent(gomock.Any(), roomEvent, untypedForwarder).Return(codes.OK, nil)
+
+		code, err := typedForwarder.ForwardRoomEvent(context.Background(), roomEvent, untypedForwarder)
+		require.NoError(t, err)
+		require.Equal(t, codes.OK, code)
+	})
+
+	t.Run("fails when there is no forwarder for the type", func(t *testing.T) {
+		typedForwarder := NewTypedEventsForwarder(map[forwarder.ForwardType]ports.EventsForwarder{})
+
+		code, err := typedForwarder.ForwardPlayerEvent(context.Background(), newPlayerEventAttributes(), newHTTPForwarder("http://localhost", nil))
+		require.Error(t, err)
+		require.Equal(t, codes.InvalidArgument, code)
+	})
+}
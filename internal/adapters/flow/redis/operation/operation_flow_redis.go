@@ -26,8 +26,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/topfreegames/maestro/internal/adapters/metrics"
+	"github.com/topfreegames/maestro/internal/core/entities/operation"
 
 	"github.com/topfreegames/maestro/internal/core/ports"
 
@@ -39,7 +42,46 @@ import (
 var _ ports.OperationFlow = (*redisOperationFlow)(nil)
 var watchOperationCancellationRequestKey = "scheduler:operation_cancellation_requests"
 
-const operationFlowStorageMetricLabel = "operation-flow-storage"
+// moveNextOpIDScript moves the first operation ID of the first non-empty
+// pending operations list (KEYS[3:], ordered by priority) to the auxiliary
+// list (KEYS[1]), consuming one insertion signal (KEYS[2]). Redis 6 can't
+// block waiting on several lists, so the signal list is used to wait for new
+// operations when all of them are empty.
+var moveNextOpIDScript = redis.NewScript(`
+for i = 3, #KEYS do
+	local opID = redis.call("LMOVE", KEYS[i], KEYS[1], "LEFT", "RIGHT")
+	if opID then
+		redis.call("LPOP", KEYS[2])
+		return opID
+	end
+end
+return false
+`)
+
+// holdOpIDScript pushes the operation ID (ARGV[1]) to the held operations
+// list (KEYS[2]) and its priority (ARGV[2]) to the held priorities hash
+// (KEYS[3]), only while the operations pause (KEYS[1]) exists. Checking the
+// pause on the same step keeps a resume from missing the operation.
+var holdOpIDScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return 0
+end
+redis.call("LREM", KEYS[2], 0, ARGV[1])
+redis.call("RPUSH", KEYS[2], ARGV[1])
+redis.call("HSET", KEYS[3], ARGV[1], ARGV[2])
+return 1
+`)
+
+// resumeOperationsTxMaxAttempts is how many times the resume transaction runs
+// when the held operations change under it.
+const resumeOperationsTxMaxAttempts = 3
+
+const (
+	operationFlowStorageMetricLabel = "operation-flow-storage"
+
+	pauseReasonRedisKey   = "reason"
+	pausePausedAtRedisKey = "pausedAt"
+)
 
 // redisOperationFlow adapter of the OperationStorage port. It stores
 // the operations in lists to keep their creation/update order.
@@ -52,23 +94,14 @@ func NewRedisOperationFlow(client *redis.Client) *redisOperationFlow {
 }
 
 // InsertOperationID pushes the operation ID to the scheduler pending
-// operations list.
-func (r *redisOperationFlow) InsertOperationID(ctx context.Context, schedulerName string, operationID string) (err error) {
-	metrics.RunWithMetrics(operationFlowStorageMetricLabel, func() error {
-		err = r.client.RPush(ctx, r.buildSchedulerPendingOperationsKey(schedulerName), operationID).Err()
-		return err
-	})
-	if err != nil {
-		return errors.NewErrUnexpected("failed to insert operation ID on redis").WithError(err)
-	}
-
-	return nil
-}
-
-// InsertPriorityOperationID inserts the operationID on the top of pending operations list.
-func (r *redisOperationFlow) InsertPriorityOperationID(ctx context.Context, schedulerName string, operationID string) (err error) {
+// operations list of the given priority, signaling that a new operation is
+// available.
+func (r *redisOperationFlow) InsertOperationID(ctx context.Context, schedulerName string, operationID string, priority operation.Priority) (err error) {
 	metrics.RunWithMetrics(operationFlowStorageMetricLabel, func() error {
-		err = r.client.LPush(ctx, r.buildSchedulerPendingOperationsKey(schedulerName), operationID).Err()
+		pipe := r.client.TxPipeline()
+		pipe.RPush(ctx, r.buildSchedulerPendingOperationsKey(schedulerName, priority), operationID)
+		pipe.RPush(ctx, r.buildSchedulerPendingOperationsSignalKey(schedulerName), operationID)
+		_, err = pipe.Exec(ctx)
 		return err
 	})
 	if err != nil {
@@ -78,8 +111,9 @@ func (r *redisOperationFlow) InsertPriorityOperationID(ctx context.Context, sche
 	return nil
 }
 
-// NextOperationID fetches the next scheduler operation ID from the
-// pending_operations list.
+// NextOperationID fetches the next scheduler operation ID, moving it from the
+// pending operations list with the highest priority to the auxiliary list.
+// If there is no pending operation, it waits until one is inserted.
 func (r *redisOperationFlow) NextOperationID(ctx context.Context, schedulerName string) (opID string, err error) {
 	opID, err = r.fetchNextOpIDFromAuxiliaryQueue(ctx, schedulerName)
 
@@ -91,13 +125,21 @@ func (r *redisOperationFlow) NextOperationID(ctx context.Context, schedulerName
 		return "", errors.NewErrUnexpected("failed to fetch next operation ID from auxiliary queue").WithError(err)
 	}
 
-	opID, err = r.waitAndFetchNextOpIDFromMainQueue(ctx, schedulerName)
+	for {
+		opID, err = r.moveNextOpIDToAuxiliaryQueue(ctx, schedulerName)
+		if err == nil {
+			return opID, nil
+		}
+
+		if err != redis.Nil {
+			return "", errors.NewErrUnexpected("failed to fetch next operation ID from pending queues").WithError(err)
+		}
 
-	if err != nil {
-		return "", errors.NewErrUnexpected("failed to fetch next operation ID from main queue").WithError(err)
+		err = r.waitOperationIDInsertion(ctx, schedulerName)
+		if err != nil {
+			return "", errors.NewErrUnexpected("failed to wait for pending operations").WithError(err)
+		}
 	}
-
-	return opID, nil
 }
 
 // RemoveNextOperation removes the next operation from the operation flow.
@@ -117,11 +159,15 @@ func (r *redisOperationFlow) RemoveNextOperation(ctx context.Context, schedulerN
 	return nil
 }
 
+// ListSchedulerPendingOperationIDs lists the scheduler pending operation IDs
+// in the order they will be processed: the ones held while the scheduler
+// operations are paused, the one being processed and then the ones of each
+// priority, from the highest to the lowest.
 func (r *redisOperationFlow) ListSchedulerPendingOperationIDs(ctx context.Context, schedulerName string) (operationsIDs []string, err error) {
-	operationsIDs, err = r.listPendingOperationsFromQueue(ctx, schedulerName, r.buildSchedulerPendingOperationsKey(schedulerName))
+	operationsIDs, err = r.listPendingOperationsFromQueue(ctx, schedulerName, r.buildSchedulerHeldOperationsKey(schedulerName))
 
-	if err != nil {
-		return nil, errors.NewErrUnexpected("failed to list pending operations for \"%s\" from main queue", schedulerName).WithError(err)
+	if err != nil && err != redis.Nil {
+		return nil, errors.NewErrUnexpected("failed to list held operations for \"%s\"", schedulerName).WithError(err)
 	}
 
 	operationsIDsFromAux, err := r.listPendingOperationsFromQueue(ctx, schedulerName, r.buildSchedulerAuxiliaryPendingOperationsKey(schedulerName))
@@ -129,8 +175,138 @@ func (r *redisOperationFlow) ListSchedulerPendingOperationIDs(ctx context.Contex
 	if err != nil && err != redis.Nil {
 		return nil, errors.NewErrUnexpected("failed to list pending operations for \"%s\"", schedulerName).WithError(err)
 	}
+	operationsIDs = append(operationsIDs, operationsIDsFromAux...)
+
+	for _, priority := range operation.Priorities {
+		priorityOperationsIDs, err := r.listPendingOperationsFromQueue(ctx, schedulerName, r.buildSchedulerPendingOperationsKey(schedulerName, priority))
+
+		if err != nil {
+			return nil, errors.NewErrUnexpected("failed to list pending operations for \"%s\" from priority queues", schedulerName).WithError(err)
+		}
+		operationsIDs = append(operationsIDs, priorityOperationsIDs...)
+	}
 
-	return append(operationsIDsFromAux, operationsIDs...), nil
+	return operationsIDs, nil
+}
+
+// PauseOperations stores the scheduler operations pause with the given reason.
+func (r *redisOperationFlow) PauseOperations(ctx context.Context, schedulerName, reason string) (err error) {
+	metrics.RunWithMetrics(operationFlowStorageMetricLabel, func() error {
+		err = r.client.HSet(ctx, r.buildSchedulerOperationsPauseKey(schedulerName), map[string]interface{}{
+			pauseReasonRedisKey:   reason,
+			pausePausedAtRedisKey: time.Now().Unix(),
+		}).Err()
+		return err
+	})
+	if err != nil {
+		return errors.NewErrUnexpected("failed to pause operations for \"%s\"", schedulerName).WithError(err)
+	}
+
+	return nil
+}
+
+// ResumeOperations removes the scheduler operations pause and moves the held
+// operation IDs back to the top of the pending operations list of the
+// priority they were held with, keeping their order.
+func (r *redisOperationFlow) ResumeOperations(ctx context.Context, schedulerName string) (err error) {
+	heldKey := r.buildSchedulerHeldOperationsKey(schedulerName)
+	heldPrioritiesKey := r.buildSchedulerHeldOperationsPrioritiesKey(schedulerName)
+	resume := func(tx *redis.Tx) error {
+		heldIDs, err := tx.LRange(ctx, heldKey, 0, -1).Result()
+		if err != nil {
+			return err
+		}
+
+		heldPriorities, err := tx.HGetAll(ctx, heldPrioritiesKey).Result()
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			// The IDs are pushed to the head of their lists from the
+			// last to the first one, so they keep their order.
+			for i := len(heldIDs) - 1; i >= 0; i-- {
+				priority := operation.PriorityNormal
+				if heldPriority, err := strconv.Atoi(heldPriorities[heldIDs[i]]); err == nil {
+					priority = operation.Priority(heldPriority)
+				}
+				pipe.LPush(ctx, r.buildSchedulerPendingOperationsKey(schedulerName, priority), heldIDs[i])
+				pipe.RPush(ctx, r.buildSchedulerPendingOperationsSignalKey(schedulerName), heldIDs[i])
+			}
+			pipe.Del(ctx, heldKey, heldPrioritiesKey, r.buildSchedulerOperationsPauseKey(schedulerName))
+			return nil
+		})
+		return err
+	}
+
+	metrics.RunWithMetrics(operationFlowStorageMetricLabel, func() error {
+		// When an operation is held while this resume is watching the held
+		// operations, the transaction is retried so it is resumed as well.
+		for attempt := 0; attempt < resumeOperationsTxMaxAttempts; attempt++ {
+			err = r.client.Watch(ctx, resume, heldKey, heldPrioritiesKey)
+			if err != redis.TxFailedErr {
+				return err
+			}
+		}
+		return err
+	})
+	if err != nil {
+		return errors.NewErrUnexpected("failed to resume operations for \"%s\"", schedulerName).WithError(err)
+	}
+
+	return nil
+}
+
+// GetOperationsPause fetches the scheduler operations pause, returning nil if
+// the scheduler operations are not paused.
+func (r *redisOperationFlow) GetOperationsPause(ctx context.Context, schedulerName string) (pause *operation.OperationsPause, err error) {
+	var pauseHash map[string]string
+	metrics.RunWithMetrics(operationFlowStorageMetricLabel, func() error {
+		pauseHash, err = r.client.HGetAll(ctx, r.buildSchedulerOperationsPauseKey(schedulerName)).Result()
+		return err
+	})
+	if err != nil {
+		return nil, errors.NewErrUnexpected("failed to fetch operations pause for \"%s\"", schedulerName).WithError(err)
+	}
+
+	if len(pauseHash) == 0 {
+		return nil, nil
+	}
+
+	pausedAt, err := strconv.ParseInt(pauseHash[pausePausedAtRedisKey], 10, 64)
+	if err != nil {
+		return nil, errors.NewErrEncoding("failed to parse operations pause date").WithError(err)
+	}
+
+	return &operation.OperationsPause{
+		Reason:   pauseHash[pauseReasonRedisKey],
+		PausedAt: time.Unix(pausedAt, 0),
+	}, nil
+}
+
+// HoldOperationID pushes the operation ID to the scheduler held operations
+// list, along with its priority, where it stays until the scheduler
+// operations are resumed. The operation is only held if the scheduler
+// operations are still paused, returning false otherwise.
+func (r *redisOperationFlow) HoldOperationID(ctx context.Context, schedulerName, operationID string, priority operation.Priority) (held bool, err error) {
+	keys := []string{
+		r.buildSchedulerOperationsPauseKey(schedulerName),
+		r.buildSchedulerHeldOperationsKey(schedulerName),
+		r.buildSchedulerHeldOperationsPrioritiesKey(schedulerName),
+	}
+	metrics.RunWithMetrics(operationFlowStorageMetricLabel, func() error {
+		// The operation may already be held if the worker stopped before
+		// removing it from the auxiliary list, so it is removed first.
+		var result int64
+		result, err = holdOpIDScript.Run(ctx, r.client, keys, operationID, strconv.Itoa(int(priority))).Int64()
+		held = result == 1
+		return err
+	})
+	if err != nil {
+		return false, errors.NewErrUnexpected("failed to hold operation ID on redis").WithError(err)
+	}
+
+	return held, nil
 }
 
 func (r *redisOperationFlow) EnqueueOperationCancellationRequest(ctx context.Context, request ports.OperationCancellationRequest) (err error) {
@@ -187,14 +363,38 @@ func (r *redisOperationFlow) WatchOperationCancellationRequests(ctx context.Cont
 	return resultChan
 }
 
-func (r *redisOperationFlow) buildSchedulerPendingOperationsKey(schedulerName string) string {
-	return fmt.Sprintf("pending_operations:%s", schedulerName)
+// buildSchedulerPendingOperationsKey returns the pending operations list key
+// of the priority. The normal priority keeps the key used before the
+// priorities were introduced, so the operations enqueued with it are still
+// processed.
+func (r *redisOperationFlow) buildSchedulerPendingOperationsKey(schedulerName string, priority operation.Priority) string {
+	if priority == operation.PriorityNormal {
+		return fmt.Sprintf("pending_operations:%s", schedulerName)
+	}
+
+	return fmt.Sprintf("pending_operations:%s:priority:%d", schedulerName, priority)
+}
+
+func (r *redisOperationFlow) buildSchedulerPendingOperationsSignalKey(schedulerName string) string {
+	return fmt.Sprintf("pending_operations:%s:signal", schedulerName)
 }
 
 func (r *redisOperationFlow) buildSchedulerAuxiliaryPendingOperationsKey(schedulerName string) string {
 	return fmt.Sprintf("pending_operations:%s:auxiliary", schedulerName)
 }
 
+func (r *redisOperationFlow) buildSchedulerHeldOperationsKey(schedulerName string) string {
+	return fmt.Sprintf("pending_operations:%s:held", schedulerName)
+}
+
+func (r *redisOperationFlow) buildSchedulerHeldOperationsPrioritiesKey(schedulerName string) string {
+	return fmt.Sprintf("pending_operations:%s:held:priorities", schedulerName)
+}
+
+func (r *redisOperationFlow) buildSchedulerOperationsPauseKey(schedulerName string) string {
+	return fmt.Sprintf("pending_operations:%s:pause", schedulerName)
+}
+
 func (r *redisOperationFlow) fetchNextOpIDFromAuxiliaryQueue(ctx context.Context, schedulerName string) (opID string, err error) {
 	metrics.RunWithMetrics(operationFlowStorageMetricLabel, func() error {
 		opID, err = r.client.LIndex(ctx, r.buildSchedulerAuxiliaryPendingOperationsKey(schedulerName), 0).Result()
@@ -204,15 +404,34 @@ func (r *redisOperationFlow) fetchNextOpIDFromAuxiliaryQueue(ctx context.Context
 	return opID, err
 }
 
-func (r *redisOperationFlow) waitAndFetchNextOpIDFromMainQueue(ctx context.Context, schedulerName string) (opID string, err error) {
+func (r *redisOperationFlow) moveNextOpIDToAuxiliaryQueue(ctx context.Context, schedulerName string) (opID string, err error) {
+	keys := []string{
+		r.buildSchedulerAuxiliaryPendingOperationsKey(schedulerName),
+		r.buildSchedulerPendingOperationsSignalKey(schedulerName),
+	}
+	for _, priority := range operation.Priorities {
+		keys = append(keys, r.buildSchedulerPendingOperationsKey(schedulerName, priority))
+	}
+
 	metrics.RunWithMetrics(operationFlowStorageMetricLabel, func() error {
-		opID, err = r.client.BLMove(ctx, r.buildSchedulerPendingOperationsKey(schedulerName), r.buildSchedulerAuxiliaryPendingOperationsKey(schedulerName), "LEFT", "RIGHT", 0).Result()
+		opID, err = moveNextOpIDScript.Run(ctx, r.client, keys).Text()
 
 		return err
 	})
 	return opID, err
 }
 
+// waitOperationIDInsertion blocks until an operation ID is inserted, consuming
+// its insertion signal.
+func (r *redisOperationFlow) waitOperationIDInsertion(ctx context.Context, schedulerName string) (err error) {
+	metrics.RunWithMetrics(operationFlowStorageMetricLabel, func() error {
+		err = r.client.BLPop(ctx, 0, r.buildSchedulerPendingOperationsSignalKey(schedulerName)).Err()
+
+		return err
+	})
+	return err
+}
+
 func (r *redisOperationFlow) listPendingOperationsFromQueue(ctx context.Context, schedulerName string, queueKey string) (operationsIDs []string, err error) {
 	metrics.RunWithMetrics(operationFlowStorageMetricLabel, func() error {
 		operationsIDs, err = r.client.LRange(ctx, queueKey, 0, -1).Result()
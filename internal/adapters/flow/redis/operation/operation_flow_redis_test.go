@@ -35,6 +35,7 @@ import (
 	"github.com/go-redis/redis/v8"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/topfreegames/maestro/internal/core/entities/operation"
 	"github.com/topfreegames/maestro/internal/core/ports"
 
 	"github.com/google/uuid"
@@ -50,45 +51,38 @@ func TestInsertOperationID(t *testing.T) {
 		schedulerName := "test-scheduler"
 		expectedOperationID := "some-op-id"
 
-		err := flow.InsertOperationID(context.Background(), schedulerName, expectedOperationID)
+		err := flow.InsertOperationID(context.Background(), schedulerName, expectedOperationID, operation.PriorityNormal)
 		require.NoError(t, err)
 
-		opID, err := client.LPop(context.Background(), flow.buildSchedulerPendingOperationsKey(schedulerName)).Result()
+		opID, err := client.LPop(context.Background(), flow.buildSchedulerPendingOperationsKey(schedulerName, operation.PriorityNormal)).Result()
 		require.NoError(t, err)
 		require.Equal(t, expectedOperationID, opID)
-	})
-
-	t.Run("fails on redis", func(t *testing.T) {
-		client := test.GetRedisConnection(t, redisAddress)
-		flow := NewRedisOperationFlow(client)
 
-		// "drop" redis connection
-		client.Close()
-
-		err := flow.InsertOperationID(context.Background(), "", "")
-		require.ErrorIs(t, errors.ErrUnexpected, err)
+		signalCount, err := client.LLen(context.Background(), flow.buildSchedulerPendingOperationsSignalKey(schedulerName)).Result()
+		require.NoError(t, err)
+		require.Equal(t, int64(1), signalCount)
 	})
-}
 
-func TestInsertPriorityOperationID(t *testing.T) {
-	t.Run("with success", func(t *testing.T) {
+	t.Run("keeps the insertion order inside each priority", func(t *testing.T) {
 		client := test.GetRedisConnection(t, redisAddress)
 		flow := NewRedisOperationFlow(client)
-
+		ctx := context.Background()
 		schedulerName := "test-scheduler"
 
-		lowPriorityOperationID := "low-priority-operation"
-		highPriorityOperationID := "high-priority-operation"
-
-		err := flow.InsertPriorityOperationID(context.Background(), schedulerName, lowPriorityOperationID)
+		err := flow.InsertOperationID(ctx, schedulerName, "high-op-id1", operation.PriorityHigh)
+		require.NoError(t, err)
+		err = flow.InsertOperationID(ctx, schedulerName, "normal-op-id", operation.PriorityNormal)
+		require.NoError(t, err)
+		err = flow.InsertOperationID(ctx, schedulerName, "high-op-id2", operation.PriorityHigh)
 		require.NoError(t, err)
 
-		err = flow.InsertPriorityOperationID(context.Background(), schedulerName, highPriorityOperationID)
+		opIDs, err := client.LRange(ctx, flow.buildSchedulerPendingOperationsKey(schedulerName, operation.PriorityHigh), 0, -1).Result()
 		require.NoError(t, err)
+		require.Equal(t, []string{"high-op-id1", "high-op-id2"}, opIDs)
 
-		opID, err := client.LPop(context.Background(), flow.buildSchedulerPendingOperationsKey(schedulerName)).Result()
+		opIDs, err = client.LRange(ctx, flow.buildSchedulerPendingOperationsKey(schedulerName, operation.PriorityNormal), 0, -1).Result()
 		require.NoError(t, err)
-		require.Equal(t, highPriorityOperationID, opID)
+		require.Equal(t, []string{"normal-op-id"}, opIDs)
 	})
 
 	t.Run("fails on redis", func(t *testing.T) {
@@ -98,7 +92,7 @@ func TestInsertPriorityOperationID(t *testing.T) {
 		// "drop" redis connection
 		client.Close()
 
-		err := flow.InsertPriorityOperationID(context.Background(), "", "")
+		err := flow.InsertOperationID(context.Background(), "", "", operation.PriorityNormal)
 		require.ErrorIs(t, errors.ErrUnexpected, err)
 	})
 }
@@ -111,7 +105,7 @@ func TestNextOperationID(t *testing.T) {
 		schedulerName := "test-scheduler"
 		expectedOperationID := "some-op-id"
 
-		err := flow.InsertOperationID(context.Background(), schedulerName, expectedOperationID)
+		err := flow.InsertOperationID(context.Background(), schedulerName, expectedOperationID, operation.PriorityNormal)
 		require.NoError(t, err)
 
 		opID, err := flow.NextOperationID(context.Background(), schedulerName)
@@ -119,6 +113,71 @@ func TestNextOperationID(t *testing.T) {
 		require.Equal(t, expectedOperationID, opID)
 	})
 
+	t.Run("receives the operation IDs ordered by priority", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		flow := NewRedisOperationFlow(client)
+		ctx := context.Background()
+		schedulerName := "test-scheduler"
+
+		inserts := []struct {
+			operationID string
+			priority    operation.Priority
+		}{
+			{"low-op-id", operation.PriorityLow},
+			{"normal-op-id1", operation.PriorityNormal},
+			{"high-op-id", operation.PriorityHigh},
+			{"normal-op-id2", operation.PriorityNormal},
+			{"critical-op-id", operation.PriorityCritical},
+		}
+		for _, insert := range inserts {
+			err := flow.InsertOperationID(ctx, schedulerName, insert.operationID, insert.priority)
+			require.NoError(t, err)
+		}
+
+		for _, expectedOperationID := range []string{"critical-op-id", "high-op-id", "normal-op-id1", "normal-op-id2", "low-op-id"} {
+			opID, err := flow.NextOperationID(ctx, schedulerName)
+			require.NoError(t, err)
+			require.Equal(t, expectedOperationID, opID)
+
+			err = flow.RemoveNextOperation(ctx, schedulerName)
+			require.NoError(t, err)
+		}
+
+		signalCount, err := client.LLen(ctx, flow.buildSchedulerPendingOperationsSignalKey(schedulerName)).Result()
+		require.NoError(t, err)
+		require.Zero(t, signalCount)
+	})
+
+	t.Run("waits until an operation ID is inserted", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		flow := NewRedisOperationFlow(client)
+		ctx := context.Background()
+		schedulerName := "test-scheduler"
+
+		nextOpID := make(chan string)
+		go func() {
+			opID, err := flow.NextOperationID(ctx, schedulerName)
+			require.NoError(t, err)
+			nextOpID <- opID
+		}()
+
+		select {
+		case opID := <-nextOpID:
+			require.Fail(t, "unexpected operation ID received", opID)
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		err := flow.InsertOperationID(ctx, schedulerName, "some-op-id", operation.PriorityLow)
+		require.NoError(t, err)
+
+		select {
+		case opID := <-nextOpID:
+			require.Equal(t, "some-op-id", opID)
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "operation ID not received")
+		}
+	})
+
 	t.Run("failed with context canceled", func(t *testing.T) {
 		client := test.GetRedisConnection(t, redisAddress)
 		flow := NewRedisOperationFlow(client)
@@ -320,6 +379,44 @@ func TestListSchedulerPendingOperationIDs(t *testing.T) {
 			[]string{"some-op-id1", "some-op-id2"},
 			nil,
 		},
+		{"return no error and the list of pending operations ordered by priority",
+			args{
+				schedulerName: "test-scheduler",
+			},
+			environmentSetup{
+				prepareDatabase: func(schedulerName string, client *redis.Client) {
+					err := client.RPush(context.Background(), fmt.Sprintf("pending_operations:%s:priority:-1", schedulerName), "some-op-id4").Err()
+					require.NoError(t, err)
+					err = client.RPush(context.Background(), fmt.Sprintf("pending_operations:%s", schedulerName), "some-op-id3").Err()
+					require.NoError(t, err)
+					err = client.RPush(context.Background(), fmt.Sprintf("pending_operations:%s:priority:1", schedulerName), "some-op-id2").Err()
+					require.NoError(t, err)
+					err = client.RPush(context.Background(), fmt.Sprintf("pending_operations:%s:priority:2", schedulerName), "some-op-id1").Err()
+					require.NoError(t, err)
+				},
+				forceClientError: false,
+			},
+			[]string{"some-op-id1", "some-op-id2", "some-op-id3", "some-op-id4"},
+			nil,
+		},
+		{"return no error and the list of pending operations including the held ones",
+			args{
+				schedulerName: "test-scheduler",
+			},
+			environmentSetup{
+				prepareDatabase: func(schedulerName string, client *redis.Client) {
+					err := client.RPush(context.Background(), fmt.Sprintf("pending_operations:%s", schedulerName), "some-op-id3").Err()
+					require.NoError(t, err)
+					err = client.RPush(context.Background(), fmt.Sprintf("pending_operations:%s:auxiliary", schedulerName), "some-op-id2").Err()
+					require.NoError(t, err)
+					err = client.RPush(context.Background(), fmt.Sprintf("pending_operations:%s:held", schedulerName), "some-op-id1").Err()
+					require.NoError(t, err)
+				},
+				forceClientError: false,
+			},
+			[]string{"some-op-id1", "some-op-id2", "some-op-id3"},
+			nil,
+		},
 		{"return error when some error occurs with redis client",
 			args{
 				schedulerName: "test-scheduler",
@@ -329,7 +426,7 @@ func TestListSchedulerPendingOperationIDs(t *testing.T) {
 				forceClientError: true,
 			},
 			[]string{"some-op-id1", "some-op-id2"},
-			errors.NewErrUnexpected("failed to list pending operations for \"test-scheduler\" from main queue: redis: client is closed"),
+			errors.NewErrUnexpected("failed to list held operations for \"test-scheduler\": redis: client is closed"),
 		},
 	}
 	for _, tt := range tests {
@@ -437,3 +534,140 @@ func TestRemoveNextOperation(t *testing.T) {
 		})
 	}
 }
+
+func TestPauseOperations(t *testing.T) {
+	t.Run("with success", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		flow := NewRedisOperationFlow(client)
+		ctx := context.Background()
+		schedulerName := "test-scheduler"
+
+		pause, err := flow.GetOperationsPause(ctx, schedulerName)
+		require.NoError(t, err)
+		require.Nil(t, pause)
+
+		err = flow.PauseOperations(ctx, schedulerName, "incident")
+		require.NoError(t, err)
+
+		pause, err = flow.GetOperationsPause(ctx, schedulerName)
+		require.NoError(t, err)
+		require.Equal(t, "incident", pause.Reason)
+		require.WithinDuration(t, time.Now(), pause.PausedAt, time.Minute)
+	})
+
+	t.Run("fails on redis error", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		flow := NewRedisOperationFlow(client)
+		client.Close()
+
+		err := flow.PauseOperations(context.Background(), "test-scheduler", "incident")
+		require.ErrorIs(t, err, errors.ErrUnexpected)
+	})
+}
+
+func TestResumeOperations(t *testing.T) {
+	t.Run("removes the pause and moves held operations to the top of the pending list of their priority", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		flow := NewRedisOperationFlow(client)
+		ctx := context.Background()
+		schedulerName := "test-scheduler"
+
+		err := flow.PauseOperations(ctx, schedulerName, "incident")
+		require.NoError(t, err)
+		err = flow.InsertOperationID(ctx, schedulerName, "some-op-id3", operation.PriorityCritical)
+		require.NoError(t, err)
+		err = flow.InsertOperationID(ctx, schedulerName, "some-op-id5", operation.PriorityLow)
+		require.NoError(t, err)
+		_, err = flow.HoldOperationID(ctx, schedulerName, "some-op-id1", operation.PriorityCritical)
+		require.NoError(t, err)
+		_, err = flow.HoldOperationID(ctx, schedulerName, "some-op-id2", operation.PriorityCritical)
+		require.NoError(t, err)
+		_, err = flow.HoldOperationID(ctx, schedulerName, "some-op-id4", operation.PriorityLow)
+		require.NoError(t, err)
+
+		err = flow.ResumeOperations(ctx, schedulerName)
+		require.NoError(t, err)
+
+		pause, err := flow.GetOperationsPause(ctx, schedulerName)
+		require.NoError(t, err)
+		require.Nil(t, pause)
+
+		opIDs, err := client.LRange(ctx, flow.buildSchedulerPendingOperationsKey(schedulerName, operation.PriorityCritical), 0, -1).Result()
+		require.NoError(t, err)
+		require.Equal(t, []string{"some-op-id1", "some-op-id2", "some-op-id3"}, opIDs)
+
+		opIDs, err = client.LRange(ctx, flow.buildSchedulerPendingOperationsKey(schedulerName, operation.PriorityLow), 0, -1).Result()
+		require.NoError(t, err)
+		require.Equal(t, []string{"some-op-id4", "some-op-id5"}, opIDs)
+
+		signalCount, err := client.LLen(ctx, flow.buildSchedulerPendingOperationsSignalKey(schedulerName)).Result()
+		require.NoError(t, err)
+		require.Equal(t, int64(5), signalCount)
+
+		heldCount, err := client.LLen(ctx, flow.buildSchedulerHeldOperationsKey(schedulerName)).Result()
+		require.NoError(t, err)
+		require.Zero(t, heldCount)
+
+		heldPrioritiesCount, err := client.HLen(ctx, flow.buildSchedulerHeldOperationsPrioritiesKey(schedulerName)).Result()
+		require.NoError(t, err)
+		require.Zero(t, heldPrioritiesCount)
+	})
+
+	t.Run("removes the pause when there are no held operations", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		flow := NewRedisOperationFlow(client)
+		ctx := context.Background()
+		schedulerName := "test-scheduler"
+
+		err := flow.PauseOperations(ctx, schedulerName, "incident")
+		require.NoError(t, err)
+
+		err = flow.ResumeOperations(ctx, schedulerName)
+		require.NoError(t, err)
+
+		pause, err := flow.GetOperationsPause(ctx, schedulerName)
+		require.NoError(t, err)
+		require.Nil(t, pause)
+	})
+}
+
+func TestHoldOperationID(t *testing.T) {
+	t.Run("does not duplicate an operation already held", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		flow := NewRedisOperationFlow(client)
+		ctx := context.Background()
+		schedulerName := "test-scheduler"
+
+		err := flow.PauseOperations(ctx, schedulerName, "incident")
+		require.NoError(t, err)
+		held, err := flow.HoldOperationID(ctx, schedulerName, "some-op-id", operation.PriorityNormal)
+		require.NoError(t, err)
+		require.True(t, held)
+		held, err = flow.HoldOperationID(ctx, schedulerName, "some-op-id", operation.PriorityNormal)
+		require.NoError(t, err)
+		require.True(t, held)
+
+		opIDs, err := client.LRange(ctx, flow.buildSchedulerHeldOperationsKey(schedulerName), 0, -1).Result()
+		require.NoError(t, err)
+		require.Equal(t, []string{"some-op-id"}, opIDs)
+	})
+
+	t.Run("does not hold the operation when the scheduler operations are not paused", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		flow := NewRedisOperationFlow(client)
+		ctx := context.Background()
+		schedulerName := "test-scheduler"
+
+		held, err := flow.HoldOperationID(ctx, schedulerName, "some-op-id", operation.PriorityNormal)
+		require.NoError(t, err)
+		require.False(t, held)
+
+		heldCount, err := client.LLen(ctx, flow.buildSchedulerHeldOperationsKey(schedulerName)).Result()
+		require.NoError(t, err)
+		require.Zero(t, heldCount)
+
+		heldPrioritiesCount, err := client.HLen(ctx, flow.buildSchedulerHeldOperationsPrioritiesKey(schedulerName)).Result()
+		require.NoError(t, err)
+		require.Zero(t, heldPrioritiesCount)
+	})
+}
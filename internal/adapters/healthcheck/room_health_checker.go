@@ -0,0 +1,116 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/topfreegames/maestro/internal/core/entities/rollout"
+	"github.com/topfreegames/maestro/internal/core/ports"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const defaultTimeout = 5 * time.Second
+
+var _ ports.RoomHealthChecker = (*RoomHealthChecker)(nil)
+
+// RoomHealthChecker checks the game rooms health using HTTP requests or the
+// standard gRPC health checking protocol.
+type RoomHealthChecker struct {
+	httpClient *http.Client
+}
+
+// NewRoomHealthChecker returns a new instance of RoomHealthChecker.
+func NewRoomHealthChecker() *RoomHealthChecker {
+	return &RoomHealthChecker{
+		httpClient: &http.Client{},
+	}
+}
+
+// Check sends the health check to the game room listening on the address.
+func (c *RoomHealthChecker) Check(ctx context.Context, address string, healthCheck *rollout.HealthCheckParams) error {
+	timeout := healthCheck.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	switch healthCheck.Protocol {
+	case rollout.HealthCheckHTTP:
+		return c.checkHTTP(ctx, address, healthCheck.Path)
+	case rollout.HealthCheckGRPC:
+		return c.checkGRPC(ctx, address, healthCheck.Path)
+	}
+
+	return fmt.Errorf("unknown health check protocol: %s", healthCheck.Protocol)
+}
+
+func (c *RoomHealthChecker) checkHTTP(ctx context.Context, address, path string) error {
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://%s%s", address, path), nil)
+	if err != nil {
+		return fmt.Errorf("failed to build health check request: %w", err)
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to request room health check: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("room health check responded with status code %d", response.StatusCode)
+	}
+
+	return nil
+}
+
+func (c *RoomHealthChecker) checkGRPC(ctx context.Context, address, service string) error {
+	conn, err := grpc.DialContext(ctx, address, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	if err != nil {
+		return fmt.Errorf("failed to connect to room: %w", err)
+	}
+	defer conn.Close()
+
+	response, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		return fmt.Errorf("failed to call room health check: %w", err)
+	}
+
+	if response.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("room health check responded with status %s", response.GetStatus())
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build unit
+// +build unit
+
+package healthcheck
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/topfreegames/maestro/internal/core/entities/rollout"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestRoomHealthChecker_Check(t *testing.T) {
+	checker := NewRoomHealthChecker()
+
+	t.Run("http", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/healthz" {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+		address := strings.TrimPrefix(server.URL, "http://")
+
+		t.Run("returns no error when the room responds with success", func(t *testing.T) {
+			err := checker.Check(context.Background(), address, &rollout.HealthCheckParams{Protocol: rollout.HealthCheckHTTP, Path: "healthz"})
+			require.NoError(t, err)
+		})
+
+		t.Run("returns error when the room responds with failure", func(t *testing.T) {
+			err := checker.Check(context.Background(), address, &rollout.HealthCheckParams{Protocol: rollout.HealthCheckHTTP, Path: "/other"})
+			require.ErrorContains(t, err, "status code 503")
+		})
+	})
+
+	t.Run("grpc", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		healthServer := health.NewServer()
+		healthServer.SetServingStatus("game", healthpb.HealthCheckResponse_SERVING)
+		healthServer.SetServingStatus("broken", healthpb.HealthCheckResponse_NOT_SERVING)
+		server := grpc.NewServer()
+		healthpb.RegisterHealthServer(server, healthServer)
+		go func() { _ = server.Serve(listener) }()
+		defer server.Stop()
+
+		t.Run("returns no error when the room is serving", func(t *testing.T) {
+			err := checker.Check(context.Background(), listener.Addr().String(), &rollout.HealthCheckParams{Protocol: rollout.HealthCheckGRPC, Path: "game"})
+			require.NoError(t, err)
+		})
+
+		t.Run("returns error when the room is not serving", func(t *testing.T) {
+			err := checker.Check(context.Background(), listener.Addr().String(), &rollout.HealthCheckParams{Protocol: rollout.HealthCheckGRPC, Path: "broken"})
+			require.ErrorContains(t, err, "NOT_SERVING")
+		})
+	})
+
+	t.Run("returns error with unknown protocol", func(t *testing.T) {
+		err := checker.Check(context.Background(), "127.0.0.1:1", &rollout.HealthCheckParams{Protocol: "tcp"})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,71 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package events
+
+import (
+	"context"
+	"sync"
+
+	"github.com/topfreegames/maestro/internal/core/ports"
+)
+
+var _ ports.EventsPublisher = (*memoryEventsPublisher)(nil)
+
+// Message is an event published on the memory events publisher.
+type Message struct {
+	Key     string
+	Payload []byte
+	Headers map[string]string
+}
+
+// memoryEventsPublisher adapter of the EventsPublisher port. It keeps the
+// published messages in memory, being used to run and test the broker
+// forwarders without a message broker.
+type memoryEventsPublisher struct {
+	mutex    sync.Mutex
+	messages map[string][]Message
+}
+
+func NewMemoryEventsPublisher() *memoryEventsPublisher {
+	return &memoryEventsPublisher{messages: map[string][]Message{}}
+}
+
+// Publish appends the message to the topic messages.
+func (m *memoryEventsPublisher) Publish(ctx context.Context, topic string, key string, payload []byte, headers map[string]string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.messages[topic] = append(m.messages[topic], Message{Key: key, Payload: payload, Headers: headers})
+	return nil
+}
+
+// Messages returns the messages published on the topic, in the order they
+// were published.
+func (m *memoryEventsPublisher) Messages(topic string) []Message {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return append([]Message(nil), m.messages[topic]...)
+}
@@ -0,0 +1,60 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build unit
+// +build unit
+
+package events
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryEventsPublisher_Publish(t *testing.T) {
+	t.Run("keeps the messages of each topic in order", func(t *testing.T) {
+		publisher := NewMemoryEventsPublisher()
+
+		require.NoError(t, publisher.Publish(context.Background(), "topic-1", "room-1", []byte("first"), nil))
+		require.NoError(t, publisher.Publish(context.Background(), "topic-2", "room-1", []byte("other"), nil))
+		require.NoError(t, publisher.Publish(context.Background(), "topic-1", "room-2", []byte("second"), map[string]string{"ce_type": "room.status.ready"}))
+
+		require.Equal(t, []Message{
+			{Key: "room-1", Payload: []byte("first")},
+			{Key: "room-2", Payload: []byte("second"), Headers: map[string]string{"ce_type": "room.status.ready"}},
+		}, publisher.Messages("topic-1"))
+		require.Equal(t, []Message{{Key: "room-1", Payload: []byte("other")}}, publisher.Messages("topic-2"))
+		require.Empty(t, publisher.Messages("topic-3"))
+	})
+
+	t.Run("fails when the context is done", func(t *testing.T) {
+		publisher := NewMemoryEventsPublisher()
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := publisher.Publish(ctx, "topic", "room", []byte("payload"), nil)
+		require.ErrorIs(t, err, context.Canceled)
+		require.Empty(t, publisher.Messages("topic"))
+	})
+}
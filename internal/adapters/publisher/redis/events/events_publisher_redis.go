@@ -0,0 +1,87 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package events
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/topfreegames/maestro/internal/adapters/metrics"
+	"github.com/topfreegames/maestro/internal/core/ports"
+	"github.com/topfreegames/maestro/internal/core/ports/errors"
+)
+
+const (
+	eventsPublisherMetricLabel = "events-publisher"
+
+	keyField     = "key"
+	payloadField = "payload"
+)
+
+var _ ports.EventsPublisher = (*redisEventsPublisher)(nil)
+
+// redisEventsPublisher adapter of the EventsPublisher port. It publishes the
+// events on redis streams, one stream per topic, so consumers can read them
+// with consumer groups.
+type redisEventsPublisher struct {
+	client *redis.Client
+	// maxLen is the approximate number of messages kept on each stream.
+	maxLen int64
+}
+
+func NewRedisEventsPublisher(client *redis.Client, maxLen int64) *redisEventsPublisher {
+	return &redisEventsPublisher{client: client, maxLen: maxLen}
+}
+
+// Publish appends the message to the topic stream, with the headers as
+// additional fields. Since a stream has a single order, the messages of each
+// key are also kept in order.
+func (r *redisEventsPublisher) Publish(ctx context.Context, topic string, key string, payload []byte, headers map[string]string) (err error) {
+	values := make(map[string]interface{}, len(headers)+2)
+	for name, value := range headers {
+		values[name] = value
+	}
+	values[keyField] = key
+	values[payloadField] = payload
+
+	metrics.RunWithMetrics(eventsPublisherMetricLabel, func() error {
+		err = r.client.XAdd(ctx, &redis.XAddArgs{
+			Stream: buildTopicKey(topic),
+			MaxLen: r.maxLen,
+			Approx: true,
+			Values: values,
+		}).Err()
+		return err
+	})
+	if err != nil {
+		return errors.NewErrUnexpected("failed to publish event on topic \"%s\"", topic).WithError(err)
+	}
+	return nil
+}
+
+// buildTopicKey prefixes the topic stream, so the topics set on the schedulers
+// can't reach the other keys Maestro keeps on Redis.
+func buildTopicKey(topic string) string {
+	return fmt.Sprintf("forwarders:topics:%s", topic)
+}
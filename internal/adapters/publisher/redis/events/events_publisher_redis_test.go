@@ -0,0 +1,83 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build integration
+// +build integration
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/topfreegames/maestro/test"
+)
+
+func TestPublish(t *testing.T) {
+	t.Run("appends the messages to the topic stream", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		publisher := NewRedisEventsPublisher(client, 100)
+		ctx := context.Background()
+
+		err := publisher.Publish(ctx, "room-events", "room-1", []byte(`{"type":"roomStatus"}`), nil)
+		require.NoError(t, err)
+		err = publisher.Publish(ctx, "room-events", "room-2", []byte(`{"type":"playerEvent"}`), nil)
+		require.NoError(t, err)
+
+		messages, err := client.XRange(ctx, "forwarders:topics:room-events", "-", "+").Result()
+		require.NoError(t, err)
+		require.Len(t, messages, 2)
+		require.Equal(t, map[string]interface{}{"key": "room-1", "payload": `{"type":"roomStatus"}`}, messages[0].Values)
+		require.Equal(t, map[string]interface{}{"key": "room-2", "payload": `{"type":"playerEvent"}`}, messages[1].Values)
+	})
+
+	t.Run("adds the headers to the message fields", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		publisher := NewRedisEventsPublisher(client, 100)
+		ctx := context.Background()
+
+		err := publisher.Publish(ctx, "cloud-events", "room-1", []byte(`{"type":"roomStatus"}`), map[string]string{"ce_type": "room.status.ready"})
+		require.NoError(t, err)
+
+		messages, err := client.XRange(ctx, "forwarders:topics:cloud-events", "-", "+").Result()
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+		require.Equal(t, map[string]interface{}{"key": "room-1", "payload": `{"type":"roomStatus"}`, "ce_type": "room.status.ready"}, messages[0].Values)
+	})
+
+	t.Run("trims the topic stream", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		publisher := NewRedisEventsPublisher(client, 1)
+		ctx := context.Background()
+
+		for i := 0; i < 1000; i++ {
+			err := publisher.Publish(ctx, "room-events", "room", []byte(fmt.Sprint(i)), nil)
+			require.NoError(t, err)
+		}
+
+		length, err := client.XLen(ctx, "forwarders:topics:room-events").Result()
+		require.NoError(t, err)
+		require.Less(t, length, int64(1000))
+	})
+}
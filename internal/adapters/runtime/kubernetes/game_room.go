@@ -63,6 +63,29 @@ func (k *kubernetes) CreateGameRoomInstance(ctx context.Context, scheduler *enti
 	return instance, nil
 }
 
+func (k *kubernetes) DryRunGameRoomInstance(ctx context.Context, scheduler *entities.Scheduler, gameRoomSpec game_room.Spec) error {
+	gameRoomName, err := k.CreateGameRoomName(ctx, *scheduler)
+	if err != nil {
+		return errors.NewErrUnexpected("error creating game room name: %s", err)
+	}
+
+	pod, err := convertGameRoomSpec(*scheduler, gameRoomName, gameRoomSpec)
+	if err != nil {
+		return errors.NewErrInvalidArgument("invalid game room spec: %s", err)
+	}
+
+	_, err = k.clientSet.CoreV1().Pods(scheduler.Name).Create(ctx, pod, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+	if err != nil {
+		if kerrors.IsInvalid(err) {
+			return errors.NewErrInvalidArgument("invalid game room spec: %s", err)
+		}
+
+		return errors.NewErrUnexpected("error dry running game room instance: %s", err)
+	}
+
+	return nil
+}
+
 func (k *kubernetes) DeleteGameRoomInstance(ctx context.Context, gameRoomInstance *game_room.Instance, reason string) error {
 	_ = k.createKubernetesEvent(ctx, gameRoomInstance.SchedulerID, gameRoomInstance.ID, reason, "GameRoomDeleted")
 
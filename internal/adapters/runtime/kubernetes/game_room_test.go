@@ -93,6 +93,48 @@ func TestGameRoomCreation(t *testing.T) {
 	})
 }
 
+func TestGameRoomDryRun(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	client := test.GetKubernetesClientSet(t, kubernetesContainer)
+	kubernetesRuntime := New(client)
+
+	t.Run("successfully dry run a room without creating it", func(t *testing.T) {
+		t.Parallel()
+		// first, create the scheduler
+		scheduler := &entities.Scheduler{Name: "game-room-dry-run-test"}
+		err := kubernetesRuntime.CreateScheduler(ctx, scheduler)
+		require.NoError(t, err)
+
+		gameRoomSpec := game_room.Spec{
+			Containers: []game_room.Container{
+				{
+					Name:  "nginx",
+					Image: "nginx:stable-alpine",
+				},
+			},
+		}
+		err = kubernetesRuntime.DryRunGameRoomInstance(ctx, scheduler, gameRoomSpec)
+		require.NoError(t, err)
+
+		pods, err := client.CoreV1().Pods(scheduler.Name).List(ctx, metav1.ListOptions{})
+		require.NoError(t, err)
+		require.Len(t, pods.Items, 0)
+	})
+
+	t.Run("fail with wrong game room spec", func(t *testing.T) {
+		t.Parallel()
+		// first, create the scheduler
+		scheduler := &entities.Scheduler{Name: "game-room-dry-run-invalid-spec"}
+		err := kubernetesRuntime.CreateScheduler(ctx, scheduler)
+		require.NoError(t, err)
+
+		err = kubernetesRuntime.DryRunGameRoomInstance(ctx, scheduler, game_room.Spec{})
+		require.Error(t, err)
+		require.ErrorIs(t, err, errors.ErrInvalidArgument)
+	})
+}
+
 func TestGameRoomDeletion(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
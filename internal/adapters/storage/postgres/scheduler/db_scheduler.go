@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/topfreegames/maestro/internal/core/entities/autoscaling"
+	"github.com/topfreegames/maestro/internal/core/entities/rollout"
 
 	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
 	"github.com/topfreegames/maestro/internal/core/entities/port"
@@ -60,12 +61,16 @@ type schedulerInfo struct {
 	Containers             []game_room.Container
 	PortRange              *port.PortRange
 	MaxSurge               string
+	MaxUnavailable         string
 	RoomsReplicas          int
 	Forwarders             []*forwarder.Forwarder
 	Autoscaling            *autoscaling.Autoscaling
+	RolloutStrategy        *rollout.Strategy
+	Template               *entities.SchedulerTemplateRef
 	Annotations            map[string]string
 	Labels                 map[string]string
 	LastDownscaleAt        time.Time
+	RolloutHealth          *rollout.Health
 }
 
 func NewDBScheduler(scheduler *entities.Scheduler) *Scheduler {
@@ -76,12 +81,16 @@ func NewDBScheduler(scheduler *entities.Scheduler) *Scheduler {
 		Containers:             scheduler.Spec.Containers,
 		PortRange:              scheduler.PortRange,
 		MaxSurge:               scheduler.MaxSurge,
+		MaxUnavailable:         scheduler.MaxUnavailable,
 		RoomsReplicas:          scheduler.RoomsReplicas,
 		Forwarders:             scheduler.Forwarders,
 		Autoscaling:            scheduler.Autoscaling,
+		RolloutStrategy:        scheduler.RolloutStrategy,
+		Template:               scheduler.Template,
 		Annotations:            scheduler.Annotations,
 		Labels:                 scheduler.Labels,
 		LastDownscaleAt:        scheduler.LastDownscaleAt,
+		RolloutHealth:          scheduler.RolloutHealth,
 	}
 	yamlBytes, _ := yaml.Marshal(info)
 	return &Scheduler{
@@ -118,8 +127,12 @@ func (s *Scheduler) ToScheduler() (*entities.Scheduler, error) {
 		CreatedAt:       s.CreatedAt.Time,
 		LastDownscaleAt: info.LastDownscaleAt,
 		MaxSurge:        info.MaxSurge,
+		MaxUnavailable:  info.MaxUnavailable,
 		RoomsReplicas:   info.RoomsReplicas,
 		Forwarders:      info.Forwarders,
 		Autoscaling:     info.Autoscaling,
+		RolloutStrategy: info.RolloutStrategy,
+		Template:        info.Template,
+		RolloutHealth:   info.RolloutHealth,
 	}, nil
 }
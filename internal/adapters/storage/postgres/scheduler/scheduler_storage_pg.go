@@ -90,11 +90,16 @@ INSERT INTO scheduler_versions (name, version, yaml, rollback_version)
 	VALUES (?, ?, ?, ?)
 	ON CONFLICT DO NOTHING`
 	queryGetSchedulerVersions = `
-SELECT v.version, v.version = s.version as is_active, v.created_at
+SELECT v.version, v.version = s.version as is_active, v.created_at, v.pinned, v.blocked
 	FROM scheduler_versions v
 	INNER JOIN schedulers s
 	ON v.name = s.name
 	WHERE v.name = ? ORDER BY created_at DESC`
+	queryUpdateVersion = `
+UPDATE scheduler_versions
+	SET (pinned, blocked) = (?, ?)
+	WHERE name = ? AND version = ?`
+	queryDeleteVersions = `DELETE FROM scheduler_versions WHERE name = ? AND version IN (?)`
 )
 
 func (s schedulerStorage) EnableTracing() {
@@ -183,6 +188,39 @@ func (s schedulerStorage) GetSchedulerVersions(ctx context.Context, name string)
 	return versions, nil
 }
 
+func (s schedulerStorage) UpdateSchedulerVersion(ctx context.Context, schedulerName string, schedulerVersion *entities.SchedulerVersion) error {
+	client := s.db.WithContext(ctx)
+	var err error
+	runSchedulerStorageFunctionCollectingLatency("UpdateSchedulerVersion", func() {
+		_, err = client.ExecOne(queryUpdateVersion, schedulerVersion.Pinned, schedulerVersion.Blocked, schedulerName, schedulerVersion.Version)
+	})
+	if err == pg.ErrNoRows {
+		return errors.NewErrNotFound("scheduler %s version %s not found", schedulerName, schedulerVersion.Version)
+	}
+	if err != nil {
+		reportSchedulerStorageFailsCounterMetric("UpdateSchedulerVersion", schedulerName)
+		return errors.NewErrUnexpected("error updating scheduler %s version %s", schedulerName, schedulerVersion.Version).WithError(err)
+	}
+	return nil
+}
+
+func (s schedulerStorage) DeleteSchedulerVersions(ctx context.Context, schedulerName string, versions []string) error {
+	if len(versions) == 0 {
+		return nil
+	}
+
+	client := s.db.WithContext(ctx)
+	var err error
+	runSchedulerStorageFunctionCollectingLatency("DeleteSchedulerVersions", func() {
+		_, err = client.Exec(queryDeleteVersions, schedulerName, pg.In(versions))
+	})
+	if err != nil {
+		reportSchedulerStorageFailsCounterMetric("DeleteSchedulerVersions", schedulerName)
+		return errors.NewErrUnexpected("error deleting scheduler %s versions", schedulerName).WithError(err)
+	}
+	return nil
+}
+
 func (s schedulerStorage) GetSchedulers(ctx context.Context, names []string) ([]*entities.Scheduler, error) {
 	client := s.db.WithContext(ctx)
 	var dbSchedulers []Scheduler
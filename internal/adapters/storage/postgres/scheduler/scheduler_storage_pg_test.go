@@ -393,6 +393,75 @@ func TestSchedulerStorage_GetSchedulerVersions(t *testing.T) {
 	})
 }
 
+func TestSchedulerStorage_UpdateSchedulerVersion(t *testing.T) {
+	t.Run("scheduler version exists", func(t *testing.T) {
+		db := getPostgresDB(t)
+		storage := NewSchedulerStorage(db.Options())
+
+		require.NoError(t, storage.CreateScheduler(context.Background(), expectedScheduler))
+		require.NoError(t, storage.CreateSchedulerVersion(context.Background(), "", expectedScheduler))
+
+		err := storage.UpdateSchedulerVersion(context.Background(), expectedScheduler.Name, &entities.SchedulerVersion{Version: "v1", Pinned: true, Blocked: true})
+		require.NoError(t, err)
+
+		versions, err := storage.GetSchedulerVersions(context.Background(), expectedScheduler.Name)
+		require.NoError(t, err)
+		require.Len(t, versions, 1)
+		require.True(t, versions[0].Pinned)
+		require.True(t, versions[0].Blocked)
+	})
+
+	t.Run("scheduler version does not exist", func(t *testing.T) {
+		db := getPostgresDB(t)
+		storage := NewSchedulerStorage(db.Options())
+
+		require.NoError(t, storage.CreateScheduler(context.Background(), expectedScheduler))
+		require.NoError(t, storage.CreateSchedulerVersion(context.Background(), "", expectedScheduler))
+
+		err := storage.UpdateSchedulerVersion(context.Background(), expectedScheduler.Name, &entities.SchedulerVersion{Version: "v99", Pinned: true})
+		require.Error(t, err)
+		require.ErrorIs(t, errors.ErrNotFound, err)
+	})
+}
+
+func TestSchedulerStorage_DeleteSchedulerVersions(t *testing.T) {
+	t.Run("delete only the given versions", func(t *testing.T) {
+		db := getPostgresDB(t)
+		storage := NewSchedulerStorage(db.Options())
+		version1 := *expectedScheduler
+		version2 := *expectedScheduler
+		version2.Spec.Version = "v2"
+		version3 := *expectedScheduler
+		version3.Spec.Version = "v3"
+
+		require.NoError(t, storage.CreateScheduler(context.Background(), expectedScheduler))
+		require.NoError(t, storage.CreateSchedulerVersion(context.Background(), "", &version1))
+		require.NoError(t, storage.CreateSchedulerVersion(context.Background(), "", &version2))
+		require.NoError(t, storage.CreateSchedulerVersion(context.Background(), "", &version3))
+
+		require.NoError(t, storage.DeleteSchedulerVersions(context.Background(), expectedScheduler.Name, []string{"v2", "v3"}))
+
+		versions, err := storage.GetSchedulerVersions(context.Background(), expectedScheduler.Name)
+		require.NoError(t, err)
+		require.Len(t, versions, 1)
+		require.Equal(t, "v1", versions[0].Version)
+	})
+
+	t.Run("does nothing when no versions are given", func(t *testing.T) {
+		db := getPostgresDB(t)
+		storage := NewSchedulerStorage(db.Options())
+
+		require.NoError(t, storage.CreateScheduler(context.Background(), expectedScheduler))
+		require.NoError(t, storage.CreateSchedulerVersion(context.Background(), "", expectedScheduler))
+
+		require.NoError(t, storage.DeleteSchedulerVersions(context.Background(), expectedScheduler.Name, nil))
+
+		versions, err := storage.GetSchedulerVersions(context.Background(), expectedScheduler.Name)
+		require.NoError(t, err)
+		require.Len(t, versions, 1)
+	})
+}
+
 func TestSchedulerStorage_CreateScheduler(t *testing.T) {
 	t.Run("scheduler does not exist", func(t *testing.T) {
 		db := getPostgresDB(t)
@@ -31,6 +31,8 @@ type SchedulerVersion struct {
 	Version   string      `db:"version"`
 	IsActive  bool        `db:"is_active"`
 	CreatedAt pg.NullTime `db:"created_at"`
+	Pinned    bool        `db:"pinned"`
+	Blocked   bool        `db:"blocked"`
 }
 
 func (s *SchedulerVersion) ToSchedulerVersion() *entities.SchedulerVersion {
@@ -38,5 +40,7 @@ func (s *SchedulerVersion) ToSchedulerVersion() *entities.SchedulerVersion {
 		Version:   s.Version,
 		IsActive:  s.IsActive,
 		CreatedAt: s.CreatedAt.Time,
+		Pinned:    s.Pinned,
+		Blocked:   s.Blocked,
 	}
 }
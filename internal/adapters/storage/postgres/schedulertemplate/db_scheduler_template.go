@@ -0,0 +1,115 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package schedulertemplate
+
+import (
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/go-pg/pg/v10"
+
+	"github.com/topfreegames/maestro/internal/core/entities"
+	"github.com/topfreegames/maestro/internal/core/entities/autoscaling"
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
+	"github.com/topfreegames/maestro/internal/core/entities/game_room"
+	"github.com/topfreegames/maestro/internal/core/entities/port"
+	"github.com/topfreegames/maestro/internal/core/entities/rollout"
+)
+
+type SchedulerTemplate struct {
+	ID        string      `db:"id"`
+	Name      string      `db:"name"`
+	Yaml      string      `db:"yaml"`
+	CreatedAt pg.NullTime `db:"created_at"`
+	UpdatedAt pg.NullTime `db:"updated_at"`
+}
+
+type schedulerTemplateInfo struct {
+	Version                string
+	TerminationGracePeriod time.Duration
+	Toleration             string
+	Affinity               string
+	Containers             []game_room.Container
+	PortRange              *port.PortRange
+	MaxSurge               string
+	MaxUnavailable         string
+	RoomsReplicas          int
+	Forwarders             []*forwarder.Forwarder
+	Autoscaling            *autoscaling.Autoscaling
+	RolloutStrategy        *rollout.Strategy
+	Annotations            map[string]string
+	Labels                 map[string]string
+}
+
+func NewDBSchedulerTemplate(template *entities.SchedulerTemplate) *SchedulerTemplate {
+	info := schedulerTemplateInfo{
+		Version:                template.Spec.Version,
+		TerminationGracePeriod: template.Spec.TerminationGracePeriod,
+		Toleration:             template.Spec.Toleration,
+		Affinity:               template.Spec.Affinity,
+		Containers:             template.Spec.Containers,
+		PortRange:              template.PortRange,
+		MaxSurge:               template.MaxSurge,
+		MaxUnavailable:         template.MaxUnavailable,
+		RoomsReplicas:          template.RoomsReplicas,
+		Forwarders:             template.Forwarders,
+		Autoscaling:            template.Autoscaling,
+		RolloutStrategy:        template.RolloutStrategy,
+		Annotations:            template.Annotations,
+		Labels:                 template.Labels,
+	}
+	yamlBytes, _ := yaml.Marshal(info)
+	return &SchedulerTemplate{
+		Name: template.Name,
+		Yaml: string(yamlBytes),
+	}
+}
+
+func (t *SchedulerTemplate) ToSchedulerTemplate() (*entities.SchedulerTemplate, error) {
+	var info schedulerTemplateInfo
+	err := yaml.Unmarshal([]byte(t.Yaml), &info)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.SchedulerTemplate{
+		Name: t.Name,
+		Spec: game_room.Spec{
+			Version:                info.Version,
+			TerminationGracePeriod: info.TerminationGracePeriod,
+			Toleration:             info.Toleration,
+			Affinity:               info.Affinity,
+			Containers:             info.Containers,
+		},
+		PortRange:       info.PortRange,
+		MaxSurge:        info.MaxSurge,
+		MaxUnavailable:  info.MaxUnavailable,
+		RoomsReplicas:   info.RoomsReplicas,
+		Forwarders:      info.Forwarders,
+		Autoscaling:     info.Autoscaling,
+		RolloutStrategy: info.RolloutStrategy,
+		Annotations:     info.Annotations,
+		Labels:          info.Labels,
+		CreatedAt:       t.CreatedAt.Time,
+		UpdatedAt:       t.UpdatedAt.Time,
+	}, nil
+}
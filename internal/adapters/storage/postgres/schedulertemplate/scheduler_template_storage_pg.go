@@ -0,0 +1,141 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package schedulertemplate
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-pg/pg/extra/pgotel/v10"
+	"github.com/go-pg/pg/v10"
+
+	"github.com/topfreegames/maestro/internal/core/entities"
+	"github.com/topfreegames/maestro/internal/core/ports"
+	"github.com/topfreegames/maestro/internal/core/ports/errors"
+)
+
+var (
+	_ ports.SchedulerTemplateStorage = (*schedulerTemplateStorage)(nil)
+)
+
+type schedulerTemplateStorage struct {
+	db *pg.DB
+}
+
+func NewSchedulerTemplateStorage(opts *pg.Options) *schedulerTemplateStorage {
+	return &schedulerTemplateStorage{db: pg.Connect(opts)}
+}
+
+const (
+	queryGetSchedulerTemplate     = `SELECT * FROM scheduler_templates WHERE name = ?`
+	queryGetAllSchedulerTemplates = `SELECT * FROM scheduler_templates ORDER BY name`
+	queryInsertSchedulerTemplate  = `
+INSERT INTO scheduler_templates (name, yaml)
+	VALUES (?name, ?yaml)
+	RETURNING id`
+	queryUpdateSchedulerTemplate = `
+UPDATE scheduler_templates
+	SET (yaml, updated_at) = (?yaml, now())
+	WHERE name = ?name`
+)
+
+func (s schedulerTemplateStorage) EnableTracing() {
+	s.db.AddQueryHook(pgotel.NewTracingHook())
+}
+
+func (s schedulerTemplateStorage) GetSchedulerTemplate(ctx context.Context, name string) (*entities.SchedulerTemplate, error) {
+	client := s.db.WithContext(ctx)
+	var dbTemplate SchedulerTemplate
+	var err error
+	runSchedulerTemplateStorageFunctionCollectingLatency("GetSchedulerTemplate", func() {
+		_, err = client.QueryOne(&dbTemplate, queryGetSchedulerTemplate, name)
+	})
+	if err == pg.ErrNoRows {
+		return nil, errors.NewErrNotFound("scheduler template %s not found", name)
+	}
+	if err != nil {
+		reportSchedulerTemplateStorageFailsCounterMetric("GetSchedulerTemplate", name)
+		return nil, errors.NewErrUnexpected("error getting scheduler template %s", name).WithError(err)
+	}
+	template, err := dbTemplate.ToSchedulerTemplate()
+	if err != nil {
+		return nil, errors.NewErrEncoding("error decoding scheduler template %s", name).WithError(err)
+	}
+	return template, nil
+}
+
+func (s schedulerTemplateStorage) GetAllSchedulerTemplates(ctx context.Context) ([]*entities.SchedulerTemplate, error) {
+	client := s.db.WithContext(ctx)
+	var dbTemplates []SchedulerTemplate
+	var err error
+	runSchedulerTemplateStorageFunctionCollectingLatency("GetAllSchedulerTemplates", func() {
+		_, err = client.Query(&dbTemplates, queryGetAllSchedulerTemplates)
+	})
+	if err != nil {
+		reportSchedulerTemplateStorageFailsCounterMetric("GetAllSchedulerTemplates")
+		return nil, errors.NewErrUnexpected("error getting scheduler templates").WithError(err)
+	}
+	templates := make([]*entities.SchedulerTemplate, len(dbTemplates))
+	for i := range dbTemplates {
+		template, err := dbTemplates[i].ToSchedulerTemplate()
+		if err != nil {
+			return nil, errors.NewErrEncoding("error decoding scheduler template %s", dbTemplates[i].Name).WithError(err)
+		}
+		templates[i] = template
+	}
+	return templates, nil
+}
+
+func (s schedulerTemplateStorage) CreateSchedulerTemplate(ctx context.Context, template *entities.SchedulerTemplate) error {
+	client := s.db.WithContext(ctx)
+	dbTemplate := NewDBSchedulerTemplate(template)
+	var err error
+	runSchedulerTemplateStorageFunctionCollectingLatency("CreateSchedulerTemplate", func() {
+		_, err = client.Exec(queryInsertSchedulerTemplate, dbTemplate)
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "scheduler_templates_name_unique") {
+			return errors.NewErrAlreadyExists("error creating scheduler template %s: name already exists", dbTemplate.Name)
+		}
+		reportSchedulerTemplateStorageFailsCounterMetric("CreateSchedulerTemplate", template.Name)
+		return errors.NewErrUnexpected("error creating scheduler template %s", dbTemplate.Name).WithError(err)
+	}
+	return nil
+}
+
+func (s schedulerTemplateStorage) UpdateSchedulerTemplate(ctx context.Context, template *entities.SchedulerTemplate) error {
+	client := s.db.WithContext(ctx)
+	dbTemplate := NewDBSchedulerTemplate(template)
+	var err error
+	runSchedulerTemplateStorageFunctionCollectingLatency("UpdateSchedulerTemplate", func() {
+		_, err = client.ExecOne(queryUpdateSchedulerTemplate, dbTemplate)
+	})
+	if err == pg.ErrNoRows {
+		return errors.NewErrNotFound("scheduler template %s not found", dbTemplate.Name)
+	}
+	if err != nil {
+		reportSchedulerTemplateStorageFailsCounterMetric("UpdateSchedulerTemplate", dbTemplate.Name)
+		return errors.NewErrUnexpected("error updating scheduler template %s", dbTemplate.Name).WithError(err)
+	}
+	return nil
+}
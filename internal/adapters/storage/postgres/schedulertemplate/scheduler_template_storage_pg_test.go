@@ -0,0 +1,142 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build integration
+// +build integration
+
+package schedulertemplate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/topfreegames/maestro/internal/core/entities"
+	"github.com/topfreegames/maestro/internal/core/entities/game_room"
+	"github.com/topfreegames/maestro/internal/core/entities/port"
+	"github.com/topfreegames/maestro/internal/core/ports/errors"
+)
+
+func newTemplate(name string) *entities.SchedulerTemplate {
+	return &entities.SchedulerTemplate{
+		Name:     name,
+		MaxSurge: "10%",
+		Spec: game_room.Spec{
+			Version:                "v1.0.0",
+			TerminationGracePeriod: 60,
+			Containers: []game_room.Container{
+				{Name: "default", Image: "image:v1", ImagePullPolicy: "IfNotPresent"},
+			},
+		},
+		PortRange:   &port.PortRange{Start: 40000, End: 60000},
+		Annotations: map[string]string{"key": "value"},
+	}
+}
+
+func requireEqualTemplate(t *testing.T, expected, actual *entities.SchedulerTemplate) {
+	require.NotZero(t, actual.CreatedAt)
+	actual.CreatedAt = expected.CreatedAt
+	actual.UpdatedAt = expected.UpdatedAt
+	require.Equal(t, expected, actual)
+}
+
+func TestSchedulerTemplateStorage_CreateSchedulerTemplate(t *testing.T) {
+	t.Run("creates and fetches the template", func(t *testing.T) {
+		storage := NewSchedulerTemplateStorage(getPostgresDB(t).Options())
+		template := newTemplate("template")
+
+		err := storage.CreateSchedulerTemplate(context.Background(), template)
+		require.NoError(t, err)
+
+		actualTemplate, err := storage.GetSchedulerTemplate(context.Background(), "template")
+		require.NoError(t, err)
+		requireEqualTemplate(t, template, actualTemplate)
+	})
+
+	t.Run("fails when the template already exists", func(t *testing.T) {
+		storage := NewSchedulerTemplateStorage(getPostgresDB(t).Options())
+
+		err := storage.CreateSchedulerTemplate(context.Background(), newTemplate("template"))
+		require.NoError(t, err)
+
+		err = storage.CreateSchedulerTemplate(context.Background(), newTemplate("template"))
+		require.ErrorIs(t, err, errors.ErrAlreadyExists)
+	})
+}
+
+func TestSchedulerTemplateStorage_GetSchedulerTemplate(t *testing.T) {
+	t.Run("fails when the template doesn't exist", func(t *testing.T) {
+		storage := NewSchedulerTemplateStorage(getPostgresDB(t).Options())
+
+		_, err := storage.GetSchedulerTemplate(context.Background(), "template")
+		require.ErrorIs(t, err, errors.ErrNotFound)
+	})
+
+	t.Run("fails when the template is invalid", func(t *testing.T) {
+		db := getPostgresDB(t)
+		storage := NewSchedulerTemplateStorage(db.Options())
+		require.NoError(t, storage.CreateSchedulerTemplate(context.Background(), newTemplate("template")))
+
+		_, err := db.Exec("UPDATE scheduler_templates SET yaml = 'invalid yaml' WHERE name = 'template'")
+		require.NoError(t, err)
+
+		_, err = storage.GetSchedulerTemplate(context.Background(), "template")
+		require.ErrorIs(t, err, errors.ErrEncoding)
+	})
+}
+
+func TestSchedulerTemplateStorage_GetAllSchedulerTemplates(t *testing.T) {
+	storage := NewSchedulerTemplateStorage(getPostgresDB(t).Options())
+	require.NoError(t, storage.CreateSchedulerTemplate(context.Background(), newTemplate("template-b")))
+	require.NoError(t, storage.CreateSchedulerTemplate(context.Background(), newTemplate("template-a")))
+
+	templates, err := storage.GetAllSchedulerTemplates(context.Background())
+	require.NoError(t, err)
+	require.Len(t, templates, 2)
+	requireEqualTemplate(t, newTemplate("template-a"), templates[0])
+	requireEqualTemplate(t, newTemplate("template-b"), templates[1])
+}
+
+func TestSchedulerTemplateStorage_UpdateSchedulerTemplate(t *testing.T) {
+	t.Run("updates the template", func(t *testing.T) {
+		storage := NewSchedulerTemplateStorage(getPostgresDB(t).Options())
+		require.NoError(t, storage.CreateSchedulerTemplate(context.Background(), newTemplate("template")))
+
+		template := newTemplate("template")
+		template.RoomsReplicas = 10
+		err := storage.UpdateSchedulerTemplate(context.Background(), template)
+		require.NoError(t, err)
+
+		actualTemplate, err := storage.GetSchedulerTemplate(context.Background(), "template")
+		require.NoError(t, err)
+		require.NotZero(t, actualTemplate.UpdatedAt)
+		requireEqualTemplate(t, template, actualTemplate)
+	})
+
+	t.Run("fails when the template doesn't exist", func(t *testing.T) {
+		storage := NewSchedulerTemplateStorage(getPostgresDB(t).Options())
+
+		err := storage.UpdateSchedulerTemplate(context.Background(), newTemplate("template"))
+		require.ErrorIs(t, err, errors.ErrNotFound)
+	})
+}
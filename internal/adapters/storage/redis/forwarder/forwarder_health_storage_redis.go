@@ -0,0 +1,157 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package forwarder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/topfreegames/maestro/internal/adapters/metrics"
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
+	"github.com/topfreegames/maestro/internal/core/ports"
+	"github.com/topfreegames/maestro/internal/core/ports/errors"
+)
+
+var _ ports.ForwarderHealthStorage = (*redisForwarderHealthStorage)(nil)
+
+const (
+	forwarderHealthStorageMetricLabel = "forwarder-health-storage"
+
+	// forwarderHealthTTL expires the health of the addresses that are no
+	// longer used, and of the instances that stopped saving it. The health
+	// is saved every time the circuit state changes, so addresses without
+	// health saved are healthy.
+	forwarderHealthTTL = 24 * time.Hour
+)
+
+// redisForwarderHealthStorage adapter of the ForwarderHealthStorage port. The
+// health of each forwarder address is kept on a hash, with the JSON health
+// seen by each Maestro instance on its own field.
+type redisForwarderHealthStorage struct {
+	client *redis.Client
+	// instance identifies the Maestro instance saving the health.
+	instance string
+}
+
+type redisForwarderHealth struct {
+	Address             string                 `json:"address"`
+	State               forwarder.CircuitState `json:"state"`
+	ConsecutiveFailures int                    `json:"consecutiveFailures"`
+	LastError           string                 `json:"lastError,omitempty"`
+	LastFailureAt       time.Time              `json:"lastFailureAt"`
+	UpdatedAt           time.Time              `json:"updatedAt"`
+}
+
+func NewRedisForwarderHealthStorage(client *redis.Client, instance string) *redisForwarderHealthStorage {
+	return &redisForwarderHealthStorage{client: client, instance: instance}
+}
+
+// SetForwarderHealth saves the health of the forwarder address seen by the
+// instance.
+func (r *redisForwarderHealthStorage) SetForwarderHealth(ctx context.Context, health *forwarder.Health) error {
+	value, err := json.Marshal(redisForwarderHealth{
+		Address:             health.Address,
+		State:               health.State,
+		ConsecutiveFailures: health.ConsecutiveFailures,
+		LastError:           health.LastError,
+		LastFailureAt:       health.LastFailureAt,
+		UpdatedAt:           health.UpdatedAt,
+	})
+	if err != nil {
+		return errors.NewErrEncoding("failed to encode forwarder health").WithError(err)
+	}
+
+	metrics.RunWithMetrics(forwarderHealthStorageMetricLabel, func() error {
+		pipe := r.client.TxPipeline()
+		pipe.HSet(ctx, forwarderHealthKey(health.Address), r.instance, value)
+		pipe.Expire(ctx, forwarderHealthKey(health.Address), forwarderHealthTTL)
+		_, err = pipe.Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return errors.NewErrUnexpected("failed to save forwarder health on redis").WithError(err)
+	}
+	return nil
+}
+
+// GetForwarderHealth returns the health of the forwarder address aggregated
+// from the instances that saved it recently: the worst circuit state, the
+// most consecutive failures and the last failure.
+func (r *redisForwarderHealthStorage) GetForwarderHealth(ctx context.Context, address string) (*forwarder.Health, error) {
+	var values map[string]string
+	var err error
+	metrics.RunWithMetrics(forwarderHealthStorageMetricLabel, func() error {
+		values, err = r.client.HGetAll(ctx, forwarderHealthKey(address)).Result()
+		return err
+	})
+	if err != nil {
+		return nil, errors.NewErrUnexpected("failed to get forwarder health from redis").WithError(err)
+	}
+
+	var aggregated *forwarder.Health
+	for instance, value := range values {
+		var health redisForwarderHealth
+		err = json.Unmarshal([]byte(value), &health)
+		if err != nil {
+			return nil, errors.NewErrEncoding("failed to decode forwarder health of instance %s", instance).WithError(err)
+		}
+		if time.Since(health.UpdatedAt) > forwarderHealthTTL {
+			continue
+		}
+
+		if aggregated == nil {
+			aggregated = forwarder.NewHealthyForwarderHealth(address)
+		}
+		if circuitStateSeverity[health.State] > circuitStateSeverity[aggregated.State] {
+			aggregated.State = health.State
+		}
+		if health.ConsecutiveFailures > aggregated.ConsecutiveFailures {
+			aggregated.ConsecutiveFailures = health.ConsecutiveFailures
+		}
+		if health.LastFailureAt.After(aggregated.LastFailureAt) {
+			aggregated.LastFailureAt = health.LastFailureAt
+			aggregated.LastError = health.LastError
+		}
+		if health.UpdatedAt.After(aggregated.UpdatedAt) {
+			aggregated.UpdatedAt = health.UpdatedAt
+		}
+	}
+	if aggregated == nil {
+		return nil, errors.NewErrNotFound("health of forwarder at %s not found", address)
+	}
+	return aggregated, nil
+}
+
+// circuitStateSeverity orders the circuit states from the healthiest.
+var circuitStateSeverity = map[forwarder.CircuitState]int{
+	forwarder.CircuitClosed:   0,
+	forwarder.CircuitHalfOpen: 1,
+	forwarder.CircuitOpen:     2,
+}
+
+func forwarderHealthKey(address string) string {
+	return fmt.Sprintf("forwarders:%s:health", address)
+}
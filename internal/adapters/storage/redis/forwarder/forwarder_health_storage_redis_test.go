@@ -0,0 +1,128 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build integration
+// +build integration
+
+package forwarder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
+	"github.com/topfreegames/maestro/internal/core/ports/errors"
+	"github.com/topfreegames/maestro/test"
+)
+
+func TestSetAndGetForwarderHealth(t *testing.T) {
+	t.Run("returns the saved forwarder health", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		storage := NewRedisForwarderHealthStorage(client, "instance")
+		ctx := context.Background()
+
+		now := time.Now().UTC().Truncate(time.Second)
+		health := &forwarder.Health{
+			Address:             "forwarder:8080",
+			State:               forwarder.CircuitOpen,
+			ConsecutiveFailures: 5,
+			LastError:           "unavailable",
+			LastFailureAt:       now,
+			UpdatedAt:           now,
+		}
+		err := storage.SetForwarderHealth(ctx, health)
+		require.NoError(t, err)
+
+		savedHealth, err := storage.GetForwarderHealth(ctx, "forwarder:8080")
+		require.NoError(t, err)
+		require.Equal(t, health, savedHealth)
+
+		ttl, err := client.TTL(ctx, forwarderHealthKey("forwarder:8080")).Result()
+		require.NoError(t, err)
+		require.Greater(t, ttl, time.Duration(0))
+	})
+
+	t.Run("returns the health aggregated across the instances", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		storage := NewRedisForwarderHealthStorage(client, "instance")
+		otherStorage := NewRedisForwarderHealthStorage(client, "other-instance")
+		ctx := context.Background()
+
+		now := time.Now().UTC().Truncate(time.Second)
+		err := storage.SetForwarderHealth(ctx, &forwarder.Health{
+			Address:             "forwarder:8080",
+			State:               forwarder.CircuitOpen,
+			ConsecutiveFailures: 5,
+			LastError:           "unavailable",
+			LastFailureAt:       now.Add(-time.Minute),
+			UpdatedAt:           now.Add(-time.Minute),
+		})
+		require.NoError(t, err)
+		err = otherStorage.SetForwarderHealth(ctx, &forwarder.Health{
+			Address:             "forwarder:8080",
+			State:               forwarder.CircuitClosed,
+			ConsecutiveFailures: 1,
+			LastError:           "deadline exceeded",
+			LastFailureAt:       now,
+			UpdatedAt:           now,
+		})
+		require.NoError(t, err)
+
+		savedHealth, err := otherStorage.GetForwarderHealth(ctx, "forwarder:8080")
+		require.NoError(t, err)
+		require.Equal(t, &forwarder.Health{
+			Address:             "forwarder:8080",
+			State:               forwarder.CircuitOpen,
+			ConsecutiveFailures: 5,
+			LastError:           "deadline exceeded",
+			LastFailureAt:       now,
+			UpdatedAt:           now,
+		}, savedHealth)
+	})
+
+	t.Run("ignores the health instances stopped saving", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		storage := NewRedisForwarderHealthStorage(client, "instance")
+		ctx := context.Background()
+
+		err := storage.SetForwarderHealth(ctx, &forwarder.Health{
+			Address:   "forwarder:8080",
+			State:     forwarder.CircuitOpen,
+			UpdatedAt: time.Now().Add(-2 * forwarderHealthTTL),
+		})
+		require.NoError(t, err)
+
+		_, err = storage.GetForwarderHealth(ctx, "forwarder:8080")
+		require.ErrorIs(t, err, errors.ErrNotFound)
+	})
+
+	t.Run("returns not found when the forwarder has no health saved", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		storage := NewRedisForwarderHealthStorage(client, "instance")
+
+		_, err := storage.GetForwarderHealth(context.Background(), "unknown:8080")
+		require.ErrorIs(t, err, errors.ErrNotFound)
+	})
+}
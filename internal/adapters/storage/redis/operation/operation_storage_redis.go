@@ -43,16 +43,22 @@ const (
 	idRedisKey                 = "id"
 	schedulerNameRedisKey      = "schedulerName"
 	statusRedisKey             = "status"
+	priorityRedisKey           = "priority"
 	definitionNameRedisKey     = "definitionName"
 	createdAtRedisKey          = "createdAt"
 	definitionContentsRedisKey = "definitionContents"
 	executionHistoryRedisKey   = "executionHistory"
+	progressRedisKey           = "progress"
 )
 
 var _ ports.OperationStorage = (*redisOperationStorage)(nil)
 
 const operationStorageMetricLabel = "operation-storage"
 
+// idempotencyKeyTxMaxAttempts is how many times the idempotency key
+// transaction runs when the watched key changes under it.
+const idempotencyKeyTxMaxAttempts = 3
+
 type Definition string
 
 // redisOperationStorage adapter of the OperationStorage port. It store store
@@ -82,20 +88,7 @@ func (r *redisOperationStorage) CreateOperation(ctx context.Context, op *operati
 	}
 
 	pipe := r.client.Pipeline()
-
-	pipe.HSet(ctx, r.buildSchedulerOperationKey(op.SchedulerName, op.ID), map[string]interface{}{
-		idRedisKey:                 op.ID,
-		schedulerNameRedisKey:      op.SchedulerName,
-		statusRedisKey:             strconv.Itoa(int(op.Status)),
-		definitionNameRedisKey:     op.DefinitionName,
-		createdAtRedisKey:          op.CreatedAt.Format(time.RFC3339Nano),
-		definitionContentsRedisKey: op.Input,
-		executionHistoryRedisKey:   executionHistoryJson,
-	})
-
-	if tll, ok := r.operationsTTLMap[Definition(op.DefinitionName)]; ok {
-		pipe.Expire(ctx, r.buildSchedulerOperationKey(op.SchedulerName, op.ID), tll)
-	}
+	r.addCreateOperationCommands(ctx, pipe, op, executionHistoryJson)
 
 	metrics.RunWithMetrics(operationStorageMetricLabel, func() error {
 		_, err = pipe.Exec(ctx)
@@ -109,6 +102,54 @@ func (r *redisOperationStorage) CreateOperation(ctx context.Context, op *operati
 	return nil
 }
 
+// CreateOperationWithIdempotencyKey stores the operation and associates the
+// idempotency key to it in the same transaction, watching the key so
+// concurrent requests can't both take it. When the key is already associated
+// to an operation nothing is stored and the associated operation ID is
+// returned.
+func (r *redisOperationStorage) CreateOperationWithIdempotencyKey(ctx context.Context, op *operation.Operation, idempotencyKey string, ttl time.Duration) (existingOperationID string, err error) {
+	executionHistoryJson, err := json.Marshal(op.ExecutionHistory)
+	if err != nil {
+		return "", errors.NewErrUnexpected("failed to create operation on redis").WithError(err)
+	}
+
+	idempotencyRedisKey := r.buildSchedulerIdempotencyKey(op.SchedulerName, idempotencyKey)
+	createWithKey := func(tx *redis.Tx) error {
+		existingOperationID, err = tx.Get(ctx, idempotencyRedisKey).Result()
+		if err != redis.Nil {
+			return err
+		}
+		existingOperationID = ""
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, idempotencyRedisKey, op.ID, ttl)
+			r.addCreateOperationCommands(ctx, pipe, op, executionHistoryJson)
+			return nil
+		})
+		return err
+	}
+
+	metrics.RunWithMetrics(operationStorageMetricLabel, func() error {
+		// When another request takes the key while this one is watching it,
+		// the transaction is retried to return the operation it created.
+		for attempt := 0; attempt < idempotencyKeyTxMaxAttempts; attempt++ {
+			err = r.client.Watch(ctx, createWithKey, idempotencyRedisKey)
+			if err != redis.TxFailedErr {
+				return err
+			}
+		}
+		return err
+	})
+	if err == redis.TxFailedErr {
+		return "", errors.NewErrConflict("an operation with the idempotency key %s is already being created", idempotencyKey)
+	}
+	if err != nil {
+		return "", errors.NewErrUnexpected("failed to create operation with idempotency key on redis").WithError(err)
+	}
+
+	return existingOperationID, nil
+}
+
 func (r *redisOperationStorage) GetOperation(ctx context.Context, schedulerName, operationID string) (op *operation.Operation, err error) {
 	var res map[string]string
 	metrics.RunWithMetrics(operationStorageMetricLabel, func() error {
@@ -146,6 +187,7 @@ func (r *redisOperationStorage) UpdateOperationStatus(ctx context.Context, sched
 	pipe.HSet(ctx, r.buildSchedulerOperationKey(schedulerName, operationID), map[string]interface{}{
 		statusRedisKey: strconv.Itoa(int(status)),
 	})
+	pipe.Publish(ctx, r.buildSchedulerOperationsUpdatesKey(schedulerName), operationID)
 
 	metrics.RunWithMetrics(operationStorageMetricLabel, func() error {
 		_, err = pipe.Exec(ctx)
@@ -164,10 +206,14 @@ func (r *redisOperationStorage) UpdateOperationExecutionHistory(ctx context.Cont
 	if err != nil {
 		return errors.NewErrUnexpected("failed to marshal operation execution history").WithError(err)
 	}
+	pipe := r.client.Pipeline()
+	pipe.HSet(ctx, r.buildSchedulerOperationKey(op.SchedulerName, op.ID), map[string]interface{}{
+		executionHistoryRedisKey: jsonExecutionHistory,
+	})
+	pipe.Publish(ctx, r.buildSchedulerOperationsUpdatesKey(op.SchedulerName), op.ID)
+
 	metrics.RunWithMetrics(operationStorageMetricLabel, func() error {
-		err = r.client.HSet(ctx, r.buildSchedulerOperationKey(op.SchedulerName, op.ID), map[string]interface{}{
-			executionHistoryRedisKey: jsonExecutionHistory,
-		}).Err()
+		_, err = pipe.Exec(ctx)
 		return err
 	})
 
@@ -178,6 +224,29 @@ func (r *redisOperationStorage) UpdateOperationExecutionHistory(ctx context.Cont
 	return nil
 }
 
+func (r *redisOperationStorage) UpdateOperationProgress(ctx context.Context, op *operation.Operation) (err error) {
+	jsonProgress, err := json.Marshal(op.Progress)
+	if err != nil {
+		return errors.NewErrUnexpected("failed to marshal operation progress").WithError(err)
+	}
+	pipe := r.client.Pipeline()
+	pipe.HSet(ctx, r.buildSchedulerOperationKey(op.SchedulerName, op.ID), map[string]interface{}{
+		progressRedisKey: jsonProgress,
+	})
+	pipe.Publish(ctx, r.buildSchedulerOperationsUpdatesKey(op.SchedulerName), op.ID)
+
+	metrics.RunWithMetrics(operationStorageMetricLabel, func() error {
+		_, err = pipe.Exec(ctx)
+		return err
+	})
+
+	if err != nil {
+		return errors.NewErrUnexpected("failed to update operation progress").WithError(err)
+	}
+
+	return nil
+}
+
 func (r *redisOperationStorage) ListSchedulerActiveOperations(ctx context.Context, schedulerName string) (operations []*operation.Operation, err error) {
 	var operationsIDs []string
 	metrics.RunWithMetrics(operationStorageMetricLabel, func() error {
@@ -343,6 +412,70 @@ func (r *redisOperationStorage) CleanExpiredOperations(ctx context.Context, sche
 	return nil
 }
 
+// WatchSchedulerOperationsUpdates subscribes to the scheduler operations
+// updates channel, which receives the operation ID every time an operation is
+// created or updated.
+func (r *redisOperationStorage) WatchSchedulerOperationsUpdates(ctx context.Context, schedulerName string) (chan string, error) {
+	sub := r.client.Subscribe(ctx, r.buildSchedulerOperationsUpdatesKey(schedulerName))
+
+	var err error
+	metrics.RunWithMetrics(operationStorageMetricLabel, func() error {
+		// waits for the subscription to be confirmed, so no update published
+		// after this function returns is lost.
+		_, err = sub.Receive(ctx)
+		return err
+	})
+	if err != nil {
+		_ = sub.Close()
+		return nil, errors.NewErrUnexpected("failed to subscribe to operations updates of \"%s\"", schedulerName).WithError(err)
+	}
+
+	resultChan := make(chan string, 100)
+	go func() {
+		defer sub.Close()
+		defer close(resultChan)
+
+		subChan := sub.Channel()
+		for {
+			select {
+			case msg, ok := <-subChan:
+				if !ok {
+					return
+				}
+
+				select {
+				case resultChan <- msg.Payload:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return resultChan, nil
+}
+
+func (r *redisOperationStorage) addCreateOperationCommands(ctx context.Context, pipe redis.Pipeliner, op *operation.Operation, executionHistoryJson []byte) {
+	pipe.HSet(ctx, r.buildSchedulerOperationKey(op.SchedulerName, op.ID), map[string]interface{}{
+		idRedisKey:                 op.ID,
+		schedulerNameRedisKey:      op.SchedulerName,
+		statusRedisKey:             strconv.Itoa(int(op.Status)),
+		priorityRedisKey:           strconv.Itoa(int(op.Priority)),
+		definitionNameRedisKey:     op.DefinitionName,
+		createdAtRedisKey:          op.CreatedAt.Format(time.RFC3339Nano),
+		definitionContentsRedisKey: op.Input,
+		executionHistoryRedisKey:   executionHistoryJson,
+	})
+
+	if tll, ok := r.operationsTTLMap[Definition(op.DefinitionName)]; ok {
+		pipe.Expire(ctx, r.buildSchedulerOperationKey(op.SchedulerName, op.ID), tll)
+	}
+
+	pipe.Publish(ctx, r.buildSchedulerOperationsUpdatesKey(op.SchedulerName), op.ID)
+}
+
 func (r *redisOperationStorage) buildSchedulerOperationKey(schedulerName, opID string) string {
 	return fmt.Sprintf("operations:%s:%s", schedulerName, opID)
 }
@@ -355,6 +488,14 @@ func (r *redisOperationStorage) buildSchedulerHistoryOperationsKey(schedulerName
 	return fmt.Sprintf("operations:%s:lists:history", schedulerName)
 }
 
+func (r *redisOperationStorage) buildSchedulerOperationsUpdatesKey(schedulerName string) string {
+	return fmt.Sprintf("operations:%s:updates", schedulerName)
+}
+
+func (r *redisOperationStorage) buildSchedulerIdempotencyKey(schedulerName, idempotencyKey string) string {
+	return fmt.Sprintf("operations:%s:idempotency_keys:%s", schedulerName, idempotencyKey)
+}
+
 func (r *redisOperationStorage) buildSchedulerNoActionKey(schedulerName string) string {
 	return fmt.Sprintf("operations:%s:lists:noaction", schedulerName)
 }
@@ -439,11 +580,30 @@ func buildOperationFromMap(opMap map[string]string) (*operation.Operation, error
 		}
 	}
 
+	var progress *operation.OperationProgress
+	if rawProgress, ok := opMap[progressRedisKey]; ok {
+		err := json.Unmarshal([]byte(rawProgress), &progress)
+		if err != nil {
+			return nil, errors.NewErrEncoding("failed to parse operation progress").WithError(err)
+		}
+	}
+
 	statusInt, err := strconv.Atoi(opMap[statusRedisKey])
 	if err != nil {
 		return nil, errors.NewErrEncoding("failed to parse operation status").WithError(err)
 	}
 
+	// Operations created before the priorities were introduced don't have
+	// it, and were enqueued with the normal priority.
+	priority := operation.PriorityNormal
+	if rawPriority, ok := opMap[priorityRedisKey]; ok {
+		priorityInt, err := strconv.Atoi(rawPriority)
+		if err != nil {
+			return nil, errors.NewErrEncoding("failed to parse operation priority").WithError(err)
+		}
+		priority = operation.Priority(priorityInt)
+	}
+
 	createdAt, err := time.Parse(time.RFC3339Nano, opMap[createdAtRedisKey])
 	if err != nil {
 		return nil, errors.NewErrEncoding("failed to parse operation createdAt field").WithError(err)
@@ -455,7 +615,9 @@ func buildOperationFromMap(opMap map[string]string) (*operation.Operation, error
 		DefinitionName:   opMap[definitionNameRedisKey],
 		CreatedAt:        createdAt,
 		Status:           operation.Status(statusInt),
+		Priority:         priority,
 		Input:            []byte(opMap[definitionContentsRedisKey]),
 		ExecutionHistory: executionHistory,
+		Progress:         progress,
 	}, nil
 }
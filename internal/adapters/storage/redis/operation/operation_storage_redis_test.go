@@ -907,6 +907,69 @@ func TestUpdateOperationExecutionHistory(t *testing.T) {
 
 }
 
+func TestUpdateOperationProgress(t *testing.T) {
+
+	t.Run("set progress with value", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		now := time.Now()
+		clock := clockmock.NewFakeClock(now)
+		operationsTTLMap := map[Definition]time.Duration{}
+		definitionProvider, _ := createOperationDefinitionProvider(t)
+		storage := NewRedisOperationStorage(client, clock, operationsTTLMap, definitionProvider)
+
+		op := &operation.Operation{
+			ID:            "some-op-id",
+			SchedulerName: "test-scheduler",
+			Status:        operation.StatusPending,
+		}
+
+		err := storage.CreateOperation(context.Background(), op)
+		require.NoError(t, err)
+
+		op.Progress = &operation.OperationProgress{
+			TotalUnits:     10,
+			CompletedUnits: 4,
+			FailedUnits:    1,
+			StartedAt:      now,
+			UpdatedAt:      now.Add(time.Minute),
+		}
+
+		err = storage.UpdateOperationProgress(context.Background(), op)
+		require.NoError(t, err)
+
+		operationStored, err := storage.GetOperation(context.Background(), op.SchedulerName, op.ID)
+		require.NoError(t, err)
+		require.NotNil(t, operationStored.Progress)
+		assert.Equal(t, op.Progress.TotalUnits, operationStored.Progress.TotalUnits)
+		assert.Equal(t, op.Progress.CompletedUnits, operationStored.Progress.CompletedUnits)
+		assert.Equal(t, op.Progress.FailedUnits, operationStored.Progress.FailedUnits)
+		assert.Equal(t, op.Progress.StartedAt.Unix(), operationStored.Progress.StartedAt.Unix())
+		assert.Equal(t, op.Progress.UpdatedAt.Unix(), operationStored.Progress.UpdatedAt.Unix())
+	})
+
+	t.Run("redis connection closed: returns error", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		now := time.Now()
+		clock := clockmock.NewFakeClock(now)
+		operationsTTLMap := map[Definition]time.Duration{}
+		definitionProvider, _ := createOperationDefinitionProvider(t)
+		storage := NewRedisOperationStorage(client, clock, operationsTTLMap, definitionProvider)
+
+		op := &operation.Operation{
+			ID:            "some-op-id",
+			SchedulerName: "test-scheduler",
+			Status:        operation.StatusPending,
+			Progress:      &operation.OperationProgress{TotalUnits: 10},
+		}
+		client.Close()
+
+		err := storage.UpdateOperationProgress(context.Background(), op)
+		require.Error(t, err)
+		require.ErrorContains(t, err, "failed to update operation progress: redis: client is closed")
+	})
+
+}
+
 func TestListSchedulerActiveOperations(t *testing.T) {
 	t.Run("list all operations", func(t *testing.T) {
 		client := test.GetRedisConnection(t, redisAddress)
@@ -1356,6 +1419,148 @@ func TestCleanExpiredOperations(t *testing.T) {
 
 	})
 }
+
+func TestWatchSchedulerOperationsUpdates(t *testing.T) {
+	t.Run("receives the operation ID when operations are created and updated", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		clock := clockmock.NewFakeClock(time.Now())
+		operationsTTLMap := map[Definition]time.Duration{}
+		definitionProvider, _ := createOperationDefinitionProvider(t)
+		storage := NewRedisOperationStorage(client, clock, operationsTTLMap, definitionProvider)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		updates, err := storage.WatchSchedulerOperationsUpdates(ctx, "test-scheduler")
+		require.NoError(t, err)
+
+		op := &operation.Operation{
+			ID:             "some-op-id",
+			SchedulerName:  "test-scheduler",
+			Status:         operation.StatusPending,
+			DefinitionName: definitionName,
+		}
+		err = storage.CreateOperation(ctx, op)
+		require.NoError(t, err)
+
+		op.ExecutionHistory = []operation.OperationEvent{{CreatedAt: time.Now(), Event: "event"}}
+		err = storage.UpdateOperationExecutionHistory(ctx, op)
+		require.NoError(t, err)
+
+		otherSchedulerOp := &operation.Operation{
+			ID:             "other-op-id",
+			SchedulerName:  "other-scheduler",
+			Status:         operation.StatusPending,
+			DefinitionName: definitionName,
+		}
+		err = storage.CreateOperation(ctx, otherSchedulerOp)
+		require.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			select {
+			case operationID := <-updates:
+				require.Equal(t, op.ID, operationID)
+			case <-time.After(5 * time.Second):
+				require.Fail(t, "operation update not received")
+			}
+		}
+
+		select {
+		case operationID := <-updates:
+			require.Fail(t, "unexpected operation update received", operationID)
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		cancel()
+		require.Eventually(t, func() bool {
+			_, ok := <-updates
+			return !ok
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("if client is closed it returns error", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		clock := clockmock.NewFakeClock(time.Now())
+		operationsTTLMap := map[Definition]time.Duration{}
+		definitionProvider, _ := createOperationDefinitionProvider(t)
+		storage := NewRedisOperationStorage(client, clock, operationsTTLMap, definitionProvider)
+		client.Close()
+
+		_, err := storage.WatchSchedulerOperationsUpdates(context.Background(), "test-scheduler")
+		require.ErrorContains(t, err, "failed to subscribe to operations updates of \"test-scheduler\"")
+	})
+}
+
+func TestCreateOperationWithIdempotencyKey(t *testing.T) {
+	newOperation := func(id, schedulerName string) *operation.Operation {
+		return &operation.Operation{
+			ID:             id,
+			Status:         operation.StatusPending,
+			DefinitionName: definitionName,
+			SchedulerName:  schedulerName,
+			CreatedAt:      time.Now(),
+			Input:          []byte("hello test"),
+		}
+	}
+
+	t.Run("creates the operation along with the key only once", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		clock := clockmock.NewFakeClock(time.Now())
+		operationsTTLMap := map[Definition]time.Duration{}
+		definitionProvider, _ := createOperationDefinitionProvider(t)
+		storage := NewRedisOperationStorage(client, clock, operationsTTLMap, definitionProvider)
+		ctx := context.Background()
+
+		existingID, err := storage.CreateOperationWithIdempotencyKey(ctx, newOperation("some-op-id", "test-scheduler"), "some-key", time.Hour)
+		require.NoError(t, err)
+		require.Empty(t, existingID)
+
+		existingID, err = storage.CreateOperationWithIdempotencyKey(ctx, newOperation("other-op-id", "test-scheduler"), "some-key", time.Hour)
+		require.NoError(t, err)
+		require.Equal(t, "some-op-id", existingID)
+
+		_, err = storage.GetOperation(ctx, "test-scheduler", "some-op-id")
+		require.NoError(t, err)
+
+		_, err = storage.GetOperation(ctx, "test-scheduler", "other-op-id")
+		require.ErrorIs(t, err, errors.ErrNotFound)
+
+		ttl, err := client.TTL(ctx, "operations:test-scheduler:idempotency_keys:some-key").Result()
+		require.NoError(t, err)
+		require.Greater(t, ttl, time.Duration(0))
+		require.LessOrEqual(t, ttl, time.Hour)
+	})
+
+	t.Run("keys are scoped by scheduler", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		clock := clockmock.NewFakeClock(time.Now())
+		operationsTTLMap := map[Definition]time.Duration{}
+		definitionProvider, _ := createOperationDefinitionProvider(t)
+		storage := NewRedisOperationStorage(client, clock, operationsTTLMap, definitionProvider)
+		ctx := context.Background()
+
+		existingID, err := storage.CreateOperationWithIdempotencyKey(ctx, newOperation("some-op-id", "test-scheduler"), "some-key", time.Hour)
+		require.NoError(t, err)
+		require.Empty(t, existingID)
+
+		existingID, err = storage.CreateOperationWithIdempotencyKey(ctx, newOperation("other-op-id", "other-scheduler"), "some-key", time.Hour)
+		require.NoError(t, err)
+		require.Empty(t, existingID)
+	})
+
+	t.Run("if client is closed it returns error", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		clock := clockmock.NewFakeClock(time.Now())
+		operationsTTLMap := map[Definition]time.Duration{}
+		definitionProvider, _ := createOperationDefinitionProvider(t)
+		storage := NewRedisOperationStorage(client, clock, operationsTTLMap, definitionProvider)
+		client.Close()
+
+		_, err := storage.CreateOperationWithIdempotencyKey(context.Background(), newOperation("some-op-id", "test-scheduler"), "some-key", time.Hour)
+		require.ErrorIs(t, err, errors.ErrUnexpected)
+	})
+}
+
 func createOperationDefinitionProvider(t *testing.T) (map[string]operations.DefinitionConstructor, *mockoperation.MockDefinition) {
 	mockCtrl := gomock.NewController(t)
 	mockDefinition := mockoperation.NewMockDefinition(mockCtrl)
@@ -0,0 +1,419 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/topfreegames/maestro/internal/adapters/metrics"
+	"github.com/topfreegames/maestro/internal/core/entities/events"
+	"github.com/topfreegames/maestro/internal/core/ports"
+	"github.com/topfreegames/maestro/internal/core/ports/errors"
+)
+
+var _ ports.EventsOutbox = (*redisEventsOutbox)(nil)
+
+const (
+	eventsOutboxStorageMetricLabel = "events-outbox-storage"
+
+	outboxStreamKey   = "events:outbox"
+	outboxRetriesKey  = "events:outbox:retries"
+	outboxGroup       = "events-dispatchers"
+	deliveryField     = "delivery"
+	nextBlockDuration = 5 * time.Second
+	// claimMinIdle is the time after which the deliveries read by a
+	// dispatcher that stopped are claimed by the others. Dispatchers renew
+	// the deliveries they're still dispatching before it.
+	claimMinIdle = time.Minute
+	// defaultDeadLettersMaxLen is the approximate number of dead-letter
+	// events kept per scheduler when no limit is configured.
+	defaultDeadLettersMaxLen = 10000
+)
+
+// moveDueRetriesScript moves the deliveries with retries due (score lower
+// than ARGV[1]) from the retries sorted set (KEYS[1]) to the outbox stream
+// (KEYS[2]), up to ARGV[2] deliveries.
+var moveDueRetriesScript = redis.NewScript(`
+local deliveries = redis.call("ZRANGEBYSCORE", KEYS[1], "-inf", ARGV[1], "LIMIT", 0, ARGV[2])
+for _, delivery in ipairs(deliveries) do
+	redis.call("XADD", KEYS[2], "*", "delivery", delivery)
+	redis.call("ZREM", KEYS[1], delivery)
+end
+return #deliveries
+`)
+
+// redisEventsOutbox adapter of the EventsOutbox port. The deliveries are
+// written to a stream read by the dispatchers through a consumer group, the
+// retries are kept on a sorted set ordered by the retry time and the
+// dead-letter events on a stream per scheduler, capped to the most recent
+// ones.
+type redisEventsOutbox struct {
+	client *redis.Client
+	// consumer identifies the dispatcher on the consumer group.
+	consumer          string
+	deadLettersMaxLen int64
+}
+
+type redisEventDelivery struct {
+	// ID keeps the retries of identical deliveries apart on the retries
+	// sorted set.
+	ID            string     `json:"id,omitempty"`
+	Event         redisEvent `json:"event"`
+	ForwarderName string     `json:"forwarderName"`
+	Attempts      int        `json:"attempts"`
+	LastError     string     `json:"lastError,omitempty"`
+	FailedAt      *time.Time `json:"failedAt,omitempty"`
+}
+
+type redisEvent struct {
+	ID          string                 `json:"id,omitempty"`
+	CreatedAt   *time.Time             `json:"createdAt,omitempty"`
+	Name        events.EventName       `json:"name"`
+	SchedulerID string                 `json:"schedulerId"`
+	RoomID      string                 `json:"roomId"`
+	Attributes  map[string]interface{} `json:"attributes"`
+}
+
+func NewRedisEventsOutbox(client *redis.Client, consumer string, deadLettersMaxLen int64) *redisEventsOutbox {
+	if deadLettersMaxLen <= 0 {
+		deadLettersMaxLen = defaultDeadLettersMaxLen
+	}
+	return &redisEventsOutbox{client: client, consumer: consumer, deadLettersMaxLen: deadLettersMaxLen}
+}
+
+// Enqueue adds the deliveries to the outbox stream.
+func (r *redisEventsOutbox) Enqueue(ctx context.Context, deliveries []*events.EventDelivery) (err error) {
+	values := make([]string, len(deliveries))
+	for i, delivery := range deliveries {
+		values[i], err = encodeDelivery(delivery)
+		if err != nil {
+			return errors.NewErrEncoding("failed to encode event delivery").WithError(err)
+		}
+	}
+
+	metrics.RunWithMetrics(eventsOutboxStorageMetricLabel, func() error {
+		pipe := r.client.Pipeline()
+		for _, value := range values {
+			pipe.XAdd(ctx, &redis.XAddArgs{Stream: outboxStreamKey, Values: map[string]interface{}{deliveryField: value}})
+		}
+		_, err = pipe.Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return errors.NewErrUnexpected("failed to enqueue events on redis").WithError(err)
+	}
+	return nil
+}
+
+// Next moves the due retries to the outbox stream, then claims the
+// deliveries left by stopped dispatchers or reads the new ones, blocking for a
+// while when there are none.
+func (r *redisEventsOutbox) Next(ctx context.Context, count int) ([]*events.EventDelivery, error) {
+	err := r.createGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics.RunWithMetrics(eventsOutboxStorageMetricLabel, func() error {
+		err = moveDueRetriesScript.Run(ctx, r.client, []string{outboxRetriesKey, outboxStreamKey}, time.Now().UnixMilli(), count).Err()
+		return err
+	})
+	if err != nil {
+		return nil, errors.NewErrUnexpected("failed to move due events retries on redis").WithError(err)
+	}
+
+	var messages []redis.XMessage
+	metrics.RunWithMetrics(eventsOutboxStorageMetricLabel, func() error {
+		messages, _, err = r.client.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+			Stream:   outboxStreamKey,
+			Group:    outboxGroup,
+			Consumer: r.consumer,
+			MinIdle:  claimMinIdle,
+			Start:    "0-0",
+			Count:    int64(count),
+		}).Result()
+		return err
+	})
+	if err != nil {
+		return nil, errors.NewErrUnexpected("failed to claim events on redis").WithError(err)
+	}
+
+	if len(messages) == 0 {
+		var streams []redis.XStream
+		streams, err = r.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    outboxGroup,
+			Consumer: r.consumer,
+			Streams:  []string{outboxStreamKey, ">"},
+			Count:    int64(count),
+			Block:    nextBlockDuration,
+		}).Result()
+		if err != nil && err != redis.Nil {
+			return nil, errors.NewErrUnexpected("failed to read events on redis").WithError(err)
+		}
+		for _, stream := range streams {
+			messages = append(messages, stream.Messages...)
+		}
+	}
+
+	deliveries := make([]*events.EventDelivery, 0, len(messages))
+	for _, message := range messages {
+		delivery, err := decodeMessage(message)
+		if err != nil {
+			// Deliveries that can't be decoded would be claimed forever.
+			_ = r.Ack(ctx, &events.EventDelivery{ID: message.ID})
+			continue
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, nil
+}
+
+// Renew claims the deliveries again for the dispatcher, resetting their idle
+// time so they aren't claimed by the other dispatchers.
+func (r *redisEventsOutbox) Renew(ctx context.Context, deliveries []*events.EventDelivery) (err error) {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(deliveries))
+	for i, delivery := range deliveries {
+		ids[i] = delivery.ID
+	}
+
+	metrics.RunWithMetrics(eventsOutboxStorageMetricLabel, func() error {
+		err = r.client.XClaimJustID(ctx, &redis.XClaimArgs{
+			Stream:   outboxStreamKey,
+			Group:    outboxGroup,
+			Consumer: r.consumer,
+			Messages: ids,
+		}).Err()
+		return err
+	})
+	if err != nil {
+		return errors.NewErrUnexpected("failed to renew events on redis").WithError(err)
+	}
+	return nil
+}
+
+// Ack acknowledges and removes the delivery from the outbox stream.
+func (r *redisEventsOutbox) Ack(ctx context.Context, delivery *events.EventDelivery) (err error) {
+	metrics.RunWithMetrics(eventsOutboxStorageMetricLabel, func() error {
+		pipe := r.client.TxPipeline()
+		pipe.XAck(ctx, outboxStreamKey, outboxGroup, delivery.ID)
+		pipe.XDel(ctx, outboxStreamKey, delivery.ID)
+		_, err = pipe.Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return errors.NewErrUnexpected("failed to remove event from the outbox on redis").WithError(err)
+	}
+	return nil
+}
+
+// Retry adds the delivery to the retries sorted set, scored by the retry
+// time, and removes it from the outbox stream.
+func (r *redisEventsOutbox) Retry(ctx context.Context, delivery *events.EventDelivery, at time.Time) error {
+	value, err := encodeDelivery(delivery)
+	if err != nil {
+		return errors.NewErrEncoding("failed to encode event delivery").WithError(err)
+	}
+
+	metrics.RunWithMetrics(eventsOutboxStorageMetricLabel, func() error {
+		pipe := r.client.TxPipeline()
+		pipe.ZAdd(ctx, outboxRetriesKey, &redis.Z{Score: float64(at.UnixMilli()), Member: value})
+		pipe.XAck(ctx, outboxStreamKey, outboxGroup, delivery.ID)
+		pipe.XDel(ctx, outboxStreamKey, delivery.ID)
+		_, err = pipe.Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return errors.NewErrUnexpected("failed to schedule event retry on redis").WithError(err)
+	}
+	return nil
+}
+
+// DeadLetter adds the delivery to the scheduler dead-letter stream, trimming
+// the oldest dead-letter events, and removes it from the outbox stream.
+func (r *redisEventsOutbox) DeadLetter(ctx context.Context, delivery *events.EventDelivery) error {
+	value, err := encodeDelivery(delivery)
+	if err != nil {
+		return errors.NewErrEncoding("failed to encode event delivery").WithError(err)
+	}
+
+	metrics.RunWithMetrics(eventsOutboxStorageMetricLabel, func() error {
+		pipe := r.client.TxPipeline()
+		pipe.XAdd(ctx, &redis.XAddArgs{
+			Stream: r.buildDeadLettersKey(delivery.Event.SchedulerID),
+			MaxLen: r.deadLettersMaxLen,
+			Approx: true,
+			Values: map[string]interface{}{deliveryField: value},
+		})
+		pipe.XAck(ctx, outboxStreamKey, outboxGroup, delivery.ID)
+		pipe.XDel(ctx, outboxStreamKey, delivery.ID)
+		_, err = pipe.Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return errors.NewErrUnexpected("failed to move event to the dead-letter events on redis").WithError(err)
+	}
+	return nil
+}
+
+func (r *redisEventsOutbox) ListDeadLetters(ctx context.Context, schedulerName string) ([]*events.EventDelivery, error) {
+	var messages []redis.XMessage
+	var err error
+	metrics.RunWithMetrics(eventsOutboxStorageMetricLabel, func() error {
+		messages, err = r.client.XRange(ctx, r.buildDeadLettersKey(schedulerName), "-", "+").Result()
+		return err
+	})
+	if err != nil {
+		return nil, errors.NewErrUnexpected("failed to list dead-letter events on redis").WithError(err)
+	}
+
+	deliveries := make([]*events.EventDelivery, 0, len(messages))
+	for _, message := range messages {
+		delivery, err := decodeMessage(message)
+		if err != nil {
+			return nil, errors.NewErrEncoding("failed to decode dead-letter event %s", message.ID).WithError(err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, nil
+}
+
+func (r *redisEventsOutbox) RemoveDeadLetters(ctx context.Context, schedulerName string, ids []string) (err error) {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	metrics.RunWithMetrics(eventsOutboxStorageMetricLabel, func() error {
+		err = r.client.XDel(ctx, r.buildDeadLettersKey(schedulerName), ids...).Err()
+		return err
+	})
+	if err != nil {
+		return errors.NewErrUnexpected("failed to remove dead-letter events on redis").WithError(err)
+	}
+	return nil
+}
+
+func (r *redisEventsOutbox) DeleteDeadLetters(ctx context.Context, schedulerName string) (err error) {
+	metrics.RunWithMetrics(eventsOutboxStorageMetricLabel, func() error {
+		err = r.client.Del(ctx, r.buildDeadLettersKey(schedulerName)).Err()
+		return err
+	})
+	if err != nil {
+		return errors.NewErrUnexpected("failed to delete dead-letter events on redis").WithError(err)
+	}
+	return nil
+}
+
+// createGroup creates the dispatchers consumer group, reading the outbox
+// stream from its beginning so the events enqueued before it are dispatched.
+func (r *redisEventsOutbox) createGroup(ctx context.Context) (err error) {
+	metrics.RunWithMetrics(eventsOutboxStorageMetricLabel, func() error {
+		err = r.client.XGroupCreateMkStream(ctx, outboxStreamKey, outboxGroup, "0").Err()
+		if err != nil && strings.HasPrefix(err.Error(), "BUSYGROUP") {
+			err = nil
+		}
+		return err
+	})
+	if err != nil {
+		return errors.NewErrUnexpected("failed to create events dispatchers group on redis").WithError(err)
+	}
+	return nil
+}
+
+func (r *redisEventsOutbox) buildDeadLettersKey(schedulerName string) string {
+	return fmt.Sprintf("scheduler:%s:events:dead_letters", schedulerName)
+}
+
+func encodeDelivery(delivery *events.EventDelivery) (string, error) {
+	redisDelivery := redisEventDelivery{
+		ID: delivery.ID,
+		Event: redisEvent{
+			ID:          delivery.Event.ID,
+			Name:        delivery.Event.Name,
+			SchedulerID: delivery.Event.SchedulerID,
+			RoomID:      delivery.Event.RoomID,
+			Attributes:  delivery.Event.Attributes,
+		},
+		ForwarderName: delivery.ForwarderName,
+		Attempts:      delivery.Attempts,
+		LastError:     delivery.LastError,
+	}
+	if !delivery.FailedAt.IsZero() {
+		redisDelivery.FailedAt = &delivery.FailedAt
+	}
+	if !delivery.Event.CreatedAt.IsZero() {
+		redisDelivery.Event.CreatedAt = &delivery.Event.CreatedAt
+	}
+
+	value, err := json.Marshal(redisDelivery)
+	return string(value), err
+}
+
+func decodeMessage(message redis.XMessage) (*events.EventDelivery, error) {
+	value, ok := message.Values[deliveryField].(string)
+	if !ok {
+		return nil, fmt.Errorf("message %s has no delivery", message.ID)
+	}
+
+	// Numbers are kept as json.Number so the event attributes are forwarded
+	// as they were received.
+	var redisDelivery redisEventDelivery
+	decoder := json.NewDecoder(bytes.NewReader([]byte(value)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&redisDelivery); err != nil {
+		return nil, err
+	}
+
+	delivery := &events.EventDelivery{
+		ID: message.ID,
+		Event: &events.Event{
+			ID:          redisDelivery.Event.ID,
+			Name:        redisDelivery.Event.Name,
+			SchedulerID: redisDelivery.Event.SchedulerID,
+			RoomID:      redisDelivery.Event.RoomID,
+			Attributes:  redisDelivery.Event.Attributes,
+		},
+		ForwarderName: redisDelivery.ForwarderName,
+		Attempts:      redisDelivery.Attempts,
+		LastError:     redisDelivery.LastError,
+	}
+	if redisDelivery.FailedAt != nil {
+		delivery.FailedAt = *redisDelivery.FailedAt
+	}
+	if redisDelivery.Event.CreatedAt != nil {
+		delivery.Event.CreatedAt = *redisDelivery.Event.CreatedAt
+	}
+	if delivery.Event.Attributes == nil {
+		delivery.Event.Attributes = map[string]interface{}{}
+	}
+	return delivery, nil
+}
@@ -0,0 +1,247 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build integration
+// +build integration
+
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/require"
+
+	"github.com/topfreegames/maestro/internal/core/entities/events"
+	"github.com/topfreegames/maestro/test"
+)
+
+func newDelivery(forwarderName string) *events.EventDelivery {
+	return &events.EventDelivery{
+		Event: &events.Event{
+			ID:          "event",
+			CreatedAt:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			Name:        events.PlayerEvent,
+			SchedulerID: "scheduler",
+			RoomID:      "room",
+			Attributes: map[string]interface{}{
+				"eventType": "playerLeft",
+				"playerId":  "player",
+				"score":     10,
+			},
+		},
+		ForwarderName: forwarderName,
+	}
+}
+
+func TestEnqueueAndNext(t *testing.T) {
+	t.Run("returns the enqueued deliveries", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		outbox := NewRedisEventsOutbox(client, "consumer", 0)
+		ctx := context.Background()
+
+		err := outbox.Enqueue(ctx, []*events.EventDelivery{newDelivery("fwd-1"), newDelivery("fwd-2")})
+		require.NoError(t, err)
+
+		deliveries, err := outbox.Next(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 2)
+		require.NotEmpty(t, deliveries[0].ID)
+		require.Equal(t, "fwd-1", deliveries[0].ForwarderName)
+		require.Equal(t, "fwd-2", deliveries[1].ForwarderName)
+		require.Equal(t, "event", deliveries[0].Event.ID)
+		require.True(t, deliveries[0].Event.CreatedAt.Equal(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)))
+		require.Equal(t, events.PlayerEvent, deliveries[0].Event.Name)
+		require.Equal(t, "scheduler", deliveries[0].Event.SchedulerID)
+		require.Equal(t, "room", deliveries[0].Event.RoomID)
+		require.Equal(t, json.Number("10"), deliveries[0].Event.Attributes["score"])
+		require.Equal(t, "player", deliveries[0].Event.Attributes["playerId"])
+	})
+
+	t.Run("returns the deliveries with due retries", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		outbox := NewRedisEventsOutbox(client, "consumer", 0)
+		ctx := context.Background()
+
+		err := outbox.Enqueue(ctx, []*events.EventDelivery{newDelivery("fwd")})
+		require.NoError(t, err)
+		deliveries, err := outbox.Next(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+
+		deliveries[0].Attempts = 1
+		deliveries[0].LastError = "unavailable"
+		err = outbox.Retry(ctx, deliveries[0], time.Now().Add(-time.Second))
+		require.NoError(t, err)
+
+		retried, err := outbox.Next(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, retried, 1)
+		require.NotEqual(t, deliveries[0].ID, retried[0].ID)
+		require.Equal(t, 1, retried[0].Attempts)
+		require.Equal(t, "unavailable", retried[0].LastError)
+	})
+}
+
+func TestRenew(t *testing.T) {
+	t.Run("keeps the deliveries with the dispatcher", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		outbox := NewRedisEventsOutbox(client, "consumer", 0)
+		otherOutbox := NewRedisEventsOutbox(client, "other-consumer", 0)
+		ctx := context.Background()
+
+		err := outbox.Enqueue(ctx, []*events.EventDelivery{newDelivery("fwd")})
+		require.NoError(t, err)
+		deliveries, err := outbox.Next(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+
+		err = otherOutbox.Renew(ctx, deliveries)
+		require.NoError(t, err)
+
+		pending, err := client.XPendingExt(ctx, &redis.XPendingExtArgs{Stream: outboxStreamKey, Group: outboxGroup, Start: "-", End: "+", Count: 10}).Result()
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		require.Equal(t, deliveries[0].ID, pending[0].ID)
+		require.Equal(t, "other-consumer", pending[0].Consumer)
+	})
+}
+
+func TestAck(t *testing.T) {
+	t.Run("removes the delivery from the outbox", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		outbox := NewRedisEventsOutbox(client, "consumer", 0)
+		ctx := context.Background()
+
+		err := outbox.Enqueue(ctx, []*events.EventDelivery{newDelivery("fwd")})
+		require.NoError(t, err)
+		deliveries, err := outbox.Next(ctx, 10)
+		require.NoError(t, err)
+
+		err = outbox.Ack(ctx, deliveries[0])
+		require.NoError(t, err)
+
+		length, err := client.XLen(ctx, outboxStreamKey).Result()
+		require.NoError(t, err)
+		require.Equal(t, int64(0), length)
+		pending, err := client.XPending(ctx, outboxStreamKey, outboxGroup).Result()
+		require.NoError(t, err)
+		require.Equal(t, int64(0), pending.Count)
+	})
+}
+
+func TestRetry(t *testing.T) {
+	t.Run("holds the delivery until the retry time", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		outbox := NewRedisEventsOutbox(client, "consumer", 0)
+		ctx := context.Background()
+
+		err := outbox.Enqueue(ctx, []*events.EventDelivery{newDelivery("fwd")})
+		require.NoError(t, err)
+		deliveries, err := outbox.Next(ctx, 10)
+		require.NoError(t, err)
+
+		err = outbox.Retry(ctx, deliveries[0], time.Now().Add(time.Hour))
+		require.NoError(t, err)
+
+		length, err := client.XLen(ctx, outboxStreamKey).Result()
+		require.NoError(t, err)
+		require.Equal(t, int64(0), length)
+		retries, err := client.ZCard(ctx, outboxRetriesKey).Result()
+		require.NoError(t, err)
+		require.Equal(t, int64(1), retries)
+	})
+}
+
+func TestDeadLetters(t *testing.T) {
+	t.Run("moves, lists and removes the dead-letter events", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		outbox := NewRedisEventsOutbox(client, "consumer", 0)
+		ctx := context.Background()
+
+		err := outbox.Enqueue(ctx, []*events.EventDelivery{newDelivery("fwd-1"), newDelivery("fwd-2")})
+		require.NoError(t, err)
+		deliveries, err := outbox.Next(ctx, 10)
+		require.NoError(t, err)
+
+		failedAt := time.Now().UTC().Truncate(time.Second)
+		for _, delivery := range deliveries {
+			delivery.Attempts = 5
+			delivery.LastError = "unavailable"
+			delivery.FailedAt = failedAt
+			err = outbox.DeadLetter(ctx, delivery)
+			require.NoError(t, err)
+		}
+
+		length, err := client.XLen(ctx, outboxStreamKey).Result()
+		require.NoError(t, err)
+		require.Equal(t, int64(0), length)
+
+		deadLetters, err := outbox.ListDeadLetters(ctx, "scheduler")
+		require.NoError(t, err)
+		require.Len(t, deadLetters, 2)
+		require.Equal(t, "fwd-1", deadLetters[0].ForwarderName)
+		require.Equal(t, 5, deadLetters[0].Attempts)
+		require.Equal(t, "unavailable", deadLetters[0].LastError)
+		require.True(t, failedAt.Equal(deadLetters[0].FailedAt))
+
+		err = outbox.RemoveDeadLetters(ctx, "scheduler", []string{deadLetters[0].ID})
+		require.NoError(t, err)
+
+		deadLetters, err = outbox.ListDeadLetters(ctx, "scheduler")
+		require.NoError(t, err)
+		require.Len(t, deadLetters, 1)
+		require.Equal(t, "fwd-2", deadLetters[0].ForwarderName)
+	})
+
+	t.Run("deletes the scheduler dead-letter events", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		outbox := NewRedisEventsOutbox(client, "consumer", 0)
+		ctx := context.Background()
+
+		err := outbox.Enqueue(ctx, []*events.EventDelivery{newDelivery("fwd")})
+		require.NoError(t, err)
+		deliveries, err := outbox.Next(ctx, 10)
+		require.NoError(t, err)
+		err = outbox.DeadLetter(ctx, deliveries[0])
+		require.NoError(t, err)
+
+		err = outbox.DeleteDeadLetters(ctx, "scheduler")
+		require.NoError(t, err)
+
+		deadLetters, err := outbox.ListDeadLetters(ctx, "scheduler")
+		require.NoError(t, err)
+		require.Empty(t, deadLetters)
+	})
+
+	t.Run("returns no dead-letter events for other schedulers", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		outbox := NewRedisEventsOutbox(client, "consumer", 0)
+
+		deadLetters, err := outbox.ListDeadLetters(context.Background(), "other-scheduler")
+		require.NoError(t, err)
+		require.Empty(t, deadLetters)
+	})
+}
@@ -0,0 +1,140 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package room
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/topfreegames/maestro/internal/adapters/metrics"
+	"github.com/topfreegames/maestro/internal/core/entities/game_room"
+	"github.com/topfreegames/maestro/internal/core/ports"
+	"github.com/topfreegames/maestro/internal/core/ports/errors"
+)
+
+var _ ports.RoomTimelineStorage = (*redisRoomTimelineStorage)(nil)
+
+const roomTimelineStorageMetricLabel = "room-timeline-storage"
+
+// redisRoomTimelineStorage adapter of the RoomTimelineStorage port. The
+// timeline of each room is kept as a list of JSON entries, trimmed to the
+// max entries, which expires when no entry is added for the TTL.
+type redisRoomTimelineStorage struct {
+	client     *redis.Client
+	maxEntries int64
+	ttl        time.Duration
+}
+
+type redisTimelineEntry struct {
+	Kind           game_room.TimelineEntryKind `json:"kind"`
+	Timestamp      time.Time                   `json:"timestamp"`
+	Source         game_room.TimelineSource    `json:"source,omitempty"`
+	PreviousStatus string                      `json:"previousStatus,omitempty"`
+	Status         string                      `json:"status,omitempty"`
+	EventName      string                      `json:"eventName,omitempty"`
+	EventType      string                      `json:"eventType,omitempty"`
+	ForwarderName  string                      `json:"forwarderName,omitempty"`
+	Error          string                      `json:"error,omitempty"`
+	Reason         string                      `json:"reason,omitempty"`
+}
+
+func NewRedisRoomTimelineStorage(client *redis.Client, maxEntries int64, ttl time.Duration) *redisRoomTimelineStorage {
+	return &redisRoomTimelineStorage{client: client, maxEntries: maxEntries, ttl: ttl}
+}
+
+// AddRoomTimelineEntry appends the entry to the room timeline, dropping the
+// oldest entries over the max entries.
+func (r *redisRoomTimelineStorage) AddRoomTimelineEntry(ctx context.Context, scheduler, roomID string, entry *game_room.TimelineEntry) error {
+	value, err := json.Marshal(redisTimelineEntry{
+		Kind:           entry.Kind,
+		Timestamp:      entry.Timestamp,
+		Source:         entry.Source,
+		PreviousStatus: entry.PreviousStatus,
+		Status:         entry.Status,
+		EventName:      entry.EventName,
+		EventType:      entry.EventType,
+		ForwarderName:  entry.ForwarderName,
+		Error:          entry.Error,
+		Reason:         entry.Reason,
+	})
+	if err != nil {
+		return errors.NewErrEncoding("failed to encode room timeline entry").WithError(err)
+	}
+
+	key := getRoomTimelineRedisKey(scheduler, roomID)
+	metrics.RunWithMetrics(roomTimelineStorageMetricLabel, func() error {
+		pipe := r.client.TxPipeline()
+		pipe.RPush(ctx, key, value)
+		pipe.LTrim(ctx, key, -r.maxEntries, -1)
+		pipe.Expire(ctx, key, r.ttl)
+		_, err = pipe.Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return errors.NewErrUnexpected("failed to add room timeline entry on redis").WithError(err)
+	}
+	return nil
+}
+
+// GetRoomTimeline returns the room timeline, oldest entry first.
+func (r *redisRoomTimelineStorage) GetRoomTimeline(ctx context.Context, scheduler, roomID string) ([]*game_room.TimelineEntry, error) {
+	var values []string
+	var err error
+	metrics.RunWithMetrics(roomTimelineStorageMetricLabel, func() error {
+		values, err = r.client.LRange(ctx, getRoomTimelineRedisKey(scheduler, roomID), 0, -1).Result()
+		return err
+	})
+	if err != nil {
+		return nil, errors.NewErrUnexpected("failed to get room timeline from redis").WithError(err)
+	}
+
+	timeline := make([]*game_room.TimelineEntry, 0, len(values))
+	for _, value := range values {
+		var entry redisTimelineEntry
+		err = json.Unmarshal([]byte(value), &entry)
+		if err != nil {
+			return nil, errors.NewErrEncoding("failed to decode room timeline entry").WithError(err)
+		}
+
+		timeline = append(timeline, &game_room.TimelineEntry{
+			Kind:           entry.Kind,
+			Timestamp:      entry.Timestamp,
+			Source:         entry.Source,
+			PreviousStatus: entry.PreviousStatus,
+			Status:         entry.Status,
+			EventName:      entry.EventName,
+			EventType:      entry.EventType,
+			ForwarderName:  entry.ForwarderName,
+			Error:          entry.Error,
+			Reason:         entry.Reason,
+		})
+	}
+	return timeline, nil
+}
+
+func getRoomTimelineRedisKey(scheduler, roomID string) string {
+	return fmt.Sprintf("scheduler:%s:rooms:%s:timeline", scheduler, roomID)
+}
@@ -0,0 +1,101 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build integration
+// +build integration
+
+package room
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/topfreegames/maestro/internal/core/entities/game_room"
+	"github.com/topfreegames/maestro/test"
+)
+
+func TestRedisRoomTimelineStorage_AddRoomTimelineEntry(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("appends the entries to the room timeline", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		storage := NewRedisRoomTimelineStorage(client, 10, time.Hour)
+		now := time.Unix(time.Now().Unix(), 0).UTC()
+
+		statusChanged := &game_room.TimelineEntry{
+			Kind:           game_room.TimelineStatusChanged,
+			Timestamp:      now,
+			Source:         game_room.TimelineSourcePing,
+			PreviousStatus: "ready",
+			Status:         "occupied",
+		}
+		eventForwarded := &game_room.TimelineEntry{
+			Kind:          game_room.TimelineEventForwarded,
+			Timestamp:     now.Add(time.Second),
+			EventName:     "RoomEvent",
+			EventType:     "resync",
+			ForwarderName: "matchmaking",
+			Error:         "unavailable",
+		}
+		require.NoError(t, storage.AddRoomTimelineEntry(ctx, "scheduler", "room-1", statusChanged))
+		require.NoError(t, storage.AddRoomTimelineEntry(ctx, "scheduler", "room-1", eventForwarded))
+
+		timeline, err := storage.GetRoomTimeline(ctx, "scheduler", "room-1")
+		require.NoError(t, err)
+		require.Equal(t, []*game_room.TimelineEntry{statusChanged, eventForwarded}, timeline)
+
+		ttl, err := client.TTL(ctx, getRoomTimelineRedisKey("scheduler", "room-1")).Result()
+		require.NoError(t, err)
+		require.Greater(t, ttl, time.Duration(0))
+	})
+
+	t.Run("keeps only the newest entries", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		storage := NewRedisRoomTimelineStorage(client, 3, time.Hour)
+
+		for i := 0; i < 5; i++ {
+			entry := &game_room.TimelineEntry{Kind: game_room.TimelineDeleted, Reason: fmt.Sprint(i)}
+			require.NoError(t, storage.AddRoomTimelineEntry(ctx, "scheduler", "room-2", entry))
+		}
+
+		timeline, err := storage.GetRoomTimeline(ctx, "scheduler", "room-2")
+		require.NoError(t, err)
+		require.Len(t, timeline, 3)
+		require.Equal(t, "2", timeline[0].Reason)
+		require.Equal(t, "4", timeline[2].Reason)
+	})
+}
+
+func TestRedisRoomTimelineStorage_GetRoomTimeline(t *testing.T) {
+	t.Run("returns an empty timeline when the room has none", func(t *testing.T) {
+		client := test.GetRedisConnection(t, redisAddress)
+		storage := NewRedisRoomTimelineStorage(client, 10, time.Hour)
+
+		timeline, err := storage.GetRoomTimeline(context.Background(), "scheduler", "unknown")
+		require.NoError(t, err)
+		require.Empty(t, timeline)
+	})
+}
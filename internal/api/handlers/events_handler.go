@@ -0,0 +1,144 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/topfreegames/maestro/internal/api/handlers/requestadapters"
+	"github.com/topfreegames/maestro/internal/core/logs"
+	"github.com/topfreegames/maestro/internal/core/ports"
+	portsErrors "github.com/topfreegames/maestro/internal/core/ports/errors"
+	api "github.com/topfreegames/maestro/pkg/api/v1"
+)
+
+type EventsHandler struct {
+	deadLetterEventsManager ports.DeadLetterEventsManager
+	forwardersHealthManager ports.ForwardersHealthManager
+	forwardersTester        ports.ForwardersTester
+	logger                  *zap.Logger
+	api.UnimplementedEventsServiceServer
+}
+
+func ProvideEventsHandler(deadLetterEventsManager ports.DeadLetterEventsManager, forwardersHealthManager ports.ForwardersHealthManager, forwardersTester ports.ForwardersTester) *EventsHandler {
+	return &EventsHandler{
+		deadLetterEventsManager: deadLetterEventsManager,
+		forwardersHealthManager: forwardersHealthManager,
+		forwardersTester:        forwardersTester,
+		logger: zap.L().
+			With(zap.String(logs.LogFieldComponent, "handler"), zap.String(logs.LogFieldHandlerName, "events_handler")),
+	}
+}
+
+func (h *EventsHandler) ListDeadLetterEvents(ctx context.Context, request *api.ListDeadLetterEventsRequest) (*api.ListDeadLetterEventsResponse, error) {
+	handlerLogger := h.logger.With(zap.String(logs.LogFieldSchedulerName, request.GetSchedulerName()))
+	handlerLogger.Info("handling list dead-letter events request")
+	deliveries, err := h.deadLetterEventsManager.ListDeadLetterEvents(ctx, request.GetSchedulerName())
+	if err != nil {
+		handlerLogger.Error("error listing dead-letter events", zap.Error(err))
+		return nil, eventsErrorStatus(err)
+	}
+
+	deadLetterEvents, err := requestadapters.FromEventDeliveriesToDeadLetterEventsResponse(deliveries)
+	if err != nil {
+		handlerLogger.Error("error parsing dead-letter events to response", zap.Error(err))
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+
+	handlerLogger.Info("finish handling list dead-letter events request")
+	return &api.ListDeadLetterEventsResponse{Events: deadLetterEvents}, nil
+}
+
+func (h *EventsHandler) ReplayDeadLetterEvents(ctx context.Context, request *api.ReplayDeadLetterEventsRequest) (*api.ReplayDeadLetterEventsResponse, error) {
+	handlerLogger := h.logger.With(zap.String(logs.LogFieldSchedulerName, request.GetSchedulerName()))
+	handlerLogger.Info("handling replay dead-letter events request")
+	deliveries, err := h.deadLetterEventsManager.ReplayDeadLetterEvents(ctx, request.GetSchedulerName(), request.GetIds())
+	if err != nil {
+		handlerLogger.Error("error replaying dead-letter events", zap.Error(err))
+		return nil, eventsErrorStatus(err)
+	}
+
+	deadLetterEvents, err := requestadapters.FromEventDeliveriesToDeadLetterEventsResponse(deliveries)
+	if err != nil {
+		handlerLogger.Error("error parsing replayed events to response", zap.Error(err))
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+
+	handlerLogger.Info("finish handling replay dead-letter events request")
+	return &api.ReplayDeadLetterEventsResponse{Events: deadLetterEvents}, nil
+}
+
+func (h *EventsHandler) ListForwardersHealth(ctx context.Context, request *api.ListForwardersHealthRequest) (*api.ListForwardersHealthResponse, error) {
+	handlerLogger := h.logger.With(zap.String(logs.LogFieldSchedulerName, request.GetSchedulerName()))
+	handlerLogger.Info("handling list forwarders health request")
+	forwardersHealth, err := h.forwardersHealthManager.ListForwardersHealth(ctx, request.GetSchedulerName())
+	if err != nil {
+		handlerLogger.Error("error listing forwarders health", zap.Error(err))
+		return nil, eventsErrorStatus(err)
+	}
+
+	handlerLogger.Info("finish handling list forwarders health request")
+	return &api.ListForwardersHealthResponse{Forwarders: requestadapters.FromForwardersHealthToResponse(forwardersHealth)}, nil
+}
+
+func (h *EventsHandler) TestForwarders(ctx context.Context, request *api.TestForwardersRequest) (*api.TestForwardersResponse, error) {
+	handlerLogger := h.logger.With(zap.String(logs.LogFieldGame, request.GetGame()))
+	handlerLogger.Info("handling test forwarders request")
+	results, err := h.forwardersTester.TestForwarders(ctx, request.GetGame(), requestadapters.FromApiTestForwardersRequestToEntities(request))
+	if err != nil {
+		handlerLogger.Error("error testing forwarders", zap.Error(err))
+		return nil, eventsErrorStatus(err)
+	}
+
+	handlerLogger.Info("finish handling test forwarders request")
+	return &api.TestForwardersResponse{Forwarders: requestadapters.FromForwarderTestResultsToResponse(results)}, nil
+}
+
+func (h *EventsHandler) TestSchedulerForwarders(ctx context.Context, request *api.TestSchedulerForwardersRequest) (*api.TestForwardersResponse, error) {
+	handlerLogger := h.logger.With(zap.String(logs.LogFieldSchedulerName, request.GetSchedulerName()))
+	handlerLogger.Info("handling test scheduler forwarders request")
+	results, err := h.forwardersTester.TestSchedulerForwarders(ctx, request.GetSchedulerName())
+	if err != nil {
+		handlerLogger.Error("error testing scheduler forwarders", zap.Error(err))
+		return nil, eventsErrorStatus(err)
+	}
+
+	handlerLogger.Info("finish handling test scheduler forwarders request")
+	return &api.TestForwardersResponse{Forwarders: requestadapters.FromForwarderTestResultsToResponse(results)}, nil
+}
+
+func eventsErrorStatus(err error) error {
+	switch {
+	case errors.Is(err, portsErrors.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, portsErrors.ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Unknown, err.Error())
+	}
+}
@@ -0,0 +1,369 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build integration
+// +build integration
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/topfreegames/maestro/internal/core/entities/events"
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
+	portsErrors "github.com/topfreegames/maestro/internal/core/ports/errors"
+	mockports "github.com/topfreegames/maestro/internal/core/ports/mock"
+	api "github.com/topfreegames/maestro/pkg/api/v1"
+)
+
+func TestListDeadLetterEvents(t *testing.T) {
+	t.Run("returns the scheduler dead-letter events", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		deadLetterEventsManager := mockports.NewMockDeadLetterEventsManager(mockCtrl)
+		forwardersHealthManager := mockports.NewMockForwardersHealthManager(mockCtrl)
+		forwardersTester := mockports.NewMockForwardersTester(mockCtrl)
+		deadLetterEventsManager.EXPECT().ListDeadLetterEvents(gomock.Any(), "scheduler").Return([]*events.EventDelivery{newHandlerDeadLetterEvent()}, nil)
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterEventsServiceHandlerServer(context.Background(), mux, ProvideEventsHandler(deadLetterEventsManager, forwardersHealthManager, forwardersTester))
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodGet, "/schedulers/scheduler/events/dead-letters", nil)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		responseBody, expectedResponseBody := extractBodyForComparison(t, rr.Body.Bytes(), "events_handler/list_dead_letter_events.json")
+		require.Equal(t, expectedResponseBody, responseBody)
+	})
+
+	t.Run("returns not found when the scheduler doesn't exist", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		deadLetterEventsManager := mockports.NewMockDeadLetterEventsManager(mockCtrl)
+		forwardersHealthManager := mockports.NewMockForwardersHealthManager(mockCtrl)
+		forwardersTester := mockports.NewMockForwardersTester(mockCtrl)
+		deadLetterEventsManager.EXPECT().ListDeadLetterEvents(gomock.Any(), "scheduler").Return(nil, portsErrors.NewErrNotFound("scheduler scheduler not found"))
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterEventsServiceHandlerServer(context.Background(), mux, ProvideEventsHandler(deadLetterEventsManager, forwardersHealthManager, forwardersTester))
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodGet, "/schedulers/scheduler/events/dead-letters", nil)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("returns unknown error when the events can't be listed", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		deadLetterEventsManager := mockports.NewMockDeadLetterEventsManager(mockCtrl)
+		forwardersHealthManager := mockports.NewMockForwardersHealthManager(mockCtrl)
+		forwardersTester := mockports.NewMockForwardersTester(mockCtrl)
+		deadLetterEventsManager.EXPECT().ListDeadLetterEvents(gomock.Any(), "scheduler").Return(nil, portsErrors.NewErrUnexpected("error"))
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterEventsServiceHandlerServer(context.Background(), mux, ProvideEventsHandler(deadLetterEventsManager, forwardersHealthManager, forwardersTester))
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodGet, "/schedulers/scheduler/events/dead-letters", nil)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
+
+func TestReplayDeadLetterEvents(t *testing.T) {
+	t.Run("replays the informed dead-letter events", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		deadLetterEventsManager := mockports.NewMockDeadLetterEventsManager(mockCtrl)
+		forwardersHealthManager := mockports.NewMockForwardersHealthManager(mockCtrl)
+		forwardersTester := mockports.NewMockForwardersTester(mockCtrl)
+		deadLetterEventsManager.EXPECT().ReplayDeadLetterEvents(gomock.Any(), "scheduler", []string{"1700000000000-0"}).Return([]*events.EventDelivery{newHandlerDeadLetterEvent()}, nil)
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterEventsServiceHandlerServer(context.Background(), mux, ProvideEventsHandler(deadLetterEventsManager, forwardersHealthManager, forwardersTester))
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPost, "/schedulers/scheduler/events/dead-letters/replay", strings.NewReader(`{"ids": ["1700000000000-0"]}`))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response api.ReplayDeadLetterEventsResponse
+		require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), &response))
+		require.Len(t, response.Events, 1)
+		require.Equal(t, "1700000000000-0", response.Events[0].Id)
+	})
+
+	t.Run("replays every dead-letter event when no id is informed", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		deadLetterEventsManager := mockports.NewMockDeadLetterEventsManager(mockCtrl)
+		forwardersHealthManager := mockports.NewMockForwardersHealthManager(mockCtrl)
+		forwardersTester := mockports.NewMockForwardersTester(mockCtrl)
+		deadLetterEventsManager.EXPECT().ReplayDeadLetterEvents(gomock.Any(), "scheduler", gomock.Len(0)).Return([]*events.EventDelivery{}, nil)
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterEventsServiceHandlerServer(context.Background(), mux, ProvideEventsHandler(deadLetterEventsManager, forwardersHealthManager, forwardersTester))
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPost, "/schedulers/scheduler/events/dead-letters/replay", strings.NewReader(`{}`))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("returns not found when a dead-letter event doesn't exist", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		deadLetterEventsManager := mockports.NewMockDeadLetterEventsManager(mockCtrl)
+		forwardersHealthManager := mockports.NewMockForwardersHealthManager(mockCtrl)
+		forwardersTester := mockports.NewMockForwardersTester(mockCtrl)
+		deadLetterEventsManager.EXPECT().ReplayDeadLetterEvents(gomock.Any(), "scheduler", []string{"1-0"}).Return(nil, portsErrors.NewErrNotFound("dead-letter events not found: 1-0"))
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterEventsServiceHandlerServer(context.Background(), mux, ProvideEventsHandler(deadLetterEventsManager, forwardersHealthManager, forwardersTester))
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPost, "/schedulers/scheduler/events/dead-letters/replay", strings.NewReader(`{"ids": ["1-0"]}`))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestListForwardersHealth(t *testing.T) {
+	t.Run("returns the health of the scheduler forwarders", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		deadLetterEventsManager := mockports.NewMockDeadLetterEventsManager(mockCtrl)
+		forwardersHealthManager := mockports.NewMockForwardersHealthManager(mockCtrl)
+		forwardersTester := mockports.NewMockForwardersTester(mockCtrl)
+		failedAt := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+		forwardersHealthManager.EXPECT().ListForwardersHealth(gomock.Any(), "scheduler").Return([]*forwarder.Health{
+			{
+				ForwarderName:       "matchmaking",
+				Address:             "matchmaker:8080",
+				State:               forwarder.CircuitOpen,
+				ConsecutiveFailures: 5,
+				LastError:           "failed to forward event room at \"matchmaking\" with code Unavailable",
+				LastFailureAt:       failedAt,
+				UpdatedAt:           failedAt,
+			},
+			{
+				ForwarderName: "analytics",
+				Address:       "http://analytics",
+				State:         forwarder.CircuitClosed,
+			},
+		}, nil)
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterEventsServiceHandlerServer(context.Background(), mux, ProvideEventsHandler(deadLetterEventsManager, forwardersHealthManager, forwardersTester))
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodGet, "/schedulers/scheduler/forwarders/health", nil)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		responseBody, expectedResponseBody := extractBodyForComparison(t, rr.Body.Bytes(), "events_handler/list_forwarders_health.json")
+		require.Equal(t, expectedResponseBody, responseBody)
+	})
+
+	t.Run("returns not found when the scheduler doesn't exist", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		deadLetterEventsManager := mockports.NewMockDeadLetterEventsManager(mockCtrl)
+		forwardersHealthManager := mockports.NewMockForwardersHealthManager(mockCtrl)
+		forwardersTester := mockports.NewMockForwardersTester(mockCtrl)
+		forwardersHealthManager.EXPECT().ListForwardersHealth(gomock.Any(), "scheduler").Return(nil, portsErrors.NewErrNotFound("scheduler scheduler not found"))
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterEventsServiceHandlerServer(context.Background(), mux, ProvideEventsHandler(deadLetterEventsManager, forwardersHealthManager, forwardersTester))
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodGet, "/schedulers/scheduler/forwarders/health", nil)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestTestForwarders(t *testing.T) {
+	t.Run("returns the test result of the forwarders", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		deadLetterEventsManager := mockports.NewMockDeadLetterEventsManager(mockCtrl)
+		forwardersHealthManager := mockports.NewMockForwardersHealthManager(mockCtrl)
+		forwardersTester := mockports.NewMockForwardersTester(mockCtrl)
+		forwardersTester.EXPECT().TestForwarders(gomock.Any(), "game", []*forwarder.Forwarder{
+			{
+				Name:        "matchmaking",
+				Enabled:     true,
+				ForwardType: forwarder.TypeGrpc,
+				Address:     "matchmaker:8080",
+				Options:     &forwarder.ForwardOptions{Timeout: 1000, Metadata: map[string]interface{}{}},
+			},
+		}).Return(newHandlerForwarderTestResults(), nil)
+
+		request, err := os.ReadFile(fixturesRelativePath + "/request/test-forwarders.json")
+		require.NoError(t, err)
+
+		mux := runtime.NewServeMux()
+		err = api.RegisterEventsServiceHandlerServer(context.Background(), mux, ProvideEventsHandler(deadLetterEventsManager, forwardersHealthManager, forwardersTester))
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPost, "/forwarders/test", bytes.NewReader(request))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		responseBody, expectedResponseBody := extractBodyForComparison(t, rr.Body.Bytes(), "events_handler/test_forwarders.json")
+		require.Equal(t, expectedResponseBody, responseBody)
+	})
+
+	t.Run("returns bad request when the forwarders are invalid", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		deadLetterEventsManager := mockports.NewMockDeadLetterEventsManager(mockCtrl)
+		forwardersHealthManager := mockports.NewMockForwardersHealthManager(mockCtrl)
+		forwardersTester := mockports.NewMockForwardersTester(mockCtrl)
+		forwardersTester.EXPECT().TestForwarders(gomock.Any(), "game", gomock.Any()).Return(nil, portsErrors.NewErrInvalidArgument("no forwarder informed"))
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterEventsServiceHandlerServer(context.Background(), mux, ProvideEventsHandler(deadLetterEventsManager, forwardersHealthManager, forwardersTester))
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPost, "/forwarders/test", strings.NewReader(`{"game": "game"}`))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestTestSchedulerForwarders(t *testing.T) {
+	t.Run("returns the test result of the scheduler forwarders", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		deadLetterEventsManager := mockports.NewMockDeadLetterEventsManager(mockCtrl)
+		forwardersHealthManager := mockports.NewMockForwardersHealthManager(mockCtrl)
+		forwardersTester := mockports.NewMockForwardersTester(mockCtrl)
+		forwardersTester.EXPECT().TestSchedulerForwarders(gomock.Any(), "scheduler").Return(newHandlerForwarderTestResults(), nil)
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterEventsServiceHandlerServer(context.Background(), mux, ProvideEventsHandler(deadLetterEventsManager, forwardersHealthManager, forwardersTester))
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPost, "/schedulers/scheduler/forwarders/test", bytes.NewReader([]byte{}))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		responseBody, expectedResponseBody := extractBodyForComparison(t, rr.Body.Bytes(), "events_handler/test_forwarders.json")
+		require.Equal(t, expectedResponseBody, responseBody)
+	})
+
+	t.Run("returns not found when the scheduler doesn't exist", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		deadLetterEventsManager := mockports.NewMockDeadLetterEventsManager(mockCtrl)
+		forwardersHealthManager := mockports.NewMockForwardersHealthManager(mockCtrl)
+		forwardersTester := mockports.NewMockForwardersTester(mockCtrl)
+		forwardersTester.EXPECT().TestSchedulerForwarders(gomock.Any(), "scheduler").Return(nil, portsErrors.NewErrNotFound("scheduler scheduler not found"))
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterEventsServiceHandlerServer(context.Background(), mux, ProvideEventsHandler(deadLetterEventsManager, forwardersHealthManager, forwardersTester))
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPost, "/schedulers/scheduler/forwarders/test", bytes.NewReader([]byte{}))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func newHandlerDeadLetterEvent() *events.EventDelivery {
+	return &events.EventDelivery{
+		ID: "1700000000000-0",
+		Event: &events.Event{
+			Name:        events.PlayerEvent,
+			SchedulerID: "scheduler",
+			RoomID:      "room",
+			Attributes: map[string]interface{}{
+				"eventType": "playerLeft",
+				"playerId":  "player",
+				"score":     json.Number("10"),
+			},
+		},
+		ForwarderName: "fwd",
+		Attempts:      5,
+		LastError:     "forwarder unavailable",
+		FailedAt:      time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC),
+	}
+}
+
+func newHandlerForwarderTestResults() []*forwarder.TestResult {
+	return []*forwarder.TestResult{
+		{
+			ForwarderName: "matchmaking",
+			Address:       "matchmaker:8080",
+			RoomEvent:     &forwarder.EventTestResult{Success: true, Latency: 12 * time.Millisecond, Code: 200, Message: "ok"},
+			PlayerEvent:   &forwarder.EventTestResult{Latency: time.Second, Message: "rpc error: code = DeadlineExceeded desc = context deadline exceeded"},
+		},
+	}
+}
@@ -0,0 +1,68 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package handlers
+
+import (
+	"context"
+	"net/textproto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// IdempotencyKeyHeader is the HTTP header used to send the idempotency key
+	// of requests that create operations.
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	idempotencyKeyMetadata = "idempotency-key"
+)
+
+// IncomingHeaderMatcher forwards the idempotency key header to the handlers,
+// besides the headers forwarded by default.
+func IncomingHeaderMatcher(key string) (string, bool) {
+	if textproto.CanonicalMIMEHeaderKey(key) == IdempotencyKeyHeader {
+		return idempotencyKeyMetadata, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// idempotencyKeyFromRequest returns the idempotency key sent on the request
+// field, falling back to the one sent on the request metadata (or header).
+func idempotencyKeyFromRequest(ctx context.Context, requestIdempotencyKey *string) string {
+	if requestIdempotencyKey != nil {
+		return *requestIdempotencyKey
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if values := md.Get(idempotencyKeyMetadata); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
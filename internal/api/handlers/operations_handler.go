@@ -26,6 +26,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"sort"
 	"strings"
 
@@ -36,12 +37,18 @@ import (
 	"github.com/topfreegames/maestro/internal/core/ports"
 	"go.uber.org/zap"
 
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/topfreegames/maestro/internal/core/entities/operation"
 	api "github.com/topfreegames/maestro/pkg/api/v1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
+// watchEventName is the Server-Sent Events event name used when streaming
+// operations through HTTP.
+const watchEventName = "operation"
+
 type OperationsHandler struct {
 	operationManager ports.OperationManager
 	logger           *zap.Logger
@@ -144,6 +151,116 @@ func (h *OperationsHandler) GetOperation(ctx context.Context, request *api.GetOp
 	return &api.GetOperationResponse{Operation: convertedOp}, nil
 }
 
+func (h *OperationsHandler) WatchOperation(request *api.WatchOperationRequest, stream api.OperationsService_WatchOperationServer) error {
+	handlerLogger := h.logger.With(zap.String(logs.LogFieldSchedulerName, request.GetSchedulerName()), zap.String(logs.LogFieldOperationID, request.GetOperationId()))
+	handlerLogger.Info("received request to watch operation")
+	opChan, err := h.operationManager.WatchOperation(stream.Context(), request.GetSchedulerName(), request.GetOperationId())
+	if err != nil {
+		return h.watchErrorToStatus(handlerLogger, err)
+	}
+
+	return h.streamOperations(opChan, func(op *api.Operation) error {
+		return stream.Send(&api.WatchOperationResponse{Operation: op})
+	})
+}
+
+func (h *OperationsHandler) WatchSchedulerOperations(request *api.WatchSchedulerOperationsRequest, stream api.OperationsService_WatchSchedulerOperationsServer) error {
+	handlerLogger := h.logger.With(zap.String(logs.LogFieldSchedulerName, request.GetSchedulerName()))
+	handlerLogger.Info("received request to watch scheduler operations")
+	opChan, err := h.operationManager.WatchSchedulerOperations(stream.Context(), request.GetSchedulerName())
+	if err != nil {
+		return h.watchErrorToStatus(handlerLogger, err)
+	}
+
+	return h.streamOperations(opChan, func(op *api.Operation) error {
+		return stream.Send(&api.WatchSchedulerOperationsResponse{Operation: op})
+	})
+}
+
+func (h *OperationsHandler) PauseSchedulerOperations(ctx context.Context, request *api.PauseSchedulerOperationsRequest) (*api.PauseSchedulerOperationsResponse, error) {
+	handlerLogger := h.logger.With(zap.String(logs.LogFieldSchedulerName, request.GetSchedulerName()))
+	handlerLogger.Info("received request to pause scheduler operations", zap.String("reason", request.GetReason()))
+	err := h.operationManager.PauseSchedulerOperations(ctx, request.GetSchedulerName(), request.GetReason())
+	if err != nil {
+		return nil, h.pauseErrorToStatus(handlerLogger, err)
+	}
+
+	return &api.PauseSchedulerOperationsResponse{}, nil
+}
+
+func (h *OperationsHandler) ResumeSchedulerOperations(ctx context.Context, request *api.ResumeSchedulerOperationsRequest) (*api.ResumeSchedulerOperationsResponse, error) {
+	handlerLogger := h.logger.With(zap.String(logs.LogFieldSchedulerName, request.GetSchedulerName()))
+	handlerLogger.Info("received request to resume scheduler operations")
+	err := h.operationManager.ResumeSchedulerOperations(ctx, request.GetSchedulerName())
+	if err != nil {
+		return nil, h.pauseErrorToStatus(handlerLogger, err)
+	}
+
+	return &api.ResumeSchedulerOperationsResponse{}, nil
+}
+
+// WatchOperationHTTP is the HTTP equivalent of WatchOperation, it streams the
+// operation as Server-Sent Events.
+func (h *OperationsHandler) WatchOperationHTTP(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	sseWriter := &serverSentEventsWriter{ctx: r.Context(), writer: w}
+	err := h.WatchOperation(
+		&api.WatchOperationRequest{SchedulerName: pathParams["schedulerName"], OperationId: pathParams["operationId"]},
+		&watchOperationServerSentEventsStream{serverSentEventsWriter: sseWriter},
+	)
+	sseWriter.writeError(err)
+}
+
+// WatchSchedulerOperationsHTTP is the HTTP equivalent of
+// WatchSchedulerOperations, it streams the operations as Server-Sent Events.
+func (h *OperationsHandler) WatchSchedulerOperationsHTTP(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	sseWriter := &serverSentEventsWriter{ctx: r.Context(), writer: w}
+	err := h.WatchSchedulerOperations(
+		&api.WatchSchedulerOperationsRequest{SchedulerName: pathParams["schedulerName"]},
+		&watchSchedulerOperationsServerSentEventsStream{serverSentEventsWriter: sseWriter},
+	)
+	sseWriter.writeError(err)
+}
+
+func (h *OperationsHandler) streamOperations(opChan <-chan *operation.Operation, send func(*api.Operation) error) error {
+	for op := range opChan {
+		convertedOp, err := requestadapters.FromOperationToResponse(op)
+		if err != nil {
+			h.logger.Error("invalid operation object. Fail to convert", zap.Error(err))
+			return status.Error(codes.Unknown, err.Error())
+		}
+
+		if err = send(convertedOp); err != nil {
+			h.logger.Warn("failed to send watched operation", zap.Error(err))
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *OperationsHandler) watchErrorToStatus(handlerLogger *zap.Logger, err error) error {
+	if errors.Is(err, portsErrors.ErrNotFound) {
+		handlerLogger.Warn("operation or scheduler not found", zap.Error(err))
+		return status.Error(codes.NotFound, err.Error())
+	}
+	handlerLogger.Error("error watching operations", zap.Error(err))
+	return status.Error(codes.Unknown, err.Error())
+}
+
+func (h *OperationsHandler) pauseErrorToStatus(handlerLogger *zap.Logger, err error) error {
+	switch {
+	case errors.Is(err, portsErrors.ErrNotFound):
+		handlerLogger.Warn("scheduler not found", zap.Error(err))
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, portsErrors.ErrConflict):
+		handlerLogger.Warn("scheduler operations pause conflict", zap.Error(err))
+		return status.Error(codes.Aborted, err.Error())
+	default:
+		handlerLogger.Error("error changing scheduler operations pause", zap.Error(err))
+		return status.Error(codes.Unknown, err.Error())
+	}
+}
+
 func (h *OperationsHandler) parseListOperationsResponse(ctx context.Context, operationEntities []*operation.Operation) ([]*api.ListOperationItem, error) {
 	operationResponse, err := requestadapters.FromOperationsToListOperationsResponses(operationEntities)
 	if err != nil {
@@ -245,3 +362,81 @@ func extractSortingParameters(orderBy string) (string, error) {
 	}
 	return sortingOrder, nil
 }
+
+// serverSentEventsWriter writes watched operations to HTTP responses as
+// Server-Sent Events.
+type serverSentEventsWriter struct {
+	ctx     context.Context
+	writer  http.ResponseWriter
+	started bool
+}
+
+func (s *serverSentEventsWriter) writeOperation(op *api.Operation) error {
+	data, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(op)
+	if err != nil {
+		return err
+	}
+
+	if !s.started {
+		s.writer.Header().Set("Content-Type", "text/event-stream")
+		s.writer.Header().Set("Cache-Control", "no-cache")
+		s.writer.Header().Set("Connection", "keep-alive")
+		s.writer.WriteHeader(http.StatusOK)
+		s.started = true
+	}
+
+	_, err = fmt.Fprintf(s.writer, "event: %s\ndata: %s\n\n", watchEventName, data)
+	if err != nil {
+		return err
+	}
+
+	if flusher, ok := s.writer.(http.Flusher); ok {
+		flusher.Flush()
+	}
+
+	return nil
+}
+
+// writeError writes the error as a regular JSON response if no event was sent
+// yet, otherwise the stream is just ended.
+func (s *serverSentEventsWriter) writeError(err error) {
+	if err == nil || s.started {
+		return
+	}
+
+	st := status.Convert(err)
+	body, marshalErr := protojson.Marshal(st.Proto())
+	if marshalErr != nil {
+		body = []byte(fmt.Sprintf(`{"code":%d,"message":%q}`, st.Code(), st.Message()))
+	}
+
+	s.writer.Header().Set("Content-Type", "application/json")
+	s.writer.WriteHeader(runtime.HTTPStatusFromCode(st.Code()))
+	_, _ = s.writer.Write(body)
+}
+
+type watchOperationServerSentEventsStream struct {
+	api.OperationsService_WatchOperationServer
+	*serverSentEventsWriter
+}
+
+func (s *watchOperationServerSentEventsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchOperationServerSentEventsStream) Send(response *api.WatchOperationResponse) error {
+	return s.writeOperation(response.GetOperation())
+}
+
+type watchSchedulerOperationsServerSentEventsStream struct {
+	api.OperationsService_WatchSchedulerOperationsServer
+	*serverSentEventsWriter
+}
+
+func (s *watchSchedulerOperationsServerSentEventsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchSchedulerOperationsServerSentEventsStream) Send(response *api.WatchSchedulerOperationsResponse) error {
+	return s.writeOperation(response.GetOperation())
+}
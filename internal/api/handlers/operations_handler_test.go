@@ -33,6 +33,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -624,6 +625,82 @@ func TestCancelOperation(t *testing.T) {
 	})
 }
 
+func TestPauseSchedulerOperations(t *testing.T) {
+	schedulerName := uuid.New().String()
+
+	tests := []struct {
+		description  string
+		managerError error
+		expectedCode int
+	}{
+		{"pauses the scheduler operations with success", nil, 200},
+		{"fails when the scheduler does not exist", errors.NewErrNotFound("scheduler not found"), 404},
+		{"fails when the scheduler operations are already paused", errors.NewErrConflict("already paused"), 409},
+		{"fails when the operation flow fails", errors.NewErrUnexpected("failed to pause"), 500},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			operationManager := mock.NewMockOperationManager(mockCtrl)
+
+			operationManager.EXPECT().PauseSchedulerOperations(gomock.Any(), schedulerName, "incident").Return(test.managerError)
+
+			mux := runtime.NewServeMux()
+			err := api.RegisterOperationsServiceHandlerServer(context.Background(), mux, ProvideOperationsHandler(operationManager))
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/schedulers/%s/operations/pause", schedulerName), strings.NewReader(`{"reason": "incident"}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, req)
+
+			require.Equal(t, test.expectedCode, rr.Code)
+		})
+	}
+}
+
+func TestResumeSchedulerOperations(t *testing.T) {
+	schedulerName := uuid.New().String()
+
+	tests := []struct {
+		description  string
+		managerError error
+		expectedCode int
+	}{
+		{"resumes the scheduler operations with success", nil, 200},
+		{"fails when the scheduler does not exist", errors.NewErrNotFound("scheduler not found"), 404},
+		{"fails when the scheduler operations are not paused", errors.NewErrConflict("not paused"), 409},
+		{"fails when the operation flow fails", errors.NewErrUnexpected("failed to resume"), 500},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			operationManager := mock.NewMockOperationManager(mockCtrl)
+
+			operationManager.EXPECT().ResumeSchedulerOperations(gomock.Any(), schedulerName).Return(test.managerError)
+
+			mux := runtime.NewServeMux()
+			err := api.RegisterOperationsServiceHandlerServer(context.Background(), mux, ProvideOperationsHandler(operationManager))
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/schedulers/%s/operations/resume", schedulerName), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, req)
+
+			require.Equal(t, test.expectedCode, rr.Code)
+		})
+	}
+}
+
 func TestGetOperation(t *testing.T) {
 	dates := []time.Time{
 		time.Time{}.AddDate(2020, 0, 0),
@@ -790,3 +867,121 @@ func extractBodyForComparison(t *testing.T, body []byte, expectedBodyFixturePath
 	require.NoError(t, err)
 	return bodyBuffer.String(), expectedBodyBuffer.String()
 }
+
+func TestWatchOperation(t *testing.T) {
+	schedulerName := uuid.New().String()
+	operationID := uuid.New().String()
+
+	newWatchMux := func(t *testing.T, operationManager *mock.MockOperationManager) *runtime.ServeMux {
+		handler := ProvideOperationsHandler(operationManager)
+		mux := runtime.NewServeMux()
+		err := api.RegisterOperationsServiceHandlerServer(context.Background(), mux, handler)
+		require.NoError(t, err)
+		err = mux.HandlePath(http.MethodGet, "/schedulers/{schedulerName}/operations/watch", handler.WatchSchedulerOperationsHTTP)
+		require.NoError(t, err)
+		err = mux.HandlePath(http.MethodGet, "/schedulers/{schedulerName}/operations/{operationId}/watch", handler.WatchOperationHTTP)
+		require.NoError(t, err)
+		return mux
+	}
+
+	t.Run("streams the operation updates as server-sent events", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		operationManager := mock.NewMockOperationManager(mockCtrl)
+
+		opChan := make(chan *operation.Operation, 2)
+		opChan <- &operation.Operation{ID: operationID, SchedulerName: schedulerName, Status: operation.StatusInProgress, DefinitionName: "add_rooms"}
+		opChan <- &operation.Operation{ID: operationID, SchedulerName: schedulerName, Status: operation.StatusFinished, DefinitionName: "add_rooms"}
+		close(opChan)
+		operationManager.EXPECT().WatchOperation(gomock.Any(), schedulerName, operationID).Return(opChan, nil)
+
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/schedulers/%s/operations/%s/watch", schedulerName, operationID), nil)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		newWatchMux(t, operationManager).ServeHTTP(rr, req)
+
+		require.Equal(t, 200, rr.Code)
+		require.Equal(t, "text/event-stream", rr.Header().Get("Content-Type"))
+
+		events := bytes.Split(bytes.TrimSpace(rr.Body.Bytes()), []byte("\n\n"))
+		require.Len(t, events, 2)
+		for i, expectedStatus := range []string{"in_progress", "finished"} {
+			lines := bytes.SplitN(events[i], []byte("\n"), 2)
+			require.Equal(t, "event: operation", string(lines[0]))
+
+			var apiOperation map[string]interface{}
+			err = json.Unmarshal(bytes.TrimPrefix(lines[1], []byte("data: ")), &apiOperation)
+			require.NoError(t, err)
+			require.Equal(t, operationID, apiOperation["id"])
+			require.Equal(t, expectedStatus, apiOperation["status"])
+		}
+	})
+
+	t.Run("returns 404 when the operation does not exist", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		operationManager := mock.NewMockOperationManager(mockCtrl)
+
+		operationManager.EXPECT().WatchOperation(gomock.Any(), schedulerName, operationID).Return(nil, errors.NewErrNotFound("operation not found"))
+
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/schedulers/%s/operations/%s/watch", schedulerName, operationID), nil)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		newWatchMux(t, operationManager).ServeHTTP(rr, req)
+
+		require.Equal(t, 404, rr.Code)
+		require.Contains(t, rr.Body.String(), "operation not found")
+	})
+
+	t.Run("returns 500 when it fails to watch the operation", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		operationManager := mock.NewMockOperationManager(mockCtrl)
+
+		operationManager.EXPECT().WatchOperation(gomock.Any(), schedulerName, operationID).Return(nil, errors.NewErrUnexpected("some error"))
+
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/schedulers/%s/operations/%s/watch", schedulerName, operationID), nil)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		newWatchMux(t, operationManager).ServeHTTP(rr, req)
+
+		require.Equal(t, 500, rr.Code)
+	})
+
+	t.Run("streams the scheduler operations as server-sent events", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		operationManager := mock.NewMockOperationManager(mockCtrl)
+
+		opChan := make(chan *operation.Operation, 2)
+		opChan <- &operation.Operation{ID: "first-op", SchedulerName: schedulerName, Status: operation.StatusPending, DefinitionName: "add_rooms"}
+		opChan <- &operation.Operation{ID: "second-op", SchedulerName: schedulerName, Status: operation.StatusPending, DefinitionName: "remove_rooms"}
+		close(opChan)
+		operationManager.EXPECT().WatchSchedulerOperations(gomock.Any(), schedulerName).Return(opChan, nil)
+
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/schedulers/%s/operations/watch", schedulerName), nil)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		newWatchMux(t, operationManager).ServeHTTP(rr, req)
+
+		require.Equal(t, 200, rr.Code)
+		require.Equal(t, 2, bytes.Count(rr.Body.Bytes(), []byte("event: operation\n")))
+		require.Contains(t, rr.Body.String(), `"id":"first-op"`)
+		require.Contains(t, rr.Body.String(), `"id":"second-op"`)
+	})
+
+	t.Run("returns 404 when the scheduler does not exist", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		operationManager := mock.NewMockOperationManager(mockCtrl)
+
+		operationManager.EXPECT().WatchSchedulerOperations(gomock.Any(), schedulerName).Return(nil, errors.NewErrNotFound("scheduler not found"))
+
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/schedulers/%s/operations/watch", schedulerName), nil)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		newWatchMux(t, operationManager).ServeHTTP(rr, req)
+
+		require.Equal(t, 404, rr.Code)
+	})
+}
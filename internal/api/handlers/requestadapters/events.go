@@ -0,0 +1,130 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package requestadapters
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/topfreegames/maestro/internal/core/entities/events"
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
+	api "github.com/topfreegames/maestro/pkg/api/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	_struct "google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func FromEventDeliveriesToDeadLetterEventsResponse(entities []*events.EventDelivery) ([]*api.DeadLetterEvent, error) {
+	responses := make([]*api.DeadLetterEvent, len(entities))
+	for i, entity := range entities {
+		response, err := fromEventDeliveryToDeadLetterEventResponse(entity)
+		if err != nil {
+			return nil, err
+		}
+		responses[i] = response
+	}
+
+	return responses, nil
+}
+
+func fromEventDeliveryToDeadLetterEventResponse(entity *events.EventDelivery) (*api.DeadLetterEvent, error) {
+	deadLetterEvent := &api.DeadLetterEvent{
+		Id:            entity.ID,
+		Name:          string(entity.Event.Name),
+		RoomId:        entity.Event.RoomID,
+		ForwarderName: entity.ForwarderName,
+		Attempts:      int32(entity.Attempts),
+		LastError:     entity.LastError,
+	}
+	if len(entity.Event.Attributes) > 0 {
+		// The attributes are converted through json since they may hold
+		// values (e.g. json.Number) not supported by the struct conversion.
+		attributesJSON, err := json.Marshal(entity.Event.Attributes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert event attributes to response struct: %w", err)
+		}
+
+		deadLetterEvent.Attributes = &_struct.Struct{}
+		err = deadLetterEvent.Attributes.UnmarshalJSON(attributesJSON)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert event attributes to response struct: %w", err)
+		}
+	}
+	if !entity.FailedAt.IsZero() {
+		deadLetterEvent.FailedAt = timestamppb.New(entity.FailedAt)
+	}
+
+	return deadLetterEvent, nil
+}
+
+func FromForwardersHealthToResponse(entities []*forwarder.Health) []*api.ForwarderHealth {
+	responses := make([]*api.ForwarderHealth, len(entities))
+	for i, entity := range entities {
+		responses[i] = &api.ForwarderHealth{
+			ForwarderName:       entity.ForwarderName,
+			Address:             entity.Address,
+			State:               string(entity.State),
+			ConsecutiveFailures: int32(entity.ConsecutiveFailures),
+			LastError:           entity.LastError,
+		}
+		if !entity.LastFailureAt.IsZero() {
+			responses[i].LastFailureAt = timestamppb.New(entity.LastFailureAt)
+		}
+		if !entity.UpdatedAt.IsZero() {
+			responses[i].UpdatedAt = timestamppb.New(entity.UpdatedAt)
+		}
+	}
+
+	return responses
+}
+
+func FromApiTestForwardersRequestToEntities(request *api.TestForwardersRequest) []*forwarder.Forwarder {
+	return fromApiForwarders(request.GetForwarders())
+}
+
+func FromForwarderTestResultsToResponse(entities []*forwarder.TestResult) []*api.ForwarderTestResult {
+	responses := make([]*api.ForwarderTestResult, len(entities))
+	for i, entity := range entities {
+		responses[i] = &api.ForwarderTestResult{
+			ForwarderName: entity.ForwarderName,
+			Address:       entity.Address,
+			RoomEvent:     fromEventTestResultToResponse(entity.RoomEvent),
+			PlayerEvent:   fromEventTestResultToResponse(entity.PlayerEvent),
+		}
+	}
+
+	return responses
+}
+
+func fromEventTestResultToResponse(entity *forwarder.EventTestResult) *api.ForwarderEventTestResult {
+	if entity == nil {
+		return nil
+	}
+
+	return &api.ForwarderEventTestResult{
+		Success: entity.Success,
+		Latency: durationpb.New(entity.Latency),
+		Code:    entity.Code,
+		Message: entity.Message,
+	}
+}
@@ -0,0 +1,140 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build unit
+// +build unit
+
+package requestadapters_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/topfreegames/maestro/internal/api/handlers/requestadapters"
+	"github.com/topfreegames/maestro/internal/core/entities/events"
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
+	api "github.com/topfreegames/maestro/pkg/api/v1"
+	_struct "google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestFromEventDeliveriesToDeadLetterEventsResponse(t *testing.T) {
+	failedAt := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("converts the deliveries with their attributes", func(t *testing.T) {
+		deliveries := []*events.EventDelivery{
+			{
+				ID: "1-0",
+				Event: &events.Event{
+					Name:        events.RoomEvent,
+					SchedulerID: "scheduler",
+					RoomID:      "room",
+					Attributes: map[string]interface{}{
+						"eventType": "resync",
+						"occupied":  json.Number("2"),
+					},
+				},
+				ForwarderName: "fwd",
+				Attempts:      5,
+				LastError:     "error",
+				FailedAt:      failedAt,
+			},
+		}
+
+		response, err := requestadapters.FromEventDeliveriesToDeadLetterEventsResponse(deliveries)
+		require.NoError(t, err)
+
+		attributes, err := _struct.NewStruct(map[string]interface{}{"eventType": "resync", "occupied": 2})
+		require.NoError(t, err)
+		require.Len(t, response, 1)
+		require.Equal(t, "1-0", response[0].Id)
+		require.Equal(t, "RoomEvent", response[0].Name)
+		require.Equal(t, "room", response[0].RoomId)
+		require.Equal(t, attributes.AsMap(), response[0].Attributes.AsMap())
+		require.Equal(t, "fwd", response[0].ForwarderName)
+		require.Equal(t, int32(5), response[0].Attempts)
+		require.Equal(t, "error", response[0].LastError)
+		require.Equal(t, timestamppb.New(failedAt).AsTime(), response[0].FailedAt.AsTime())
+	})
+
+	t.Run("leaves the attributes and failed at empty when they are not set", func(t *testing.T) {
+		deliveries := []*events.EventDelivery{{ID: "1-0", Event: &events.Event{Name: events.PlayerEvent}}}
+
+		response, err := requestadapters.FromEventDeliveriesToDeadLetterEventsResponse(deliveries)
+		require.NoError(t, err)
+		require.Equal(t, []*api.DeadLetterEvent{{Id: "1-0", Name: "PlayerEvent"}}, response)
+	})
+}
+
+func TestFromForwardersHealthToResponse(t *testing.T) {
+	failedAt := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	response := requestadapters.FromForwardersHealthToResponse([]*forwarder.Health{
+		{
+			ForwarderName:       "matchmaking",
+			Address:             "matchmaker:8080",
+			State:               forwarder.CircuitHalfOpen,
+			ConsecutiveFailures: 5,
+			LastError:           "error",
+			LastFailureAt:       failedAt,
+			UpdatedAt:           failedAt,
+		},
+		{ForwarderName: "analytics", Address: "http://analytics", State: forwarder.CircuitClosed},
+	})
+
+	require.Equal(t, []*api.ForwarderHealth{
+		{
+			ForwarderName:       "matchmaking",
+			Address:             "matchmaker:8080",
+			State:               "half-open",
+			ConsecutiveFailures: 5,
+			LastError:           "error",
+			LastFailureAt:       timestamppb.New(failedAt),
+			UpdatedAt:           timestamppb.New(failedAt),
+		},
+		{ForwarderName: "analytics", Address: "http://analytics", State: "closed"},
+	}, response)
+}
+
+func TestFromForwarderTestResultsToResponse(t *testing.T) {
+	response := requestadapters.FromForwarderTestResultsToResponse([]*forwarder.TestResult{
+		{
+			ForwarderName: "matchmaking",
+			Address:       "matchmaker:8080",
+			RoomEvent:     &forwarder.EventTestResult{Success: true, Latency: 12 * time.Millisecond, Code: 200, Message: "ok"},
+			PlayerEvent:   &forwarder.EventTestResult{Latency: time.Second, Message: "connection refused"},
+		},
+	})
+
+	require.Equal(t, []*api.ForwarderTestResult{
+		{
+			ForwarderName: "matchmaking",
+			Address:       "matchmaker:8080",
+			RoomEvent:     &api.ForwarderEventTestResult{Success: true, Latency: durationpb.New(12 * time.Millisecond), Code: 200, Message: "ok"},
+			PlayerEvent:   &api.ForwarderEventTestResult{Latency: durationpb.New(time.Second), Message: "connection refused"},
+		},
+	}, response)
+}
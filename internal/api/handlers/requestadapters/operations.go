@@ -29,6 +29,7 @@ import (
 
 	"github.com/topfreegames/maestro/internal/core/entities/operation"
 	api "github.com/topfreegames/maestro/pkg/api/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
 	_struct "google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -60,6 +61,11 @@ func FromOperationToResponse(entity *operation.Operation) (*api.Operation, error
 		return nil, fmt.Errorf("failed to convert operation entity to response: %w", err)
 	}
 
+	apiOperation.Priority, err = entity.Priority.String()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert operation entity to response: %w", err)
+	}
+
 	apiOperation.ExecutionHistory = fromOperationEventsToResponse(entity.ExecutionHistory)
 
 	if len(entity.Input) > 0 {
@@ -79,6 +85,10 @@ func FromOperationToResponse(entity *operation.Operation) (*api.Operation, error
 		apiOperation.Lease = &api.Lease{Ttl: entity.Lease.Ttl.UTC().Format(time.RFC3339)}
 	}
 
+	if entity.Progress != nil {
+		apiOperation.Progress = fromOperationProgressToResponse(entity.Progress)
+	}
+
 	return apiOperation, nil
 }
 
@@ -96,6 +106,11 @@ func fromOperationToListOperationResponse(entity *operation.Operation) (*api.Lis
 		return nil, fmt.Errorf("failed to convert operation entity to response: %w", err)
 	}
 
+	apiOperation.Priority, err = entity.Priority.String()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert operation entity to response: %w", err)
+	}
+
 	if entity.Lease != nil {
 		apiOperation.Lease = &api.Lease{Ttl: entity.Lease.Ttl.UTC().Format(time.RFC3339)}
 	}
@@ -112,6 +127,17 @@ func fromOperationEventsToResponse(entities []operation.OperationEvent) []*api.O
 	return apiOperationEvents
 }
 
+func fromOperationProgressToResponse(entity *operation.OperationProgress) *api.OperationProgress {
+	return &api.OperationProgress{
+		TotalUnits:     int32(entity.TotalUnits),
+		CompletedUnits: int32(entity.CompletedUnits),
+		FailedUnits:    int32(entity.FailedUnits),
+		Eta:            durationpb.New(entity.ETA()),
+		StartedAt:      timestamppb.New(entity.StartedAt),
+		UpdatedAt:      timestamppb.New(entity.UpdatedAt),
+	}
+}
+
 func fromOperationEventToResponse(entity operation.OperationEvent) *api.OperationEvent {
 	return &api.OperationEvent{
 		CreatedAt: timestamppb.New(entity.CreatedAt),
@@ -31,6 +31,7 @@ import (
 	"time"
 
 	"github.com/topfreegames/maestro/internal/core/entities/operation"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/stretchr/testify/assert"
@@ -68,6 +69,7 @@ func TestFromOperationsToListOperationsResponses(t *testing.T) {
 					{
 						ID:             genericString,
 						Status:         operation.StatusPending,
+						Priority:       operation.PriorityHigh,
 						DefinitionName: genericString,
 						SchedulerName:  genericString,
 						Lease: &operation.OperationLease{
@@ -90,6 +92,7 @@ func TestFromOperationsToListOperationsResponses(t *testing.T) {
 					{
 						Id:             genericString,
 						Status:         "pending",
+						Priority:       "high",
 						DefinitionName: genericString,
 						Lease:          &api.Lease{Ttl: genericTime.UTC().Format(time.RFC3339)},
 						SchedulerName:  genericString,
@@ -192,6 +195,7 @@ func TestFromOperationToResponse(t *testing.T) {
 				ApiOperation: &api.Operation{
 					Id:             genericString,
 					Status:         "pending",
+					Priority:       "normal",
 					DefinitionName: genericString,
 					Lease:          &api.Lease{Ttl: genericTime.UTC().Format(time.RFC3339)},
 					SchedulerName:  genericString,
@@ -227,6 +231,7 @@ func TestFromOperationToResponse(t *testing.T) {
 				ApiOperation: &api.Operation{
 					Id:             genericString,
 					Status:         "pending",
+					Priority:       "normal",
 					DefinitionName: genericString,
 					SchedulerName:  genericString,
 					CreatedAt:      timestamppb.New(genericTime),
@@ -239,6 +244,55 @@ func TestFromOperationToResponse(t *testing.T) {
 				},
 			},
 		},
+		{
+			Title: "receives operation with progress, returns converted operation",
+			Input: Input{
+				Operation: &operation.Operation{
+					ID:             genericString,
+					Status:         operation.StatusInProgress,
+					DefinitionName: genericString,
+					SchedulerName:  genericString,
+					CreatedAt:      genericTime,
+					ExecutionHistory: []operation.OperationEvent{
+						{
+							CreatedAt: genericTime,
+							Event:     genericString,
+						},
+					},
+					Progress: &operation.OperationProgress{
+						TotalUnits:     10,
+						CompletedUnits: 4,
+						FailedUnits:    1,
+						StartedAt:      genericTime,
+						UpdatedAt:      genericTime.Add(5 * time.Minute),
+					},
+				},
+			},
+			Output: Output{
+				ApiOperation: &api.Operation{
+					Id:             genericString,
+					Status:         "in_progress",
+					Priority:       "normal",
+					DefinitionName: genericString,
+					SchedulerName:  genericString,
+					CreatedAt:      timestamppb.New(genericTime),
+					ExecutionHistory: []*api.OperationEvent{
+						{
+							CreatedAt: timestamppb.New(genericTime),
+							Event:     genericString,
+						},
+					},
+					Progress: &api.OperationProgress{
+						TotalUnits:     10,
+						CompletedUnits: 4,
+						FailedUnits:    1,
+						Eta:            durationpb.New(5 * time.Minute),
+						StartedAt:      timestamppb.New(genericTime),
+						UpdatedAt:      timestamppb.New(genericTime.Add(5 * time.Minute)),
+					},
+				},
+			},
+		},
 		{
 			Title: "receives invalid operation in list, returns err",
 			Input: Input{
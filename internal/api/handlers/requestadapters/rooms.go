@@ -27,6 +27,7 @@ import (
 
 	"github.com/topfreegames/maestro/internal/core/entities/game_room"
 	api "github.com/topfreegames/maestro/pkg/api/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func FromApiUpdateRoomRequestToEntity(request *api.UpdateRoomWithPingRequest) (*game_room.GameRoom, error) {
@@ -60,3 +61,23 @@ func FromInstanceEntityToGameRoomAddressResponse(instance *game_room.Instance) *
 	}
 	return response
 }
+
+func FromRoomTimelineToResponse(entities []*game_room.TimelineEntry) []*api.RoomTimelineEntry {
+	responses := make([]*api.RoomTimelineEntry, len(entities))
+	for i, entity := range entities {
+		responses[i] = &api.RoomTimelineEntry{
+			Kind:           string(entity.Kind),
+			Timestamp:      timestamppb.New(entity.Timestamp),
+			Source:         string(entity.Source),
+			PreviousStatus: entity.PreviousStatus,
+			Status:         entity.Status,
+			EventName:      entity.EventName,
+			EventType:      entity.EventType,
+			ForwarderName:  entity.ForwarderName,
+			Error:          entity.Error,
+			Reason:         entity.Reason,
+		}
+	}
+
+	return responses
+}
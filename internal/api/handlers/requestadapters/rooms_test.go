@@ -34,6 +34,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/topfreegames/maestro/internal/api/handlers/requestadapters"
 	api "github.com/topfreegames/maestro/pkg/api/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestFromApiUpdateRoomRequestToEntity(t *testing.T) {
@@ -106,3 +107,27 @@ func TestFromApiUpdateRoomRequestToEntity(t *testing.T) {
 		})
 	}
 }
+
+func TestFromRoomTimelineToResponse(t *testing.T) {
+	now := time.Now()
+
+	t.Run("converts each kind of timeline entry", func(t *testing.T) {
+		timeline := []*game_room.TimelineEntry{
+			{Kind: game_room.TimelineStatusChanged, Timestamp: now, Source: game_room.TimelineSourceRuntime, PreviousStatus: "pending", Status: "ready"},
+			{Kind: game_room.TimelineEventForwarded, Timestamp: now, EventName: "RoomEvent", EventType: "resync", ForwarderName: "matchmaking", Error: "unavailable"},
+			{Kind: game_room.TimelineDeleted, Timestamp: now, Reason: "ping_timeout"},
+		}
+
+		result := requestadapters.FromRoomTimelineToResponse(timeline)
+		assert.Equal(t, []*api.RoomTimelineEntry{
+			{Kind: "statusChanged", Timestamp: timestamppb.New(now), Source: "runtime", PreviousStatus: "pending", Status: "ready"},
+			{Kind: "eventForwarded", Timestamp: timestamppb.New(now), EventName: "RoomEvent", EventType: "resync", ForwarderName: "matchmaking", Error: "unavailable"},
+			{Kind: "deleted", Timestamp: timestamppb.New(now), Reason: "ping_timeout"},
+		}, result)
+	})
+
+	t.Run("returns an empty list when there are no entries", func(t *testing.T) {
+		result := requestadapters.FromRoomTimelineToResponse([]*game_room.TimelineEntry{})
+		assert.Empty(t, result)
+	})
+}
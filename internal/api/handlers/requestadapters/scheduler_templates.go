@@ -0,0 +1,163 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package requestadapters
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/topfreegames/maestro/internal/core/entities"
+	api "github.com/topfreegames/maestro/pkg/api/v1"
+)
+
+// schedulerTemplateRequest has the fields shared by the create and update
+// scheduler template requests.
+type schedulerTemplateRequest interface {
+	GetName() string
+	GetSpec() *api.Spec
+	GetPortRange() *api.PortRange
+	GetMaxSurge() string
+	GetMaxUnavailable() string
+	GetRoomsReplicas() int32
+	GetAutoscaling() *api.Autoscaling
+	GetForwarders() []*api.Forwarder
+	GetAnnotations() map[string]string
+	GetLabels() map[string]string
+	GetRolloutStrategy() *api.RolloutStrategy
+}
+
+func FromApiCreateSchedulerTemplateRequestToEntity(request *api.CreateSchedulerTemplateRequest) (*entities.SchedulerTemplate, error) {
+	return fromApiSchedulerTemplateRequest(request)
+}
+
+func FromApiUpdateSchedulerTemplateRequestToEntity(request *api.UpdateSchedulerTemplateRequest) (*entities.SchedulerTemplate, error) {
+	return fromApiSchedulerTemplateRequest(request)
+}
+
+func FromEntitySchedulerTemplateToResponse(entity *entities.SchedulerTemplate) (*api.SchedulerTemplate, error) {
+	forwarders, err := fromEntityForwardersToResponse(entity.Forwarders)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.SchedulerTemplate{
+		Name:            entity.Name,
+		PortRange:       getPortRange(entity.PortRange),
+		CreatedAt:       timestamppb.New(entity.CreatedAt),
+		UpdatedAt:       timestamppb.New(entity.UpdatedAt),
+		MaxSurge:        entity.MaxSurge,
+		MaxUnavailable:  entity.MaxUnavailable,
+		RoomsReplicas:   int32(entity.RoomsReplicas),
+		Spec:            getSpec(entity.Spec),
+		Autoscaling:     getAutoscaling(entity.Autoscaling),
+		Forwarders:      forwarders,
+		Annotations:     entity.Annotations,
+		Labels:          entity.Labels,
+		RolloutStrategy: getRolloutStrategy(entity.RolloutStrategy),
+	}, nil
+}
+
+func FromApiTemplateContainerOverridesToEntity(apiOverrides []*api.TemplateContainerOverride) []*entities.TemplateContainerOverride {
+	if len(apiOverrides) == 0 {
+		return nil
+	}
+
+	overrides := make([]*entities.TemplateContainerOverride, len(apiOverrides))
+	for i, apiOverride := range apiOverrides {
+		overrides[i] = &entities.TemplateContainerOverride{
+			Name:        apiOverride.GetName(),
+			Image:       apiOverride.GetImage(),
+			Environment: fromApiContainerEnvironments(apiOverride.GetEnvironment()),
+		}
+	}
+	return overrides
+}
+
+func FromEntityDerivedSchedulersToResponse(entities []*entities.DerivedScheduler) []*api.DerivedScheduler {
+	derivedSchedulers := make([]*api.DerivedScheduler, len(entities))
+	for i, derivedScheduler := range entities {
+		derivedSchedulers[i] = &api.DerivedScheduler{
+			SchedulerName: derivedScheduler.SchedulerName,
+			Outdated:      derivedScheduler.Outdated,
+			Changes:       fromEntitySchedulerFieldChangesToResponse(derivedScheduler.Diff.Changes),
+		}
+	}
+	return derivedSchedulers
+}
+
+func FromEntitySchedulerTemplatePropagationsToResponse(entities []*entities.SchedulerTemplatePropagation) []*api.SchedulerTemplatePropagation {
+	propagations := make([]*api.SchedulerTemplatePropagation, len(entities))
+	for i, propagation := range entities {
+		propagations[i] = &api.SchedulerTemplatePropagation{
+			SchedulerName: propagation.SchedulerName,
+			OperationId:   propagation.OperationID,
+			Error:         propagation.Error,
+		}
+	}
+	return propagations
+}
+
+func fromApiSchedulerTemplateRequest(request schedulerTemplateRequest) (*entities.SchedulerTemplate, error) {
+	templateAutoscaling, err := fromApiAutoscaling(request.GetAutoscaling())
+	if err != nil {
+		return nil, err
+	}
+
+	rolloutStrategy, err := fromApiRolloutStrategy(request.GetRolloutStrategy())
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.SchedulerTemplate{
+		Name:            request.GetName(),
+		Spec:            *fromApiSpec(request.GetSpec()),
+		PortRange:       fromApiPortRange(request.GetPortRange()),
+		MaxSurge:        request.GetMaxSurge(),
+		MaxUnavailable:  request.GetMaxUnavailable(),
+		RoomsReplicas:   int(request.GetRoomsReplicas()),
+		Autoscaling:     templateAutoscaling,
+		Forwarders:      fromApiForwarders(request.GetForwarders()),
+		Annotations:     request.GetAnnotations(),
+		Labels:          request.GetLabels(),
+		RolloutStrategy: rolloutStrategy,
+	}, nil
+}
+
+func getSchedulerTemplateRef(ref *entities.SchedulerTemplateRef) *api.SchedulerTemplateRef {
+	if ref == nil {
+		return nil
+	}
+
+	containers := make([]*api.TemplateContainerOverride, len(ref.Containers))
+	for i, override := range ref.Containers {
+		containers[i] = &api.TemplateContainerOverride{
+			Name:        override.Name,
+			Image:       override.Image,
+			Environment: fromEntityContainerEnvironmentToApiContainerEnvironment(override.Environment),
+		}
+	}
+
+	return &api.SchedulerTemplateRef{
+		Name:       ref.Name,
+		Containers: containers,
+	}
+}
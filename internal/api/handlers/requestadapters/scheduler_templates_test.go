@@ -0,0 +1,147 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build unit
+// +build unit
+
+package requestadapters_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/topfreegames/maestro/internal/api/handlers/requestadapters"
+	"github.com/topfreegames/maestro/internal/core/entities"
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
+	"github.com/topfreegames/maestro/internal/core/entities/game_room"
+	"github.com/topfreegames/maestro/internal/core/entities/port"
+	api "github.com/topfreegames/maestro/pkg/api/v1"
+)
+
+func TestFromApiCreateSchedulerTemplateRequestToEntity(t *testing.T) {
+	t.Run("converts the request to a template", func(t *testing.T) {
+		request := &api.CreateSchedulerTemplateRequest{
+			Name: "template",
+			Spec: &api.Spec{
+				TerminationGracePeriod: durationpb.New(time.Duration(10)),
+				Containers: []*api.Container{
+					{Name: "default", Image: "image:v1", ImagePullPolicy: "IfNotPresent"},
+				},
+			},
+			PortRange:     &api.PortRange{Start: 10000, End: 10100},
+			MaxSurge:      "10%",
+			RoomsReplicas: 2,
+			Annotations:   map[string]string{"key": "value"},
+		}
+
+		template, err := requestadapters.FromApiCreateSchedulerTemplateRequestToEntity(request)
+		assert.NoError(t, err)
+		assert.Equal(t, &entities.SchedulerTemplate{
+			Name: "template",
+			Spec: game_room.Spec{
+				Version:                "v1.0.0",
+				TerminationGracePeriod: time.Duration(10),
+				Containers: []game_room.Container{
+					{Name: "default", Image: "image:v1", ImagePullPolicy: "IfNotPresent"},
+				},
+			},
+			PortRange:     &port.PortRange{Start: 10000, End: 10100},
+			MaxSurge:      "10%",
+			RoomsReplicas: 2,
+			Forwarders:    []*forwarder.Forwarder{},
+			Annotations:   map[string]string{"key": "value"},
+		}, template)
+	})
+}
+
+func TestFromEntitySchedulerTemplateToResponse(t *testing.T) {
+	t.Run("converts the template to the response", func(t *testing.T) {
+		createdAt := time.Now()
+		template := &entities.SchedulerTemplate{
+			Name: "template",
+			Spec: game_room.Spec{
+				Version:                "v1.0.0",
+				TerminationGracePeriod: time.Duration(10),
+			},
+			PortRange: &port.PortRange{Start: 10000, End: 10100},
+			MaxSurge:  "10%",
+			CreatedAt: createdAt,
+		}
+
+		response, err := requestadapters.FromEntitySchedulerTemplateToResponse(template)
+		assert.NoError(t, err)
+		assert.Equal(t, "template", response.Name)
+		assert.Equal(t, "10%", response.MaxSurge)
+		assert.Equal(t, &api.PortRange{Start: 10000, End: 10100}, response.PortRange)
+		assert.Equal(t, timestamppb.New(createdAt), response.CreatedAt)
+		assert.Equal(t, "v1.0.0", response.Spec.Version)
+	})
+}
+
+func TestFromApiTemplateContainerOverridesToEntity(t *testing.T) {
+	t.Run("returns nil when there are no overrides", func(t *testing.T) {
+		assert.Nil(t, requestadapters.FromApiTemplateContainerOverridesToEntity(nil))
+	})
+
+	t.Run("converts the overrides", func(t *testing.T) {
+		value := "eu"
+		overrides := requestadapters.FromApiTemplateContainerOverridesToEntity([]*api.TemplateContainerOverride{
+			{
+				Name:        "default",
+				Image:       "image:v2",
+				Environment: []*api.ContainerEnvironment{{Name: "REGION", Value: &value}},
+			},
+		})
+
+		assert.Equal(t, []*entities.TemplateContainerOverride{
+			{
+				Name:        "default",
+				Image:       "image:v2",
+				Environment: []game_room.ContainerEnvironment{{Name: "REGION", Value: "eu"}},
+			},
+		}, overrides)
+	})
+}
+
+func TestFromEntityDerivedSchedulersToResponse(t *testing.T) {
+	derivedSchedulers := []*entities.DerivedScheduler{
+		{
+			SchedulerName: "scheduler",
+			Outdated:      true,
+			Diff: &entities.SchedulerDiff{
+				Changes: []*entities.SchedulerFieldChange{{Path: "RoomsReplicas", OldValue: "1", NewValue: "2"}},
+			},
+		},
+	}
+
+	assert.Equal(t, []*api.DerivedScheduler{
+		{
+			SchedulerName: "scheduler",
+			Outdated:      true,
+			Changes:       []*api.SchedulerFieldChange{{Path: "RoomsReplicas", OldValue: "1", NewValue: "2"}},
+		},
+	}, requestadapters.FromEntityDerivedSchedulersToResponse(derivedSchedulers))
+}
@@ -30,6 +30,7 @@ import (
 
 	"github.com/topfreegames/maestro/internal/core/entities/autoscaling"
 	"github.com/topfreegames/maestro/internal/core/entities/port"
+	"github.com/topfreegames/maestro/internal/core/entities/rollout"
 
 	"google.golang.org/protobuf/types/known/durationpb"
 	_struct "google.golang.org/protobuf/types/known/structpb"
@@ -59,6 +60,10 @@ func FromApiPatchSchedulerRequestToChangeMap(request *api.PatchSchedulerRequest)
 		patchMap[patch.LabelSchedulerMaxSurge] = request.GetMaxSurge()
 	}
 
+	if request.MaxUnavailable != nil {
+		patchMap[patch.LabelSchedulerMaxUnavailable] = request.GetMaxUnavailable()
+	}
+
 	if request.RoomsReplicas != nil {
 		patchMap[patch.LabelSchedulerRoomsReplicas] = int(request.GetRoomsReplicas())
 	}
@@ -80,6 +85,10 @@ func FromApiPatchSchedulerRequestToChangeMap(request *api.PatchSchedulerRequest)
 		patchMap[patch.LabelLabels] = request.GetLabels()
 	}
 
+	if request.RolloutStrategy != nil {
+		patchMap[patch.LabelRolloutStrategy] = fromApiRolloutStrategyToEntity(request.GetRolloutStrategy())
+	}
+
 	return patchMap
 }
 
@@ -116,11 +125,17 @@ func FromApiCreateSchedulerRequestToEntity(request *api.CreateSchedulerRequest)
 		return nil, err
 	}
 
-	return entities.NewScheduler(
+	rolloutStrategy, err := fromApiRolloutStrategy(request.GetRolloutStrategy())
+	if err != nil {
+		return nil, err
+	}
+
+	scheduler, err := entities.NewScheduler(
 		request.GetName(),
 		request.GetGame(),
 		entities.StateCreating,
 		request.GetMaxSurge(),
+		request.GetMaxUnavailable(),
 		*fromApiSpec(request.GetSpec()),
 		fromApiPortRange(request.PortRange),
 		int(request.GetRoomsReplicas()),
@@ -129,18 +144,57 @@ func FromApiCreateSchedulerRequestToEntity(request *api.CreateSchedulerRequest)
 		request.GetAnnotations(),
 		request.GetLabels(),
 	)
+	scheduler.RolloutStrategy = rolloutStrategy
+	return scheduler, err
+}
+
+// FromApiApplySchedulersRequestToEntities converts every scheduler declared on
+// the apply request, the schedulers without a game belong to the request game.
+func FromApiApplySchedulersRequestToEntities(request *api.ApplySchedulersRequest) ([]*entities.Scheduler, error) {
+	schedulers := make([]*entities.Scheduler, len(request.GetSchedulers()))
+	for i, schedulerRequest := range request.GetSchedulers() {
+		if schedulerRequest.GetGame() == "" {
+			schedulerRequest.Game = request.GetGame()
+		}
+
+		scheduler, err := FromApiCreateSchedulerRequestToEntity(schedulerRequest)
+		if err != nil {
+			return nil, fmt.Errorf("invalid scheduler %s: %w", schedulerRequest.GetName(), err)
+		}
+		schedulers[i] = scheduler
+	}
+	return schedulers, nil
+}
+
+func FromEntitySchedulerApplyStepsToResponse(entities []*entities.SchedulerApplyStep) []*api.SchedulerApplyStep {
+	steps := make([]*api.SchedulerApplyStep, len(entities))
+	for i, step := range entities {
+		steps[i] = &api.SchedulerApplyStep{
+			SchedulerName: step.SchedulerName,
+			Action:        string(step.Action),
+			OperationId:   step.OperationID,
+			Error:         step.Error,
+			Changes:       []*api.SchedulerFieldChange{},
+		}
+		if step.Diff != nil {
+			steps[i].IsMajor = step.Diff.IsMajor
+			steps[i].Changes = fromEntitySchedulerFieldChangesToResponse(step.Diff.Changes)
+		}
+	}
+	return steps
 }
 
 func FromEntitySchedulerToListResponse(entity *entities.Scheduler) *api.SchedulerWithoutSpec {
 	return &api.SchedulerWithoutSpec{
-		Name:          entity.Name,
-		Game:          entity.Game,
-		State:         entity.State,
-		Version:       entity.Spec.Version,
-		PortRange:     getPortRange(entity.PortRange),
-		CreatedAt:     timestamppb.New(entity.CreatedAt),
-		MaxSurge:      entity.MaxSurge,
-		RoomsReplicas: int32(entity.RoomsReplicas),
+		Name:           entity.Name,
+		Game:           entity.Game,
+		State:          entity.State,
+		Version:        entity.Spec.Version,
+		PortRange:      getPortRange(entity.PortRange),
+		CreatedAt:      timestamppb.New(entity.CreatedAt),
+		MaxSurge:       entity.MaxSurge,
+		MaxUnavailable: entity.MaxUnavailable,
+		RoomsReplicas:  int32(entity.RoomsReplicas),
 	}
 }
 
@@ -150,11 +204,17 @@ func FromApiNewSchedulerVersionRequestToEntity(request *api.NewSchedulerVersionR
 		return nil, err
 	}
 
-	return entities.NewScheduler(
+	rolloutStrategy, err := fromApiRolloutStrategy(request.GetRolloutStrategy())
+	if err != nil {
+		return nil, err
+	}
+
+	scheduler, err := entities.NewScheduler(
 		request.GetName(),
 		request.GetGame(),
 		entities.StateCreating,
 		request.GetMaxSurge(),
+		request.GetMaxUnavailable(),
 		*fromApiSpec(request.GetSpec()),
 		port.NewPortRange(
 			request.GetPortRange().GetStart(),
@@ -166,6 +226,8 @@ func FromApiNewSchedulerVersionRequestToEntity(request *api.NewSchedulerVersionR
 		request.GetAnnotations(),
 		request.GetLabels(),
 	)
+	scheduler.RolloutStrategy = rolloutStrategy
+	return scheduler, err
 }
 
 func FromEntitySchedulerToResponse(entity *entities.Scheduler) (*api.Scheduler, error) {
@@ -176,35 +238,72 @@ func FromEntitySchedulerToResponse(entity *entities.Scheduler) (*api.Scheduler,
 	}
 
 	return &api.Scheduler{
-		Name:          entity.Name,
-		Game:          entity.Game,
-		State:         entity.State,
-		PortRange:     getPortRange(entity.PortRange),
-		CreatedAt:     timestamppb.New(entity.CreatedAt),
-		MaxSurge:      entity.MaxSurge,
-		RoomsReplicas: int32(entity.RoomsReplicas),
-		Spec:          getSpec(entity.Spec),
-		Autoscaling:   getAutoscaling(entity.Autoscaling),
-		Forwarders:    forwarders,
-		Annotations:   entity.Annotations,
-		Labels:        entity.Labels,
+		Name:            entity.Name,
+		Game:            entity.Game,
+		State:           entity.State,
+		PortRange:       getPortRange(entity.PortRange),
+		CreatedAt:       timestamppb.New(entity.CreatedAt),
+		MaxSurge:        entity.MaxSurge,
+		MaxUnavailable:  entity.MaxUnavailable,
+		RoomsReplicas:   int32(entity.RoomsReplicas),
+		Spec:            getSpec(entity.Spec),
+		Autoscaling:     getAutoscaling(entity.Autoscaling),
+		Forwarders:      forwarders,
+		Annotations:     entity.Annotations,
+		Labels:          entity.Labels,
+		RolloutStrategy: getRolloutStrategy(entity.RolloutStrategy),
+		Template:        getSchedulerTemplateRef(entity.Template),
 	}, nil
 }
 
 func FromEntitySchedulerVersionListToResponse(entity []*entities.SchedulerVersion) []*api.SchedulerVersion {
 	versions := make([]*api.SchedulerVersion, len(entity))
 	for i, version := range entity {
-		versions[i] = &api.SchedulerVersion{
-			Version:   version.Version,
-			IsActive:  version.IsActive,
-			CreatedAt: timestamppb.New(version.CreatedAt),
-		}
+		versions[i] = FromEntitySchedulerVersionToResponse(version)
 	}
 	return versions
 }
 
+func FromEntitySchedulerVersionToResponse(entity *entities.SchedulerVersion) *api.SchedulerVersion {
+	return &api.SchedulerVersion{
+		Version:   entity.Version,
+		IsActive:  entity.IsActive,
+		CreatedAt: timestamppb.New(entity.CreatedAt),
+		Pinned:    entity.Pinned,
+		Blocked:   entity.Blocked,
+	}
+}
+
+func FromEntitySchedulerDiffToResponse(entity *entities.SchedulerDiff) *api.GetSchedulerVersionsDiffResponse {
+	return &api.GetSchedulerVersionsDiffResponse{
+		FromVersion: entity.FromVersion,
+		ToVersion:   entity.ToVersion,
+		IsMajor:     entity.IsMajor,
+		Changes:     fromEntitySchedulerFieldChangesToResponse(entity.Changes),
+	}
+}
+
+func FromEntitySchedulerDiffToDryRunResponse(entity *entities.SchedulerDiff) *api.SchedulerDryRunResult {
+	return &api.SchedulerDryRunResult{
+		IsMajor: entity.IsMajor,
+		Changes: fromEntitySchedulerFieldChangesToResponse(entity.Changes),
+	}
+}
+
+func fromEntitySchedulerFieldChangesToResponse(entities []*entities.SchedulerFieldChange) []*api.SchedulerFieldChange {
+	changes := make([]*api.SchedulerFieldChange, len(entities))
+	for i, change := range entities {
+		changes[i] = &api.SchedulerFieldChange{
+			Path:     change.Path,
+			OldValue: change.OldValue,
+			NewValue: change.NewValue,
+		}
+	}
+	return changes
+}
+
 func FromEntitySchedulerInfoToListResponse(entity *entities.SchedulerInfo) *api.SchedulerInfo {
-	return &api.SchedulerInfo{
+	schedulerInfo := &api.SchedulerInfo{
 		Name:             entity.Name,
 		Game:             entity.Game,
 		State:            entity.State,
@@ -215,6 +314,14 @@ func FromEntitySchedulerInfoToListResponse(entity *entities.SchedulerInfo) *api.
 		RoomsTerminating: int32(entity.RoomsTerminating),
 		Autoscaling:      fromEntityAutoscalingInfoToApiResponse(entity.Autoscaling),
 	}
+
+	if entity.OperationsPause != nil {
+		schedulerInfo.OperationsPaused = true
+		schedulerInfo.OperationsPauseReason = &entity.OperationsPause.Reason
+		schedulerInfo.OperationsPausedAt = timestamppb.New(entity.OperationsPause.PausedAt)
+	}
+
+	return schedulerInfo
 }
 
 func fromEntityAutoscalingInfoToApiResponse(entity *entities.AutoscalingInfo) *api.AutoscalingInfo {
@@ -329,11 +436,99 @@ func fromEntityForwardOptions(entity *forwarder.ForwardOptions) (*api.ForwarderO
 	}
 
 	return &api.ForwarderOptions{
-		Timeout:  int64(entity.Timeout),
-		Metadata: protoStruct,
+		Timeout:     int64(entity.Timeout),
+		Metadata:    protoStruct,
+		Grpc:        fromEntityGRPCForwarderOptions(entity.GRPC),
+		Http:        fromEntityHTTPForwarderOptions(entity.HTTP),
+		Broker:      fromEntityBrokerForwarderOptions(entity.Broker),
+		Filter:      fromEntityForwarderFilterOptions(entity.Filter),
+		Transform:   fromEntityForwarderTransformOptions(entity.Transform),
+		BestEffort:  fromEntityForwarderBestEffort(entity.BestEffort),
+		CloudEvents: fromEntityForwarderCloudEventsOptions(entity.CloudEvents),
 	}, nil
 }
 
+func fromEntityForwarderFilterOptions(entity *forwarder.FilterOptions) *api.ForwarderFilterOptions {
+	if entity == nil {
+		return nil
+	}
+	return &api.ForwarderFilterOptions{
+		EventNames: entity.EventNames,
+		EventTypes: entity.EventTypes,
+	}
+}
+
+func fromEntityForwarderTransformOptions(entity *forwarder.TransformOptions) *api.ForwarderTransformOptions {
+	if entity == nil {
+		return nil
+	}
+	return &api.ForwarderTransformOptions{
+		IncludeMetadata: entity.IncludeMetadata,
+		DropMetadata:    entity.DropMetadata,
+		Attributes:      entity.Attributes,
+	}
+}
+
+func fromEntityBrokerForwarderOptions(entity *forwarder.BrokerOptions) *api.BrokerForwarderOptions {
+	if entity == nil {
+		return nil
+	}
+	return &api.BrokerForwarderOptions{
+		Key: string(entity.Key),
+	}
+}
+
+func fromEntityForwarderCloudEventsOptions(entity *forwarder.CloudEventsOptions) *api.ForwarderCloudEventsOptions {
+	if entity == nil {
+		return nil
+	}
+	return &api.ForwarderCloudEventsOptions{
+		Mode: string(entity.Mode),
+	}
+}
+
+// fromEntityForwarderBestEffort only sets the flag of best-effort
+// forwarders, so it is left out of the required forwarders responses.
+func fromEntityForwarderBestEffort(bestEffort bool) *bool {
+	if !bestEffort {
+		return nil
+	}
+	return &bestEffort
+}
+
+func fromEntityGRPCForwarderOptions(entity *forwarder.GRPCOptions) *api.GRPCForwarderOptions {
+	if entity == nil {
+		return nil
+	}
+	options := &api.GRPCForwarderOptions{}
+	if entity.TLS != nil {
+		options.Tls = &api.ForwarderTLSOptions{
+			CaSecretRef:   entity.TLS.CASecretRef,
+			CertSecretRef: entity.TLS.CertSecretRef,
+			KeySecretRef:  entity.TLS.KeySecretRef,
+			ServerName:    entity.TLS.ServerName,
+		}
+	}
+	if entity.Auth != nil {
+		options.Auth = &api.ForwarderAuthOptions{
+			Type:      string(entity.Auth.Type),
+			SecretRef: entity.Auth.SecretRef,
+			Header:    entity.Auth.Header,
+		}
+	}
+	return options
+}
+
+func fromEntityHTTPForwarderOptions(entity *forwarder.HTTPOptions) *api.HTTPForwarderOptions {
+	if entity == nil {
+		return nil
+	}
+	return &api.HTTPForwarderOptions{
+		Headers:          entity.Headers,
+		SigningSecretRef: entity.SigningSecretRef,
+	}
+}
+
 func fromApiSpec(apiSpec *api.Spec) *game_room.Spec {
 	return game_room.NewSpec(
 		"",
@@ -403,6 +598,55 @@ func fromApiAutoscaling(apiAutoscaling *api.Autoscaling) (*autoscaling.Autoscali
 	return nil, nil
 }
 
+func fromApiRolloutStrategyToEntity(apiRolloutStrategy *api.RolloutStrategy) *rollout.Strategy {
+	strategy := &rollout.Strategy{
+		Type: rollout.StrategyType(apiRolloutStrategy.GetType()),
+	}
+	if apiCanary := apiRolloutStrategy.GetCanary(); apiCanary != nil {
+		strategy.Canary = &rollout.CanaryParams{
+			Percentage:       int(apiCanary.GetPercentage()),
+			Rooms:            int(apiCanary.GetRooms()),
+			BakeDuration:     apiCanary.GetBakeDuration().AsDuration(),
+			FailureThreshold: apiCanary.GetFailureThreshold(),
+		}
+	}
+	if apiAutoRollback := apiRolloutStrategy.GetAutoRollback(); apiAutoRollback != nil {
+		strategy.AutoRollback = &rollout.AutoRollbackParams{
+			FailureThreshold: apiAutoRollback.GetFailureThreshold(),
+			MinRooms:         int(apiAutoRollback.GetMinRooms()),
+		}
+	}
+	if apiValidation := apiRolloutStrategy.GetValidation(); apiValidation != nil {
+		strategy.Validation = &rollout.ValidationParams{
+			Rooms:            int(apiValidation.GetRooms()),
+			MinReadyDuration: apiValidation.GetMinReadyDuration().AsDuration(),
+		}
+		if apiHealthCheck := apiValidation.GetHealthCheck(); apiHealthCheck != nil {
+			strategy.Validation.HealthCheck = &rollout.HealthCheckParams{
+				Protocol: rollout.HealthCheckProtocol(apiHealthCheck.GetProtocol()),
+				PortName: apiHealthCheck.GetPortName(),
+				Path:     apiHealthCheck.GetPath(),
+				Timeout:  apiHealthCheck.GetTimeout().AsDuration(),
+			}
+		}
+		if apiSmokeTest := apiValidation.GetSmokeTest(); apiSmokeTest != nil {
+			strategy.Validation.SmokeTest = &rollout.SmokeTestParams{
+				ForwarderName: apiSmokeTest.GetForwarderName(),
+				EventName:     apiSmokeTest.GetEventName(),
+			}
+		}
+	}
+	return strategy
+}
+
+func fromApiRolloutStrategy(apiRolloutStrategy *api.RolloutStrategy) (*rollout.Strategy, error) {
+	if apiRolloutStrategy != nil {
+		strategy := fromApiRolloutStrategyToEntity(apiRolloutStrategy)
+		return rollout.NewStrategy(strategy.Type, strategy.Canary, strategy.AutoRollback, strategy.Validation)
+	}
+	return nil, nil
+}
+
 func fromApiContainers(apiContainers []*api.Container) []game_room.Container {
 	var containers []game_room.Container
 	for _, apiContainer := range apiContainers {
@@ -479,8 +723,56 @@ func fromApiForwarders(apiForwarders []*api.Forwarder) []*forwarder.Forwarder {
 		var options *forwarder.ForwardOptions
 		if apiForwarder.GetOptions() != nil {
 			options = &forwarder.ForwardOptions{
-				Timeout:  time.Duration(apiForwarder.Options.GetTimeout()),
-				Metadata: apiForwarder.Options.Metadata.AsMap(),
+				Timeout:    time.Duration(apiForwarder.Options.GetTimeout()),
+				Metadata:   apiForwarder.Options.Metadata.AsMap(),
+				BestEffort: apiForwarder.Options.GetBestEffort(),
+			}
+			if grpcOptions := apiForwarder.Options.GetGrpc(); grpcOptions != nil {
+				options.GRPC = &forwarder.GRPCOptions{}
+				if tlsOptions := grpcOptions.GetTls(); tlsOptions != nil {
+					options.GRPC.TLS = &forwarder.TLSOptions{
+						CASecretRef:   tlsOptions.GetCaSecretRef(),
+						CertSecretRef: tlsOptions.GetCertSecretRef(),
+						KeySecretRef:  tlsOptions.GetKeySecretRef(),
+						ServerName:    tlsOptions.GetServerName(),
+					}
+				}
+				if authOptions := grpcOptions.GetAuth(); authOptions != nil {
+					options.GRPC.Auth = &forwarder.AuthOptions{
+						Type:      forwarder.AuthType(authOptions.GetType()),
+						SecretRef: authOptions.GetSecretRef(),
+						Header:    authOptions.GetHeader(),
+					}
+				}
+			}
+			if httpOptions := apiForwarder.Options.GetHttp(); httpOptions != nil {
+				options.HTTP = &forwarder.HTTPOptions{
+					Headers:          httpOptions.GetHeaders(),
+					SigningSecretRef: httpOptions.GetSigningSecretRef(),
+				}
+			}
+			if brokerOptions := apiForwarder.Options.GetBroker(); brokerOptions != nil {
+				options.Broker = &forwarder.BrokerOptions{
+					Key: forwarder.BrokerKey(brokerOptions.GetKey()),
+				}
+			}
+			if filterOptions := apiForwarder.Options.GetFilter(); filterOptions != nil {
+				options.Filter = &forwarder.FilterOptions{
+					EventNames: filterOptions.GetEventNames(),
+					EventTypes: filterOptions.GetEventTypes(),
+				}
+			}
+			if transformOptions := apiForwarder.Options.GetTransform(); transformOptions != nil {
+				options.Transform = &forwarder.TransformOptions{
+					IncludeMetadata: transformOptions.GetIncludeMetadata(),
+					DropMetadata:    transformOptions.GetDropMetadata(),
+					Attributes:      transformOptions.GetAttributes(),
+				}
+			}
+			if cloudEventsOptions := apiForwarder.Options.GetCloudEvents(); cloudEventsOptions != nil {
+				options.CloudEvents = &forwarder.CloudEventsOptions{
+					Mode: forwarder.CloudEventsMode(cloudEventsOptions.GetMode()),
+				}
 			}
 		}
 
@@ -534,6 +826,49 @@ func getAutoscaling(autoscaling *autoscaling.Autoscaling) *api.Autoscaling {
 	return nil
 }
 
+func getRolloutStrategy(strategy *rollout.Strategy) *api.RolloutStrategy {
+	if strategy == nil {
+		return nil
+	}
+
+	apiStrategy := &api.RolloutStrategy{Type: string(strategy.Type)}
+	if strategy.Canary != nil {
+		apiStrategy.Canary = &api.CanaryRollout{
+			Percentage:       int32(strategy.Canary.Percentage),
+			Rooms:            int32(strategy.Canary.Rooms),
+			BakeDuration:     durationpb.New(strategy.Canary.BakeDuration),
+			FailureThreshold: strategy.Canary.FailureThreshold,
+		}
+	}
+	if strategy.AutoRollback != nil {
+		apiStrategy.AutoRollback = &api.AutoRollback{
+			FailureThreshold: strategy.AutoRollback.FailureThreshold,
+			MinRooms:         int32(strategy.AutoRollback.MinRooms),
+		}
+	}
+	if strategy.Validation != nil {
+		apiStrategy.Validation = &api.RolloutValidation{
+			Rooms:            int32(strategy.Validation.Rooms),
+			MinReadyDuration: durationpb.New(strategy.Validation.MinReadyDuration),
+		}
+		if strategy.Validation.HealthCheck != nil {
+			apiStrategy.Validation.HealthCheck = &api.RolloutHealthCheck{
+				Protocol: string(strategy.Validation.HealthCheck.Protocol),
+				PortName: strategy.Validation.HealthCheck.PortName,
+				Path:     strategy.Validation.HealthCheck.Path,
+				Timeout:  durationpb.New(strategy.Validation.HealthCheck.Timeout),
+			}
+		}
+		if strategy.Validation.SmokeTest != nil {
+			apiStrategy.Validation.SmokeTest = &api.RolloutSmokeTest{
+				ForwarderName: strategy.Validation.SmokeTest.ForwarderName,
+				EventName:     strategy.Validation.SmokeTest.EventName,
+			}
+		}
+	}
+	return apiStrategy
+}
+
 func getAutoscalingPolicy(autoscalingPolicy autoscaling.Policy) *api.AutoscalingPolicy {
 	return &api.AutoscalingPolicy{
 		Type:       string(autoscalingPolicy.Type),
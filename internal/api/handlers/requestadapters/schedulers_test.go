@@ -32,6 +32,7 @@ import (
 	"github.com/topfreegames/maestro/internal/core/services/schedulers/patch"
 
 	"github.com/topfreegames/maestro/internal/core/entities/autoscaling"
+	"github.com/topfreegames/maestro/internal/core/entities/operation"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	structpb "github.com/golang/protobuf/ptypes/struct"
@@ -39,6 +40,7 @@ import (
 	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
 	"github.com/topfreegames/maestro/internal/core/entities/game_room"
 	"github.com/topfreegames/maestro/internal/core/entities/port"
+	"github.com/topfreegames/maestro/internal/core/entities/rollout"
 	"github.com/topfreegames/maestro/internal/validations"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -64,6 +66,7 @@ func TestFromApiPatchSchedulerRequestToChangeMap(t *testing.T) {
 
 	terminationValue := durationpb.New(time.Duration(62))
 	maxSurgeValue := "60%"
+	maxUnavailableValue := "10%"
 	roomsReplicasValue := int32(2)
 	genericString := "some-value"
 	genericStringList := []string{"some-value", "another-value"}
@@ -122,6 +125,19 @@ func TestFromApiPatchSchedulerRequestToChangeMap(t *testing.T) {
 				},
 			},
 		},
+		{
+			Title: "only max unavailable should convert api.PatchSchedulerRequest to change map",
+			Input: Input{
+				PatchScheduler: &api.PatchSchedulerRequest{
+					MaxUnavailable: &maxUnavailableValue,
+				},
+			},
+			Output: Output{
+				PatchScheduler: map[string]interface{}{
+					patch.LabelSchedulerMaxUnavailable: maxUnavailableValue,
+				},
+			},
+		},
 		{
 			Title: "only rooms replicas should convert api.PatchSchedulerRequest to change map",
 			Input: Input{
@@ -423,6 +439,33 @@ func TestFromApiPatchSchedulerRequestToChangeMap(t *testing.T) {
 				},
 			},
 		},
+		{
+			Title: "only rollout strategy should convert api.PatchSchedulerRequest to change map",
+			Input: Input{
+				PatchScheduler: &api.PatchSchedulerRequest{
+					RolloutStrategy: &api.RolloutStrategy{
+						Type: "canary",
+						Canary: &api.CanaryRollout{
+							Rooms:            2,
+							BakeDuration:     durationpb.New(time.Minute),
+							FailureThreshold: 0.1,
+						},
+					},
+				},
+			},
+			Output: Output{
+				PatchScheduler: map[string]interface{}{
+					patch.LabelRolloutStrategy: &rollout.Strategy{
+						Type: rollout.Canary,
+						Canary: &rollout.CanaryParams{
+							Rooms:            2,
+							BakeDuration:     time.Minute,
+							FailureThreshold: 0.1,
+						},
+					},
+				},
+			},
+		},
 		{
 			Title: "only autoscaling cooldown should convert api.PatchSchedulerRequest to change map",
 			Input: Input{
@@ -455,6 +498,7 @@ func TestFromApiCreateSchedulerRequestToEntity(t *testing.T) {
 	if err != nil {
 		t.Errorf("unexpected error %d'", err)
 	}
+	bestEffort := true
 
 	type Input struct {
 		CreateScheduler *api.CreateSchedulerRequest
@@ -570,6 +614,19 @@ func TestFromApiCreateSchedulerRequestToEntity(t *testing.T) {
 							Address: "localhost:8888",
 							Options: &api.ForwarderOptions{
 								Timeout: int64(10),
+								Filter:  &api.ForwarderFilterOptions{EventNames: []string{"PlayerEvent"}, EventTypes: []string{"playerLeft"}},
+								Transform: &api.ForwarderTransformOptions{
+									DropMetadata: []string{"token"},
+									Attributes:   map[string]string{"team": "matchmaking"},
+								},
+								BestEffort: &bestEffort,
+								CloudEvents: &api.ForwarderCloudEventsOptions{
+									Mode: "binary",
+								},
+								Grpc: &api.GRPCForwarderOptions{
+									Tls:  &api.ForwarderTLSOptions{CaSecretRef: "matchmakerCA", CertSecretRef: "maestroCert", KeySecretRef: "maestroKey", ServerName: "matchmaker"},
+									Auth: &api.ForwarderAuthOptions{Type: "apiKey", SecretRef: "matchmakerKey", Header: "x-game-key"},
+								},
 							},
 						},
 					},
@@ -671,6 +728,19 @@ func TestFromApiCreateSchedulerRequestToEntity(t *testing.T) {
 							Options: &forwarder.ForwardOptions{
 								Timeout:  time.Duration(10),
 								Metadata: map[string]interface{}{},
+								Filter:   &forwarder.FilterOptions{EventNames: []string{"PlayerEvent"}, EventTypes: []string{"playerLeft"}},
+								Transform: &forwarder.TransformOptions{
+									DropMetadata: []string{"token"},
+									Attributes:   map[string]string{"team": "matchmaking"},
+								},
+								BestEffort: true,
+								CloudEvents: &forwarder.CloudEventsOptions{
+									Mode: forwarder.CloudEventsBinary,
+								},
+								GRPC: &forwarder.GRPCOptions{
+									TLS:  &forwarder.TLSOptions{CASecretRef: "matchmakerCA", CertSecretRef: "maestroCert", KeySecretRef: "maestroKey", ServerName: "matchmaker"},
+									Auth: &forwarder.AuthOptions{Type: forwarder.AuthAPIKey, SecretRef: "matchmakerKey", Header: "x-game-key"},
+								},
 							},
 						},
 					},
@@ -861,6 +931,77 @@ func TestFromApiCreateSchedulerRequestToEntity(t *testing.T) {
 			assert.EqualValues(t, testCase.Output.Scheduler, _scheduler)
 		})
 	}
+
+	t.Run("should convert the rollout strategy", func(t *testing.T) {
+		request := &api.CreateSchedulerRequest{
+			Spec: &api.Spec{},
+			RolloutStrategy: &api.RolloutStrategy{
+				Type: "canary",
+				Canary: &api.CanaryRollout{
+					Percentage:   10,
+					BakeDuration: durationpb.New(time.Minute),
+				},
+			},
+		}
+
+		_scheduler, _ := requestadapters.FromApiCreateSchedulerRequestToEntity(request)
+		assert.EqualValues(t, &rollout.Strategy{
+			Type:   rollout.Canary,
+			Canary: &rollout.CanaryParams{Percentage: 10, BakeDuration: time.Minute},
+		}, _scheduler.RolloutStrategy)
+	})
+
+	t.Run("should convert the rollout strategy auto rollback", func(t *testing.T) {
+		request := &api.CreateSchedulerRequest{
+			Spec: &api.Spec{},
+			RolloutStrategy: &api.RolloutStrategy{
+				Type:         "rollingUpdate",
+				AutoRollback: &api.AutoRollback{FailureThreshold: 0.2, MinRooms: 5},
+			},
+		}
+
+		_scheduler, _ := requestadapters.FromApiCreateSchedulerRequestToEntity(request)
+		assert.EqualValues(t, &rollout.Strategy{
+			Type:         rollout.RollingUpdate,
+			AutoRollback: &rollout.AutoRollbackParams{FailureThreshold: 0.2, MinRooms: 5},
+		}, _scheduler.RolloutStrategy)
+	})
+
+	t.Run("should convert the rollout strategy validation", func(t *testing.T) {
+		request := &api.CreateSchedulerRequest{
+			Spec: &api.Spec{},
+			RolloutStrategy: &api.RolloutStrategy{
+				Type: "rollingUpdate",
+				Validation: &api.RolloutValidation{
+					Rooms:            3,
+					MinReadyDuration: durationpb.New(time.Minute),
+					HealthCheck:      &api.RolloutHealthCheck{Protocol: "http", PortName: "http", Path: "/healthz", Timeout: durationpb.New(time.Second)},
+					SmokeTest:        &api.RolloutSmokeTest{ForwarderName: "matchmaker", EventName: "smokeTest"},
+				},
+			},
+		}
+
+		_scheduler, _ := requestadapters.FromApiCreateSchedulerRequestToEntity(request)
+		assert.EqualValues(t, &rollout.Strategy{
+			Type: rollout.RollingUpdate,
+			Validation: &rollout.ValidationParams{
+				Rooms:            3,
+				MinReadyDuration: time.Minute,
+				HealthCheck:      &rollout.HealthCheckParams{Protocol: rollout.HealthCheckHTTP, PortName: "http", Path: "/healthz", Timeout: time.Second},
+				SmokeTest:        &rollout.SmokeTestParams{ForwarderName: "matchmaker", EventName: "smokeTest"},
+			},
+		}, _scheduler.RolloutStrategy)
+	})
+
+	t.Run("should return error when the rollout strategy is invalid", func(t *testing.T) {
+		request := &api.CreateSchedulerRequest{
+			Spec:            &api.Spec{},
+			RolloutStrategy: &api.RolloutStrategy{Type: "canary"},
+		}
+
+		_, err := requestadapters.FromApiCreateSchedulerRequestToEntity(request)
+		assert.Error(t, err)
+	})
 }
 
 func TestFromEntitySchedulerToListResponse(t *testing.T) {
@@ -1349,6 +1490,7 @@ func TestFromEntitySchedulerToResponse(t *testing.T) {
 	if err != nil {
 		t.Errorf("unexpected error %d'", err)
 	}
+	bestEffort := true
 
 	type Input struct {
 		Scheduler *entities.Scheduler
@@ -1458,8 +1600,18 @@ func TestFromEntitySchedulerToResponse(t *testing.T) {
 							ForwardType: forwarder.ForwardType("some-type"),
 							Address:     "localhost:8080",
 							Options: &forwarder.ForwardOptions{
-								Timeout:  time.Duration(10),
-								Metadata: map[string]interface{}{"some-value": "another-value"},
+								Timeout:    time.Duration(10),
+								Metadata:   map[string]interface{}{"some-value": "another-value"},
+								Filter:     &forwarder.FilterOptions{EventTypes: []string{"roomTerminated"}},
+								Transform:  &forwarder.TransformOptions{IncludeMetadata: []string{"region"}},
+								BestEffort: true,
+								CloudEvents: &forwarder.CloudEventsOptions{
+									Mode: forwarder.CloudEventsBinary,
+								},
+								GRPC: &forwarder.GRPCOptions{
+									TLS:  &forwarder.TLSOptions{CASecretRef: "matchmakerCA", CertSecretRef: "maestroCert", KeySecretRef: "maestroKey", ServerName: "matchmaker"},
+									Auth: &forwarder.AuthOptions{Type: forwarder.AuthAPIKey, SecretRef: "matchmakerKey", Header: "x-game-key"},
+								},
 							},
 						},
 					},
@@ -1550,7 +1702,17 @@ func TestFromEntitySchedulerToResponse(t *testing.T) {
 							Type:    "some-type",
 							Address: "localhost:8080",
 							Options: &api.ForwarderOptions{
-								Timeout: int64(10),
+								Timeout:    int64(10),
+								Filter:     &api.ForwarderFilterOptions{EventTypes: []string{"roomTerminated"}},
+								Transform:  &api.ForwarderTransformOptions{IncludeMetadata: []string{"region"}},
+								BestEffort: &bestEffort,
+								CloudEvents: &api.ForwarderCloudEventsOptions{
+									Mode: "binary",
+								},
+								Grpc: &api.GRPCForwarderOptions{
+									Tls:  &api.ForwarderTLSOptions{CaSecretRef: "matchmakerCA", CertSecretRef: "maestroCert", KeySecretRef: "maestroKey", ServerName: "matchmaker"},
+									Auth: &api.ForwarderAuthOptions{Type: "apiKey", SecretRef: "matchmakerKey", Header: "x-game-key"},
+								},
 								Metadata: &structpb.Struct{
 									Fields: map[string]*structpb.Value{
 										"some-value": {
@@ -1914,6 +2076,40 @@ func TestFromEntitySchedulerInfoToListResponse(t *testing.T) {
 				},
 			},
 		},
+		{
+			Title: "convert scheduler info entity to api with operations paused",
+			Input: Input{
+				SchedulerInfo: &entities.SchedulerInfo{
+					Name:             genericString,
+					Game:             genericString,
+					State:            entities.StateCreating,
+					RoomsReplicas:    genericInt,
+					RoomsReady:       genericInt,
+					RoomsOccupied:    genericInt,
+					RoomsPending:     genericInt,
+					RoomsTerminating: genericInt,
+					OperationsPause: &operation.OperationsPause{
+						Reason:   genericString,
+						PausedAt: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+			Output: Output{
+				ApiSchedulerInfo: &api.SchedulerInfo{
+					Name:                  genericString,
+					Game:                  genericString,
+					State:                 "creating",
+					RoomsReplicas:         int32(genericInt),
+					RoomsReady:            int32(genericInt),
+					RoomsOccupied:         int32(genericInt),
+					RoomsPending:          int32(genericInt),
+					RoomsTerminating:      int32(genericInt),
+					OperationsPaused:      true,
+					OperationsPauseReason: &genericString,
+					OperationsPausedAt:    timestamppb.New(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -1923,3 +2119,42 @@ func TestFromEntitySchedulerInfoToListResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestFromEntitySchedulerApplyStepsToResponse(t *testing.T) {
+	t.Run("converts every step keeping the diff changes", func(t *testing.T) {
+		steps := []*entities.SchedulerApplyStep{
+			{
+				SchedulerName: "scheduler-1",
+				Action:        entities.SchedulerApplyActionUpdate,
+				Diff: &entities.SchedulerDiff{
+					IsMajor: true,
+					Changes: []*entities.SchedulerFieldChange{{Path: "Spec.Containers[0].Image", OldValue: "image:1", NewValue: "image:2"}},
+				},
+				OperationID: "op-1",
+			},
+			{
+				SchedulerName: "scheduler-2",
+				Action:        entities.SchedulerApplyActionDelete,
+				Error:         "failed to enqueue",
+			},
+		}
+
+		returnValues := requestadapters.FromEntitySchedulerApplyStepsToResponse(steps)
+
+		assert.EqualValues(t, []*api.SchedulerApplyStep{
+			{
+				SchedulerName: "scheduler-1",
+				Action:        "update",
+				IsMajor:       true,
+				Changes:       []*api.SchedulerFieldChange{{Path: "Spec.Containers[0].Image", OldValue: "image:1", NewValue: "image:2"}},
+				OperationId:   "op-1",
+			},
+			{
+				SchedulerName: "scheduler-2",
+				Action:        "delete",
+				Changes:       []*api.SchedulerFieldChange{},
+				Error:         "failed to enqueue",
+			},
+		}, returnValues)
+	})
+}
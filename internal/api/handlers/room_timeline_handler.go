@@ -0,0 +1,68 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/topfreegames/maestro/internal/api/handlers/requestadapters"
+	"github.com/topfreegames/maestro/internal/core/logs"
+	"github.com/topfreegames/maestro/internal/core/ports"
+	portsErrors "github.com/topfreegames/maestro/internal/core/ports/errors"
+	api "github.com/topfreegames/maestro/pkg/api/v1"
+)
+
+type RoomTimelineHandler struct {
+	roomTimelineManager ports.RoomTimelineManager
+	logger              *zap.Logger
+	api.UnimplementedRoomTimelineServiceServer
+}
+
+func ProvideRoomTimelineHandler(roomTimelineManager ports.RoomTimelineManager) *RoomTimelineHandler {
+	return &RoomTimelineHandler{
+		roomTimelineManager: roomTimelineManager,
+		logger: zap.L().
+			With(zap.String(logs.LogFieldComponent, "handler"), zap.String(logs.LogFieldHandlerName, "room_timeline_handler")),
+	}
+}
+
+func (h *RoomTimelineHandler) GetRoomTimeline(ctx context.Context, request *api.GetRoomTimelineRequest) (*api.GetRoomTimelineResponse, error) {
+	handlerLogger := h.logger.With(zap.String(logs.LogFieldSchedulerName, request.GetSchedulerName()), zap.String(logs.LogFieldRoomID, request.GetRoomId()))
+	handlerLogger.Info("handling get room timeline request")
+	timeline, err := h.roomTimelineManager.GetRoomTimeline(ctx, request.GetSchedulerName(), request.GetRoomId())
+	if err != nil {
+		handlerLogger.Error("error getting room timeline", zap.Error(err))
+		if errors.Is(err, portsErrors.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+
+	handlerLogger.Info("finish handling get room timeline request")
+	return &api.GetRoomTimelineResponse{Entries: requestadapters.FromRoomTimelineToResponse(timeline)}, nil
+}
@@ -0,0 +1,106 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build integration
+// +build integration
+
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+
+	"github.com/topfreegames/maestro/internal/core/entities/game_room"
+	portsErrors "github.com/topfreegames/maestro/internal/core/ports/errors"
+	mockports "github.com/topfreegames/maestro/internal/core/ports/mock"
+	api "github.com/topfreegames/maestro/pkg/api/v1"
+)
+
+func TestGetRoomTimeline(t *testing.T) {
+	t.Run("returns the room timeline", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		roomTimelineManager := mockports.NewMockRoomTimelineManager(mockCtrl)
+		timestamp := time.Date(2022, time.January, 1, 10, 0, 0, 0, time.UTC)
+		roomTimelineManager.EXPECT().GetRoomTimeline(gomock.Any(), "scheduler", "room").Return([]*game_room.TimelineEntry{
+			{Kind: game_room.TimelineStatusChanged, Timestamp: timestamp, Source: game_room.TimelineSourceRuntime, PreviousStatus: "pending", Status: "ready"},
+			{Kind: game_room.TimelineEventForwarded, Timestamp: timestamp, EventName: "RoomEvent", EventType: "status", ForwarderName: "matchmaking", Error: "failed to forward event room at \"matchmaking\" with code Unavailable"},
+			{Kind: game_room.TimelineDeleted, Timestamp: timestamp, Reason: "ping_timeout"},
+		}, nil)
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterRoomTimelineServiceHandlerServer(context.Background(), mux, ProvideRoomTimelineHandler(roomTimelineManager))
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodGet, "/schedulers/scheduler/rooms/room/timeline", nil)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		responseBody, expectedResponseBody := extractBodyForComparison(t, rr.Body.Bytes(), "room_timeline_handler/get_room_timeline.json")
+		require.Equal(t, expectedResponseBody, responseBody)
+	})
+
+	t.Run("returns not found when the scheduler doesn't exist", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		roomTimelineManager := mockports.NewMockRoomTimelineManager(mockCtrl)
+		roomTimelineManager.EXPECT().GetRoomTimeline(gomock.Any(), "scheduler", "room").Return(nil, portsErrors.NewErrNotFound("scheduler scheduler not found"))
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterRoomTimelineServiceHandlerServer(context.Background(), mux, ProvideRoomTimelineHandler(roomTimelineManager))
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodGet, "/schedulers/scheduler/rooms/room/timeline", nil)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("returns unknown error when the timeline can't be read", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		roomTimelineManager := mockports.NewMockRoomTimelineManager(mockCtrl)
+		roomTimelineManager.EXPECT().GetRoomTimeline(gomock.Any(), "scheduler", "room").Return(nil, portsErrors.NewErrUnexpected("error"))
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterRoomTimelineServiceHandlerServer(context.Background(), mux, ProvideRoomTimelineHandler(roomTimelineManager))
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodGet, "/schedulers/scheduler/rooms/room/timeline", nil)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
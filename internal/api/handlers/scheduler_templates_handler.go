@@ -0,0 +1,223 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/topfreegames/maestro/internal/api/handlers/requestadapters"
+	"github.com/topfreegames/maestro/internal/core/logs"
+	"github.com/topfreegames/maestro/internal/core/ports"
+	portsErrors "github.com/topfreegames/maestro/internal/core/ports/errors"
+	api "github.com/topfreegames/maestro/pkg/api/v1"
+)
+
+type SchedulerTemplatesHandler struct {
+	templateManager ports.SchedulerTemplateManager
+	logger          *zap.Logger
+	api.UnimplementedSchedulerTemplatesServiceServer
+}
+
+func ProvideSchedulerTemplatesHandler(templateManager ports.SchedulerTemplateManager) *SchedulerTemplatesHandler {
+	return &SchedulerTemplatesHandler{
+		templateManager: templateManager,
+		logger: zap.L().
+			With(zap.String(logs.LogFieldComponent, "handler"), zap.String(logs.LogFieldHandlerName, "scheduler_templates_handler")),
+	}
+}
+
+func (h *SchedulerTemplatesHandler) ListSchedulerTemplates(ctx context.Context, _ *api.ListSchedulerTemplatesRequest) (*api.ListSchedulerTemplatesResponse, error) {
+	h.logger.Info("handling list scheduler templates request")
+	templateEntities, err := h.templateManager.GetAllSchedulerTemplates(ctx)
+	if err != nil {
+		h.logger.Error("error listing scheduler templates", zap.Error(err))
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+
+	templates := make([]*api.SchedulerTemplate, len(templateEntities))
+	for i, entity := range templateEntities {
+		templates[i], err = requestadapters.FromEntitySchedulerTemplateToResponse(entity)
+		if err != nil {
+			h.logger.Error("error parsing scheduler template to response", zap.String(logs.LogFieldTemplateName, entity.Name), zap.Error(err))
+			return nil, status.Error(codes.Unknown, err.Error())
+		}
+	}
+
+	h.logger.Info("finish handling list scheduler templates request")
+	return &api.ListSchedulerTemplatesResponse{Templates: templates}, nil
+}
+
+func (h *SchedulerTemplatesHandler) GetSchedulerTemplate(ctx context.Context, request *api.GetSchedulerTemplateRequest) (*api.GetSchedulerTemplateResponse, error) {
+	handlerLogger := h.logger.With(zap.String(logs.LogFieldTemplateName, request.GetName()))
+	handlerLogger.Info("handling get scheduler template request")
+	template, err := h.templateManager.GetSchedulerTemplate(ctx, request.GetName())
+	if err != nil {
+		handlerLogger.Error("error fetching scheduler template", zap.Error(err))
+		return nil, schedulerTemplateErrorStatus(err)
+	}
+
+	returnTemplate, err := requestadapters.FromEntitySchedulerTemplateToResponse(template)
+	if err != nil {
+		handlerLogger.Error("error parsing scheduler template to response", zap.Error(err))
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+
+	handlerLogger.Info("finish handling get scheduler template request")
+	return &api.GetSchedulerTemplateResponse{Template: returnTemplate}, nil
+}
+
+func (h *SchedulerTemplatesHandler) CreateSchedulerTemplate(ctx context.Context, request *api.CreateSchedulerTemplateRequest) (*api.CreateSchedulerTemplateResponse, error) {
+	handlerLogger := h.logger.With(zap.String(logs.LogFieldTemplateName, request.GetName()))
+	handlerLogger.Info("handling create scheduler template request")
+	template, err := requestadapters.FromApiCreateSchedulerTemplateRequestToEntity(request)
+	if err != nil {
+		apiValidationError := parseValidationError(err)
+		handlerLogger.Error("error parsing scheduler template", zap.Error(apiValidationError))
+		return nil, status.Error(codes.InvalidArgument, apiValidationError.Error())
+	}
+
+	template, err = h.templateManager.CreateSchedulerTemplate(ctx, template)
+	if err != nil {
+		handlerLogger.Error("error creating scheduler template", zap.Error(err))
+		return nil, schedulerTemplateErrorStatus(err)
+	}
+
+	returnTemplate, err := requestadapters.FromEntitySchedulerTemplateToResponse(template)
+	if err != nil {
+		handlerLogger.Error("error parsing scheduler template to response", zap.Error(err))
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+
+	handlerLogger.Info("finish handling create scheduler template request")
+	return &api.CreateSchedulerTemplateResponse{Template: returnTemplate}, nil
+}
+
+func (h *SchedulerTemplatesHandler) UpdateSchedulerTemplate(ctx context.Context, request *api.UpdateSchedulerTemplateRequest) (*api.UpdateSchedulerTemplateResponse, error) {
+	handlerLogger := h.logger.With(zap.String(logs.LogFieldTemplateName, request.GetName()))
+	handlerLogger.Info("handling update scheduler template request")
+	template, err := requestadapters.FromApiUpdateSchedulerTemplateRequestToEntity(request)
+	if err != nil {
+		apiValidationError := parseValidationError(err)
+		handlerLogger.Error("error parsing scheduler template", zap.Error(apiValidationError))
+		return nil, status.Error(codes.InvalidArgument, apiValidationError.Error())
+	}
+
+	template, err = h.templateManager.UpdateSchedulerTemplate(ctx, template)
+	if err != nil {
+		handlerLogger.Error("error updating scheduler template", zap.Error(err))
+		return nil, schedulerTemplateErrorStatus(err)
+	}
+
+	returnTemplate, err := requestadapters.FromEntitySchedulerTemplateToResponse(template)
+	if err != nil {
+		handlerLogger.Error("error parsing scheduler template to response", zap.Error(err))
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+
+	handlerLogger.Info("finish handling update scheduler template request")
+	return &api.UpdateSchedulerTemplateResponse{Template: returnTemplate}, nil
+}
+
+func (h *SchedulerTemplatesHandler) CreateSchedulerFromTemplate(ctx context.Context, request *api.CreateSchedulerFromTemplateRequest) (*api.CreateSchedulerFromTemplateResponse, error) {
+	handlerLogger := h.logger.With(
+		zap.String(logs.LogFieldTemplateName, request.GetTemplateName()),
+		zap.String(logs.LogFieldSchedulerName, request.GetName()),
+		zap.String(logs.LogFieldGame, request.GetGame()),
+	)
+	handlerLogger.Info("handling create scheduler from template request")
+	overrides := requestadapters.FromApiTemplateContainerOverridesToEntity(request.GetContainers())
+	scheduler, err := h.templateManager.CreateSchedulerFromTemplate(ctx, request.GetTemplateName(), request.GetName(), request.GetGame(), overrides)
+	if err != nil {
+		handlerLogger.Error("error creating scheduler from template", zap.Error(err))
+		return nil, schedulerTemplateErrorStatus(err)
+	}
+
+	returnScheduler, err := requestadapters.FromEntitySchedulerToResponse(scheduler)
+	if err != nil {
+		handlerLogger.Error("error parsing scheduler to response", zap.Error(err))
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+
+	handlerLogger.Info("finish handling create scheduler from template request")
+	return &api.CreateSchedulerFromTemplateResponse{Scheduler: returnScheduler}, nil
+}
+
+func (h *SchedulerTemplatesHandler) PatchSchedulerFromTemplate(ctx context.Context, request *api.PatchSchedulerFromTemplateRequest) (*api.PatchSchedulerFromTemplateResponse, error) {
+	handlerLogger := h.logger.With(zap.String(logs.LogFieldSchedulerName, request.GetSchedulerName()), zap.String(logs.LogFieldTemplateName, request.GetTemplateName()))
+	handlerLogger.Info("handling patch scheduler from template request")
+	overrides := requestadapters.FromApiTemplateContainerOverridesToEntity(request.GetContainers())
+	op, err := h.templateManager.PatchSchedulerFromTemplate(ctx, request.GetSchedulerName(), request.GetTemplateName(), overrides)
+	if err != nil {
+		handlerLogger.Error("error patching scheduler from template", zap.Error(err))
+		return nil, schedulerTemplateErrorStatus(err)
+	}
+
+	handlerLogger.Info("finish handling patch scheduler from template request")
+	return &api.PatchSchedulerFromTemplateResponse{OperationId: op.ID}, nil
+}
+
+func (h *SchedulerTemplatesHandler) ListDerivedSchedulers(ctx context.Context, request *api.ListDerivedSchedulersRequest) (*api.ListDerivedSchedulersResponse, error) {
+	handlerLogger := h.logger.With(zap.String(logs.LogFieldTemplateName, request.GetTemplateName()))
+	handlerLogger.Info("handling list derived schedulers request")
+	derivedSchedulers, err := h.templateManager.GetDerivedSchedulers(ctx, request.GetTemplateName())
+	if err != nil {
+		handlerLogger.Error("error listing derived schedulers", zap.Error(err))
+		return nil, schedulerTemplateErrorStatus(err)
+	}
+
+	handlerLogger.Info("finish handling list derived schedulers request")
+	return &api.ListDerivedSchedulersResponse{Schedulers: requestadapters.FromEntityDerivedSchedulersToResponse(derivedSchedulers)}, nil
+}
+
+func (h *SchedulerTemplatesHandler) PropagateSchedulerTemplate(ctx context.Context, request *api.PropagateSchedulerTemplateRequest) (*api.PropagateSchedulerTemplateResponse, error) {
+	handlerLogger := h.logger.With(zap.String(logs.LogFieldTemplateName, request.GetTemplateName()))
+	handlerLogger.Info("handling propagate scheduler template request")
+	propagations, err := h.templateManager.PropagateSchedulerTemplate(ctx, request.GetTemplateName())
+	if err != nil {
+		handlerLogger.Error("error propagating scheduler template", zap.Error(err))
+		return nil, schedulerTemplateErrorStatus(err)
+	}
+
+	handlerLogger.Info("finish handling propagate scheduler template request")
+	return &api.PropagateSchedulerTemplateResponse{Propagations: requestadapters.FromEntitySchedulerTemplatePropagationsToResponse(propagations)}, nil
+}
+
+func schedulerTemplateErrorStatus(err error) error {
+	switch {
+	case errors.Is(err, portsErrors.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, portsErrors.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, portsErrors.ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, portsErrors.ErrConflict):
+		return status.Error(codes.Aborted, err.Error())
+	default:
+		return status.Error(codes.Unknown, err.Error())
+	}
+}
@@ -0,0 +1,288 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build integration
+// +build integration
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/topfreegames/maestro/internal/core/entities"
+	"github.com/topfreegames/maestro/internal/core/entities/game_room"
+	"github.com/topfreegames/maestro/internal/core/entities/operation"
+	"github.com/topfreegames/maestro/internal/core/entities/port"
+	portsErrors "github.com/topfreegames/maestro/internal/core/ports/errors"
+	mockports "github.com/topfreegames/maestro/internal/core/ports/mock"
+	"github.com/topfreegames/maestro/internal/validations"
+	api "github.com/topfreegames/maestro/pkg/api/v1"
+)
+
+func TestGetSchedulerTemplate(t *testing.T) {
+	t.Run("returns the template", func(t *testing.T) {
+		mux, templateManager := newSchedulerTemplatesMux(t)
+		templateManager.EXPECT().GetSchedulerTemplate(gomock.Any(), "template").Return(newHandlerSchedulerTemplate(), nil)
+
+		rr := serveSchedulerTemplatesRequest(t, mux, http.MethodGet, "/templates/template", nil)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		responseBody, expectedResponseBody := extractBodyForComparison(t, rr.Body.Bytes(), "scheduler_templates_handler/get_scheduler_template.json")
+		require.Equal(t, expectedResponseBody, responseBody)
+	})
+
+	t.Run("returns not found when the template doesn't exist", func(t *testing.T) {
+		mux, templateManager := newSchedulerTemplatesMux(t)
+		templateManager.EXPECT().GetSchedulerTemplate(gomock.Any(), "template").Return(nil, portsErrors.NewErrNotFound("scheduler template template not found"))
+
+		rr := serveSchedulerTemplatesRequest(t, mux, http.MethodGet, "/templates/template", nil)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestListSchedulerTemplates(t *testing.T) {
+	mux, templateManager := newSchedulerTemplatesMux(t)
+	templateManager.EXPECT().GetAllSchedulerTemplates(gomock.Any()).Return([]*entities.SchedulerTemplate{newHandlerSchedulerTemplate()}, nil)
+
+	rr := serveSchedulerTemplatesRequest(t, mux, http.MethodGet, "/templates", nil)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var response api.ListSchedulerTemplatesResponse
+	require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), &response))
+	require.Len(t, response.Templates, 1)
+	require.Equal(t, "template", response.Templates[0].Name)
+}
+
+func TestCreateSchedulerTemplate(t *testing.T) {
+	request := map[string]interface{}{
+		"name":     "template",
+		"maxSurge": "10%",
+		"portRange": map[string]interface{}{
+			"start": 40000,
+			"end":   60000,
+		},
+		"spec": map[string]interface{}{
+			"terminationGracePeriod": "60s",
+			"containers": []map[string]interface{}{
+				{"name": "default", "image": "image:v1", "imagePullPolicy": "IfNotPresent"},
+			},
+		},
+	}
+
+	t.Run("creates the template", func(t *testing.T) {
+		mux, templateManager := newSchedulerTemplatesMux(t)
+		templateManager.EXPECT().CreateSchedulerTemplate(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, template *entities.SchedulerTemplate) (*entities.SchedulerTemplate, error) {
+				require.Equal(t, "template", template.Name)
+				require.Equal(t, "image:v1", template.Spec.Containers[0].Image)
+				return template, nil
+			},
+		)
+
+		rr := serveSchedulerTemplatesRequest(t, mux, http.MethodPost, "/templates", request)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response api.CreateSchedulerTemplateResponse
+		require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), &response))
+		require.Equal(t, "template", response.Template.Name)
+	})
+
+	t.Run("returns conflict when the template already exists", func(t *testing.T) {
+		mux, templateManager := newSchedulerTemplatesMux(t)
+		templateManager.EXPECT().CreateSchedulerTemplate(gomock.Any(), gomock.Any()).Return(nil, portsErrors.NewErrAlreadyExists("already exists"))
+
+		rr := serveSchedulerTemplatesRequest(t, mux, http.MethodPost, "/templates", request)
+
+		require.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("returns bad request when the template is invalid", func(t *testing.T) {
+		mux, templateManager := newSchedulerTemplatesMux(t)
+		templateManager.EXPECT().CreateSchedulerTemplate(gomock.Any(), gomock.Any()).Return(nil, portsErrors.NewErrInvalidArgument("invalid scheduler template"))
+
+		rr := serveSchedulerTemplatesRequest(t, mux, http.MethodPost, "/templates", request)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestCreateSchedulerFromTemplate(t *testing.T) {
+	err := validations.RegisterValidations()
+	require.NoError(t, err)
+
+	t.Run("creates the scheduler with the overrides", func(t *testing.T) {
+		mux, templateManager := newSchedulerTemplatesMux(t)
+		overrides := []*entities.TemplateContainerOverride{{Name: "default", Image: "image:v2"}}
+		scheduler, err := newHandlerSchedulerTemplate().NewScheduler("scheduler", "game", overrides)
+		require.NoError(t, err)
+
+		templateManager.EXPECT().CreateSchedulerFromTemplate(gomock.Any(), "template", "scheduler", "game", overrides).Return(scheduler, nil)
+
+		rr := serveSchedulerTemplatesRequest(t, mux, http.MethodPost, "/templates/template/schedulers", map[string]interface{}{
+			"name":       "scheduler",
+			"game":       "game",
+			"containers": []map[string]interface{}{{"name": "default", "image": "image:v2"}},
+		})
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response api.CreateSchedulerFromTemplateResponse
+		require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), &response))
+		require.Equal(t, "scheduler", response.Scheduler.Name)
+		require.Equal(t, "template", response.Scheduler.Template.Name)
+		require.Equal(t, "image:v2", response.Scheduler.Spec.Containers[0].Image)
+	})
+
+	t.Run("returns not found when the template doesn't exist", func(t *testing.T) {
+		mux, templateManager := newSchedulerTemplatesMux(t)
+		templateManager.EXPECT().CreateSchedulerFromTemplate(gomock.Any(), "template", "scheduler", "game", gomock.Nil()).Return(nil, portsErrors.NewErrNotFound("not found"))
+
+		rr := serveSchedulerTemplatesRequest(t, mux, http.MethodPost, "/templates/template/schedulers", map[string]interface{}{
+			"name": "scheduler",
+			"game": "game",
+		})
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestPatchSchedulerFromTemplate(t *testing.T) {
+	mux, templateManager := newSchedulerTemplatesMux(t)
+	templateManager.EXPECT().PatchSchedulerFromTemplate(gomock.Any(), "scheduler", "template", gomock.Nil()).Return(&operation.Operation{ID: "op-id"}, nil)
+
+	rr := serveSchedulerTemplatesRequest(t, mux, http.MethodPatch, "/schedulers/scheduler/template", map[string]interface{}{
+		"templateName": "template",
+	})
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var response api.PatchSchedulerFromTemplateResponse
+	require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), &response))
+	require.Equal(t, "op-id", response.OperationId)
+}
+
+func TestListDerivedSchedulers(t *testing.T) {
+	mux, templateManager := newSchedulerTemplatesMux(t)
+	templateManager.EXPECT().GetDerivedSchedulers(gomock.Any(), "template").Return([]*entities.DerivedScheduler{
+		{SchedulerName: "up-to-date", Diff: &entities.SchedulerDiff{}},
+		{
+			SchedulerName: "outdated",
+			Outdated:      true,
+			Diff: &entities.SchedulerDiff{
+				Changes: []*entities.SchedulerFieldChange{{Path: "RoomsReplicas", OldValue: "5", NewValue: "0"}},
+			},
+		},
+	}, nil)
+
+	rr := serveSchedulerTemplatesRequest(t, mux, http.MethodGet, "/templates/template/schedulers", nil)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	responseBody, expectedResponseBody := extractBodyForComparison(t, rr.Body.Bytes(), "scheduler_templates_handler/list_derived_schedulers.json")
+	require.Equal(t, expectedResponseBody, responseBody)
+}
+
+func TestPropagateSchedulerTemplate(t *testing.T) {
+	t.Run("returns the result for each outdated scheduler", func(t *testing.T) {
+		mux, templateManager := newSchedulerTemplatesMux(t)
+		templateManager.EXPECT().PropagateSchedulerTemplate(gomock.Any(), "template").Return([]*entities.SchedulerTemplatePropagation{
+			{SchedulerName: "outdated", OperationID: "op-id"},
+			{SchedulerName: "failing", Error: "some error"},
+		}, nil)
+
+		rr := serveSchedulerTemplatesRequest(t, mux, http.MethodPost, "/templates/template/propagate", nil)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response api.PropagateSchedulerTemplateResponse
+		require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), &response))
+		require.Len(t, response.Propagations, 2)
+		require.Equal(t, "op-id", response.Propagations[0].OperationId)
+		require.Equal(t, "some error", response.Propagations[1].Error)
+	})
+
+	t.Run("returns not found when the template doesn't exist", func(t *testing.T) {
+		mux, templateManager := newSchedulerTemplatesMux(t)
+		templateManager.EXPECT().PropagateSchedulerTemplate(gomock.Any(), "template").Return(nil, portsErrors.NewErrNotFound("not found"))
+
+		rr := serveSchedulerTemplatesRequest(t, mux, http.MethodPost, "/templates/template/propagate", nil)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func newSchedulerTemplatesMux(t *testing.T) (*runtime.ServeMux, *mockports.MockSchedulerTemplateManager) {
+	templateManager := mockports.NewMockSchedulerTemplateManager(gomock.NewController(t))
+	mux := runtime.NewServeMux()
+	err := api.RegisterSchedulerTemplatesServiceHandlerServer(context.Background(), mux, ProvideSchedulerTemplatesHandler(templateManager))
+	require.NoError(t, err)
+
+	return mux, templateManager
+}
+
+func serveSchedulerTemplatesRequest(t *testing.T, mux *runtime.ServeMux, method, url string, body interface{}) *httptest.ResponseRecorder {
+	var requestBody []byte
+	if body != nil {
+		var err error
+		requestBody, err = json.Marshal(body)
+		require.NoError(t, err)
+	}
+
+	req, err := http.NewRequest(method, url, bytes.NewReader(requestBody))
+	require.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+	return rr
+}
+
+func newHandlerSchedulerTemplate() *entities.SchedulerTemplate {
+	return &entities.SchedulerTemplate{
+		Name:     "template",
+		MaxSurge: "10%",
+		Spec: game_room.Spec{
+			Version:                "v1.0.0",
+			TerminationGracePeriod: 60 * time.Second,
+			Containers: []game_room.Container{
+				{
+					Name:            "default",
+					Image:           "image:v1",
+					ImagePullPolicy: "IfNotPresent",
+					Ports:           []game_room.ContainerPort{{Name: "tcp", Protocol: "tcp", Port: 80}},
+					Requests:        game_room.ContainerResources{CPU: "10m", Memory: "100Mi"},
+					Limits:          game_room.ContainerResources{CPU: "10m", Memory: "100Mi"},
+				},
+			},
+		},
+		PortRange: &port.PortRange{Start: 40000, End: 60000},
+		CreatedAt: time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2022, time.January, 2, 0, 0, 0, 0, time.UTC),
+	}
+}
@@ -131,6 +131,26 @@ func (h *SchedulersHandler) GetSchedulerVersions(ctx context.Context, request *a
 	return &api.GetSchedulerVersionsResponse{Versions: requestadapters.FromEntitySchedulerVersionListToResponse(versions)}, nil
 }
 
+func (h *SchedulersHandler) GetSchedulerVersionsDiff(ctx context.Context, request *api.GetSchedulerVersionsDiffRequest) (*api.GetSchedulerVersionsDiffResponse, error) {
+	handlerLogger := h.logger.With(zap.String(logs.LogFieldSchedulerName, request.GetSchedulerName()))
+	handlerLogger.Info(fmt.Sprintf("handling get scheduler versions diff request, from version: %s, to version: %s", request.GetFromVersion(), request.GetToVersion()))
+	if request.GetFromVersion() == "" || request.GetToVersion() == "" {
+		return nil, status.Error(codes.InvalidArgument, "fromVersion and toVersion are required")
+	}
+
+	diff, err := h.schedulerManager.GetSchedulerVersionsDiff(ctx, request.GetSchedulerName(), request.GetFromVersion(), request.GetToVersion())
+	if err != nil {
+		handlerLogger.Error("error getting scheduler versions diff", zap.Error(err))
+		if strings.Contains(err.Error(), "not found") {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+	handlerLogger.Info("finish handling get scheduler versions diff request")
+
+	return requestadapters.FromEntitySchedulerDiffToResponse(diff), nil
+}
+
 func (h *SchedulersHandler) CreateScheduler(ctx context.Context, request *api.CreateSchedulerRequest) (*api.CreateSchedulerResponse, error) {
 	handlerLogger := h.logger.With(zap.String(logs.LogFieldSchedulerName, request.GetName()), zap.String(logs.LogFieldGame, request.GetGame()))
 	handlerLogger.Info("handling create scheduler request")
@@ -169,13 +189,27 @@ func (h *SchedulersHandler) NewSchedulerVersion(ctx context.Context, request *ap
 		return nil, status.Error(codes.InvalidArgument, apiValidationError.Error())
 	}
 
-	operation, err := h.schedulerManager.EnqueueNewSchedulerVersionOperation(ctx, scheduler)
+	if request.GetDryRun() {
+		diff, err := h.schedulerManager.DryRunNewSchedulerVersion(ctx, scheduler)
+		if err != nil {
+			handlerLogger.Error("error dry running new scheduler version", zap.Error(err))
+			return nil, dryRunErrorToStatus(err)
+		}
+		handlerLogger.Info("finish handling new scheduler version dry run request")
+
+		return &api.NewSchedulerVersionResponse{DryRunResult: requestadapters.FromEntitySchedulerDiffToDryRunResponse(diff)}, nil
+	}
+
+	operation, err := h.schedulerManager.EnqueueNewSchedulerVersionOperation(ctx, scheduler, idempotencyKeyFromRequest(ctx, request.IdempotencyKey))
 
 	if err != nil {
 		handlerLogger.Error("error creating new scheduler version", zap.Error(err))
 		if errors.Is(err, portsErrors.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, err.Error())
 		}
+		if errors.Is(err, portsErrors.ErrConflict) {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
 	handlerLogger.Info("finish handling new scheduler version request")
@@ -192,7 +226,18 @@ func (h *SchedulersHandler) PatchScheduler(ctx context.Context, request *api.Pat
 		return nil, status.Error(codes.AlreadyExists, fmt.Sprintf("no change found to scheduler %s", request.GetName()))
 	}
 
-	operation, err := h.schedulerManager.PatchSchedulerAndCreateNewSchedulerVersionOperation(ctx, request.GetName(), patchMap)
+	if request.GetDryRun() {
+		diff, err := h.schedulerManager.DryRunPatchScheduler(ctx, request.GetName(), patchMap)
+		if err != nil {
+			handlerLogger.Error("error dry running patch scheduler", zap.Error(err))
+			return nil, dryRunErrorToStatus(err)
+		}
+		handlerLogger.Info("finish handling patch scheduler dry run request")
+
+		return &api.PatchSchedulerResponse{DryRunResult: requestadapters.FromEntitySchedulerDiffToDryRunResponse(diff)}, nil
+	}
+
+	operation, err := h.schedulerManager.PatchSchedulerAndCreateNewSchedulerVersionOperation(ctx, request.GetName(), patchMap, idempotencyKeyFromRequest(ctx, request.IdempotencyKey))
 
 	if err != nil {
 		handlerLogger.Error("error patching scheduler", zap.Error(err))
@@ -202,6 +247,9 @@ func (h *SchedulersHandler) PatchScheduler(ctx context.Context, request *api.Pat
 		if errors.Is(err, portsErrors.ErrInvalidArgument) {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
+		if errors.Is(err, portsErrors.ErrConflict) {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
 
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
@@ -210,6 +258,25 @@ func (h *SchedulersHandler) PatchScheduler(ctx context.Context, request *api.Pat
 	return &api.PatchSchedulerResponse{OperationId: operation.ID}, nil
 }
 
+func (h *SchedulersHandler) PatchSchedulerVersion(ctx context.Context, request *api.PatchSchedulerVersionRequest) (*api.PatchSchedulerVersionResponse, error) {
+	handlerLogger := h.logger.With(zap.String(logs.LogFieldSchedulerName, request.GetSchedulerName()))
+	handlerLogger.Info(fmt.Sprintf("handling patch scheduler version request, version: %s", request.GetVersion()))
+	schedulerVersion, err := h.schedulerManager.PatchSchedulerVersion(ctx, request.GetSchedulerName(), request.GetVersion(), request.Pinned, request.Blocked)
+	if err != nil {
+		handlerLogger.Error(fmt.Sprintf("error patching scheduler version %s", request.GetVersion()), zap.Error(err))
+		if errors.Is(err, portsErrors.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, portsErrors.ErrInvalidArgument) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+
+	handlerLogger.Info("finish handling patch scheduler version request")
+	return &api.PatchSchedulerVersionResponse{Version: requestadapters.FromEntitySchedulerVersionToResponse(schedulerVersion)}, nil
+}
+
 func (h *SchedulersHandler) SwitchActiveVersion(ctx context.Context, request *api.SwitchActiveVersionRequest) (*api.SwitchActiveVersionResponse, error) {
 	handlerLogger := h.logger.With(zap.String(logs.LogFieldSchedulerName, request.GetSchedulerName()))
 	handlerLogger.Info("handling switch active version request")
@@ -217,6 +284,12 @@ func (h *SchedulersHandler) SwitchActiveVersion(ctx context.Context, request *ap
 
 	if err != nil {
 		handlerLogger.Error(fmt.Sprintf("error switching active version %s", request.GetVersion()), zap.Error(err))
+		if errors.Is(err, portsErrors.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, portsErrors.ErrInvalidArgument) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
 
@@ -265,3 +338,43 @@ func (h *SchedulersHandler) DeleteScheduler(ctx context.Context, request *api.De
 	handlerLogger.Info("finish handling delete scheduler request")
 	return &api.DeleteSchedulerResponse{OperationId: op.ID}, nil
 }
+
+func (h *SchedulersHandler) ApplySchedulers(ctx context.Context, request *api.ApplySchedulersRequest) (*api.ApplySchedulersResponse, error) {
+	handlerLogger := h.logger.With(zap.String(logs.LogFieldGame, request.GetGame()))
+	handlerLogger.Info("handling apply schedulers request")
+	schedulers, err := requestadapters.FromApiApplySchedulersRequestToEntities(request)
+	if err != nil {
+		handlerLogger.Error("error parsing schedulers", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var steps []*entities.SchedulerApplyStep
+	if request.GetDryRun() {
+		steps, err = h.schedulerManager.PlanSchedulersApply(ctx, request.GetGame(), schedulers, request.GetPrune())
+	} else {
+		steps, err = h.schedulerManager.ApplySchedulers(ctx, request.GetGame(), schedulers, request.GetPrune())
+	}
+	if err != nil {
+		handlerLogger.Error("error applying schedulers", zap.Error(err))
+		if errors.Is(err, portsErrors.ErrInvalidArgument) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+	handlerLogger.Info("finish handling apply schedulers request")
+
+	return &api.ApplySchedulersResponse{Steps: requestadapters.FromEntitySchedulerApplyStepsToResponse(steps)}, nil
+}
+
+func dryRunErrorToStatus(err error) error {
+	switch {
+	case errors.Is(err, portsErrors.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, portsErrors.ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, portsErrors.ErrUnavailable):
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		return status.Error(codes.Unknown, err.Error())
+	}
+}
@@ -58,6 +58,7 @@ import (
 	mockports "github.com/topfreegames/maestro/internal/core/ports/mock"
 	"github.com/topfreegames/maestro/internal/validations"
 	api "github.com/topfreegames/maestro/pkg/api/v1"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 func TestListSchedulers(t *testing.T) {
@@ -69,7 +70,7 @@ func TestListSchedulers(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 
 		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
-		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, nil)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
 
 		schedulerStorage.EXPECT().GetSchedulersWithFilter(gomock.Any(), &filters.SchedulerFilter{Name: schedulerName, Game: game, Version: version}).Return([]*entities.Scheduler{
 			{
@@ -115,7 +116,7 @@ func TestListSchedulers(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 
 		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
-		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, nil)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
 
 		schedulerStorage.EXPECT().GetSchedulersWithFilter(gomock.Any(), gomock.Any()).Return([]*entities.Scheduler{}, nil)
 
@@ -146,7 +147,7 @@ func TestListSchedulers(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 
 		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
-		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, nil)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
 
 		schedulerStorage.EXPECT().GetSchedulersWithFilter(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("GetSchedulersWithFilter error"))
 
@@ -203,7 +204,7 @@ func TestGetScheduler(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := schedulers.NewSchedulerManager(nil, schedulerCache, nil, nil)
+		schedulerManager := schedulers.NewSchedulerManager(nil, schedulerCache, nil, nil, nil)
 
 		scheduler := &entities.Scheduler{
 			Name:            "zooba-us",
@@ -303,7 +304,7 @@ func TestGetScheduler(t *testing.T) {
 
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
 		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
-		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, schedulerCache, nil, nil)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, schedulerCache, nil, nil, nil)
 
 		schedulerCache.EXPECT().GetScheduler(gomock.Any(), gomock.Any()).Return(nil, errors.NewErrNotFound("scheduler NonExistentSchedule not found"))
 		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), gomock.Any()).Return(nil, errors.NewErrNotFound("scheduler NonExistentSchedule not found"))
@@ -336,7 +337,7 @@ func TestGetScheduler(t *testing.T) {
 
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
 		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
-		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, schedulerCache, nil, nil)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, schedulerCache, nil, nil, nil)
 
 		schedulerCache.EXPECT().GetScheduler(gomock.Any(), gomock.Any()).Return(nil, errors.NewErrInvalidArgument("Error"))
 		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), gomock.Any()).Return(nil, errors.NewErrInvalidArgument("Error"))
@@ -371,7 +372,7 @@ func TestGetSchedulerVersions(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 
 		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
-		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, nil)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
 
 		createdAtV1, _ := time.Parse(time.RFC3339Nano, "2020-01-01T00:00:00.001Z")
 		createdAtV2, _ := time.Parse(time.RFC3339Nano, "2020-01-01T00:00:00.001Z")
@@ -412,7 +413,7 @@ func TestGetSchedulerVersions(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 
 		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
-		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, nil)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
 
 		schedulerStorage.EXPECT().GetSchedulerVersions(gomock.Any(), gomock.Any()).Return(nil, errors.NewErrNotFound("scheduler NonExistentScheduler not found"))
 
@@ -438,7 +439,7 @@ func TestGetSchedulerVersions(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 
 		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
-		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, nil)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
 
 		schedulerStorage.EXPECT().GetSchedulerVersions(gomock.Any(), gomock.Any()).Return(nil, errors.NewErrInvalidArgument("Error"))
 
@@ -462,6 +463,100 @@ func TestGetSchedulerVersions(t *testing.T) {
 
 }
 
+func TestGetSchedulerVersionsDiff(t *testing.T) {
+	t.Run("with valid request and persisted scheduler versions", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
+
+		fromScheduler := &entities.Scheduler{
+			Name:     "scheduler",
+			Game:     "game",
+			MaxSurge: "10%",
+			Spec: game_room.Spec{
+				Version:    "v1.0.0",
+				Containers: []game_room.Container{{Name: "default", Image: "image:v1"}},
+			},
+		}
+		toScheduler := &entities.Scheduler{
+			Name:     "scheduler",
+			Game:     "game",
+			MaxSurge: "20%",
+			Spec: game_room.Spec{
+				Version:    "v2.0.0",
+				Containers: []game_room.Container{{Name: "default", Image: "image:v2"}},
+			},
+		}
+
+		schedulerStorage.EXPECT().GetSchedulerWithFilter(gomock.Any(), &filters.SchedulerFilter{Name: "scheduler", Version: "v1.0.0"}).Return(fromScheduler, nil)
+		schedulerStorage.EXPECT().GetSchedulerWithFilter(gomock.Any(), &filters.SchedulerFilter{Name: "scheduler", Version: "v2.0.0"}).Return(toScheduler, nil)
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterSchedulersServiceHandlerServer(context.Background(), mux, ProvideSchedulersHandler(schedulerManager))
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("GET", "/schedulers/scheduler/versions/diff?fromVersion=v1.0.0&toVersion=v2.0.0", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		responseBody, expectedResponseBody := extractBodyForComparison(t, rr.Body.Bytes(), "schedulers_handler/versions_diff_success.json")
+		require.Equal(t, expectedResponseBody, responseBody)
+	})
+
+	t.Run("with valid request and no scheduler version found", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
+
+		schedulerStorage.EXPECT().GetSchedulerWithFilter(gomock.Any(), gomock.Any()).Return(nil, errors.NewErrNotFound("scheduler NonExistentScheduler not found"))
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterSchedulersServiceHandlerServer(context.Background(), mux, ProvideSchedulersHandler(schedulerManager))
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("GET", "/schedulers/NonExistentScheduler/versions/diff?fromVersion=v1.0.0&toVersion=v2.0.0", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("without the versions to compare", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterSchedulersServiceHandlerServer(context.Background(), mux, ProvideSchedulersHandler(schedulerManager))
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("GET", "/schedulers/scheduler/versions/diff?fromVersion=v1.0.0", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
 func TestCreateScheduler(t *testing.T) {
 
 	err := validations.RegisterValidations()
@@ -475,7 +570,7 @@ func TestCreateScheduler(t *testing.T) {
 		operationManager := mock.NewMockOperationManager(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
 		scheduler := &entities.Scheduler{
 			Name:          "scheduler-name-1",
@@ -588,7 +683,7 @@ func TestCreateScheduler(t *testing.T) {
 	})
 
 	t.Run("with failure", func(t *testing.T) {
-		schedulerManager := schedulers.NewSchedulerManager(nil, nil, nil, nil)
+		schedulerManager := schedulers.NewSchedulerManager(nil, nil, nil, nil, nil)
 
 		mux := runtime.NewServeMux()
 		err := api.RegisterSchedulersServiceHandlerServer(context.Background(), mux, ProvideSchedulersHandler(schedulerManager))
@@ -634,7 +729,7 @@ func TestCreateScheduler(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
-		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, roomStorage)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, roomStorage, nil)
 
 		schedulerStorage.EXPECT().CreateScheduler(gomock.Any(), gomock.Any()).Return(errors.NewErrAlreadyExists("error creating scheduler %s: name already exists", "scheduler"))
 
@@ -680,9 +775,9 @@ func TestNewSchedulerVersion(t *testing.T) {
 		operationManager := mock.NewMockOperationManager(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
-		operationManager.EXPECT().CreateOperation(gomock.Any(), "scheduler-name-1", gomock.Any()).Return(&operation.Operation{ID: "id-1"}, nil)
+		operationManager.EXPECT().CreateOperationWithIdempotencyKey(gomock.Any(), "scheduler-name-1", "", gomock.Any()).Return(&operation.Operation{ID: "id-1"}, nil)
 		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), "scheduler-name-1").Return(currentScheduler, nil)
 
 		mux := runtime.NewServeMux()
@@ -707,11 +802,80 @@ func TestNewSchedulerVersion(t *testing.T) {
 		require.NotEmpty(t, body["operationId"])
 	})
 
+	t.Run("with success using the idempotency key header", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+
+		currentScheduler := newValidScheduler()
+		currentScheduler.PortRange = &port.PortRange{Start: 1, End: 2}
+
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		operationManager := mock.NewMockOperationManager(mockCtrl)
+		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
+		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
+
+		operationManager.EXPECT().CreateOperationWithIdempotencyKey(gomock.Any(), "scheduler-name-1", "some-key", gomock.Any()).Return(&operation.Operation{ID: "id-1"}, nil)
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), "scheduler-name-1").Return(currentScheduler, nil)
+
+		mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(IncomingHeaderMatcher))
+		err := api.RegisterSchedulersServiceHandlerServer(context.Background(), mux, ProvideSchedulersHandler(schedulerManager))
+		require.NoError(t, err)
+
+		request, err := os.ReadFile(fixturesRelativePath + "/request/scheduler-config.json")
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPost, "/schedulers/scheduler-name-1", bytes.NewReader(request))
+		require.NoError(t, err)
+		req.Header.Set(IdempotencyKeyHeader, "some-key")
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, 200, rr.Code)
+		var body map[string]interface{}
+		err = json.Unmarshal(rr.Body.Bytes(), &body)
+		require.NoError(t, err)
+
+		require.Equal(t, "id-1", body["operationId"])
+	})
+
+	t.Run("fails when the idempotency key is being used by another request", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+
+		currentScheduler := newValidScheduler()
+		currentScheduler.PortRange = &port.PortRange{Start: 1, End: 2}
+
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		operationManager := mock.NewMockOperationManager(mockCtrl)
+		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
+		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
+
+		operationManager.EXPECT().CreateOperationWithIdempotencyKey(gomock.Any(), "scheduler-name-1", "some-key", gomock.Any()).Return(nil, errors.NewErrConflict("an operation with the idempotency key some-key is already being created"))
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), "scheduler-name-1").Return(currentScheduler, nil)
+
+		mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(IncomingHeaderMatcher))
+		err := api.RegisterSchedulersServiceHandlerServer(context.Background(), mux, ProvideSchedulersHandler(schedulerManager))
+		require.NoError(t, err)
+
+		request, err := os.ReadFile(fixturesRelativePath + "/request/scheduler-config.json")
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPost, "/schedulers/scheduler-name-1", bytes.NewReader(request))
+		require.NoError(t, err)
+		req.Header.Set(IdempotencyKeyHeader, "some-key")
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, 409, rr.Code)
+	})
+
 	t.Run("fails when scheduler does not exists", func(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
-		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, roomStorage)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, roomStorage, nil)
 
 		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), "scheduler-name-1").Return(nil, errors.NewErrNotFound("err"))
 
@@ -744,9 +908,9 @@ func TestNewSchedulerVersion(t *testing.T) {
 		operationManager := mock.NewMockOperationManager(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
-		operationManager.EXPECT().CreateOperation(gomock.Any(), "scheduler-name-1", gomock.Any()).Return(nil, errors.NewErrUnexpected("storage offline"))
+		operationManager.EXPECT().CreateOperationWithIdempotencyKey(gomock.Any(), "scheduler-name-1", "", gomock.Any()).Return(nil, errors.NewErrUnexpected("storage offline"))
 		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), "scheduler-name-1").Return(currentScheduler, nil)
 
 		mux := runtime.NewServeMux()
@@ -780,8 +944,9 @@ func TestSwitchActiveVersion(t *testing.T) {
 		operationManager := mock.NewMockOperationManager(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
+		schedulerStorage.EXPECT().GetSchedulerVersions(gomock.Any(), "scheduler-name-1").Return([]*entities.SchedulerVersion{{Version: "v2.0.0"}}, nil)
 		operationManager.EXPECT().CreateOperation(gomock.Any(), "scheduler-name-1", gomock.Any()).Return(&operation.Operation{ID: "id-1"}, nil)
 
 		mux := runtime.NewServeMux()
@@ -809,8 +974,9 @@ func TestSwitchActiveVersion(t *testing.T) {
 		operationManager := mock.NewMockOperationManager(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
+		schedulerStorage.EXPECT().GetSchedulerVersions(gomock.Any(), "scheduler-name-1").Return([]*entities.SchedulerVersion{{Version: "v2.0.0"}}, nil)
 		operationManager.EXPECT().CreateOperation(gomock.Any(), "scheduler-name-1", gomock.Any()).Return(nil, errors.NewErrUnexpected("internal error"))
 
 		mux := runtime.NewServeMux()
@@ -826,6 +992,100 @@ func TestSwitchActiveVersion(t *testing.T) {
 		require.Equal(t, 500, rr.Code)
 		require.Contains(t, rr.Body.String(), "internal error")
 	})
+
+	t.Run("fails when the version is blocked", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		operationManager := mock.NewMockOperationManager(mockCtrl)
+		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
+		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
+
+		schedulerStorage.EXPECT().GetSchedulerVersions(gomock.Any(), "scheduler-name-1").Return([]*entities.SchedulerVersion{{Version: "v2.0.0", Blocked: true}}, nil)
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterSchedulersServiceHandlerServer(context.Background(), mux, ProvideSchedulersHandler(schedulerManager))
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPut, "/schedulers/scheduler-name-1", bytes.NewReader([]byte("{\"version\": \"v2.0.0\"}")))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, 400, rr.Code)
+		require.Contains(t, rr.Body.String(), "is blocked")
+	})
+}
+
+func TestPatchSchedulerVersion(t *testing.T) {
+	t.Run("with success", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
+
+		createdAt, _ := time.Parse(time.RFC3339Nano, "2020-01-01T00:00:00.001Z")
+		schedulerStorage.EXPECT().GetSchedulerVersions(gomock.Any(), "scheduler-name-1").Return([]*entities.SchedulerVersion{{Version: "v1.0.0", CreatedAt: createdAt}}, nil)
+		schedulerStorage.EXPECT().UpdateSchedulerVersion(gomock.Any(), "scheduler-name-1", gomock.Any()).Return(nil)
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterSchedulersServiceHandlerServer(context.Background(), mux, ProvideSchedulersHandler(schedulerManager))
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPatch, "/schedulers/scheduler-name-1/versions/v1.0.0", bytes.NewReader([]byte("{\"pinned\": true, \"blocked\": true}")))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, 200, rr.Code)
+		responseBody, expectedResponseBody := extractBodyForComparison(t, rr.Body.Bytes(), "schedulers_handler/patch_scheduler_version_success.json")
+		require.Equal(t, expectedResponseBody, responseBody)
+	})
+
+	t.Run("fails when the version is active and is being blocked", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
+
+		schedulerStorage.EXPECT().GetSchedulerVersions(gomock.Any(), "scheduler-name-1").Return([]*entities.SchedulerVersion{{Version: "v1.0.0", IsActive: true}}, nil)
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterSchedulersServiceHandlerServer(context.Background(), mux, ProvideSchedulersHandler(schedulerManager))
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPatch, "/schedulers/scheduler-name-1/versions/v1.0.0", bytes.NewReader([]byte("{\"blocked\": true}")))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, 400, rr.Code)
+	})
+
+	t.Run("fails when the version does not exist", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
+
+		schedulerStorage.EXPECT().GetSchedulerVersions(gomock.Any(), "scheduler-name-1").Return([]*entities.SchedulerVersion{{Version: "v1.0.0"}}, nil)
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterSchedulersServiceHandlerServer(context.Background(), mux, ProvideSchedulersHandler(schedulerManager))
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPatch, "/schedulers/scheduler-name-1/versions/v2.0.0", bytes.NewReader([]byte("{\"pinned\": true}")))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, 404, rr.Code)
+	})
 }
 
 func TestGetSchedulersInfo(t *testing.T) {
@@ -834,7 +1094,8 @@ func TestGetSchedulersInfo(t *testing.T) {
 
 		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
-		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, roomStorage)
+		operationManager := mockports.NewMockOperationManager(mockCtrl)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, operationManager, roomStorage, nil)
 
 		scheduler := newValidScheduler()
 		scheduler.Autoscaling = &autoscaling.Autoscaling{
@@ -849,6 +1110,10 @@ func TestGetSchedulersInfo(t *testing.T) {
 		roomStorage.EXPECT().GetRoomCountByStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(10, nil)
 		roomStorage.EXPECT().GetRoomCountByStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(15, nil)
 		roomStorage.EXPECT().GetRoomCountByStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(20, nil)
+		operationManager.EXPECT().GetSchedulerOperationsPause(gomock.Any(), scheduler.Name).Return(&operation.OperationsPause{
+			Reason:   "incident",
+			PausedAt: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+		}, nil)
 
 		mux := runtime.NewServeMux()
 		err := api.RegisterSchedulersServiceHandlerServer(context.Background(), mux, ProvideSchedulersHandler(schedulerManager))
@@ -871,7 +1136,7 @@ func TestGetSchedulersInfo(t *testing.T) {
 	t.Run("with valid request and no scheduler and game rooms found", func(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
-		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, nil)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
 		schedulerStorage.EXPECT().GetSchedulersWithFilter(gomock.Any(), gomock.Any()).Return(nil, errors.NewErrNotFound("err"))
 
 		mux := runtime.NewServeMux()
@@ -895,7 +1160,7 @@ func TestGetSchedulersInfo(t *testing.T) {
 	t.Run("with unknown error", func(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
-		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, nil)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
 		schedulerStorage.EXPECT().GetSchedulersWithFilter(gomock.Any(), gomock.Any()).Return(nil, errors.NewErrUnexpected("exception"))
 
 		mux := runtime.NewServeMux()
@@ -1092,7 +1357,7 @@ func TestPatchScheduler(t *testing.T) {
 
 			schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
 			operationManager := mock.NewMockOperationManager(mockCtrl)
-			schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, operationManager, nil)
+			schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, operationManager, nil, nil)
 
 			schedulerStorage.EXPECT().
 				GetScheduler(gomock.Any(), "scheduler-name-1").
@@ -1100,7 +1365,7 @@ func TestPatchScheduler(t *testing.T) {
 				AnyTimes()
 
 			operationManager.EXPECT().
-				CreateOperation(gomock.Any(), "scheduler-name-1", gomock.Any()).
+				CreateOperationWithIdempotencyKey(gomock.Any(), "scheduler-name-1", "", gomock.Any()).
 				Return(testCase.Mocks.CreateOperationReturn, testCase.Mocks.CreateOperationError).
 				AnyTimes()
 
@@ -1130,6 +1395,150 @@ func TestPatchScheduler(t *testing.T) {
 	}
 }
 
+func TestSchedulerDryRun(t *testing.T) {
+	err := validations.RegisterValidations()
+	if err != nil {
+		t.Errorf("unexpected error %d'", err)
+	}
+
+	t.Run("new scheduler version with dry run validates without creating the operation", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+
+		currentScheduler := newValidScheduler()
+		currentScheduler.PortRange = &port.PortRange{Start: 1, End: 2}
+
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		operationManager := mock.NewMockOperationManager(mockCtrl)
+		runtimeMock := mockports.NewMockRuntime(mockCtrl)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, operationManager, nil, runtimeMock)
+
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), "scheduler-name-1").Return(currentScheduler, nil)
+		runtimeMock.EXPECT().DryRunGameRoomInstance(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		operationManager.EXPECT().CreateOperationWithIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterSchedulersServiceHandlerServer(context.Background(), mux, ProvideSchedulersHandler(schedulerManager))
+		require.NoError(t, err)
+
+		request, err := os.ReadFile(fixturesRelativePath + "/request/scheduler-config.json")
+		require.NoError(t, err)
+		var requestBody map[string]interface{}
+		require.NoError(t, json.Unmarshal(request, &requestBody))
+		requestBody["dryRun"] = true
+		request, err = json.Marshal(requestBody)
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPost, "/schedulers/scheduler-name-1", bytes.NewReader(request))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, 200, rr.Code)
+		var body map[string]interface{}
+		err = json.Unmarshal(rr.Body.Bytes(), &body)
+		require.NoError(t, err)
+
+		require.Empty(t, body["operationId"])
+		require.NotNil(t, body["dryRunResult"])
+	})
+
+	t.Run("new scheduler version with dry run fails when the runtime rejects the game room", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+
+		currentScheduler := newValidScheduler()
+		currentScheduler.PortRange = &port.PortRange{Start: 1, End: 2}
+
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		runtimeMock := mockports.NewMockRuntime(mockCtrl)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, nil, runtimeMock)
+
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), "scheduler-name-1").Return(currentScheduler, nil)
+		runtimeMock.EXPECT().DryRunGameRoomInstance(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.NewErrInvalidArgument("invalid game room spec"))
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterSchedulersServiceHandlerServer(context.Background(), mux, ProvideSchedulersHandler(schedulerManager))
+		require.NoError(t, err)
+
+		request, err := os.ReadFile(fixturesRelativePath + "/request/scheduler-config.json")
+		require.NoError(t, err)
+		var requestBody map[string]interface{}
+		require.NoError(t, json.Unmarshal(request, &requestBody))
+		requestBody["dryRun"] = true
+		request, err = json.Marshal(requestBody)
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPost, "/schedulers/scheduler-name-1", bytes.NewReader(request))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("new scheduler version with dry run is unavailable without runtime", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+
+		currentScheduler := newValidScheduler()
+		currentScheduler.PortRange = &port.PortRange{Start: 1, End: 2}
+
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
+
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), "scheduler-name-1").Return(currentScheduler, nil)
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterSchedulersServiceHandlerServer(context.Background(), mux, ProvideSchedulersHandler(schedulerManager))
+		require.NoError(t, err)
+
+		request, err := os.ReadFile(fixturesRelativePath + "/request/scheduler-config.json")
+		require.NoError(t, err)
+		var requestBody map[string]interface{}
+		require.NoError(t, json.Unmarshal(request, &requestBody))
+		requestBody["dryRun"] = true
+		request, err = json.Marshal(requestBody)
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPost, "/schedulers/scheduler-name-1", bytes.NewReader(request))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	})
+
+	t.Run("patch scheduler with dry run validates without creating the operation", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		operationManager := mock.NewMockOperationManager(mockCtrl)
+		runtimeMock := mockports.NewMockRuntime(mockCtrl)
+		schedulerManager := schedulers.NewSchedulerManager(schedulerStorage, nil, operationManager, nil, runtimeMock)
+
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), "scheduler-name-1").DoAndReturn(func(_ context.Context, _ string) (*entities.Scheduler, error) {
+			return newValidScheduler(), nil
+		}).Times(2)
+		runtimeMock.EXPECT().DryRunGameRoomInstance(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		operationManager.EXPECT().CreateOperationWithIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterSchedulersServiceHandlerServer(context.Background(), mux, ProvideSchedulersHandler(schedulerManager))
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPatch, "/schedulers/scheduler-name-1", bytes.NewReader([]byte(`{"maxSurge": "50%", "dryRun": true}`)))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		responseBody, expectedResponseBody := extractBodyForComparison(t, rr.Body.Bytes(), "schedulers_handler/patch_scheduler_dry_run_response.json")
+		require.Equal(t, expectedResponseBody, responseBody)
+	})
+}
+
 func TestDeleteScheduler(t *testing.T) {
 	t.Run("with valid request and persisted schedulers it returns success", func(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
@@ -1284,3 +1693,131 @@ func newValidScheduler() *entities.Scheduler {
 		Labels:      labels,
 	}
 }
+
+func TestApplySchedulers(t *testing.T) {
+	err := validations.RegisterValidations()
+	require.NoError(t, err)
+
+	t.Run("with dry run it returns the plan", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		schedulerManager := mockports.NewMockSchedulerManager(mockCtrl)
+
+		schedulerManager.EXPECT().PlanSchedulersApply(gomock.Any(), "game-name", gomock.Any(), true).
+			DoAndReturn(func(_ context.Context, _ string, schedulers []*entities.Scheduler, _ bool) ([]*entities.SchedulerApplyStep, error) {
+				require.Len(t, schedulers, 1)
+				require.Equal(t, "game-name", schedulers[0].Game)
+
+				return []*entities.SchedulerApplyStep{
+					{
+						SchedulerName: "scheduler-name-1",
+						Action:        entities.SchedulerApplyActionUpdate,
+						Diff: &entities.SchedulerDiff{
+							IsMajor: false,
+							Changes: []*entities.SchedulerFieldChange{{Path: "RoomsReplicas", OldValue: "5", NewValue: "6"}},
+						},
+					},
+					{SchedulerName: "scheduler-name-2", Action: entities.SchedulerApplyActionDelete},
+				}, nil
+			})
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterSchedulersServiceHandlerServer(context.Background(), mux, ProvideSchedulersHandler(schedulerManager))
+		require.NoError(t, err)
+
+		request, err := os.ReadFile(fixturesRelativePath + "/request/apply-schedulers.json")
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPost, "/games/game-name/schedulers/apply", bytes.NewReader(request))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		response := &api.ApplySchedulersResponse{}
+		require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), response))
+		require.Len(t, response.Steps, 2)
+		require.Equal(t, "update", response.Steps[0].Action)
+		require.Len(t, response.Steps[0].Changes, 1)
+		require.Equal(t, "RoomsReplicas", response.Steps[0].Changes[0].Path)
+		require.Equal(t, "delete", response.Steps[1].Action)
+		require.Empty(t, response.Steps[1].OperationId)
+	})
+
+	t.Run("without dry run it applies the schedulers", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		schedulerManager := mockports.NewMockSchedulerManager(mockCtrl)
+
+		schedulerManager.EXPECT().ApplySchedulers(gomock.Any(), "game-name", gomock.Any(), true).Return([]*entities.SchedulerApplyStep{
+			{SchedulerName: "scheduler-name-1", Action: entities.SchedulerApplyActionCreate, OperationID: "op-1"},
+			{SchedulerName: "scheduler-name-2", Action: entities.SchedulerApplyActionDelete, Error: "failed to enqueue"},
+		}, nil)
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterSchedulersServiceHandlerServer(context.Background(), mux, ProvideSchedulersHandler(schedulerManager))
+		require.NoError(t, err)
+
+		request, err := os.ReadFile(fixturesRelativePath + "/request/apply-schedulers.json")
+		require.NoError(t, err)
+		request = bytes.Replace(request, []byte(`"dryRun": true`), []byte(`"dryRun": false`), 1)
+
+		req, err := http.NewRequest(http.MethodPost, "/games/game-name/schedulers/apply", bytes.NewReader(request))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		response := &api.ApplySchedulersResponse{}
+		require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), response))
+		require.Len(t, response.Steps, 2)
+		require.Equal(t, "op-1", response.Steps[0].OperationId)
+		require.Equal(t, "failed to enqueue", response.Steps[1].Error)
+	})
+
+	t.Run("without prune it doesn't ask to delete the schedulers not listed", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		schedulerManager := mockports.NewMockSchedulerManager(mockCtrl)
+
+		schedulerManager.EXPECT().PlanSchedulersApply(gomock.Any(), "game-name", gomock.Any(), false).Return([]*entities.SchedulerApplyStep{
+			{SchedulerName: "scheduler-name-1", Action: entities.SchedulerApplyActionNone},
+		}, nil)
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterSchedulersServiceHandlerServer(context.Background(), mux, ProvideSchedulersHandler(schedulerManager))
+		require.NoError(t, err)
+
+		request, err := os.ReadFile(fixturesRelativePath + "/request/apply-schedulers.json")
+		require.NoError(t, err)
+		request = bytes.Replace(request, []byte(`"prune": true`), []byte(`"prune": false`), 1)
+
+		req, err := http.NewRequest(http.MethodPost, "/games/game-name/schedulers/apply", bytes.NewReader(request))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("with invalid schedulers it returns invalid argument", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		schedulerManager := mockports.NewMockSchedulerManager(mockCtrl)
+
+		schedulerManager.EXPECT().PlanSchedulersApply(gomock.Any(), "game-name", gomock.Any(), gomock.Any()).
+			Return(nil, portsErrors.NewErrInvalidArgument("scheduler scheduler-name-1 is declared more than once"))
+
+		mux := runtime.NewServeMux()
+		err := api.RegisterSchedulersServiceHandlerServer(context.Background(), mux, ProvideSchedulersHandler(schedulerManager))
+		require.NoError(t, err)
+
+		request, err := os.ReadFile(fixturesRelativePath + "/request/apply-schedulers.json")
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPost, "/games/game-name/schedulers/apply", bytes.NewReader(request))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -39,4 +39,7 @@ type Config interface {
 	GetBool(string) bool
 	// GetDuration returns a time.Duration of the config. Default: 0
 	GetDuration(string) time.Duration
+	// GetStringSlice returns the configuration path as a slice of strings.
+	// Default: nil
+	GetStringSlice(string) []string
 }
@@ -22,6 +22,8 @@
 
 package events
 
+import "time"
+
 // EventName defines the events service possible events.
 type EventName string
 
@@ -32,6 +34,10 @@ var (
 
 // Event struct that holds information about the events.
 type Event struct {
+	// ID identifies the event, it is kept by all its deliveries and retries.
+	ID string
+	// CreatedAt is when the event was recorded.
+	CreatedAt   time.Time
 	Name        EventName
 	SchedulerID string
 	RoomID      string
@@ -0,0 +1,62 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package events
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ForwardingError reports the forwarders that failed to forward an event.
+type ForwardingError struct {
+	// Failures has the error returned by each failed forwarder, by its name.
+	Failures map[string]error
+}
+
+func (e *ForwardingError) Error() string {
+	names := e.ForwarderNames()
+	failures := make([]string, len(names))
+	for i, name := range names {
+		failures[i] = fmt.Sprintf("%s: %s", name, e.Failures[name])
+	}
+	return fmt.Sprintf("failed to forward event to %d forwarder(s): %s", len(names), strings.Join(failures, "; "))
+}
+
+func (e *ForwardingError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Failures))
+	for _, name := range e.ForwarderNames() {
+		errs = append(errs, e.Failures[name])
+	}
+	return errs
+}
+
+// ForwarderNames returns the names of the failed forwarders, sorted.
+func (e *ForwardingError) ForwarderNames() []string {
+	names := make([]string, 0, len(e.Failures))
+	for name := range e.Failures {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,49 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package events
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestForwardingError(t *testing.T) {
+	errUnavailable := errors.New("unavailable")
+	errTimeout := errors.New("timeout")
+	err := &ForwardingError{Failures: map[string]error{
+		"fwd-b": errTimeout,
+		"fwd-a": errUnavailable,
+	}}
+
+	t.Run("lists the failed forwarders sorted by name", func(t *testing.T) {
+		require.Equal(t, []string{"fwd-a", "fwd-b"}, err.ForwarderNames())
+		require.EqualError(t, err, "failed to forward event to 2 forwarder(s): fwd-a: unavailable; fwd-b: timeout")
+	})
+
+	t.Run("wraps the forwarders errors", func(t *testing.T) {
+		require.ErrorIs(t, err, errUnavailable)
+		require.ErrorIs(t, err, errTimeout)
+	})
+}
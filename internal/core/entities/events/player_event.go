@@ -22,14 +22,21 @@
 
 package events
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 type PlayerEventAttributes struct {
-	RoomId    string
-	PlayerId  string
-	EventType PlayerEventType
-	Game      string
-	Other     map[string]interface{}
+	RoomId      string
+	PlayerId    string
+	EventType   PlayerEventType
+	Game        string
+	SchedulerID string
+	Other       map[string]interface{}
+	// EventID and CreatedAt are the ID and recording time of the event.
+	EventID   string
+	CreatedAt time.Time
 }
 
 type PlayerEventType string
@@ -25,16 +25,21 @@ package events
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 type RoomEventAttributes struct {
 	Game           string
+	SchedulerID    string
 	RoomId         string
 	Host           string
 	Port           int32
 	EventType      RoomEventType
 	RoomStatusType *RoomStatusType
 	Other          map[string]interface{}
+	// EventID and CreatedAt are the ID and recording time of the event.
+	EventID   string
+	CreatedAt time.Time
 }
 
 type RoomEventType string
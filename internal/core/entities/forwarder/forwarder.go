@@ -25,14 +25,16 @@ package forwarder
 type ForwardType string
 
 const (
-	TypeGrpc ForwardType = "gRPC"
+	TypeGrpc   ForwardType = "gRPC"
+	TypeHTTP   ForwardType = "http"
+	TypeBroker ForwardType = "broker"
 )
 
 type Forwarder struct {
 	Name        string `validate:"required"`
 	Enabled     bool
 	ForwardType ForwardType `validate:"required,forwarder_type"`
-	Address     string      `validate:"required"`
+	Address     string      `validate:"required,forwarder_address=ForwardType"`
 	Options     *ForwardOptions
 }
 
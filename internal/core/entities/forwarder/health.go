@@ -0,0 +1,85 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package forwarder
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// CircuitState is the state of the circuit breaker of a forwarder address.
+type CircuitState string
+
+const (
+	// CircuitClosed forwards the events, the forwarder is healthy.
+	CircuitClosed CircuitState = "closed"
+	// CircuitOpen rejects the events without calling the forwarder, after it
+	// failed too many times in a row.
+	CircuitOpen CircuitState = "open"
+	// CircuitHalfOpen lets a few events through to check if the forwarder
+	// recovered.
+	CircuitHalfOpen CircuitState = "half-open"
+)
+
+// ErrCircuitOpen is matched by the errors of the events rejected because the
+// circuit of the forwarder address is open.
+var ErrCircuitOpen = errors.New("forwarder circuit breaker is open")
+
+// CircuitOpenError rejects an event without calling the forwarder, it can be
+// forwarded again after RetryAt.
+type CircuitOpenError struct {
+	ForwarderName string
+	Address       string
+	RetryAt       time.Time
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker of forwarder \"%s\" at \"%s\" is open", e.ForwarderName, e.Address)
+}
+
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// Health is the circuit breaker view of a forwarder address.
+type Health struct {
+	// ForwarderName is the scheduler forwarder name, it is only set when
+	// listing the health of the scheduler forwarders.
+	ForwarderName       string
+	Address             string
+	State               CircuitState
+	ConsecutiveFailures int
+	LastError           string
+	LastFailureAt       time.Time
+	UpdatedAt           time.Time
+}
+
+// NewHealthyForwarderHealth returns the health of a forwarder address without
+// failures.
+func NewHealthyForwarderHealth(address string) *Health {
+	return &Health{
+		Address: address,
+		State:   CircuitClosed,
+	}
+}
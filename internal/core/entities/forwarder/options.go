@@ -29,6 +29,194 @@ import (
 type ForwardOptions struct {
 	Timeout  time.Duration `validate:"required"`
 	Metadata map[string]interface{}
+	// GRPC configures the connection of forwarders with the gRPC type.
+	GRPC *GRPCOptions
+	// HTTP configures the requests of forwarders with the http type.
+	HTTP *HTTPOptions
+	// Broker configures the messages of forwarders with the broker type.
+	Broker *BrokerOptions
+	// Filter selects the events sent to the forwarder, every event is sent
+	// when it is empty.
+	Filter *FilterOptions
+	// Transform changes the event metadata sent to the forwarder.
+	Transform *TransformOptions
+	// BestEffort forwarders failures are only logged, instead of being
+	// reported back to the room that produced the event.
+	BestEffort bool
+	// CloudEvents, when set, sends the events of http and broker forwarders
+	// as CloudEvents.
+	CloudEvents *CloudEventsOptions
+}
+
+// GRPCOptions has the connection configuration of gRPC forwarders. The
+// secrets aren't kept on the scheduler, they're referenced by the name they
+// have on the Maestro config.
+type GRPCOptions struct {
+	// TLS, when set, connects to the forwarder with TLS. It is required to
+	// send credentials.
+	TLS *TLSOptions `validate:"required_with=Auth"`
+	// Auth, when set, sends credentials on the metadata of every request.
+	Auth *AuthOptions
+}
+
+// TLSOptions has the TLS configuration of gRPC forwarders.
+type TLSOptions struct {
+	// CASecretRef is the CA certificate used to verify the forwarder
+	// certificate, the system CAs are used when it is empty.
+	CASecretRef string `validate:"omitempty,alphanum"`
+	// CertSecretRef is the client certificate sent for mTLS.
+	CertSecretRef string `validate:"required_with=KeySecretRef,omitempty,alphanum"`
+	// KeySecretRef is the client certificate private key.
+	KeySecretRef string `validate:"required_with=CertSecretRef,omitempty,alphanum"`
+	// ServerName overrides the host name used to verify the forwarder
+	// certificate.
+	ServerName string
+}
+
+type AuthType string
+
+const (
+	// AuthBearer sends the secret as a bearer token on the authorization
+	// metadata.
+	AuthBearer AuthType = "bearer"
+	// AuthAPIKey sends the secret as an API key on the auth header metadata.
+	AuthAPIKey AuthType = "apiKey"
+)
+
+// DefaultAPIKeyHeader is the metadata key of API keys without header.
+const DefaultAPIKeyHeader = "x-api-key"
+
+// AuthOptions has the credentials sent to gRPC forwarders.
+type AuthOptions struct {
+	Type AuthType `validate:"required,oneof=bearer apiKey"`
+	// SecretRef is the token or API key sent.
+	SecretRef string `validate:"required,alphanum"`
+	// Header is the metadata key of API keys, defaults to x-api-key.
+	Header string
+}
+
+// HTTPOptions has the request configuration of http forwarders.
+type HTTPOptions struct {
+	// Headers are added to every request sent to the forwarder.
+	Headers map[string]string
+	// SigningSecretRef, when set, references the secret used to sign the
+	// request body with HMAC-SHA256, so the receiver can check the events were
+	// sent by Maestro. As the gRPC secrets, it is referenced by the name it has
+	// on the Maestro config.
+	SigningSecretRef string `validate:"omitempty,alphanum"`
+}
+
+type BrokerKey string
+
+const (
+	// BrokerKeyRoom publishes the events keyed by the room ID, keeping the
+	// order of the events of each room.
+	BrokerKeyRoom BrokerKey = "room"
+	// BrokerKeyScheduler publishes the events keyed by the scheduler name,
+	// keeping the order of the events of each scheduler.
+	BrokerKeyScheduler BrokerKey = "scheduler"
+)
+
+// BrokerOptions has the message configuration of broker forwarders, which
+// publish the events on the topic set as the forwarder address.
+type BrokerOptions struct {
+	// Key is the event field used as the message key, defaults to the room.
+	Key BrokerKey `validate:"omitempty,oneof=room scheduler"`
+}
+
+type CloudEventsMode string
+
+const (
+	// CloudEventsStructured sends the whole CloudEvent, with its attributes
+	// and data, as the event payload.
+	CloudEventsStructured CloudEventsMode = "structured"
+	// CloudEventsBinary sends the data as the event payload and the
+	// CloudEvent attributes as headers.
+	CloudEventsBinary CloudEventsMode = "binary"
+)
+
+// CloudEventsOptions has the CloudEvents 1.0 configuration of http and broker
+// forwarders.
+type CloudEventsOptions struct {
+	// Mode is the CloudEvents content mode, defaults to structured.
+	Mode CloudEventsMode `validate:"omitempty,oneof=structured binary"`
+}
+
+// FilterOptions selects the events sent to a forwarder. An event is sent when
+// it matches every non-empty list.
+type FilterOptions struct {
+	// EventNames are the event names sent, "RoomEvent" and/or "PlayerEvent".
+	EventNames []string `validate:"omitempty,dive,oneof=RoomEvent PlayerEvent"`
+	// EventTypes are the event types sent. Player events match their type
+	// (e.g. playerLeft) and room events match either their type (e.g.
+	// status) or their room status (e.g. roomTerminated).
+	EventTypes []string `validate:"omitempty,dive,oneof=playerJoin playerLeft resync roomEvent status roomReady roomOccupied roomTerminating roomTerminated"`
+}
+
+// Allows returns whether an event with the name and types is sent.
+func (o *FilterOptions) Allows(eventName string, eventTypes ...string) bool {
+	if o == nil {
+		return true
+	}
+	if len(o.EventNames) > 0 && !contains(o.EventNames, eventName) {
+		return false
+	}
+	if len(o.EventTypes) == 0 {
+		return true
+	}
+	for _, eventType := range eventTypes {
+		if contains(o.EventTypes, eventType) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReservedAttributes are the event metadata keys used to build the forwarded
+// events, so they're kept by the transformation and can't be static
+// attributes.
+var ReservedAttributes = []string{"eventType", "pingType", "roomEvent", "playerId"}
+
+// TransformOptions changes the event metadata sent to a forwarder.
+type TransformOptions struct {
+	// IncludeMetadata, when set, are the only event metadata keys sent.
+	IncludeMetadata []string
+	// DropMetadata are event metadata keys that are not sent.
+	DropMetadata []string
+	// Attributes are static attributes added to the event metadata,
+	// replacing the event values with the same keys, except the reserved
+	// ones.
+	Attributes map[string]string `validate:"dive,keys,forwarder_attribute,endkeys"`
+}
+
+// Apply returns a copy of the event metadata with the transformation
+// applied. The keep keys are never removed nor replaced.
+func (o *TransformOptions) Apply(metadata map[string]interface{}, keep ...string) map[string]interface{} {
+	if o == nil {
+		return metadata
+	}
+
+	transformed := make(map[string]interface{}, len(metadata)+len(o.Attributes))
+	for key, value := range metadata {
+		if contains(keep, key) {
+			transformed[key] = value
+			continue
+		}
+		if len(o.IncludeMetadata) > 0 && !contains(o.IncludeMetadata, key) {
+			continue
+		}
+		if contains(o.DropMetadata, key) {
+			continue
+		}
+		transformed[key] = value
+	}
+	for key, value := range o.Attributes {
+		if contains(keep, key) {
+			continue
+		}
+		transformed[key] = value
+	}
+	return transformed
 }
 
 func NewDefaultForwarderOptions() *ForwardOptions {
@@ -37,3 +225,12 @@ func NewDefaultForwarderOptions() *ForwardOptions {
 		Metadata: map[string]interface{}{},
 	}
 }
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build unit
+// +build unit
+
+package forwarder_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
+)
+
+func TestFilterOptions_Allows(t *testing.T) {
+	tests := map[string]struct {
+		filter     *forwarder.FilterOptions
+		eventName  string
+		eventTypes []string
+		allowed    bool
+	}{
+		"allows every event without filter": {
+			filter:     nil,
+			eventName:  "RoomEvent",
+			eventTypes: []string{"status", "roomReady"},
+			allowed:    true,
+		},
+		"allows events with a listed name": {
+			filter:     &forwarder.FilterOptions{EventNames: []string{"PlayerEvent"}},
+			eventName:  "PlayerEvent",
+			eventTypes: []string{"playerJoin"},
+			allowed:    true,
+		},
+		"filters out events with other names": {
+			filter:     &forwarder.FilterOptions{EventNames: []string{"PlayerEvent"}},
+			eventName:  "RoomEvent",
+			eventTypes: []string{"status", "roomReady"},
+			allowed:    false,
+		},
+		"allows events matching any of their types": {
+			filter:     &forwarder.FilterOptions{EventTypes: []string{"playerLeft", "roomTerminated"}},
+			eventName:  "RoomEvent",
+			eventTypes: []string{"status", "roomTerminated"},
+			allowed:    true,
+		},
+		"filters out events with other types": {
+			filter:     &forwarder.FilterOptions{EventTypes: []string{"playerLeft", "roomTerminated"}},
+			eventName:  "RoomEvent",
+			eventTypes: []string{"status", "roomReady"},
+			allowed:    false,
+		},
+		"requires both name and type to match": {
+			filter:     &forwarder.FilterOptions{EventNames: []string{"RoomEvent"}, EventTypes: []string{"playerLeft"}},
+			eventName:  "PlayerEvent",
+			eventTypes: []string{"playerLeft"},
+			allowed:    false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, test.allowed, test.filter.Allows(test.eventName, test.eventTypes...))
+		})
+	}
+}
+
+func TestTransformOptions_Apply(t *testing.T) {
+	metadata := map[string]interface{}{
+		"eventType": "playerLeft",
+		"playerId":  "player",
+		"region":    "us",
+		"secret":    "value",
+	}
+
+	t.Run("returns the metadata without transform", func(t *testing.T) {
+		var transform *forwarder.TransformOptions
+		require.Equal(t, metadata, transform.Apply(metadata))
+	})
+
+	t.Run("keeps only the included keys and the keep keys", func(t *testing.T) {
+		transform := &forwarder.TransformOptions{IncludeMetadata: []string{"region"}}
+		require.Equal(t, map[string]interface{}{"eventType": "playerLeft", "region": "us"}, transform.Apply(metadata, "eventType"))
+	})
+
+	t.Run("drops the listed keys except the keep keys", func(t *testing.T) {
+		transform := &forwarder.TransformOptions{DropMetadata: []string{"secret", "playerId"}}
+		require.Equal(t, map[string]interface{}{"eventType": "playerLeft", "playerId": "player", "region": "us"}, transform.Apply(metadata, "playerId"))
+	})
+
+	t.Run("adds the static attributes replacing the event values", func(t *testing.T) {
+		transform := &forwarder.TransformOptions{
+			DropMetadata: []string{"secret"},
+			Attributes:   map[string]string{"region": "eu", "team": "matchmaking"},
+		}
+		require.Equal(t, map[string]interface{}{"eventType": "playerLeft", "playerId": "player", "region": "eu", "team": "matchmaking"}, transform.Apply(metadata))
+	})
+
+	t.Run("doesn't replace the keep keys with the static attributes", func(t *testing.T) {
+		transform := &forwarder.TransformOptions{Attributes: map[string]string{"eventType": "playerJoin", "team": "matchmaking"}}
+		require.Equal(t, map[string]interface{}{"eventType": "playerLeft", "playerId": "player", "region": "us", "secret": "value", "team": "matchmaking"}, transform.Apply(metadata, "eventType"))
+	})
+
+	t.Run("doesn't change the event metadata", func(t *testing.T) {
+		transform := &forwarder.TransformOptions{DropMetadata: []string{"secret"}, Attributes: map[string]string{"team": "matchmaking"}}
+		transform.Apply(metadata)
+		require.Len(t, metadata, 4)
+		require.Equal(t, "value", metadata["secret"])
+	})
+}
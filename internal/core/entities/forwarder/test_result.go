@@ -0,0 +1,49 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package forwarder
+
+import "time"
+
+// TestResult is the result of the connectivity test of a forwarder, which
+// receives a synthetic room event and player event.
+type TestResult struct {
+	ForwarderName string
+	Address       string
+	RoomEvent     *EventTestResult
+	PlayerEvent   *EventTestResult
+}
+
+// EventTestResult is the forwarder answer to one of the synthetic events.
+type EventTestResult struct {
+	// Success is true when the forwarder answered the event with the 200
+	// code.
+	Success bool
+	// Latency is how long the forwarder took to answer the event.
+	Latency time.Duration
+	// Code is the response code returned by the forwarder, zero when the
+	// event couldn't be sent.
+	Code int32
+	// Message is the response message returned by the forwarder, or the
+	// error that prevented the event from being sent.
+	Message string
+}
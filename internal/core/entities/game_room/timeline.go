@@ -0,0 +1,70 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package game_room
+
+import "time"
+
+type TimelineEntryKind string
+
+const (
+	// TimelineStatusChanged is recorded when the room status changes.
+	TimelineStatusChanged TimelineEntryKind = "statusChanged"
+	// TimelineEventForwarded is recorded when a room event is sent to a
+	// forwarder, whether it was forwarded or not.
+	TimelineEventForwarded TimelineEntryKind = "eventForwarded"
+	// TimelineDeleted is recorded when the room is deleted.
+	TimelineDeleted TimelineEntryKind = "deleted"
+)
+
+type TimelineSource string
+
+const (
+	// TimelineSourcePing is the status change caused by a room ping.
+	TimelineSourcePing TimelineSource = "ping"
+	// TimelineSourceRuntime is the status change caused by the runtime
+	// instance status.
+	TimelineSourceRuntime TimelineSource = "runtime"
+)
+
+// TimelineEntry is something that happened to a room, kept on its timeline
+// so it can be inspected later.
+type TimelineEntry struct {
+	Kind      TimelineEntryKind
+	Timestamp time.Time
+	// Source is what changed the room status.
+	Source TimelineSource
+	// PreviousStatus and Status are the room statuses before and after the
+	// status change.
+	PreviousStatus string
+	Status         string
+	// EventName and EventType identify the forwarded event, e.g. RoomEvent
+	// and resync.
+	EventName string
+	EventType string
+	// ForwarderName is the forwarder that the event was sent to.
+	ForwarderName string
+	// Error is why the event couldn't be forwarded, empty when it was.
+	Error string
+	// Reason is why the room was deleted.
+	Reason string
+}
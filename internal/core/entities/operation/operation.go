@@ -53,21 +53,67 @@ const (
 	StatusError
 )
 
+// OperationProgress holds how many units of work (e.g. rooms) the operation
+// has to process and how many were already processed.
+type OperationProgress struct {
+	TotalUnits     int
+	CompletedUnits int
+	FailedUnits    int
+	StartedAt      time.Time
+	UpdatedAt      time.Time
+}
+
 type Operation struct {
 	ID               string
 	Status           Status
+	Priority         Priority
 	DefinitionName   string
 	SchedulerName    string
 	Lease            *OperationLease
 	CreatedAt        time.Time
-	Input            []byte           // should be used ony after conversion to its operations.Definition.
-	ExecutionHistory []OperationEvent // should be used only to return information to users.
+	Input            []byte             // should be used ony after conversion to its operations.Definition.
+	ExecutionHistory []OperationEvent   // should be used only to return information to users.
+	Progress         *OperationProgress // optional, only filled by operations that report their progress.
 }
 
 func (o *Operation) SetLease(lease *OperationLease) {
 	o.Lease = lease
 }
 
+// ProcessedUnits returns the number of units that were already processed,
+// with success or not.
+func (p *OperationProgress) ProcessedUnits() int {
+	return p.CompletedUnits + p.FailedUnits
+}
+
+// ETA estimates how long the operation will take to process the remaining
+// units based on the processing rate observed so far. It returns zero when
+// there is not enough information to estimate it.
+func (p *OperationProgress) ETA() time.Duration {
+	processed := p.ProcessedUnits()
+	remaining := p.TotalUnits - processed
+	if processed <= 0 || remaining <= 0 {
+		return 0
+	}
+
+	elapsed := p.UpdatedAt.Sub(p.StartedAt)
+	if elapsed <= 0 {
+		return 0
+	}
+
+	return time.Duration(int64(elapsed) / int64(processed) * int64(remaining))
+}
+
+// IsFinal returns whether the operation can no longer change its status.
+func (s Status) IsFinal() bool {
+	switch s {
+	case StatusFinished, StatusError, StatusCanceled, StatusEvicted:
+		return true
+	}
+
+	return false
+}
+
 func (s Status) String() (string, error) {
 	switch s {
 	case StatusPending:
@@ -0,0 +1,62 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package operation
+
+import "fmt"
+
+// Priority is the class used to order the scheduler pending operations.
+// Operations with a higher priority are executed first, and operations with
+// the same priority are executed in the order they were enqueued.
+type Priority int
+
+const (
+	// PriorityLow operations that can wait for every other one, e.g.
+	// housekeeping.
+	PriorityLow Priority = iota - 1
+	// PriorityNormal default priority, used by most of the operations.
+	PriorityNormal
+	// PriorityHigh operations that keep the scheduler rooms healthy, e.g.
+	// replacing expired rooms and autoscaling.
+	PriorityHigh
+	// PriorityCritical operations that make any other pending one
+	// irrelevant, e.g. deleting the scheduler.
+	PriorityCritical
+)
+
+// Priorities lists every priority, from the highest to the lowest.
+var Priorities = []Priority{PriorityCritical, PriorityHigh, PriorityNormal, PriorityLow}
+
+func (p Priority) String() (string, error) {
+	switch p {
+	case PriorityLow:
+		return "low", nil
+	case PriorityNormal:
+		return "normal", nil
+	case PriorityHigh:
+		return "high", nil
+	case PriorityCritical:
+		return "critical", nil
+	}
+
+	return "", fmt.Errorf("priority could not be mapped to string: %d", p)
+}
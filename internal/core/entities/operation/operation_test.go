@@ -27,6 +27,7 @@ package operation_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/topfreegames/maestro/internal/core/entities/operation"
@@ -94,3 +95,51 @@ func TestNewOperation(t *testing.T) {
 		})
 	}
 }
+
+func TestOperationProgressETA(t *testing.T) {
+	startedAt := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("returns zero when no unit was processed", func(t *testing.T) {
+		progress := &operation.OperationProgress{TotalUnits: 10, StartedAt: startedAt, UpdatedAt: startedAt.Add(time.Minute)}
+
+		assert.Equal(t, time.Duration(0), progress.ETA())
+	})
+
+	t.Run("returns zero when all units were processed", func(t *testing.T) {
+		progress := &operation.OperationProgress{TotalUnits: 10, CompletedUnits: 8, FailedUnits: 2, StartedAt: startedAt, UpdatedAt: startedAt.Add(time.Minute)}
+
+		assert.Equal(t, 10, progress.ProcessedUnits())
+		assert.Equal(t, time.Duration(0), progress.ETA())
+	})
+
+	t.Run("estimates the remaining time based on the processing rate", func(t *testing.T) {
+		progress := &operation.OperationProgress{TotalUnits: 10, CompletedUnits: 3, FailedUnits: 1, StartedAt: startedAt, UpdatedAt: startedAt.Add(4 * time.Minute)}
+
+		assert.Equal(t, 6*time.Minute, progress.ETA())
+	})
+}
+
+func TestStatusIsFinal(t *testing.T) {
+	assert.False(t, operation.StatusPending.IsFinal())
+	assert.False(t, operation.StatusInProgress.IsFinal())
+	assert.True(t, operation.StatusFinished.IsFinal())
+	assert.True(t, operation.StatusError.IsFinal())
+	assert.True(t, operation.StatusCanceled.IsFinal())
+	assert.True(t, operation.StatusEvicted.IsFinal())
+}
+
+func TestPriorityString(t *testing.T) {
+	for priority, expected := range map[operation.Priority]string{
+		operation.PriorityLow:      "low",
+		operation.PriorityNormal:   "normal",
+		operation.PriorityHigh:     "high",
+		operation.PriorityCritical: "critical",
+	} {
+		str, err := priority.String()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, str)
+	}
+
+	_, err := operation.Priority(42).String()
+	assert.Error(t, err)
+}
@@ -0,0 +1,72 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package rollout
+
+// Health is the state the health controller keeps about the rollout of a
+// scheduler version to decide on its automatic rollback. It is stored with
+// the scheduler, so it survives restarts of the workers.
+type Health struct {
+	// Version is the scheduler version being rolled out.
+	Version string
+	// Rooms maps every room observed on the version to whether it failed.
+	// A failed room stays accounted as failed after it is replaced.
+	Rooms map[string]bool
+	// RollbackOperationID is the ID of the switch active version operation
+	// enqueued to roll the version back.
+	RollbackOperationID string
+	// RolledBackFrom is set when the version is being rolled out by an
+	// automatic rollback from another version, in which case it is not rolled
+	// back again.
+	RolledBackFrom string
+}
+
+// NewHealth returns the health of a version rollout with no observed rooms.
+func NewHealth(version string) *Health {
+	return &Health{Version: version, Rooms: map[string]bool{}}
+}
+
+// ObserveRoom accounts the room, returning true if the health changed.
+func (h *Health) ObserveRoom(roomID string, failed bool) bool {
+	alreadyFailed, observed := h.Rooms[roomID]
+	if observed && (alreadyFailed || !failed) {
+		return false
+	}
+
+	if h.Rooms == nil {
+		h.Rooms = map[string]bool{}
+	}
+	h.Rooms[roomID] = failed
+	return true
+}
+
+// FailedRooms returns the number of observed rooms that failed.
+func (h *Health) FailedRooms() int {
+	failedRooms := 0
+	for _, failed := range h.Rooms {
+		if failed {
+			failedRooms++
+		}
+	}
+
+	return failedRooms
+}
@@ -0,0 +1,67 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build unit
+// +build unit
+
+package rollout
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealth_ObserveRoom(t *testing.T) {
+	t.Run("accounts new rooms", func(t *testing.T) {
+		health := NewHealth("v2")
+
+		assert.True(t, health.ObserveRoom("room-1", false))
+		assert.True(t, health.ObserveRoom("room-2", true))
+		assert.Len(t, health.Rooms, 2)
+		assert.Equal(t, 1, health.FailedRooms())
+	})
+
+	t.Run("keeps failed rooms as failed", func(t *testing.T) {
+		health := NewHealth("v2")
+
+		assert.True(t, health.ObserveRoom("room-1", true))
+		assert.False(t, health.ObserveRoom("room-1", false))
+		assert.Equal(t, 1, health.FailedRooms())
+	})
+
+	t.Run("accounts rooms that failed after being observed", func(t *testing.T) {
+		health := NewHealth("v2")
+
+		assert.True(t, health.ObserveRoom("room-1", false))
+		assert.False(t, health.ObserveRoom("room-1", false))
+		assert.True(t, health.ObserveRoom("room-1", true))
+		assert.Equal(t, 1, health.FailedRooms())
+	})
+
+	t.Run("accounts rooms on a health without rooms", func(t *testing.T) {
+		health := &Health{Version: "v2"}
+
+		assert.True(t, health.ObserveRoom("room-1", true))
+		assert.Equal(t, 1, health.FailedRooms())
+	})
+}
@@ -0,0 +1,202 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package rollout
+
+import (
+	"time"
+
+	"github.com/topfreegames/maestro/internal/validations"
+)
+
+// StrategyType represents an enum of possible strategies used to roll out a new major scheduler version.
+type StrategyType string
+
+const (
+	// RollingUpdate replaces every room by a room on the new version, respecting the scheduler max surge.
+	// It is the default strategy when none is configured.
+	RollingUpdate StrategyType = "rollingUpdate"
+	// Canary runs part of the rooms on the new version for a bake period before promoting it.
+	Canary StrategyType = "canary"
+	// BlueGreen creates every room on the new version before switching to it, so different versions never serve players at the same time.
+	BlueGreen StrategyType = "blueGreen"
+)
+
+// Strategy represents the rollout strategy configuration for a scheduler.
+type Strategy struct {
+	// Type indicates the rollout strategy type.
+	Type StrategyType `validate:"oneof=rollingUpdate canary blueGreen"`
+	// Canary represents the parameters for Canary strategy type, it must be provided if Type is Canary.
+	// +optional
+	Canary *CanaryParams `validate:"required_for_canary=Type"`
+	// AutoRollback represents the parameters to switch back to the previous version when the rooms on the new version fail,
+	// it is disabled if not provided.
+	// +optional
+	AutoRollback *AutoRollbackParams
+	// Validation represents how the game rooms of a new major version are validated before the version is created,
+	// a single room reaching ready is required if not provided.
+	// +optional
+	Validation *ValidationParams
+}
+
+// CanaryParams represents the parameters accepted by the canary rollout strategy.
+type CanaryParams struct {
+	// Percentage indicates the percentage of the scheduler rooms that will be created on the new version.
+	// It must be provided if Rooms is not.
+	Percentage int `validate:"min=0,max=100"`
+	// Rooms indicates a fixed number of rooms that will be created on the new version, used instead of Percentage.
+	Rooms int `validate:"required_without=Percentage,excluded_with=Percentage,min=0"`
+	// BakeDuration indicates for how long the canary rooms are watched before promoting the new version.
+	BakeDuration time.Duration `validate:"gt=0"`
+	// FailureThreshold indicates the maximum rate of canary rooms on error or unready at the end of the bake that still promotes the new version.
+	FailureThreshold float64 `validate:"gte=0,lte=1"`
+}
+
+// AutoRollbackParams represents the failure budget of the rooms on a new version before it is rolled back.
+type AutoRollbackParams struct {
+	// FailureThreshold indicates the maximum rate of rooms on the new version on error or expired that doesn't roll back the version.
+	FailureThreshold float64 `validate:"gte=0,lte=1"`
+	// MinRooms indicates how many rooms on the new version must be observed before the failure rate is evaluated.
+	MinRooms int `validate:"min=0"`
+}
+
+// HealthCheckProtocol represents an enum of possible protocols used to check the validation rooms health.
+type HealthCheckProtocol string
+
+const (
+	// HealthCheckHTTP requests the room address and expects a 2xx response.
+	HealthCheckHTTP HealthCheckProtocol = "http"
+	// HealthCheckGRPC calls the standard gRPC health checking service of the room and expects it to be serving.
+	HealthCheckGRPC HealthCheckProtocol = "grpc"
+)
+
+// ValidationParams represents the checks a new major version must pass before being created.
+type ValidationParams struct {
+	// Rooms indicates how many validation rooms are created in parallel, every one of them must pass the checks.
+	// Defaults to one room.
+	Rooms int `validate:"min=0"`
+	// MinReadyDuration indicates for how long the validation rooms must stay ready.
+	MinReadyDuration time.Duration `validate:"min=0"`
+	// HealthCheck represents a check sent to the validation rooms address once they are ready.
+	// +optional
+	HealthCheck *HealthCheckParams
+	// SmokeTest represents a room event forwarded for the validation rooms once they are ready.
+	// +optional
+	SmokeTest *SmokeTestParams
+}
+
+// HealthCheckParams represents a health check sent to the validation rooms.
+type HealthCheckParams struct {
+	// Protocol indicates how the room is checked.
+	Protocol HealthCheckProtocol `validate:"oneof=http grpc"`
+	// PortName indicates the name of the room port that receives the check.
+	PortName string `validate:"required"`
+	// Path indicates the HTTP path requested or, for gRPC, the service name checked.
+	Path string
+	// Timeout indicates for how long the check waits for the room response, defaults to 5 seconds.
+	Timeout time.Duration `validate:"min=0"`
+}
+
+// SmokeTestParams represents a room event sent through one of the scheduler forwarders for the validation rooms.
+type SmokeTestParams struct {
+	// ForwarderName indicates the scheduler forwarder that receives the event.
+	ForwarderName string `validate:"required"`
+	// EventName indicates the room event forwarded, the forwarder must answer it successfully.
+	EventName string `validate:"required"`
+}
+
+// Validate check if a Strategy struct is well formatted and contains valid values.
+func (s *Strategy) Validate() error {
+	return validations.Validate.Struct(s)
+}
+
+// NewStrategy instantiates a new rollout strategy struct based on its parameters.
+func NewStrategy(strategyType StrategyType, canary *CanaryParams, autoRollback *AutoRollbackParams, validation *ValidationParams) (*Strategy, error) {
+	strategy := &Strategy{
+		Type:         strategyType,
+		Canary:       canary,
+		AutoRollback: autoRollback,
+		Validation:   validation,
+	}
+	return strategy, strategy.Validate()
+}
+
+// IsCanary returns true if the strategy is the canary one.
+func (s *Strategy) IsCanary() bool {
+	return s != nil && s.Type == Canary
+}
+
+// IsBlueGreen returns true if the strategy is the blue/green one.
+func (s *Strategy) IsBlueGreen() bool {
+	return s != nil && s.Type == BlueGreen
+}
+
+// IsAutoRollbackEnabled returns true if the new version must be rolled back when its rooms fail.
+func (s *Strategy) IsAutoRollbackEnabled() bool {
+	return s != nil && s.AutoRollback != nil
+}
+
+// GetValidation returns the validation parameters of the strategy, it is safe
+// to be called on nil strategies.
+func (s *Strategy) GetValidation() *ValidationParams {
+	if s == nil {
+		return nil
+	}
+
+	return s.Validation
+}
+
+// RoomsAmount returns how many validation rooms must be created, at least one.
+func (v *ValidationParams) RoomsAmount() int {
+	if v == nil || v.Rooms < 1 {
+		return 1
+	}
+
+	return v.Rooms
+}
+
+// CanaryRoomsAmount calculates how many rooms will be created on the new
+// version based on the current number of rooms of the scheduler. It always
+// returns at least one room.
+func (c *CanaryParams) CanaryRoomsAmount(currentRooms int) int {
+	amount := c.Rooms
+	if amount <= 0 {
+		amount = (currentRooms*c.Percentage + 99) / 100
+	}
+
+	if amount < 1 {
+		return 1
+	}
+
+	return amount
+}
+
+// ShouldRollback returns true if the rate of failed rooms, out of the rooms
+// observed on the new version, is above the threshold. The rate is only
+// evaluated once at least MinRooms rooms were observed.
+func (a *AutoRollbackParams) ShouldRollback(failedRooms, observedRooms int) bool {
+	if observedRooms == 0 || observedRooms < a.MinRooms {
+		return false
+	}
+
+	return float64(failedRooms)/float64(observedRooms) > a.FailureThreshold
+}
@@ -0,0 +1,207 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build unit
+// +build unit
+
+package rollout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/topfreegames/maestro/internal/validations"
+)
+
+func TestNewStrategy(t *testing.T) {
+	err := validations.RegisterValidations()
+	if err != nil {
+		t.Errorf("unexpected error %d'", err)
+	}
+	translator := validations.GetDefaultTranslator()
+
+	t.Run("valid scenarios", func(t *testing.T) {
+		t.Run("rolling update without parameters", func(t *testing.T) {
+			_, err := NewStrategy(RollingUpdate, nil, nil, nil)
+			assert.NoError(t, err)
+		})
+
+		t.Run("canary with percentage", func(t *testing.T) {
+			_, err := NewStrategy(Canary, &CanaryParams{Percentage: 10, BakeDuration: time.Minute, FailureThreshold: 0.1}, nil, nil)
+			assert.NoError(t, err)
+		})
+
+		t.Run("canary with rooms", func(t *testing.T) {
+			_, err := NewStrategy(Canary, &CanaryParams{Rooms: 2, BakeDuration: time.Minute}, nil, nil)
+			assert.NoError(t, err)
+		})
+
+		t.Run("blue/green without parameters", func(t *testing.T) {
+			strategy, err := NewStrategy(BlueGreen, nil, nil, nil)
+			assert.NoError(t, err)
+			assert.True(t, strategy.IsBlueGreen())
+		})
+
+		t.Run("with auto rollback", func(t *testing.T) {
+			strategy, err := NewStrategy(RollingUpdate, nil, &AutoRollbackParams{FailureThreshold: 0.2, MinRooms: 5}, nil)
+			assert.NoError(t, err)
+			assert.True(t, strategy.IsAutoRollbackEnabled())
+		})
+
+		t.Run("with validation checks", func(t *testing.T) {
+			validation := &ValidationParams{
+				Rooms:            3,
+				MinReadyDuration: time.Minute,
+				HealthCheck:      &HealthCheckParams{Protocol: HealthCheckHTTP, PortName: "http", Path: "/healthz"},
+				SmokeTest:        &SmokeTestParams{ForwarderName: "matchmaker", EventName: "smokeTest"},
+			}
+			strategy, err := NewStrategy(RollingUpdate, nil, nil, validation)
+			assert.NoError(t, err)
+			assert.Equal(t, 3, strategy.GetValidation().RoomsAmount())
+		})
+	})
+
+	t.Run("invalid scenarios", func(t *testing.T) {
+		t.Run("fails with unknown type", func(t *testing.T) {
+			_, err := NewStrategy("recreate", nil, nil, nil)
+			require.Error(t, err)
+			validationErrs := err.(validator.ValidationErrors)
+			assert.Equal(t, "Type must be one of [rollingUpdate canary blueGreen]", validationErrs[0].Translate(translator))
+		})
+
+		t.Run("fails when canary parameters are missing", func(t *testing.T) {
+			_, err := NewStrategy(Canary, nil, nil, nil)
+			require.Error(t, err)
+			validationErrs := err.(validator.ValidationErrors)
+			assert.Equal(t, "Canary must not be nil for Canary rollout strategy type", validationErrs[0].Translate(translator))
+		})
+
+		t.Run("fails when neither percentage nor rooms are set", func(t *testing.T) {
+			_, err := NewStrategy(Canary, &CanaryParams{BakeDuration: time.Minute}, nil, nil)
+			require.Error(t, err)
+			validationErrs := err.(validator.ValidationErrors)
+			assert.Equal(t, "Rooms", validationErrs[0].Field())
+		})
+
+		t.Run("fails when both percentage and rooms are set", func(t *testing.T) {
+			_, err := NewStrategy(Canary, &CanaryParams{Percentage: 10, Rooms: 2, BakeDuration: time.Minute}, nil, nil)
+			require.Error(t, err)
+			validationErrs := err.(validator.ValidationErrors)
+			assert.Equal(t, "Rooms", validationErrs[0].Field())
+		})
+
+		t.Run("fails with invalid percentage", func(t *testing.T) {
+			_, err := NewStrategy(Canary, &CanaryParams{Percentage: 101, BakeDuration: time.Minute}, nil, nil)
+			require.Error(t, err)
+			validationErrs := err.(validator.ValidationErrors)
+			assert.Equal(t, "Percentage must be 100 or less", validationErrs[0].Translate(translator))
+		})
+
+		t.Run("fails without bake duration", func(t *testing.T) {
+			_, err := NewStrategy(Canary, &CanaryParams{Percentage: 10}, nil, nil)
+			require.Error(t, err)
+			validationErrs := err.(validator.ValidationErrors)
+			assert.Equal(t, "BakeDuration", validationErrs[0].Field())
+		})
+
+		t.Run("fails with invalid failure threshold", func(t *testing.T) {
+			_, err := NewStrategy(Canary, &CanaryParams{Percentage: 10, BakeDuration: time.Minute, FailureThreshold: 1.5}, nil, nil)
+			require.Error(t, err)
+			validationErrs := err.(validator.ValidationErrors)
+			assert.Equal(t, "FailureThreshold must be 1 or less", validationErrs[0].Translate(translator))
+		})
+
+		t.Run("fails with unknown health check protocol", func(t *testing.T) {
+			_, err := NewStrategy(RollingUpdate, nil, nil, &ValidationParams{HealthCheck: &HealthCheckParams{Protocol: "tcp", PortName: "http"}})
+			require.Error(t, err)
+			validationErrs := err.(validator.ValidationErrors)
+			assert.Equal(t, "Protocol must be one of [http grpc]", validationErrs[0].Translate(translator))
+		})
+
+		t.Run("fails with smoke test without forwarder", func(t *testing.T) {
+			_, err := NewStrategy(RollingUpdate, nil, nil, &ValidationParams{SmokeTest: &SmokeTestParams{EventName: "smokeTest"}})
+			require.Error(t, err)
+			validationErrs := err.(validator.ValidationErrors)
+			assert.Equal(t, "ForwarderName", validationErrs[0].Field())
+		})
+
+		t.Run("fails with invalid auto rollback failure threshold", func(t *testing.T) {
+			_, err := NewStrategy(RollingUpdate, nil, &AutoRollbackParams{FailureThreshold: -0.1}, nil)
+			require.Error(t, err)
+			validationErrs := err.(validator.ValidationErrors)
+			assert.Equal(t, "FailureThreshold must be 0 or greater", validationErrs[0].Translate(translator))
+		})
+	})
+}
+
+func TestValidationRoomsAmount(t *testing.T) {
+	t.Run("defaults to one room", func(t *testing.T) {
+		var strategy *Strategy
+		assert.Equal(t, 1, strategy.GetValidation().RoomsAmount())
+		assert.Equal(t, 1, (&ValidationParams{}).RoomsAmount())
+	})
+
+	t.Run("uses the configured number of rooms", func(t *testing.T) {
+		assert.Equal(t, 4, (&ValidationParams{Rooms: 4}).RoomsAmount())
+	})
+}
+
+func TestCanaryRoomsAmount(t *testing.T) {
+	t.Run("uses the fixed number of rooms when set", func(t *testing.T) {
+		params := &CanaryParams{Rooms: 3}
+		assert.Equal(t, 3, params.CanaryRoomsAmount(100))
+	})
+
+	t.Run("rounds the percentage up", func(t *testing.T) {
+		params := &CanaryParams{Percentage: 10}
+		assert.Equal(t, 2, params.CanaryRoomsAmount(15))
+	})
+
+	t.Run("returns at least one room", func(t *testing.T) {
+		params := &CanaryParams{Percentage: 10}
+		assert.Equal(t, 1, params.CanaryRoomsAmount(0))
+	})
+}
+
+func TestShouldRollback(t *testing.T) {
+	params := &AutoRollbackParams{FailureThreshold: 0.2, MinRooms: 5}
+
+	t.Run("doesn't roll back before observing the minimum number of rooms", func(t *testing.T) {
+		assert.False(t, params.ShouldRollback(4, 4))
+	})
+
+	t.Run("doesn't roll back when the failure rate is under the threshold", func(t *testing.T) {
+		assert.False(t, params.ShouldRollback(2, 10))
+	})
+
+	t.Run("rolls back when the failure rate is above the threshold", func(t *testing.T) {
+		assert.True(t, params.ShouldRollback(3, 10))
+	})
+
+	t.Run("doesn't roll back without observed rooms", func(t *testing.T) {
+		assert.False(t, (&AutoRollbackParams{}).ShouldRollback(0, 0))
+	})
+}
@@ -28,6 +28,7 @@ import (
 
 	"github.com/topfreegames/maestro/internal/core/entities/autoscaling"
 	"github.com/topfreegames/maestro/internal/core/entities/port"
+	"github.com/topfreegames/maestro/internal/core/entities/rollout"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -71,9 +72,16 @@ type Scheduler struct {
 	CreatedAt       time.Time
 	LastDownscaleAt time.Time
 	MaxSurge        string                 `validate:"required,max_surge"`
+	MaxUnavailable  string                 `validate:"omitempty,max_unavailable"`
 	Forwarders      []*forwarder.Forwarder `validate:"dive"`
 	Annotations     map[string]string
 	Labels          map[string]string
+	RolloutStrategy *rollout.Strategy
+	// Template is set when the scheduler was derived from a scheduler template.
+	Template *SchedulerTemplateRef
+	// RolloutHealth is kept by the health controller while a version is
+	// rolled out with automatic rollback.
+	RolloutHealth *rollout.Health
 }
 
 // NewScheduler instantiate a new scheduler struct.
@@ -82,6 +90,7 @@ func NewScheduler(
 	game string,
 	state string,
 	maxSurge string,
+	maxUnavailable string,
 	spec game_room.Spec,
 	portRange *port.PortRange,
 	roomsReplicas int,
@@ -91,17 +100,18 @@ func NewScheduler(
 	labels map[string]string,
 ) (*Scheduler, error) {
 	scheduler := &Scheduler{
-		Name:          name,
-		Game:          game,
-		State:         state,
-		Spec:          spec,
-		PortRange:     portRange,
-		MaxSurge:      maxSurge,
-		RoomsReplicas: roomsReplicas,
-		Autoscaling:   autoscaling,
-		Forwarders:    forwarders,
-		Annotations:   annotations,
-		Labels:        labels,
+		Name:           name,
+		Game:           game,
+		State:          state,
+		Spec:           spec,
+		PortRange:      portRange,
+		MaxSurge:       maxSurge,
+		MaxUnavailable: maxUnavailable,
+		RoomsReplicas:  roomsReplicas,
+		Autoscaling:    autoscaling,
+		Forwarders:     forwarders,
+		Annotations:    annotations,
+		Labels:         labels,
 	}
 	return scheduler, scheduler.Validate()
 }
@@ -157,8 +167,12 @@ func (s *Scheduler) IsMajorVersion(newScheduler *Scheduler) bool {
 			"CreatedAt",
 			"LastDownscaleAt",
 			"MaxSurge",
+			"MaxUnavailable",
 			"RoomsReplicas",
 			"Autoscaling",
+			"RolloutStrategy",
+			"Template",
+			"RolloutHealth",
 		),
 	)
 }
@@ -0,0 +1,55 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package entities
+
+// SchedulerApplyAction is what applying a scheduler manifest does to the
+// stored scheduler.
+type SchedulerApplyAction string
+
+const (
+	// SchedulerApplyActionCreate creates a scheduler that doesn't exist yet.
+	SchedulerApplyActionCreate SchedulerApplyAction = "create"
+	// SchedulerApplyActionUpdate creates a new version of a scheduler that
+	// differs from its manifest.
+	SchedulerApplyActionUpdate SchedulerApplyAction = "update"
+	// SchedulerApplyActionDelete deletes a scheduler that has no manifest.
+	SchedulerApplyActionDelete SchedulerApplyAction = "delete"
+	// SchedulerApplyActionNone is used for schedulers that already match their
+	// manifests.
+	SchedulerApplyActionNone SchedulerApplyAction = "none"
+)
+
+// SchedulerApplyStep is the action planned for a single scheduler when a set
+// of manifests is applied, and its result once executed.
+type SchedulerApplyStep struct {
+	SchedulerName string
+	Action        SchedulerApplyAction
+	// Diff has the changes from the stored scheduler, only set on updates.
+	Diff *SchedulerDiff
+	// Scheduler is the desired scheduler, not set on deletes.
+	Scheduler *Scheduler
+	// OperationID is the operation enqueued for the step, set after it is
+	// executed.
+	OperationID string
+	Error       string
+}
@@ -0,0 +1,161 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package entities
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// SchedulerDiff holds the changes between two versions of a scheduler.
+type SchedulerDiff struct {
+	FromVersion string
+	ToVersion   string
+	// IsMajor tells if the changes require the game rooms to be replaced,
+	// following the IsMajorVersion rules.
+	IsMajor bool
+	Changes []*SchedulerFieldChange
+}
+
+// SchedulerFieldChange is a single field that differs between two versions of
+// a scheduler. The values are empty when the field (or slice and map element)
+// doesn't exist in one of the versions.
+type SchedulerFieldChange struct {
+	Path     string
+	OldValue string
+	NewValue string
+}
+
+// Diff returns the changes needed to go from the scheduler to the new
+// scheduler. Fields that are expected to differ between any two versions, such
+// as the version itself and the creation time, are not reported.
+func (s *Scheduler) Diff(newScheduler *Scheduler) *SchedulerDiff {
+	reporter := &schedulerDiffReporter{}
+	cmp.Equal(
+		s,
+		newScheduler,
+		cmpopts.IgnoreFields(
+			Scheduler{},
+			"Spec.Version",
+			"State",
+			"CreatedAt",
+			"LastDownscaleAt",
+			"RolloutHealth",
+		),
+		cmp.Reporter(reporter),
+	)
+
+	return &SchedulerDiff{
+		FromVersion: s.Spec.Version,
+		ToVersion:   newScheduler.Spec.Version,
+		IsMajor:     s.IsMajorVersion(newScheduler),
+		Changes:     reporter.changes,
+	}
+}
+
+// schedulerDiffReporter is a cmp.Reporter that collects every difference
+// found, keeping the path it was found at.
+type schedulerDiffReporter struct {
+	path    cmp.Path
+	changes []*SchedulerFieldChange
+}
+
+func (r *schedulerDiffReporter) PushStep(step cmp.PathStep) {
+	r.path = append(r.path, step)
+}
+
+func (r *schedulerDiffReporter) Report(result cmp.Result) {
+	if result.Equal() {
+		return
+	}
+
+	oldValue, newValue := r.path.Last().Values()
+	r.changes = append(r.changes, &SchedulerFieldChange{
+		Path:     formatDiffPath(r.path),
+		OldValue: formatDiffValue(oldValue),
+		NewValue: formatDiffValue(newValue),
+	})
+}
+
+func (r *schedulerDiffReporter) PopStep() {
+	r.path = r.path[:len(r.path)-1]
+}
+
+func formatDiffPath(path cmp.Path) string {
+	var builder strings.Builder
+	for _, step := range path {
+		switch step := step.(type) {
+		case cmp.StructField:
+			if builder.Len() > 0 {
+				builder.WriteString(".")
+			}
+			builder.WriteString(step.Name())
+		case cmp.SliceIndex:
+			index := step.Key()
+			if index < 0 {
+				// element only exists on one of the versions.
+				oldIndex, newIndex := step.SplitKeys()
+				index = oldIndex
+				if index < 0 {
+					index = newIndex
+				}
+			}
+			builder.WriteString(fmt.Sprintf("[%d]", index))
+		case cmp.MapIndex:
+			builder.WriteString(fmt.Sprintf("[%v]", step.Key()))
+		}
+	}
+
+	return builder.String()
+}
+
+func formatDiffValue(value reflect.Value) string {
+	if !value.IsValid() {
+		return ""
+	}
+
+	if value.Kind() == reflect.String {
+		return value.String()
+	}
+
+	if (value.Kind() == reflect.Ptr || value.Kind() == reflect.Map || value.Kind() == reflect.Slice) && value.IsNil() {
+		return ""
+	}
+
+	// values such as durations are more readable on their string form.
+	if stringer, ok := value.Interface().(fmt.Stringer); ok && value.Kind() != reflect.Struct && value.Kind() != reflect.Ptr {
+		return stringer.String()
+	}
+
+	encoded, err := json.Marshal(value.Interface())
+	if err != nil {
+		return fmt.Sprint(value.Interface())
+	}
+
+	return string(encoded)
+}
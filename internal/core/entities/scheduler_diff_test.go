@@ -0,0 +1,99 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build unit
+// +build unit
+
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/topfreegames/maestro/internal/core/entities"
+	"github.com/topfreegames/maestro/internal/core/entities/game_room"
+)
+
+func TestSchedulerDiff(t *testing.T) {
+	newScheduler := func(version string) *entities.Scheduler {
+		return &entities.Scheduler{
+			Name:      "scheduler",
+			Game:      "game",
+			MaxSurge:  "10%",
+			CreatedAt: time.Now(),
+			Spec: game_room.Spec{
+				Version:                version,
+				TerminationGracePeriod: time.Minute,
+				Containers: []game_room.Container{
+					{
+						Name:  "default",
+						Image: "image:v1",
+						Ports: []game_room.ContainerPort{{Name: "tcp", Protocol: "tcp", Port: 80}},
+					},
+				},
+			},
+			Annotations: map[string]string{"key": "value"},
+		}
+	}
+
+	t.Run("returns no changes when only the version and creation time differ", func(t *testing.T) {
+		diff := newScheduler("v1.0.0").Diff(newScheduler("v1.1.0"))
+
+		require.Equal(t, "v1.0.0", diff.FromVersion)
+		require.Equal(t, "v1.1.0", diff.ToVersion)
+		require.False(t, diff.IsMajor)
+		require.Empty(t, diff.Changes)
+	})
+
+	t.Run("returns the changed fields and flags major changes", func(t *testing.T) {
+		currentScheduler := newScheduler("v1.0.0")
+		updatedScheduler := newScheduler("v2.0.0")
+		updatedScheduler.MaxSurge = "20%"
+		updatedScheduler.Spec.TerminationGracePeriod = 2 * time.Minute
+		updatedScheduler.Spec.Containers[0].Image = "image:v2"
+
+		diff := currentScheduler.Diff(updatedScheduler)
+
+		require.True(t, diff.IsMajor)
+		require.ElementsMatch(t, []*entities.SchedulerFieldChange{
+			{Path: "Spec.TerminationGracePeriod", OldValue: "1m0s", NewValue: "2m0s"},
+			{Path: "Spec.Containers[0].Image", OldValue: "image:v1", NewValue: "image:v2"},
+			{Path: "MaxSurge", OldValue: "10%", NewValue: "20%"},
+		}, diff.Changes)
+	})
+
+	t.Run("returns empty values for added and removed elements", func(t *testing.T) {
+		currentScheduler := newScheduler("v1.0.0")
+		updatedScheduler := newScheduler("v1.1.0")
+		updatedScheduler.Annotations = map[string]string{"other": "value"}
+
+		diff := currentScheduler.Diff(updatedScheduler)
+
+		require.True(t, diff.IsMajor)
+		require.ElementsMatch(t, []*entities.SchedulerFieldChange{
+			{Path: "Annotations[key]", OldValue: "value", NewValue: ""},
+			{Path: "Annotations[other]", OldValue: "", NewValue: "value"},
+		}, diff.Changes)
+	})
+}
@@ -22,7 +22,10 @@
 
 package entities
 
-import "github.com/topfreegames/maestro/internal/core/entities/autoscaling"
+import (
+	"github.com/topfreegames/maestro/internal/core/entities/autoscaling"
+	"github.com/topfreegames/maestro/internal/core/entities/operation"
+)
 
 type AutoscalingInfo struct {
 	Enabled  bool
@@ -41,6 +44,7 @@ type SchedulerInfo struct {
 	RoomsPending     int
 	RoomsTerminating int
 	Autoscaling      *AutoscalingInfo
+	OperationsPause  *operation.OperationsPause
 }
 
 func NewSchedulerInfo(opts ...SchedulerInfoOption) (schedulerInfo *SchedulerInfo) {
@@ -111,3 +115,9 @@ func WithAutoscalingInfo(schedulerAutoscaling *autoscaling.Autoscaling) Schedule
 		}
 	}
 }
+
+func WithOperationsPause(operationsPause *operation.OperationsPause) SchedulerInfoOption {
+	return func(schedulerInfo *SchedulerInfo) {
+		schedulerInfo.OperationsPause = operationsPause
+	}
+}
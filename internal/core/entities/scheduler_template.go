@@ -0,0 +1,178 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/topfreegames/maestro/internal/core/entities/autoscaling"
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
+	"github.com/topfreegames/maestro/internal/core/entities/game_room"
+	"github.com/topfreegames/maestro/internal/core/entities/port"
+	"github.com/topfreegames/maestro/internal/core/entities/rollout"
+	"github.com/topfreegames/maestro/internal/validations"
+)
+
+// SchedulerTemplate holds a scheduler configuration shared by several
+// schedulers. Each derived scheduler only has its own name, game and the
+// container overrides it was created with.
+type SchedulerTemplate struct {
+	Name            string `validate:"required,kube_resource_name"`
+	Spec            game_room.Spec
+	Autoscaling     *autoscaling.Autoscaling
+	PortRange       *port.PortRange
+	RoomsReplicas   int                    `validate:"min=0"`
+	MaxSurge        string                 `validate:"required,max_surge"`
+	MaxUnavailable  string                 `validate:"omitempty,max_unavailable"`
+	Forwarders      []*forwarder.Forwarder `validate:"dive"`
+	Annotations     map[string]string
+	Labels          map[string]string
+	RolloutStrategy *rollout.Strategy
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// SchedulerTemplateRef is kept on the schedulers derived from a template, so
+// that template changes can be applied to them again.
+type SchedulerTemplateRef struct {
+	// Name of the template the scheduler was derived from.
+	Name string `validate:"required"`
+	// Containers has the overrides applied on top of the template containers.
+	Containers []*TemplateContainerOverride `validate:"dive"`
+}
+
+// TemplateContainerOverride changes a single container of the template.
+type TemplateContainerOverride struct {
+	// Name of the template container being overridden.
+	Name string `validate:"required"`
+	// Image replaces the container image when set.
+	Image string
+	// Environment variables are added to the container, replacing the ones
+	// with the same name.
+	Environment []game_room.ContainerEnvironment `validate:"dive"`
+}
+
+func (t *SchedulerTemplate) Validate() error {
+	err := validations.Validate.Struct(t)
+	if err != nil {
+		return err
+	}
+
+	scheduler := &Scheduler{Spec: t.Spec, PortRange: t.PortRange}
+	return scheduler.HasValidPortRangeConfiguration()
+}
+
+// NewScheduler derives a scheduler from the template, applying the container
+// overrides. The template itself is never changed.
+func (t *SchedulerTemplate) NewScheduler(name, game string, overrides []*TemplateContainerOverride) (*Scheduler, error) {
+	ref := &SchedulerTemplateRef{Name: t.Name, Containers: overrides}
+	if err := validations.Validate.Struct(ref); err != nil {
+		return nil, err
+	}
+
+	spec := *t.Spec.DeepCopy()
+
+	for _, override := range overrides {
+		index := -1
+		for i, container := range spec.Containers {
+			if container.Name == override.Name {
+				index = i
+				break
+			}
+		}
+
+		if index < 0 {
+			return nil, fmt.Errorf("container %s not found on template %s", override.Name, t.Name)
+		}
+
+		spec.Containers[index] = override.apply(spec.Containers[index])
+	}
+
+	scheduler, err := NewScheduler(
+		name,
+		game,
+		StateCreating,
+		t.MaxSurge,
+		t.MaxUnavailable,
+		spec,
+		t.PortRange,
+		t.RoomsReplicas,
+		t.Autoscaling,
+		t.Forwarders,
+		t.Annotations,
+		t.Labels,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduler.RolloutStrategy = t.RolloutStrategy
+	scheduler.Template = ref
+	return scheduler, nil
+}
+
+func (o *TemplateContainerOverride) apply(container game_room.Container) game_room.Container {
+	if o.Image != "" {
+		container.Image = o.Image
+	}
+
+	if len(o.Environment) == 0 {
+		return container
+	}
+
+	environment := make([]game_room.ContainerEnvironment, 0, len(container.Environment)+len(o.Environment))
+	overridden := map[string]bool{}
+	for _, env := range o.Environment {
+		overridden[env.Name] = true
+	}
+
+	for _, env := range container.Environment {
+		if !overridden[env.Name] {
+			environment = append(environment, env)
+		}
+	}
+
+	container.Environment = append(environment, o.Environment...)
+	return container
+}
+
+// DerivedScheduler tells how a scheduler derived from a template differs from
+// the scheduler the template currently generates.
+type DerivedScheduler struct {
+	SchedulerName string
+	// Outdated is true when the template changed after the scheduler was
+	// derived from it, and the changes weren't applied yet.
+	Outdated bool
+	Diff     *SchedulerDiff
+}
+
+// SchedulerTemplatePropagation is the result of applying the template changes
+// to one of its derived schedulers.
+type SchedulerTemplatePropagation struct {
+	SchedulerName string
+	// OperationID is the new_scheduler_version operation enqueued, it is empty
+	// when the propagation failed.
+	OperationID string
+	Error       string
+}
@@ -0,0 +1,150 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build unit
+// +build unit
+
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/topfreegames/maestro/internal/core/entities"
+	"github.com/topfreegames/maestro/internal/core/entities/game_room"
+	"github.com/topfreegames/maestro/internal/core/entities/port"
+	"github.com/topfreegames/maestro/internal/validations"
+)
+
+func TestSchedulerTemplate_NewScheduler(t *testing.T) {
+	err := validations.RegisterValidations()
+	require.NoError(t, err)
+
+	newTemplate := func() *entities.SchedulerTemplate {
+		return &entities.SchedulerTemplate{
+			Name:     "template",
+			MaxSurge: "10%",
+			Spec: game_room.Spec{
+				Version:                "v1.0.0",
+				TerminationGracePeriod: time.Minute,
+				Containers: []game_room.Container{
+					{
+						Name:            "default",
+						Image:           "image:v1",
+						ImagePullPolicy: "IfNotPresent",
+						Environment: []game_room.ContainerEnvironment{
+							{Name: "REGION", Value: "us"},
+							{Name: "LOG_LEVEL", Value: "info"},
+						},
+						Requests: game_room.ContainerResources{CPU: "100m", Memory: "100Mi"},
+						Limits:   game_room.ContainerResources{CPU: "100m", Memory: "100Mi"},
+						Ports:    []game_room.ContainerPort{{Name: "tcp", Protocol: "tcp", Port: 80}},
+					},
+				},
+			},
+			PortRange:   &port.PortRange{Start: 40000, End: 60000},
+			Annotations: map[string]string{"key": "value"},
+		}
+	}
+
+	t.Run("derives the scheduler from the template without overrides", func(t *testing.T) {
+		template := newTemplate()
+
+		scheduler, err := template.NewScheduler("scheduler", "game", nil)
+		require.NoError(t, err)
+
+		require.Equal(t, "scheduler", scheduler.Name)
+		require.Equal(t, "game", scheduler.Game)
+		require.Equal(t, entities.StateCreating, scheduler.State)
+		require.Equal(t, template.Spec.Version, scheduler.Spec.Version)
+		require.Equal(t, template.Spec.Containers, scheduler.Spec.Containers)
+		require.Equal(t, template.PortRange, scheduler.PortRange)
+		require.Equal(t, template.Annotations, scheduler.Annotations)
+		require.Equal(t, &entities.SchedulerTemplateRef{Name: "template"}, scheduler.Template)
+	})
+
+	t.Run("applies the container overrides without changing the template", func(t *testing.T) {
+		template := newTemplate()
+		overrides := []*entities.TemplateContainerOverride{
+			{
+				Name:        "default",
+				Image:       "image:v2",
+				Environment: []game_room.ContainerEnvironment{{Name: "REGION", Value: "eu"}, {Name: "MODE", Value: "ranked"}},
+			},
+		}
+
+		scheduler, err := template.NewScheduler("scheduler", "game", overrides)
+		require.NoError(t, err)
+
+		require.Equal(t, "image:v2", scheduler.Spec.Containers[0].Image)
+		require.Equal(t, []game_room.ContainerEnvironment{
+			{Name: "LOG_LEVEL", Value: "info"},
+			{Name: "REGION", Value: "eu"},
+			{Name: "MODE", Value: "ranked"},
+		}, scheduler.Spec.Containers[0].Environment)
+		require.Equal(t, overrides, scheduler.Template.Containers)
+
+		require.Equal(t, newTemplate(), template)
+	})
+
+	t.Run("fails when the overridden container doesn't exist", func(t *testing.T) {
+		overrides := []*entities.TemplateContainerOverride{{Name: "other", Image: "image:v2"}}
+
+		_, err := newTemplate().NewScheduler("scheduler", "game", overrides)
+		require.ErrorContains(t, err, "container other not found on template template")
+	})
+
+	t.Run("fails when the derived scheduler is invalid", func(t *testing.T) {
+		_, err := newTemplate().NewScheduler("Invalid_Name", "game", nil)
+		require.Error(t, err)
+	})
+}
+
+func TestSchedulerTemplate_Validate(t *testing.T) {
+	err := validations.RegisterValidations()
+	require.NoError(t, err)
+
+	t.Run("fails when the template has no port range", func(t *testing.T) {
+		template := &entities.SchedulerTemplate{
+			Name:     "template",
+			MaxSurge: "10%",
+			Spec: game_room.Spec{
+				Version:                "v1.0.0",
+				TerminationGracePeriod: time.Minute,
+				Containers: []game_room.Container{
+					{
+						Name:            "default",
+						Image:           "image:v1",
+						ImagePullPolicy: "IfNotPresent",
+						Requests:        game_room.ContainerResources{CPU: "100m", Memory: "100Mi"},
+						Limits:          game_room.ContainerResources{CPU: "100m", Memory: "100Mi"},
+						Ports:           []game_room.ContainerPort{{Name: "tcp", Protocol: "tcp", Port: 80}},
+					},
+				},
+			},
+		}
+
+		require.ErrorIs(t, template.Validate(), entities.ErrMissingContainerPortRange)
+	})
+}
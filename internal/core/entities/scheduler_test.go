@@ -100,6 +100,7 @@ func TestNewScheduler(t *testing.T) {
 			game,
 			entities.StateCreating,
 			maxSurge,
+			"",
 			spec,
 			portRange,
 			roomsReplicas,
@@ -132,6 +133,7 @@ func TestNewScheduler(t *testing.T) {
 			"",
 			entities.StateCreating,
 			maxSurge,
+			"",
 			spec,
 			portRange,
 			roomsReplicas,
@@ -147,6 +149,7 @@ func TestNewScheduler(t *testing.T) {
 			"",
 			entities.StateCreating,
 			"10",
+			"",
 			*game_room.NewSpec(
 				"v1",
 				10,
@@ -171,6 +174,7 @@ func TestNewScheduler(t *testing.T) {
 			"",
 			entities.StateCreating,
 			"10",
+			"",
 			*game_room.NewSpec(
 				"v1",
 				10,
@@ -188,6 +192,264 @@ func TestNewScheduler(t *testing.T) {
 
 		require.Error(t, err)
 	})
+
+	t.Run("fails when try to create scheduler with invalid broker forwarder key", func(t *testing.T) {
+		brokerForwarder := &forwarder.Forwarder{
+			Name:        "analytics",
+			Enabled:     true,
+			ForwardType: forwarder.TypeBroker,
+			Address:     "room-events",
+			Options: &forwarder.ForwardOptions{
+				Timeout: time.Second * 5,
+				Broker:  &forwarder.BrokerOptions{Key: "player"},
+			},
+		}
+		_, err := entities.NewScheduler(
+			name,
+			game,
+			entities.StateCreating,
+			maxSurge,
+			"",
+			spec,
+			portRange,
+			roomsReplicas,
+			nil,
+			[]*forwarder.Forwarder{brokerForwarder}, annotations, labels)
+
+		require.Error(t, err)
+
+		brokerForwarder.Options.Broker.Key = forwarder.BrokerKeyScheduler
+		_, err = entities.NewScheduler(
+			name,
+			game,
+			entities.StateCreating,
+			maxSurge,
+			"",
+			spec,
+			portRange,
+			roomsReplicas,
+			nil,
+			[]*forwarder.Forwarder{brokerForwarder}, annotations, labels)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("fails when try to create scheduler with invalid broker forwarder topic", func(t *testing.T) {
+		brokerForwarder := &forwarder.Forwarder{
+			Name:        "analytics",
+			Enabled:     true,
+			ForwardType: forwarder.TypeBroker,
+			Address:     "operations:scheduler",
+			Options:     &forwarder.ForwardOptions{Timeout: time.Second * 5},
+		}
+		_, err := entities.NewScheduler(
+			name,
+			game,
+			entities.StateCreating,
+			maxSurge,
+			"",
+			spec,
+			portRange,
+			roomsReplicas,
+			nil,
+			[]*forwarder.Forwarder{brokerForwarder}, annotations, labels)
+
+		require.Error(t, err)
+	})
+
+	t.Run("fails when try to create scheduler with invalid forwarder filter", func(t *testing.T) {
+		filteredForwarder := &forwarder.Forwarder{
+			Name:        "matchmaker",
+			Enabled:     true,
+			ForwardType: forwarder.TypeGrpc,
+			Address:     "matchmaker:8080",
+			Options: &forwarder.ForwardOptions{
+				Timeout: time.Second * 5,
+				Filter:  &forwarder.FilterOptions{EventTypes: []string{"playerLeft", "playerKicked"}},
+			},
+		}
+		_, err := entities.NewScheduler(
+			name,
+			game,
+			entities.StateCreating,
+			maxSurge,
+			"",
+			spec,
+			portRange,
+			roomsReplicas,
+			nil,
+			[]*forwarder.Forwarder{filteredForwarder}, annotations, labels)
+
+		require.Error(t, err)
+
+		filteredForwarder.Options.Filter = &forwarder.FilterOptions{EventNames: []string{"PlayerEvent"}, EventTypes: []string{"playerLeft", "roomTerminated"}}
+		_, err = entities.NewScheduler(
+			name,
+			game,
+			entities.StateCreating,
+			maxSurge,
+			"",
+			spec,
+			portRange,
+			roomsReplicas,
+			nil,
+			[]*forwarder.Forwarder{filteredForwarder}, annotations, labels)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("fails when try to create scheduler with a reserved forwarder attribute", func(t *testing.T) {
+		transformedForwarder := &forwarder.Forwarder{
+			Name:        "matchmaker",
+			Enabled:     true,
+			ForwardType: forwarder.TypeGrpc,
+			Address:     "matchmaker:8080",
+			Options: &forwarder.ForwardOptions{
+				Timeout:   time.Second * 5,
+				Transform: &forwarder.TransformOptions{Attributes: map[string]string{"team": "matchmaking", "roomEvent": "ping"}},
+			},
+		}
+		_, err := entities.NewScheduler(
+			name,
+			game,
+			entities.StateCreating,
+			maxSurge,
+			"",
+			spec,
+			portRange,
+			roomsReplicas,
+			nil,
+			[]*forwarder.Forwarder{transformedForwarder}, annotations, labels)
+
+		require.Error(t, err)
+
+		transformedForwarder.Options.Transform.Attributes = map[string]string{"team": "matchmaking"}
+		_, err = entities.NewScheduler(
+			name,
+			game,
+			entities.StateCreating,
+			maxSurge,
+			"",
+			spec,
+			portRange,
+			roomsReplicas,
+			nil,
+			[]*forwarder.Forwarder{transformedForwarder}, annotations, labels)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("fails when try to create scheduler with invalid gRPC forwarder credentials", func(t *testing.T) {
+		securedForwarder := &forwarder.Forwarder{
+			Name:        "matchmaker",
+			Enabled:     true,
+			ForwardType: forwarder.TypeGrpc,
+			Address:     "matchmaker:8443",
+			Options: &forwarder.ForwardOptions{
+				Timeout: time.Second * 5,
+				GRPC: &forwarder.GRPCOptions{
+					TLS:  &forwarder.TLSOptions{CertSecretRef: "matchmakerCert"},
+					Auth: &forwarder.AuthOptions{Type: "basic", SecretRef: "matchmakerToken"},
+				},
+			},
+		}
+		newScheduler := func() error {
+			_, err := entities.NewScheduler(
+				name,
+				game,
+				entities.StateCreating,
+				maxSurge,
+				"",
+				spec,
+				portRange,
+				roomsReplicas,
+				nil,
+				[]*forwarder.Forwarder{securedForwarder}, annotations, labels)
+			return err
+		}
+
+		require.Error(t, newScheduler())
+
+		securedForwarder.Options.GRPC.TLS.KeySecretRef = "matchmakerKey"
+		require.Error(t, newScheduler())
+
+		securedForwarder.Options.GRPC.Auth.Type = forwarder.AuthBearer
+		require.NoError(t, newScheduler())
+
+		securedForwarder.Options.GRPC.Auth.SecretRef = "matchmaker/token"
+		require.Error(t, newScheduler())
+
+		securedForwarder.Options.GRPC.Auth.SecretRef = "matchmakerToken"
+		securedForwarder.Options.GRPC.TLS = nil
+		require.Error(t, newScheduler())
+	})
+
+	t.Run("fails when try to create scheduler with invalid CloudEvents mode", func(t *testing.T) {
+		webhookForwarder := &forwarder.Forwarder{
+			Name:        "webhook",
+			Enabled:     true,
+			ForwardType: forwarder.TypeHTTP,
+			Address:     "https://webhook.example.com/events",
+			Options: &forwarder.ForwardOptions{
+				Timeout:     time.Second * 5,
+				CloudEvents: &forwarder.CloudEventsOptions{Mode: "batched"},
+			},
+		}
+		newScheduler := func() error {
+			_, err := entities.NewScheduler(
+				name,
+				game,
+				entities.StateCreating,
+				maxSurge,
+				"",
+				spec,
+				portRange,
+				roomsReplicas,
+				nil,
+				[]*forwarder.Forwarder{webhookForwarder}, annotations, labels)
+			return err
+		}
+
+		require.Error(t, newScheduler())
+
+		webhookForwarder.Options.CloudEvents.Mode = forwarder.CloudEventsBinary
+		require.NoError(t, newScheduler())
+
+		webhookForwarder.Options.CloudEvents.Mode = ""
+		require.NoError(t, newScheduler())
+	})
+
+	t.Run("fails when try to create scheduler with invalid signing secret ref", func(t *testing.T) {
+		webhookForwarder := &forwarder.Forwarder{
+			Name:        "webhook",
+			Enabled:     true,
+			ForwardType: forwarder.TypeHTTP,
+			Address:     "https://webhook.example.com/events",
+			Options: &forwarder.ForwardOptions{
+				Timeout: time.Second * 5,
+				HTTP:    &forwarder.HTTPOptions{SigningSecretRef: "webhook/secret"},
+			},
+		}
+		newScheduler := func() error {
+			_, err := entities.NewScheduler(
+				name,
+				game,
+				entities.StateCreating,
+				maxSurge,
+				"",
+				spec,
+				portRange,
+				roomsReplicas,
+				nil,
+				[]*forwarder.Forwarder{webhookForwarder}, annotations, labels)
+			return err
+		}
+
+		require.Error(t, newScheduler())
+
+		webhookForwarder.Options.HTTP.SigningSecretRef = "webhookSecret"
+		require.NoError(t, newScheduler())
+	})
 }
 
 func TestIsMajorVersion(t *testing.T) {
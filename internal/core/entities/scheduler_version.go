@@ -28,4 +28,8 @@ type SchedulerVersion struct {
 	Version   string `validate:"min=1"`
 	IsActive  bool
 	CreatedAt time.Time
+	// Pinned versions are never removed by the versions retention policy.
+	Pinned bool
+	// Blocked versions can't be switched to, e.g. versions known to be broken.
+	Blocked bool
 }
@@ -25,6 +25,7 @@ package logs
 const (
 	LogFieldSchedulerName       = "scheduler_name"
 	LogFieldSchedulerVersion    = "scheduler_version"
+	LogFieldTemplateName        = "template_name"
 	LogFieldGame                = "game"
 	LogFieldInstanceID          = "instance_id"
 	LogFieldRoomID              = "room_id"
@@ -42,4 +42,7 @@ const (
 	LabelGame              = "game"
 	LabelOperation         = "operation"
 	LabelStorage           = "storage"
+	LabelForwarder         = "forwarder"
+	LabelAddress           = "address"
+	LabelState             = "state"
 )
@@ -50,4 +50,7 @@ type Definition interface {
 	Name() string
 	// HasNoAction return a boolean showing when an operation does not change the runtime.
 	HasNoAction() bool
+	// Priority returns the priority class of the operation, used to order it
+	// among the scheduler pending operations.
+	Priority() operation.Priority
 }
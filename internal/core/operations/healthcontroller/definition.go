@@ -71,3 +71,7 @@ func (d *Definition) HasNoAction() bool {
 
 	return true
 }
+
+func (d *Definition) Priority() operation.Priority {
+	return operation.PriorityNormal
+}
@@ -24,16 +24,20 @@ package healthcontroller
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/topfreegames/maestro/internal/core/operations/rooms/add"
 	"github.com/topfreegames/maestro/internal/core/operations/rooms/remove"
+	"github.com/topfreegames/maestro/internal/core/operations/schedulers/switchversion"
 
 	"github.com/topfreegames/maestro/internal/core/entities"
 	"github.com/topfreegames/maestro/internal/core/entities/game_room"
+	"github.com/topfreegames/maestro/internal/core/entities/rollout"
 	"github.com/topfreegames/maestro/internal/core/logs"
 	"github.com/topfreegames/maestro/internal/core/ports"
+	porterrors "github.com/topfreegames/maestro/internal/core/ports/errors"
 
 	"github.com/topfreegames/maestro/internal/core/entities/operation"
 	"github.com/topfreegames/maestro/internal/core/operations"
@@ -126,6 +130,20 @@ func (ex *Executor) Execute(ctx context.Context, op *operation.Operation, defini
 
 	// Check if the system is in a rollingUpdate by listing rooms that are not the current scheduler version
 	roomsPreviousSchedulerVersion, isRollingUpdate := ex.checkRollingUpdate(ctx, logger, scheduler, availableRooms)
+	if isRollingUpdate && scheduler.RolloutStrategy.IsAutoRollbackEnabled() {
+		rolledBack, err := ex.checkRolloutHealth(ctx, op, def, logger, scheduler, existentGameRoomsInstancesMap)
+		if err != nil {
+			logger.Error("could not check the rollout health", zap.Error(err))
+			return err
+		}
+		if rolledBack {
+			ex.setTookAction(def, false)
+			return nil
+		}
+	}
+	if isRollingUpdate && scheduler.RolloutStrategy.IsBlueGreen() {
+		return ex.performBlueGreenDrain(ctx, op, def, logger, scheduler, desiredNumberOfRooms, availableRooms, roomsPreviousSchedulerVersion)
+	}
 	if isRollingUpdate {
 		return ex.performRollingUpdate(ctx, op, def, logger, scheduler, desiredNumberOfRooms, availableRooms, roomsPreviousSchedulerVersion)
 	}
@@ -201,7 +219,7 @@ func (ex *Executor) ensureDesiredAmountOfInstances(ctx context.Context, op *oper
 				return err
 			}
 			removeAmount := actualAmount - desiredAmount
-			removeOperation, err := ex.operationManager.CreatePriorityOperation(ctx, op.SchedulerName, &remove.Definition{
+			removeOperation, err := ex.operationManager.CreateOperation(ctx, op.SchedulerName, &remove.Definition{
 				Amount: removeAmount,
 				Reason: remove.ScaleDown,
 			})
@@ -216,8 +234,9 @@ func (ex *Executor) ensureDesiredAmountOfInstances(ctx context.Context, op *oper
 		}
 	case actualAmount < desiredAmount: // Need to scale up
 		addAmount := desiredAmount - actualAmount
-		addOperation, err := ex.operationManager.CreatePriorityOperation(ctx, op.SchedulerName, &add.Definition{
+		addOperation, err := ex.operationManager.CreateOperation(ctx, op.SchedulerName, &add.Definition{
 			Amount: int32(addAmount),
+			Reason: add.ScaleUp,
 		})
 		if err != nil {
 			return err
@@ -297,7 +316,7 @@ func (ex *Executor) isRoomStatus(room *game_room.GameRoom, status game_room.Game
 }
 
 func (ex *Executor) enqueueRemoveRooms(ctx context.Context, op *operation.Operation, logger *zap.Logger, roomsIDs []string) error {
-	removeOperation, err := ex.operationManager.CreatePriorityOperation(ctx, op.SchedulerName, &remove.Definition{
+	removeOperation, err := ex.operationManager.CreateOperation(ctx, op.SchedulerName, &remove.Definition{
 		RoomsIDs: roomsIDs,
 		Reason:   remove.Expired,
 	})
@@ -437,6 +456,11 @@ func (ex *Executor) performRollingUpdate(
 	if maxSurgeAmount <= 0 {
 		maxSurgeAmount = 1
 	}
+	maxUnavailableAmount, err := ex.roomManager.SchedulerMaxUnavailable(ctx, scheduler)
+	if err != nil {
+		logger.Error("failed to perform rolling update while getting max unavailable amount of rooms", zap.Error(err))
+		return err
+	}
 	logger.Info(
 		"upscaling new rooms",
 		zap.Int("desired", desiredNumberOfRooms),
@@ -444,8 +468,9 @@ func (ex *Executor) performRollingUpdate(
 		zap.Int("available", len(availableRoomsIDs)),
 		zap.Int("oldRooms", len(roomsWithPreviousSchedulerVersion)),
 	)
-	addOp, err := ex.operationManager.CreatePriorityOperation(ctx, op.SchedulerName, &add.Definition{
+	addOp, err := ex.operationManager.CreateOperation(ctx, op.SchedulerName, &add.Definition{
 		Amount: int32(maxSurgeAmount),
+		Reason: add.RollingUpdateSurge,
 	})
 	if err != nil {
 		logger.Error("failed to enqueue add operation for rolling update", zap.Error(err))
@@ -461,6 +486,7 @@ func (ex *Executor) performRollingUpdate(
 		scheduler,
 		roomsWithPreviousSchedulerVersion,
 		desiredNumberOfRooms,
+		maxUnavailableAmount,
 	)
 	if err != nil {
 		logger.Error("could not delete rooms with previous scheduler version", zap.Error(err))
@@ -485,12 +511,183 @@ func (ex *Executor) performRollingUpdate(
 	return nil
 }
 
+// performBlueGreenDrain removes the rooms on previous versions once they are
+// not occupied. Differently from the rolling update, no rooms are surged since
+// the blue/green switch already created every room on the active version, the
+// active version rooms are only replaced if they are below the desired amount.
+func (ex *Executor) performBlueGreenDrain(
+	ctx context.Context,
+	op *operation.Operation,
+	def *Definition,
+	logger *zap.Logger,
+	scheduler *entities.Scheduler,
+	desiredNumberOfRooms int,
+	availableRoomsIDs []string,
+	roomsWithPreviousSchedulerVersion []string,
+) error {
+	logger.Info("draining rooms with previous scheduler version", zap.String("scheduler.Version", scheduler.Spec.Version))
+	occupiedRooms, err := ex.roomStorage.GetRoomIDsByStatus(ctx, scheduler.Name, game_room.GameStatusOccupied)
+	if err != nil {
+		logger.Error("failed to list scheduler rooms on occupied status", zap.Error(err))
+		return err
+	}
+
+	occupiedRoomsMap := make(map[string]struct{}, len(occupiedRooms))
+	for _, roomID := range occupiedRooms {
+		occupiedRoomsMap[roomID] = struct{}{}
+	}
+
+	var roomsToDrain []string
+	for _, roomID := range roomsWithPreviousSchedulerVersion {
+		if _, ok := occupiedRoomsMap[roomID]; !ok {
+			roomsToDrain = append(roomsToDrain, roomID)
+		}
+	}
+
+	if len(roomsToDrain) > 0 {
+		removeOp, err := ex.operationManager.CreateOperation(ctx, op.SchedulerName, &remove.Definition{
+			RoomsIDs: roomsToDrain,
+			Reason:   remove.BlueGreenDrain,
+		})
+		if err != nil {
+			logger.Error("failed to enqueue remove operation for blue/green drain", zap.Error(err))
+			return err
+		}
+		msgToAppend := fmt.Sprintf("created operation (id: %s) to drain %v rooms with previous scheduler version.", removeOp.ID, len(roomsToDrain))
+		ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, msgToAppend)
+		ex.setTookAction(def, true)
+	}
+
+	activeVersionRoomsAmount := len(availableRoomsIDs) - len(roomsWithPreviousSchedulerVersion)
+	if activeVersionRoomsAmount >= desiredNumberOfRooms {
+		return nil
+	}
+
+	return ex.ensureDesiredAmountOfInstances(ctx, op, def, scheduler, logger, activeVersionRoomsAmount, desiredNumberOfRooms)
+}
+
+// checkRolloutHealth accounts the rooms on the version being rolled out that
+// are on error or expired, keeping them on the scheduler rollout health. When
+// the failure rate goes above the scheduler auto rollback threshold, it
+// enqueues the switch back to the rollback version and returns true, so the
+// rolling update doesn't proceed while the switch is pending.
+func (ex *Executor) checkRolloutHealth(
+	ctx context.Context,
+	op *operation.Operation,
+	def *Definition,
+	logger *zap.Logger,
+	scheduler *entities.Scheduler,
+	existentGameRoomsInstancesMap map[string]*game_room.Instance,
+) (bool, error) {
+	if scheduler.RollbackVersion == "" || scheduler.RollbackVersion == scheduler.Spec.Version {
+		return false, nil
+	}
+
+	health := scheduler.RolloutHealth
+	if health == nil || health.Version != scheduler.Spec.Version {
+		health = rollout.NewHealth(scheduler.Spec.Version)
+	}
+
+	// Rolling back a version that is itself the target of a rollback would
+	// cascade through the previous versions.
+	if health.RolledBackFrom != "" {
+		return false, nil
+	}
+
+	if health.RollbackOperationID != "" {
+		return ex.isRollbackPending(ctx, logger, scheduler.Name, health.RollbackOperationID)
+	}
+
+	healthChanged := scheduler.RolloutHealth != health
+	for gameRoomID := range existentGameRoomsInstancesMap {
+		room, err := ex.roomStorage.GetRoom(ctx, scheduler.Name, gameRoomID)
+		// if err != nil we will miss the room, it can be accounted in the next
+		// health_controller operation
+		if err != nil || room.Version != scheduler.Spec.Version {
+			continue
+		}
+
+		if health.ObserveRoom(gameRoomID, ex.isRolloutRoomFailed(room)) {
+			healthChanged = true
+		}
+	}
+
+	params := scheduler.RolloutStrategy.AutoRollback
+	failedAmount, observedAmount := health.FailedRooms(), len(health.Rooms)
+	if !params.ShouldRollback(failedAmount, observedAmount) {
+		if healthChanged {
+			return false, ex.updateRolloutHealth(ctx, scheduler, health)
+		}
+		return false, nil
+	}
+
+	reason := fmt.Sprintf(
+		"%d of %d rooms on version %s on error or expired, rate %.2f is above the threshold %.2f",
+		failedAmount, observedAmount, scheduler.Spec.Version, float64(failedAmount)/float64(observedAmount), params.FailureThreshold,
+	)
+	logger.Warn("rolling back scheduler version", zap.String("rollbackVersion", scheduler.RollbackVersion), zap.String("reason", reason))
+
+	switchOp, err := ex.operationManager.CreateOperation(ctx, scheduler.Name, &switchversion.Definition{NewActiveVersion: scheduler.RollbackVersion, RolledBackFrom: scheduler.Spec.Version})
+	if err != nil {
+		return false, fmt.Errorf("failed to enqueue switch active version operation to roll back: %w", err)
+	}
+
+	msgToAppend := fmt.Sprintf("created operation (id: %s) to roll back to version %s: %s.", switchOp.ID, scheduler.RollbackVersion, reason)
+	ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, msgToAppend)
+	ex.operationManager.AppendOperationEventToExecutionHistory(ctx, switchOp, fmt.Sprintf("automatic rollback from version %s: %s.", scheduler.Spec.Version, reason))
+	ex.setTookAction(def, true)
+
+	health.RollbackOperationID = switchOp.ID
+	return true, ex.updateRolloutHealth(ctx, scheduler, health)
+}
+
+// isRollbackPending returns true while the rollback operation is yet to be
+// executed. Once it ends without switching the version, e.g. it failed or was
+// canceled, the scheduler is reconciled as usual and the version is not rolled
+// back again.
+func (ex *Executor) isRollbackPending(ctx context.Context, logger *zap.Logger, schedulerName, rollbackOperationID string) (bool, error) {
+	rollbackOp, _, err := ex.operationManager.GetOperation(ctx, schedulerName, rollbackOperationID)
+	if err != nil && !errors.Is(err, porterrors.ErrNotFound) {
+		return false, fmt.Errorf("failed to fetch the rollback operation: %w", err)
+	}
+
+	if err == nil && (rollbackOp.Status == operation.StatusPending || rollbackOp.Status == operation.StatusInProgress) {
+		logger.Info("rollback already enqueued, waiting for the switch to the previous version", zap.String(logs.LogFieldOperationID, rollbackOperationID))
+		return true, nil
+	}
+
+	logger.Warn("rollback operation ended without switching the version, reconciling the scheduler", zap.String(logs.LogFieldOperationID, rollbackOperationID))
+	return false, nil
+}
+
+func (ex *Executor) updateRolloutHealth(ctx context.Context, scheduler *entities.Scheduler, health *rollout.Health) error {
+	scheduler.RolloutHealth = health
+	err := ex.schedulerStorage.UpdateScheduler(ctx, scheduler)
+	if err != nil {
+		return fmt.Errorf("failed to store the rollout health: %w", err)
+	}
+
+	return nil
+}
+
+func (ex *Executor) isRolloutRoomFailed(room *game_room.GameRoom) bool {
+	switch room.Status {
+	case game_room.GameStatusError:
+		return true
+	case game_room.GameStatusTerminating, game_room.GameStatusTerminated:
+		return false
+	}
+
+	return ex.isInitializingRoomExpired(room) || ex.isRoomPingExpired(room)
+}
+
 func (ex *Executor) markPreviousSchedulerRoomsForDeletion(
 	ctx context.Context,
 	logger *zap.Logger,
 	scheduler *entities.Scheduler,
 	roomsWithPreviousSchedulerVersion []string,
 	desiredNumberOfTotalRooms int,
+	maxUnavailableAmount int,
 ) ([]string, error) {
 	curReadyRooms, err := ex.roomStorage.GetRoomIDsByStatus(ctx, scheduler.Name, game_room.GameStatusReady)
 	if err != nil {
@@ -500,7 +697,9 @@ func (ex *Executor) markPreviousSchedulerRoomsForDeletion(
 	if err != nil {
 		return []string{}, fmt.Errorf("failed to list scheduler rooms on occupied status: %w", err)
 	}
-	desiredNumberOfReadyRooms := desiredNumberOfTotalRooms - len(curOccupiedRooms)
+	// the scheduler accepts having up to maxUnavailable rooms less than the
+	// desired while old rooms are being replaced.
+	desiredNumberOfReadyRooms := desiredNumberOfTotalRooms - len(curOccupiedRooms) - maxUnavailableAmount
 	bufferRoomsToBeRemoved := len(curReadyRooms) - desiredNumberOfReadyRooms
 	logger = logger.With(
 		zap.Int("roomsWithPreviousSchedulerVersion", len(roomsWithPreviousSchedulerVersion)),
@@ -508,10 +707,11 @@ func (ex *Executor) markPreviousSchedulerRoomsForDeletion(
 		zap.Int("currentReadyRooms", len(curReadyRooms)),
 		zap.Int("desiredNumberOfReadyRooms", desiredNumberOfReadyRooms),
 		zap.Int("desiredNumberOfTotalRooms", desiredNumberOfTotalRooms),
+		zap.Int("maxUnavailableAmount", maxUnavailableAmount),
 		zap.Int("bufferRoomsToBeRemoved", bufferRoomsToBeRemoved),
 	)
 	if bufferRoomsToBeRemoved < 0 {
-		logger.Info("can not delete old rooms without offending maxUnavailable: 0")
+		logger.Info("can not delete old rooms without offending maxUnavailable", zap.Int("maxUnavailable", maxUnavailableAmount))
 		return []string{}, nil
 	}
 	if bufferRoomsToBeRemoved > len(roomsWithPreviousSchedulerVersion) {
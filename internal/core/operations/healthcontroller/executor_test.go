@@ -28,11 +28,13 @@ package healthcontroller_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/topfreegames/maestro/internal/core/operations/rooms/add"
 	"github.com/topfreegames/maestro/internal/core/operations/rooms/remove"
+	"github.com/topfreegames/maestro/internal/core/operations/schedulers/switchversion"
 
 	"github.com/topfreegames/maestro/internal/core/entities/autoscaling"
 
@@ -43,6 +45,7 @@ import (
 	"github.com/topfreegames/maestro/internal/core/entities/game_room"
 	"github.com/topfreegames/maestro/internal/core/entities/operation"
 	"github.com/topfreegames/maestro/internal/core/entities/port"
+	"github.com/topfreegames/maestro/internal/core/entities/rollout"
 	"github.com/topfreegames/maestro/internal/core/operations/healthcontroller"
 	mockports "github.com/topfreegames/maestro/internal/core/ports/mock"
 )
@@ -224,10 +227,10 @@ func TestSchedulerHealthController_Execute(t *testing.T) {
 
 					op := operation.New(genericSchedulerNoAutoscaling.Name, definition.Name(), nil)
 					operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), gomock.Any(), gomock.Any())
-					operationManager.EXPECT().CreatePriorityOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &remove.Definition{RoomsIDs: []string{gameRoomIDs[1]}, Reason: remove.Expired}).Return(op, nil)
+					operationManager.EXPECT().CreateOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &remove.Definition{RoomsIDs: []string{gameRoomIDs[1]}, Reason: remove.Expired}).Return(op, nil)
 
 					operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), gomock.Any(), gomock.Any())
-					operationManager.EXPECT().CreatePriorityOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &add.Definition{Amount: 1}).Return(op, nil)
+					operationManager.EXPECT().CreateOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &add.Definition{Amount: 1, Reason: add.ScaleUp}).Return(op, nil)
 
 					genericSchedulerNoAutoscaling.RoomsReplicas = 2
 
@@ -290,10 +293,10 @@ func TestSchedulerHealthController_Execute(t *testing.T) {
 
 					op := operation.New(genericSchedulerNoAutoscaling.Name, definition.Name(), nil)
 					operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), gomock.Any(), gomock.Any())
-					operationManager.EXPECT().CreatePriorityOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &remove.Definition{RoomsIDs: []string{gameRoomIDs[1]}, Reason: remove.Expired}).Return(op, nil)
+					operationManager.EXPECT().CreateOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &remove.Definition{RoomsIDs: []string{gameRoomIDs[1]}, Reason: remove.Expired}).Return(op, nil)
 
 					operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), gomock.Any(), gomock.Any())
-					operationManager.EXPECT().CreatePriorityOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &add.Definition{Amount: 1}).Return(op, nil)
+					operationManager.EXPECT().CreateOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &add.Definition{Amount: 1, Reason: add.ScaleUp}).Return(op, nil)
 
 					genericSchedulerNoAutoscaling.RoomsReplicas = 2
 
@@ -513,7 +516,7 @@ func TestSchedulerHealthController_Execute(t *testing.T) {
 					genericSchedulerNoAutoscaling.RoomsReplicas = 1
 					op := operation.New(genericSchedulerNoAutoscaling.Name, definition.Name(), nil)
 					operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), gomock.Any(), gomock.Any())
-					operationManager.EXPECT().CreatePriorityOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &remove.Definition{RoomsIDs: []string{gameRoomIDs[1]}, Reason: remove.Expired}).Return(op, nil)
+					operationManager.EXPECT().CreateOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &remove.Definition{RoomsIDs: []string{gameRoomIDs[1]}, Reason: remove.Expired}).Return(op, nil)
 
 					roomStorage.EXPECT().GetRoom(gomock.Any(), genericSchedulerNoAutoscaling.Name, gameRoomIDs[0]).Return(gameRoom, nil)
 				},
@@ -615,11 +618,11 @@ func TestSchedulerHealthController_Execute(t *testing.T) {
 					roomStorage.EXPECT().GetRoom(gomock.Any(), genericSchedulerNoAutoscaling.Name, gameRoomIDs[1]).Return(expiredGameRoom, nil)
 
 					genericSchedulerNoAutoscaling.RoomsReplicas = 2
-					operationManager.EXPECT().CreatePriorityOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &remove.Definition{RoomsIDs: []string{gameRoomIDs[1]}, Reason: remove.Expired}).Return(nil, errors.New("error"))
+					operationManager.EXPECT().CreateOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &remove.Definition{RoomsIDs: []string{gameRoomIDs[1]}, Reason: remove.Expired}).Return(nil, errors.New("error"))
 
 					op := operation.New(genericSchedulerNoAutoscaling.Name, definition.Name(), nil)
 					operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), gomock.Any(), gomock.Any())
-					operationManager.EXPECT().CreatePriorityOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &add.Definition{Amount: 1}).Return(op, nil)
+					operationManager.EXPECT().CreateOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &add.Definition{Amount: 1, Reason: add.ScaleUp}).Return(op, nil)
 
 					roomStorage.EXPECT().GetRoom(gomock.Any(), genericSchedulerNoAutoscaling.Name, gameRoomIDs[0]).Return(gameRoom, nil)
 				},
@@ -648,7 +651,7 @@ func TestSchedulerHealthController_Execute(t *testing.T) {
 					genericSchedulerNoAutoscaling.RoomsReplicas = 2
 					op := operation.New(genericSchedulerNoAutoscaling.Name, definition.Name(), nil)
 					operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), gomock.Any(), gomock.Any())
-					operationManager.EXPECT().CreatePriorityOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &add.Definition{Amount: 2}).Return(op, nil)
+					operationManager.EXPECT().CreateOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &add.Definition{Amount: 2, Reason: add.ScaleUp}).Return(op, nil)
 
 				},
 			},
@@ -676,7 +679,7 @@ func TestSchedulerHealthController_Execute(t *testing.T) {
 					genericSchedulerAutoscalingDisabled.RoomsReplicas = 2
 					op := operation.New(genericSchedulerAutoscalingDisabled.Name, definition.Name(), nil)
 					operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), gomock.Any(), gomock.Any())
-					operationManager.EXPECT().CreatePriorityOperation(gomock.Any(), genericSchedulerAutoscalingDisabled.Name, &add.Definition{Amount: 2}).Return(op, nil)
+					operationManager.EXPECT().CreateOperation(gomock.Any(), genericSchedulerAutoscalingDisabled.Name, &add.Definition{Amount: 2, Reason: add.ScaleUp}).Return(op, nil)
 				},
 			},
 		},
@@ -703,7 +706,7 @@ func TestSchedulerHealthController_Execute(t *testing.T) {
 
 					op := operation.New(genericSchedulerAutoscalingEnabled.Name, definition.Name(), nil)
 					operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), gomock.Any(), gomock.Any())
-					operationManager.EXPECT().CreatePriorityOperation(gomock.Any(), genericSchedulerAutoscalingEnabled.Name, &add.Definition{Amount: 2}).Return(op, nil)
+					operationManager.EXPECT().CreateOperation(gomock.Any(), genericSchedulerAutoscalingEnabled.Name, &add.Definition{Amount: 2, Reason: add.ScaleUp}).Return(op, nil)
 				},
 			},
 		},
@@ -728,7 +731,7 @@ func TestSchedulerHealthController_Execute(t *testing.T) {
 					schedulerStorage.EXPECT().GetScheduler(gomock.Any(), gomock.Any()).Return(genericSchedulerNoAutoscaling, nil)
 
 					genericSchedulerNoAutoscaling.RoomsReplicas = 2
-					operationManager.EXPECT().CreatePriorityOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &add.Definition{Amount: 2}).Return(nil, errors.New("error"))
+					operationManager.EXPECT().CreateOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &add.Definition{Amount: 2, Reason: add.ScaleUp}).Return(nil, errors.New("error"))
 
 				},
 				shouldFail: true,
@@ -776,7 +779,7 @@ func TestSchedulerHealthController_Execute(t *testing.T) {
 					genericSchedulerNoAutoscaling.RoomsReplicas = 0
 					op := operation.New(genericSchedulerNoAutoscaling.Name, definition.Name(), nil)
 					operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), gomock.Any(), gomock.Any())
-					operationManager.EXPECT().CreatePriorityOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &remove.Definition{Amount: 1, Reason: remove.ScaleDown}).Return(op, nil)
+					operationManager.EXPECT().CreateOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &remove.Definition{Amount: 1, Reason: remove.ScaleDown}).Return(op, nil)
 
 					roomStorage.EXPECT().GetRoom(gomock.Any(), genericSchedulerNoAutoscaling.Name, gameRoomIDs[0]).Return(gameRoom, nil)
 				},
@@ -824,7 +827,7 @@ func TestSchedulerHealthController_Execute(t *testing.T) {
 					genericSchedulerAutoscalingDisabled.RoomsReplicas = 0
 					op := operation.New(genericSchedulerAutoscalingDisabled.Name, definition.Name(), nil)
 					operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), gomock.Any(), gomock.Any())
-					operationManager.EXPECT().CreatePriorityOperation(gomock.Any(), genericSchedulerAutoscalingDisabled.Name, &remove.Definition{Amount: 1, Reason: remove.ScaleDown}).Return(op, nil)
+					operationManager.EXPECT().CreateOperation(gomock.Any(), genericSchedulerAutoscalingDisabled.Name, &remove.Definition{Amount: 1, Reason: remove.ScaleDown}).Return(op, nil)
 
 					roomStorage.EXPECT().GetRoom(gomock.Any(), genericSchedulerAutoscalingDisabled.Name, gameRoomIDs[0]).Return(gameRoom, nil)
 				},
@@ -872,7 +875,7 @@ func TestSchedulerHealthController_Execute(t *testing.T) {
 
 					op := operation.New(genericSchedulerAutoscalingEnabled.Name, definition.Name(), nil)
 					operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), gomock.Any(), gomock.Any())
-					operationManager.EXPECT().CreatePriorityOperation(gomock.Any(), genericSchedulerAutoscalingEnabled.Name, &remove.Definition{Amount: 1, Reason: remove.ScaleDown}).Return(op, nil)
+					operationManager.EXPECT().CreateOperation(gomock.Any(), genericSchedulerAutoscalingEnabled.Name, &remove.Definition{Amount: 1, Reason: remove.ScaleDown}).Return(op, nil)
 
 					roomStorage.EXPECT().GetRoom(gomock.Any(), genericSchedulerAutoscalingEnabled.Name, gameRoomIDs[0]).Return(gameRoom, nil)
 				},
@@ -962,7 +965,7 @@ func TestSchedulerHealthController_Execute(t *testing.T) {
 					roomStorage.EXPECT().GetRoom(gomock.Any(), genericSchedulerNoAutoscaling.Name, gameRoomIDs[0]).Return(gameRoom, nil)
 
 					genericSchedulerNoAutoscaling.RoomsReplicas = 0
-					operationManager.EXPECT().CreatePriorityOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &remove.Definition{Amount: 1, Reason: remove.ScaleDown}).Return(nil, errors.New("error"))
+					operationManager.EXPECT().CreateOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &remove.Definition{Amount: 1, Reason: remove.ScaleDown}).Return(nil, errors.New("error"))
 
 					roomStorage.EXPECT().GetRoom(gomock.Any(), genericSchedulerNoAutoscaling.Name, gameRoomIDs[0]).Return(gameRoom, nil)
 				},
@@ -1113,7 +1116,7 @@ func TestSchedulerHealthController_Execute(t *testing.T) {
 					genericSchedulerNoAutoscaling.RoomsReplicas = 2
 					op := operation.New(genericSchedulerNoAutoscaling.Name, definition.Name(), nil)
 					operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), gomock.Any(), gomock.Any())
-					operationManager.EXPECT().CreatePriorityOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &add.Definition{Amount: 1}).Return(op, nil)
+					operationManager.EXPECT().CreateOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &add.Definition{Amount: 1, Reason: add.ScaleUp}).Return(op, nil)
 
 					roomStorage.EXPECT().GetRoom(gomock.Any(), genericSchedulerNoAutoscaling.Name, gameRoomIDs[0]).Return(gameRoom, nil)
 				},
@@ -1173,7 +1176,7 @@ func TestSchedulerHealthController_Execute(t *testing.T) {
 					genericSchedulerNoAutoscaling.RoomsReplicas = 2
 					op := operation.New(genericSchedulerNoAutoscaling.Name, definition.Name(), nil)
 					operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), gomock.Any(), gomock.Any())
-					operationManager.EXPECT().CreatePriorityOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &add.Definition{Amount: 1}).Return(op, nil)
+					operationManager.EXPECT().CreateOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &add.Definition{Amount: 1, Reason: add.ScaleUp}).Return(op, nil)
 
 					roomStorage.EXPECT().GetRoom(gomock.Any(), genericSchedulerNoAutoscaling.Name, gameRoomIDs[0]).Return(gameRoom, nil)
 				},
@@ -1233,7 +1236,7 @@ func TestSchedulerHealthController_Execute(t *testing.T) {
 					genericSchedulerNoAutoscaling.RoomsReplicas = 2
 					op := operation.New(genericSchedulerNoAutoscaling.Name, definition.Name(), nil)
 					operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), gomock.Any(), gomock.Any())
-					operationManager.EXPECT().CreatePriorityOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &add.Definition{Amount: 1}).Return(op, nil)
+					operationManager.EXPECT().CreateOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &add.Definition{Amount: 1, Reason: add.ScaleUp}).Return(op, nil)
 
 					roomStorage.EXPECT().GetRoom(gomock.Any(), genericSchedulerNoAutoscaling.Name, gameRoomIDs[0]).Return(gameRoom, nil)
 				},
@@ -1294,10 +1297,10 @@ func TestSchedulerHealthController_Execute(t *testing.T) {
 
 					op := operation.New(genericSchedulerNoAutoscaling.Name, definition.Name(), nil)
 					operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), gomock.Any(), gomock.Any())
-					operationManager.EXPECT().CreatePriorityOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &remove.Definition{RoomsIDs: []string{gameRoomIDs[1]}, Reason: remove.Expired}).Return(op, nil)
+					operationManager.EXPECT().CreateOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &remove.Definition{RoomsIDs: []string{gameRoomIDs[1]}, Reason: remove.Expired}).Return(op, nil)
 
 					operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), gomock.Any(), gomock.Any())
-					operationManager.EXPECT().CreatePriorityOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &add.Definition{Amount: 1}).Return(op, nil)
+					operationManager.EXPECT().CreateOperation(gomock.Any(), genericSchedulerNoAutoscaling.Name, &add.Definition{Amount: 1, Reason: add.ScaleUp}).Return(op, nil)
 
 					genericSchedulerNoAutoscaling.RoomsReplicas = 2
 
@@ -1371,7 +1374,8 @@ func TestSchedulerHealthController_Execute(t *testing.T) {
 
 					// Perform rolling update
 					roomManager.EXPECT().SchedulerMaxSurge(gomock.Any(), newScheduler).Return(1, nil)
-					operationManager.EXPECT().CreatePriorityOperation(gomock.Any(), newScheduler.Name, &add.Definition{Amount: 1}).Return(op, nil)
+					roomManager.EXPECT().SchedulerMaxUnavailable(gomock.Any(), newScheduler).Return(0, nil)
+					operationManager.EXPECT().CreateOperation(gomock.Any(), newScheduler.Name, &add.Definition{Amount: 1, Reason: add.RollingUpdateSurge}).Return(op, nil)
 					operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), gomock.Any(), gomock.Any()).Times(2)
 					roomStorage.EXPECT().GetRoomIDsByStatus(gomock.Any(), newScheduler.Name, game_room.GameStatusOccupied).Return(gameRoomIDs, nil)
 					roomStorage.EXPECT().GetRoomIDsByStatus(gomock.Any(), newScheduler.Name, game_room.GameStatusReady).Return(gameRoomIDs, nil)
@@ -1382,6 +1386,468 @@ func TestSchedulerHealthController_Execute(t *testing.T) {
 				},
 			},
 		},
+		{
+			title:      "room scheduler version do not match current scheduler, remove old ready rooms within the max unavailable",
+			definition: &healthcontroller.Definition{},
+			executionPlan: executionPlan{
+				tookAction: true,
+				planMocks: func(
+					roomStorage *mockports.MockRoomStorage,
+					roomManager *mockports.MockRoomManager,
+					instanceStorage *mockports.MockGameRoomInstanceStorage,
+					schedulerStorage *mockports.MockSchedulerStorage,
+					operationManager *mockports.MockOperationManager,
+					autoscaler *mockports.MockAutoscaler,
+				) {
+					gameRoomIDs := []string{"room-1", "room-2", "room-3"}
+					instances := []*game_room.Instance{
+						{ID: "room-1", Status: game_room.InstanceStatus{Type: game_room.InstanceReady}},
+						{ID: "room-2", Status: game_room.InstanceStatus{Type: game_room.InstanceReady}},
+						{ID: "room-3", Status: game_room.InstanceStatus{Type: game_room.InstanceReady}},
+					}
+					newScheduler := newValidScheduler(nil)
+					newScheduler.Spec.Version = "v2"
+					newScheduler.RoomsReplicas = 3
+					newScheduler.MaxUnavailable = "1"
+
+					// load
+					roomStorage.EXPECT().GetAllRoomIDs(gomock.Any(), gomock.Any()).Return(gameRoomIDs, nil)
+					instanceStorage.EXPECT().GetAllInstances(gomock.Any(), gomock.Any()).Return(instances, nil)
+					schedulerStorage.EXPECT().GetScheduler(gomock.Any(), gomock.Any()).Return(newScheduler, nil)
+
+					// findAvailableAndExpiredRooms and check for rolling update
+					for _, roomID := range gameRoomIDs {
+						roomStorage.EXPECT().GetRoom(gomock.Any(), newScheduler.Name, roomID).Return(&game_room.GameRoom{
+							ID:          roomID,
+							SchedulerID: newScheduler.Name,
+							Status:      game_room.GameStatusReady,
+							LastPingAt:  time.Now(),
+							Version:     "v1",
+						}, nil).Times(2)
+					}
+
+					op := operation.New(newScheduler.Name, definition.Name(), nil)
+
+					// Perform rolling update
+					roomManager.EXPECT().SchedulerMaxSurge(gomock.Any(), newScheduler).Return(1, nil)
+					roomManager.EXPECT().SchedulerMaxUnavailable(gomock.Any(), newScheduler).Return(1, nil)
+					operationManager.EXPECT().CreateOperation(gomock.Any(), newScheduler.Name, &add.Definition{Amount: 1, Reason: add.RollingUpdateSurge}).Return(op, nil)
+					operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), gomock.Any(), gomock.Any()).Times(2)
+					roomStorage.EXPECT().GetRoomIDsByStatus(gomock.Any(), newScheduler.Name, game_room.GameStatusOccupied).Return([]string{}, nil)
+					roomStorage.EXPECT().GetRoomIDsByStatus(gomock.Any(), newScheduler.Name, game_room.GameStatusReady).Return(gameRoomIDs, nil)
+					// the available rooms order isn't stable, so any single old room may be removed
+					operationManager.EXPECT().CreateOperation(gomock.Any(), newScheduler.Name, removeOneOfRooms(gameRoomIDs, remove.RollingUpdateReplace)).Return(op, nil)
+
+					// Shouldn't autoscale
+					schedulerStorage.EXPECT().UpdateScheduler(gomock.Any(), gomock.Any()).Times(0)
+				},
+			},
+		},
+		{
+			title:      "room scheduler version do not match current blue/green scheduler, drain rooms that are not occupied and do not surge",
+			definition: &healthcontroller.Definition{},
+			executionPlan: executionPlan{
+				tookAction: true,
+				planMocks: func(
+					roomStorage *mockports.MockRoomStorage,
+					roomManager *mockports.MockRoomManager,
+					instanceStorage *mockports.MockGameRoomInstanceStorage,
+					schedulerStorage *mockports.MockSchedulerStorage,
+					operationManager *mockports.MockOperationManager,
+					autoscaler *mockports.MockAutoscaler,
+				) {
+					gameRoomIDs := []string{"room-1", "room-2", "room-3"}
+					instances := []*game_room.Instance{
+						{ID: "room-1", Status: game_room.InstanceStatus{Type: game_room.InstanceReady}},
+						{ID: "room-2", Status: game_room.InstanceStatus{Type: game_room.InstanceReady}},
+						{ID: "room-3", Status: game_room.InstanceStatus{Type: game_room.InstanceReady}},
+					}
+					newScheduler := newValidScheduler(nil)
+					newScheduler.Spec.Version = "v2"
+					newScheduler.RoomsReplicas = 1
+					newScheduler.RolloutStrategy = &rollout.Strategy{Type: rollout.BlueGreen}
+					oldReadyRoom := &game_room.GameRoom{
+						ID:          gameRoomIDs[0],
+						SchedulerID: newScheduler.Name,
+						Status:      game_room.GameStatusReady,
+						LastPingAt:  time.Now(),
+						Version:     "v1",
+					}
+					oldOccupiedRoom := &game_room.GameRoom{
+						ID:          gameRoomIDs[1],
+						SchedulerID: newScheduler.Name,
+						Status:      game_room.GameStatusOccupied,
+						LastPingAt:  time.Now(),
+						Version:     "v1",
+					}
+					newRoom := &game_room.GameRoom{
+						ID:          gameRoomIDs[2],
+						SchedulerID: newScheduler.Name,
+						Status:      game_room.GameStatusReady,
+						LastPingAt:  time.Now(),
+						Version:     "v2",
+					}
+
+					// load
+					roomStorage.EXPECT().GetAllRoomIDs(gomock.Any(), gomock.Any()).Return(gameRoomIDs, nil)
+					instanceStorage.EXPECT().GetAllInstances(gomock.Any(), gomock.Any()).Return(instances, nil)
+					schedulerStorage.EXPECT().GetScheduler(gomock.Any(), gomock.Any()).Return(newScheduler, nil)
+
+					// findAvailableAndExpiredRooms and check for rolling update
+					roomStorage.EXPECT().GetRoom(gomock.Any(), newScheduler.Name, gameRoomIDs[0]).Return(oldReadyRoom, nil).Times(2)
+					roomStorage.EXPECT().GetRoom(gomock.Any(), newScheduler.Name, gameRoomIDs[1]).Return(oldOccupiedRoom, nil).Times(2)
+					roomStorage.EXPECT().GetRoom(gomock.Any(), newScheduler.Name, gameRoomIDs[2]).Return(newRoom, nil).Times(2)
+
+					op := operation.New(newScheduler.Name, definition.Name(), nil)
+
+					// Perform blue/green drain
+					roomStorage.EXPECT().GetRoomIDsByStatus(gomock.Any(), newScheduler.Name, game_room.GameStatusOccupied).Return([]string{gameRoomIDs[1]}, nil)
+					operationManager.EXPECT().CreateOperation(gomock.Any(), newScheduler.Name, &remove.Definition{RoomsIDs: []string{gameRoomIDs[0]}, Reason: remove.BlueGreenDrain}).Return(op, nil)
+					operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), gomock.Any(), gomock.Any())
+
+					// Shouldn't surge nor autoscale
+					roomManager.EXPECT().SchedulerMaxSurge(gomock.Any(), gomock.Any()).Times(0)
+					schedulerStorage.EXPECT().UpdateScheduler(gomock.Any(), gomock.Any()).Times(0)
+				},
+			},
+		},
+		{
+			title:      "rooms on the new version failing above the auto rollback threshold, enqueue switch to the rollback version and do not perform rolling update",
+			definition: &healthcontroller.Definition{},
+			executionPlan: executionPlan{
+				tookAction: true,
+				planMocks: func(
+					roomStorage *mockports.MockRoomStorage,
+					roomManager *mockports.MockRoomManager,
+					instanceStorage *mockports.MockGameRoomInstanceStorage,
+					schedulerStorage *mockports.MockSchedulerStorage,
+					operationManager *mockports.MockOperationManager,
+					autoscaler *mockports.MockAutoscaler,
+				) {
+					gameRoomIDs := []string{"room-1", "room-2"}
+					instances := []*game_room.Instance{
+						{ID: "room-1", Status: game_room.InstanceStatus{Type: game_room.InstanceReady}},
+						{ID: "room-2", Status: game_room.InstanceStatus{Type: game_room.InstanceReady}},
+					}
+					newScheduler := newValidScheduler(nil)
+					newScheduler.Spec.Version = "v2"
+					newScheduler.RollbackVersion = "v1"
+					newScheduler.RoomsReplicas = 2
+					newScheduler.RolloutStrategy = &rollout.Strategy{
+						Type:         rollout.RollingUpdate,
+						AutoRollback: &rollout.AutoRollbackParams{FailureThreshold: 0.4, MinRooms: 1},
+					}
+					oldRoom := &game_room.GameRoom{
+						ID:          gameRoomIDs[0],
+						SchedulerID: newScheduler.Name,
+						Status:      game_room.GameStatusReady,
+						LastPingAt:  time.Now(),
+						Version:     "v1",
+					}
+					failedNewRoom := &game_room.GameRoom{
+						ID:          gameRoomIDs[1],
+						SchedulerID: newScheduler.Name,
+						Status:      game_room.GameStatusError,
+						LastPingAt:  time.Now(),
+						Version:     "v2",
+					}
+
+					// load
+					roomStorage.EXPECT().GetAllRoomIDs(gomock.Any(), gomock.Any()).Return(gameRoomIDs, nil)
+					instanceStorage.EXPECT().GetAllInstances(gomock.Any(), gomock.Any()).Return(instances, nil)
+					schedulerStorage.EXPECT().GetScheduler(gomock.Any(), gomock.Any()).Return(newScheduler, nil)
+
+					// findAvailableAndExpiredRooms, check for rolling update and check rollout health
+					roomStorage.EXPECT().GetRoom(gomock.Any(), newScheduler.Name, gameRoomIDs[0]).Return(oldRoom, nil).Times(3)
+					roomStorage.EXPECT().GetRoom(gomock.Any(), newScheduler.Name, gameRoomIDs[1]).Return(failedNewRoom, nil).Times(2)
+
+					// Roll back
+					switchOp := operation.New(newScheduler.Name, "switch_active_version", nil)
+					operationManager.EXPECT().CreateOperation(gomock.Any(), newScheduler.Name, &switchversion.Definition{NewActiveVersion: "v1", RolledBackFrom: "v2"}).Return(switchOp, nil)
+					operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), genericOperation, fmt.Sprintf("created operation (id: %s) to roll back to version v1: 1 of 1 rooms on version v2 on error or expired, rate 1.00 is above the threshold 0.40.", switchOp.ID))
+					operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), switchOp, "automatic rollback from version v2: 1 of 1 rooms on version v2 on error or expired, rate 1.00 is above the threshold 0.40.")
+					schedulerStorage.EXPECT().UpdateScheduler(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, scheduler *entities.Scheduler) error {
+						assert.Equal(t, &rollout.Health{
+							Version:             "v2",
+							Rooms:               map[string]bool{gameRoomIDs[1]: true},
+							RollbackOperationID: switchOp.ID,
+						}, scheduler.RolloutHealth)
+						return nil
+					})
+
+					// Shouldn't perform rolling update
+					roomManager.EXPECT().SchedulerMaxSurge(gomock.Any(), gomock.Any()).Times(0)
+				},
+			},
+		},
+		{
+			title:      "rooms accounted as failed before are kept on the rollout health, enqueue switch to the rollback version",
+			definition: &healthcontroller.Definition{},
+			executionPlan: executionPlan{
+				tookAction: true,
+				planMocks: func(
+					roomStorage *mockports.MockRoomStorage,
+					roomManager *mockports.MockRoomManager,
+					instanceStorage *mockports.MockGameRoomInstanceStorage,
+					schedulerStorage *mockports.MockSchedulerStorage,
+					operationManager *mockports.MockOperationManager,
+					autoscaler *mockports.MockAutoscaler,
+				) {
+					gameRoomIDs := []string{"room-1", "room-2"}
+					instances := []*game_room.Instance{
+						{ID: "room-1", Status: game_room.InstanceStatus{Type: game_room.InstanceReady}},
+						{ID: "room-2", Status: game_room.InstanceStatus{Type: game_room.InstanceReady}},
+					}
+					newScheduler := newValidScheduler(nil)
+					newScheduler.Spec.Version = "v2"
+					newScheduler.RollbackVersion = "v1"
+					newScheduler.RoomsReplicas = 2
+					newScheduler.RolloutStrategy = &rollout.Strategy{
+						Type:         rollout.RollingUpdate,
+						AutoRollback: &rollout.AutoRollbackParams{FailureThreshold: 0.4, MinRooms: 2},
+					}
+					// room-0 failed and was replaced before the previous health controller run.
+					newScheduler.RolloutHealth = &rollout.Health{Version: "v2", Rooms: map[string]bool{"room-0": true}}
+					oldRoom := &game_room.GameRoom{
+						ID:          gameRoomIDs[0],
+						SchedulerID: newScheduler.Name,
+						Status:      game_room.GameStatusReady,
+						LastPingAt:  time.Now(),
+						Version:     "v1",
+					}
+					newRoom := &game_room.GameRoom{
+						ID:          gameRoomIDs[1],
+						SchedulerID: newScheduler.Name,
+						Status:      game_room.GameStatusReady,
+						LastPingAt:  time.Now(),
+						Version:     "v2",
+					}
+
+					// load
+					roomStorage.EXPECT().GetAllRoomIDs(gomock.Any(), gomock.Any()).Return(gameRoomIDs, nil)
+					instanceStorage.EXPECT().GetAllInstances(gomock.Any(), gomock.Any()).Return(instances, nil)
+					schedulerStorage.EXPECT().GetScheduler(gomock.Any(), gomock.Any()).Return(newScheduler, nil)
+
+					// findAvailableAndExpiredRooms, check for rolling update and check rollout health
+					roomStorage.EXPECT().GetRoom(gomock.Any(), newScheduler.Name, gameRoomIDs[0]).Return(oldRoom, nil).Times(3)
+					roomStorage.EXPECT().GetRoom(gomock.Any(), newScheduler.Name, gameRoomIDs[1]).Return(newRoom, nil).Times(3)
+
+					// Roll back
+					switchOp := operation.New(newScheduler.Name, "switch_active_version", nil)
+					operationManager.EXPECT().CreateOperation(gomock.Any(), newScheduler.Name, &switchversion.Definition{NewActiveVersion: "v1", RolledBackFrom: "v2"}).Return(switchOp, nil)
+					operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), gomock.Any(), gomock.Any()).Times(2)
+					schedulerStorage.EXPECT().UpdateScheduler(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, scheduler *entities.Scheduler) error {
+						assert.Equal(t, &rollout.Health{
+							Version:             "v2",
+							Rooms:               map[string]bool{"room-0": true, gameRoomIDs[1]: false},
+							RollbackOperationID: switchOp.ID,
+						}, scheduler.RolloutHealth)
+						return nil
+					})
+
+					// Shouldn't perform rolling update
+					roomManager.EXPECT().SchedulerMaxSurge(gomock.Any(), gomock.Any()).Times(0)
+				},
+			},
+		},
+		{
+			title:      "rollback already enqueued and pending, do not perform rolling update",
+			definition: &healthcontroller.Definition{},
+			executionPlan: executionPlan{
+				tookAction: false,
+				planMocks: func(
+					roomStorage *mockports.MockRoomStorage,
+					roomManager *mockports.MockRoomManager,
+					instanceStorage *mockports.MockGameRoomInstanceStorage,
+					schedulerStorage *mockports.MockSchedulerStorage,
+					operationManager *mockports.MockOperationManager,
+					autoscaler *mockports.MockAutoscaler,
+				) {
+					gameRoomIDs := []string{"room-1", "room-2"}
+					instances := []*game_room.Instance{
+						{ID: "room-1", Status: game_room.InstanceStatus{Type: game_room.InstanceReady}},
+						{ID: "room-2", Status: game_room.InstanceStatus{Type: game_room.InstanceReady}},
+					}
+					switchOp := operation.New("", "switch_active_version", nil)
+					switchOp.Status = operation.StatusPending
+					newScheduler := newValidScheduler(nil)
+					newScheduler.Spec.Version = "v2"
+					newScheduler.RollbackVersion = "v1"
+					newScheduler.RoomsReplicas = 2
+					newScheduler.RolloutStrategy = &rollout.Strategy{
+						Type:         rollout.RollingUpdate,
+						AutoRollback: &rollout.AutoRollbackParams{FailureThreshold: 0.4, MinRooms: 1},
+					}
+					newScheduler.RolloutHealth = &rollout.Health{Version: "v2", Rooms: map[string]bool{"room-2": true}, RollbackOperationID: switchOp.ID}
+					oldRoom := &game_room.GameRoom{
+						ID:          gameRoomIDs[0],
+						SchedulerID: newScheduler.Name,
+						Status:      game_room.GameStatusReady,
+						LastPingAt:  time.Now(),
+						Version:     "v1",
+					}
+					failedNewRoom := &game_room.GameRoom{
+						ID:          gameRoomIDs[1],
+						SchedulerID: newScheduler.Name,
+						Status:      game_room.GameStatusError,
+						LastPingAt:  time.Now(),
+						Version:     "v2",
+					}
+
+					// load
+					roomStorage.EXPECT().GetAllRoomIDs(gomock.Any(), gomock.Any()).Return(gameRoomIDs, nil)
+					instanceStorage.EXPECT().GetAllInstances(gomock.Any(), gomock.Any()).Return(instances, nil)
+					schedulerStorage.EXPECT().GetScheduler(gomock.Any(), gomock.Any()).Return(newScheduler, nil)
+
+					// findAvailableAndExpiredRooms and check for rolling update
+					roomStorage.EXPECT().GetRoom(gomock.Any(), newScheduler.Name, gameRoomIDs[0]).Return(oldRoom, nil).Times(2)
+					roomStorage.EXPECT().GetRoom(gomock.Any(), newScheduler.Name, gameRoomIDs[1]).Return(failedNewRoom, nil).Times(1)
+
+					// Check rollback
+					operationManager.EXPECT().GetOperation(gomock.Any(), newScheduler.Name, switchOp.ID).Return(switchOp, nil, nil)
+
+					// Shouldn't roll back again nor perform rolling update
+					operationManager.EXPECT().CreateOperation(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+					schedulerStorage.EXPECT().UpdateScheduler(gomock.Any(), gomock.Any()).Times(0)
+					roomManager.EXPECT().SchedulerMaxSurge(gomock.Any(), gomock.Any()).Times(0)
+				},
+			},
+		},
+		{
+			title:      "rollback ended without switching the version, perform rolling update",
+			definition: &healthcontroller.Definition{},
+			executionPlan: executionPlan{
+				tookAction: true,
+				planMocks: func(
+					roomStorage *mockports.MockRoomStorage,
+					roomManager *mockports.MockRoomManager,
+					instanceStorage *mockports.MockGameRoomInstanceStorage,
+					schedulerStorage *mockports.MockSchedulerStorage,
+					operationManager *mockports.MockOperationManager,
+					autoscaler *mockports.MockAutoscaler,
+				) {
+					gameRoomIDs := []string{"room-1", "room-2"}
+					instances := []*game_room.Instance{
+						{ID: "room-1", Status: game_room.InstanceStatus{Type: game_room.InstanceReady}},
+						{ID: "room-2", Status: game_room.InstanceStatus{Type: game_room.InstanceReady}},
+					}
+					switchOp := operation.New("", "switch_active_version", nil)
+					switchOp.Status = operation.StatusError
+					newScheduler := newValidScheduler(nil)
+					newScheduler.Spec.Version = "v2"
+					newScheduler.RollbackVersion = "v1"
+					newScheduler.RoomsReplicas = 2
+					newScheduler.RolloutStrategy = &rollout.Strategy{
+						Type:         rollout.RollingUpdate,
+						AutoRollback: &rollout.AutoRollbackParams{FailureThreshold: 0.4, MinRooms: 1},
+					}
+					newScheduler.RolloutHealth = &rollout.Health{Version: "v2", Rooms: map[string]bool{"room-2": true}, RollbackOperationID: switchOp.ID}
+					oldRoom := &game_room.GameRoom{
+						ID:          gameRoomIDs[0],
+						SchedulerID: newScheduler.Name,
+						Status:      game_room.GameStatusReady,
+						LastPingAt:  time.Now(),
+						Version:     "v1",
+					}
+					failedNewRoom := &game_room.GameRoom{
+						ID:          gameRoomIDs[1],
+						SchedulerID: newScheduler.Name,
+						Status:      game_room.GameStatusError,
+						LastPingAt:  time.Now(),
+						Version:     "v2",
+					}
+
+					// load
+					roomStorage.EXPECT().GetAllRoomIDs(gomock.Any(), gomock.Any()).Return(gameRoomIDs, nil)
+					instanceStorage.EXPECT().GetAllInstances(gomock.Any(), gomock.Any()).Return(instances, nil)
+					schedulerStorage.EXPECT().GetScheduler(gomock.Any(), gomock.Any()).Return(newScheduler, nil)
+
+					// findAvailableAndExpiredRooms and check for rolling update
+					roomStorage.EXPECT().GetRoom(gomock.Any(), newScheduler.Name, gameRoomIDs[0]).Return(oldRoom, nil).Times(2)
+					roomStorage.EXPECT().GetRoom(gomock.Any(), newScheduler.Name, gameRoomIDs[1]).Return(failedNewRoom, nil).Times(1)
+
+					// Check rollback, shouldn't roll back again
+					operationManager.EXPECT().GetOperation(gomock.Any(), newScheduler.Name, switchOp.ID).Return(switchOp, nil, nil)
+					operationManager.EXPECT().CreateOperation(gomock.Any(), newScheduler.Name, gomock.AssignableToTypeOf(&switchversion.Definition{})).Times(0)
+					schedulerStorage.EXPECT().UpdateScheduler(gomock.Any(), gomock.Any()).Times(0)
+
+					// Perform rolling update
+					addOp := operation.New(newScheduler.Name, "add_rooms", nil)
+					roomManager.EXPECT().SchedulerMaxSurge(gomock.Any(), newScheduler).Return(1, nil)
+					roomManager.EXPECT().SchedulerMaxUnavailable(gomock.Any(), newScheduler).Return(1, nil)
+					operationManager.EXPECT().CreateOperation(gomock.Any(), newScheduler.Name, &add.Definition{Amount: 1, Reason: add.RollingUpdateSurge}).Return(addOp, nil)
+					operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), gomock.Any(), gomock.Any())
+					roomStorage.EXPECT().GetRoomIDsByStatus(gomock.Any(), newScheduler.Name, game_room.GameStatusReady).Return([]string{gameRoomIDs[0]}, nil)
+					roomStorage.EXPECT().GetRoomIDsByStatus(gomock.Any(), newScheduler.Name, game_room.GameStatusOccupied).Return([]string{}, nil)
+				},
+			},
+		},
+		{
+			title:      "version target of an automatic rollback is not rolled back, perform rolling update",
+			definition: &healthcontroller.Definition{},
+			executionPlan: executionPlan{
+				tookAction: true,
+				planMocks: func(
+					roomStorage *mockports.MockRoomStorage,
+					roomManager *mockports.MockRoomManager,
+					instanceStorage *mockports.MockGameRoomInstanceStorage,
+					schedulerStorage *mockports.MockSchedulerStorage,
+					operationManager *mockports.MockOperationManager,
+					autoscaler *mockports.MockAutoscaler,
+				) {
+					gameRoomIDs := []string{"room-1", "room-2"}
+					instances := []*game_room.Instance{
+						{ID: "room-1", Status: game_room.InstanceStatus{Type: game_room.InstanceReady}},
+						{ID: "room-2", Status: game_room.InstanceStatus{Type: game_room.InstanceReady}},
+					}
+					newScheduler := newValidScheduler(nil)
+					newScheduler.Spec.Version = "v2"
+					newScheduler.RollbackVersion = "v1"
+					newScheduler.RoomsReplicas = 2
+					newScheduler.RolloutStrategy = &rollout.Strategy{
+						Type:         rollout.RollingUpdate,
+						AutoRollback: &rollout.AutoRollbackParams{FailureThreshold: 0.4, MinRooms: 1},
+					}
+					newScheduler.RolloutHealth = &rollout.Health{Version: "v2", RolledBackFrom: "v3"}
+					oldRoom := &game_room.GameRoom{
+						ID:          gameRoomIDs[0],
+						SchedulerID: newScheduler.Name,
+						Status:      game_room.GameStatusReady,
+						LastPingAt:  time.Now(),
+						Version:     "v1",
+					}
+					failedNewRoom := &game_room.GameRoom{
+						ID:          gameRoomIDs[1],
+						SchedulerID: newScheduler.Name,
+						Status:      game_room.GameStatusError,
+						LastPingAt:  time.Now(),
+						Version:     "v2",
+					}
+
+					// load
+					roomStorage.EXPECT().GetAllRoomIDs(gomock.Any(), gomock.Any()).Return(gameRoomIDs, nil)
+					instanceStorage.EXPECT().GetAllInstances(gomock.Any(), gomock.Any()).Return(instances, nil)
+					schedulerStorage.EXPECT().GetScheduler(gomock.Any(), gomock.Any()).Return(newScheduler, nil)
+
+					// findAvailableAndExpiredRooms and check for rolling update
+					roomStorage.EXPECT().GetRoom(gomock.Any(), newScheduler.Name, gameRoomIDs[0]).Return(oldRoom, nil).Times(2)
+					roomStorage.EXPECT().GetRoom(gomock.Any(), newScheduler.Name, gameRoomIDs[1]).Return(failedNewRoom, nil).Times(1)
+
+					// Shouldn't roll back
+					operationManager.EXPECT().CreateOperation(gomock.Any(), newScheduler.Name, gomock.AssignableToTypeOf(&switchversion.Definition{})).Times(0)
+					schedulerStorage.EXPECT().UpdateScheduler(gomock.Any(), gomock.Any()).Times(0)
+
+					// Perform rolling update
+					addOp := operation.New(newScheduler.Name, "add_rooms", nil)
+					roomManager.EXPECT().SchedulerMaxSurge(gomock.Any(), newScheduler).Return(1, nil)
+					roomManager.EXPECT().SchedulerMaxUnavailable(gomock.Any(), newScheduler).Return(1, nil)
+					operationManager.EXPECT().CreateOperation(gomock.Any(), newScheduler.Name, &add.Definition{Amount: 1, Reason: add.RollingUpdateSurge}).Return(addOp, nil)
+					operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), gomock.Any(), gomock.Any())
+					roomStorage.EXPECT().GetRoomIDsByStatus(gomock.Any(), newScheduler.Name, game_room.GameStatusReady).Return([]string{gameRoomIDs[0]}, nil)
+					roomStorage.EXPECT().GetRoomIDsByStatus(gomock.Any(), newScheduler.Name, game_room.GameStatusOccupied).Return([]string{}, nil)
+				},
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -1467,3 +1933,31 @@ func newValidScheduler(autoscaling *autoscaling.Autoscaling) *entities.Scheduler
 		Autoscaling: autoscaling,
 	}
 }
+
+// removeOneOfRoomsMatcher matches remove definitions of a single room among
+// the given ones.
+type removeOneOfRoomsMatcher struct {
+	roomIDs []string
+	reason  string
+}
+
+func removeOneOfRooms(roomIDs []string, reason string) gomock.Matcher {
+	return removeOneOfRoomsMatcher{roomIDs: roomIDs, reason: reason}
+}
+
+func (m removeOneOfRoomsMatcher) Matches(x interface{}) bool {
+	definition, ok := x.(*remove.Definition)
+	if !ok || len(definition.RoomsIDs) != 1 || definition.Reason != m.reason {
+		return false
+	}
+	for _, roomID := range m.roomIDs {
+		if definition.RoomsIDs[0] == roomID {
+			return true
+		}
+	}
+	return false
+}
+
+func (m removeOneOfRoomsMatcher) String() string {
+	return fmt.Sprintf("removes one of the rooms %v with reason %s", m.roomIDs, m.reason)
+}
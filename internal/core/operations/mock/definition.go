@@ -77,6 +77,20 @@ func (mr *MockDefinitionMockRecorder) Name() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockDefinition)(nil).Name))
 }
 
+// Priority mocks base method.
+func (m *MockDefinition) Priority() operation.Priority {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Priority")
+	ret0, _ := ret[0].(operation.Priority)
+	return ret0
+}
+
+// Priority indicates an expected call of Priority.
+func (mr *MockDefinitionMockRecorder) Priority() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Priority", reflect.TypeOf((*MockDefinition)(nil).Priority))
+}
+
 // ShouldExecute mocks base method.
 func (m *MockDefinition) ShouldExecute(ctx context.Context, currentOperations []*operation.Operation) bool {
 	m.ctrl.T.Helper()
@@ -28,6 +28,8 @@ import (
 	"github.com/topfreegames/maestro/internal/core/operations/rooms/add"
 	addrooms "github.com/topfreegames/maestro/internal/core/operations/rooms/add"
 	removerooms "github.com/topfreegames/maestro/internal/core/operations/rooms/remove"
+	"github.com/topfreegames/maestro/internal/core/operations/schedulers/bluegreen"
+	"github.com/topfreegames/maestro/internal/core/operations/schedulers/canary"
 	createscheduler "github.com/topfreegames/maestro/internal/core/operations/schedulers/create"
 	deletescheduler "github.com/topfreegames/maestro/internal/core/operations/schedulers/delete"
 	newversion "github.com/topfreegames/maestro/internal/core/operations/schedulers/newversion"
@@ -59,6 +61,12 @@ func ProvideDefinitionConstructors() map[string]operations.DefinitionConstructor
 	definitionConstructors[switchversion.OperationName] = func() operations.Definition {
 		return &switchversion.Definition{}
 	}
+	definitionConstructors[canary.OperationName] = func() operations.Definition {
+		return &canary.Definition{}
+	}
+	definitionConstructors[bluegreen.OperationName] = func() operations.Definition {
+		return &bluegreen.Definition{}
+	}
 	definitionConstructors[healthcontroller.OperationName] = func() operations.Definition {
 		return &healthcontroller.Definition{}
 	}
@@ -85,9 +93,15 @@ func ProvideExecutors(
 	operationStorage ports.OperationStorage,
 	operationManager ports.OperationManager,
 	autoscaler ports.Autoscaler,
+	roomHealthChecker ports.RoomHealthChecker,
+	eventsForwarder ports.EventsForwarder,
+	eventsOutbox ports.EventsOutbox,
 	newSchedulerVersionConfig newversion.Config,
 	healthControllerConfig healthcontroller.Config,
 	addRoomsConfig add.Config,
+	canaryRolloutConfig canary.Config,
+	blueGreenSwitchConfig bluegreen.Config,
+	storageCleanupConfig storagecleanup.Config,
 ) map[string]operations.Executor {
 
 	executors := map[string]operations.Executor{}
@@ -96,10 +110,12 @@ func ProvideExecutors(
 	executors[removerooms.OperationName] = removerooms.NewExecutor(roomManager, roomStorage, operationManager)
 	executors[test.OperationName] = test.NewExecutor()
 	executors[switchversion.OperationName] = switchversion.NewExecutor(schedulerManager, operationManager)
-	executors[newversion.OperationName] = newversion.NewExecutor(roomManager, schedulerManager, operationManager, newSchedulerVersionConfig)
+	executors[newversion.OperationName] = newversion.NewExecutor(roomManager, schedulerManager, operationManager, roomHealthChecker, eventsForwarder, newSchedulerVersionConfig)
+	executors[canary.OperationName] = canary.NewExecutor(roomManager, roomStorage, schedulerManager, operationManager, canaryRolloutConfig)
+	executors[bluegreen.OperationName] = bluegreen.NewExecutor(roomManager, schedulerManager, operationManager, autoscaler, blueGreenSwitchConfig)
 	executors[healthcontroller.OperationName] = healthcontroller.NewExecutor(roomStorage, roomManager, instanceStorage, schedulerStorage, operationManager, autoscaler, healthControllerConfig)
-	executors[storagecleanup.OperationName] = storagecleanup.NewExecutor(operationStorage)
-	executors[deletescheduler.OperationName] = deletescheduler.NewExecutor(schedulerStorage, schedulerCache, instanceStorage, operationStorage, operationManager, runtime)
+	executors[storagecleanup.OperationName] = storagecleanup.NewExecutor(operationStorage, schedulerStorage, storageCleanupConfig)
+	executors[deletescheduler.OperationName] = deletescheduler.NewExecutor(schedulerStorage, schedulerCache, instanceStorage, operationStorage, operationManager, runtime, eventsOutbox)
 
 	return executors
 
@@ -31,10 +31,16 @@ import (
 	"go.uber.org/zap"
 )
 
+const (
+	ScaleUp            string = "scale_up"
+	RollingUpdateSurge string = "rolling_update_surge"
+)
+
 const OperationName = "add_rooms"
 
 type Definition struct {
-	Amount int32 `json:"amount"`
+	Amount int32  `json:"amount"`
+	Reason string `json:"reason,omitempty"`
 }
 
 func (d *Definition) ShouldExecute(_ context.Context, _ []*operation.Operation) bool {
@@ -65,3 +71,14 @@ func (d *Definition) Unmarshal(raw []byte) error {
 func (d *Definition) HasNoAction() bool {
 	return false
 }
+
+// Priority returns a high priority for rooms added by the scheduler health
+// controller, so they don't wait for the other pending operations.
+func (d *Definition) Priority() operation.Priority {
+	switch d.Reason {
+	case ScaleUp, RollingUpdateSurge:
+		return operation.PriorityHigh
+	}
+
+	return operation.PriorityNormal
+}
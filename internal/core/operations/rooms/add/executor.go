@@ -88,12 +88,19 @@ func (ex *Executor) Execute(ctx context.Context, op *operation.Operation, defini
 		amount = ex.config.AmountLimit
 	}
 
+	ex.operationManager.StartOperationProgress(ctx, op, int(amount))
+
 	errGroup, errContext := errgroup.WithContext(ctx)
 	executionLogger.Info("start adding rooms", zap.Int32("amount", amount))
 	for i := int32(1); i <= amount; i++ {
 		errGroup.Go(func() error {
 			err := ex.createRoom(errContext, scheduler, executionLogger)
-			return err
+			if err != nil {
+				ex.operationManager.UpdateOperationProgress(ctx, op, 0, 1)
+				return err
+			}
+			ex.operationManager.UpdateOperationProgress(ctx, op, 1, 0)
+			return nil
 		})
 	}
 
@@ -99,6 +99,8 @@ func TestExecutor_Execute(t *testing.T) {
 
 		schedulerStorage.EXPECT().GetScheduler(context.Background(), op.SchedulerName).Return(&scheduler, nil)
 		roomsManager.EXPECT().CreateRoom(gomock.Any(), gomock.Any(), false).Return(&gameRoom, &gameRoomInstance, nil).Times(10)
+		operationsManager.EXPECT().StartOperationProgress(gomock.Any(), &op, 10)
+		operationsManager.EXPECT().UpdateOperationProgress(gomock.Any(), &op, 1, 0).Times(10)
 
 		executor := NewExecutor(roomsManager, schedulerStorage, operationsManager, config)
 		err := executor.Execute(context.Background(), &op, &definition)
@@ -117,6 +119,9 @@ func TestExecutor_Execute(t *testing.T) {
 		roomsManager.EXPECT().CreateRoom(gomock.Any(), gomock.Any(), false).Return(&gameRoom, &gameRoomInstance, nil).Times(9)
 		roomsManager.EXPECT().CreateRoom(gomock.Any(), gomock.Any(), false).Return(nil, nil, porterrors.NewErrUnexpected("error"))
 		operationsManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), &op, "error while creating room: error")
+		operationsManager.EXPECT().StartOperationProgress(gomock.Any(), &op, 10)
+		operationsManager.EXPECT().UpdateOperationProgress(gomock.Any(), &op, 1, 0).Times(9)
+		operationsManager.EXPECT().UpdateOperationProgress(gomock.Any(), &op, 0, 1)
 
 		executor := NewExecutor(roomsManager, schedulerStorage, operationsManager, config)
 		err := executor.Execute(context.Background(), &op, &definition)
@@ -156,6 +161,8 @@ func TestExecutor_Execute(t *testing.T) {
 
 		schedulerStorage.EXPECT().GetScheduler(context.Background(), op.SchedulerName).Return(&scheduler, nil)
 		roomsManager.EXPECT().CreateRoom(gomock.Any(), gomock.Any(), false).Return(&gameRoom, &gameRoomInstance, nil).Times(int(smallDefaultConfig.AmountLimit))
+		operationsManager.EXPECT().StartOperationProgress(gomock.Any(), &op, int(smallDefaultConfig.AmountLimit))
+		operationsManager.EXPECT().UpdateOperationProgress(gomock.Any(), &op, 1, 0).Times(int(smallDefaultConfig.AmountLimit))
 
 		executor := NewExecutor(roomsManager, schedulerStorage, operationsManager, smallDefaultConfig)
 		err := executor.Execute(context.Background(), &op, &bigAmountDefinition)
@@ -39,6 +39,9 @@ const (
 	SwitchVersionRollback        string = "switch_version_rollback"
 	SwitchVersionReplace         string = "switch_version_replace"
 	RollingUpdateReplace         string = "rolling_update_replace"
+	CanaryRollback               string = "canary_rollback"
+	BlueGreenRollback            string = "blue_green_rollback"
+	BlueGreenDrain               string = "blue_green_drain"
 )
 
 const OperationName = "remove_rooms"
@@ -79,3 +82,14 @@ func (d *Definition) Unmarshal(raw []byte) error {
 func (d *Definition) HasNoAction() bool {
 	return false
 }
+
+// Priority returns a high priority for rooms removed by the scheduler health
+// controller, so they don't wait for the other pending operations.
+func (d *Definition) Priority() operation.Priority {
+	switch d.Reason {
+	case ScaleDown, Expired, RollingUpdateReplace:
+		return operation.PriorityHigh
+	}
+
+	return operation.PriorityNormal
+}
@@ -65,6 +65,7 @@ func (e *Executor) Execute(ctx context.Context, op *operation.Operation, definit
 	)
 
 	removeDefinition := definition.(*Definition)
+	e.operationManager.StartOperationProgress(ctx, op, len(removeDefinition.RoomsIDs)+removeDefinition.Amount)
 
 	if len(removeDefinition.RoomsIDs) > 0 {
 		logger.Info("start removing rooms", zap.Strings("RoomIDs", removeDefinition.RoomsIDs))
@@ -132,9 +133,11 @@ func (e *Executor) deleteRooms(ctx context.Context, rooms []*game_room.GameRoom,
 				msg := fmt.Sprintf("error removing room \"%v\". Reason => %v", room.ID, err.Error())
 				e.operationManager.AppendOperationEventToExecutionHistory(ctx, op, msg)
 			}
-			if errors.Is(err, porterrors.ErrNotFound) {
+			if err == nil || errors.Is(err, porterrors.ErrNotFound) {
+				e.operationManager.UpdateOperationProgress(ctx, op, 1, 0)
 				return nil
 			}
+			e.operationManager.UpdateOperationProgress(ctx, op, 0, 1)
 			return err
 		})
 	}
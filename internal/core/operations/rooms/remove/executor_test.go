@@ -46,11 +46,12 @@ func TestExecutor_Execute(t *testing.T) {
 
 	t.Run("RemoveRoom by Amount", func(t *testing.T) {
 		t.Run("should succeed - no rooms to be removed => returns without error", func(t *testing.T) {
-			executor, _, roomsManager, _ := testSetup(t)
+			executor, _, roomsManager, operationManager := testSetup(t)
 
 			schedulerName := uuid.NewString()
 			definition := &Definition{Amount: 2}
 			op := &operation.Operation{ID: "random-uuid", SchedulerName: schedulerName}
+			operationManager.EXPECT().StartOperationProgress(gomock.Any(), op, 2)
 
 			ctx := context.Background()
 
@@ -62,11 +63,13 @@ func TestExecutor_Execute(t *testing.T) {
 		})
 
 		t.Run("should succeed - rooms are successfully removed => returns without error", func(t *testing.T) {
-			executor, _, roomsManager, _ := testSetup(t)
+			executor, _, roomsManager, operationManager := testSetup(t)
 
 			schedulerName := uuid.NewString()
 			definition := &Definition{Amount: 2}
 			op := &operation.Operation{ID: "random-uuid", SchedulerName: schedulerName}
+			operationManager.EXPECT().StartOperationProgress(gomock.Any(), op, 2)
+			operationManager.EXPECT().UpdateOperationProgress(gomock.Any(), op, 1, 0).Times(2)
 			ctx := context.Background()
 			availableRooms := []*game_room.GameRoom{
 				{ID: "first-room", SchedulerID: schedulerName, Status: game_room.GameStatusReady, Metadata: map[string]interface{}{}},
@@ -85,6 +88,9 @@ func TestExecutor_Execute(t *testing.T) {
 			schedulerName := uuid.NewString()
 			definition := &Definition{Amount: 2, Reason: "reason"}
 			op := &operation.Operation{ID: "random-uuid", SchedulerName: schedulerName}
+			operationManager.EXPECT().StartOperationProgress(gomock.Any(), op, 2)
+			operationManager.EXPECT().UpdateOperationProgress(gomock.Any(), op, 1, 0)
+			operationManager.EXPECT().UpdateOperationProgress(gomock.Any(), op, 0, 1)
 
 			availableRooms := []*game_room.GameRoom{
 				{ID: "first-room", SchedulerID: schedulerName, Status: game_room.GameStatusReady, Metadata: map[string]interface{}{}},
@@ -108,6 +114,9 @@ func TestExecutor_Execute(t *testing.T) {
 			schedulerName := uuid.NewString()
 			definition := &Definition{Amount: 2, Reason: "reason"}
 			op := &operation.Operation{ID: "random-uuid", SchedulerName: schedulerName}
+			operationManager.EXPECT().StartOperationProgress(gomock.Any(), op, 2)
+			operationManager.EXPECT().UpdateOperationProgress(gomock.Any(), op, 1, 0)
+			operationManager.EXPECT().UpdateOperationProgress(gomock.Any(), op, 0, 1)
 
 			availableRooms := []*game_room.GameRoom{
 				{ID: "first-room", SchedulerID: schedulerName, Status: game_room.GameStatusReady, Metadata: map[string]interface{}{}},
@@ -125,10 +134,11 @@ func TestExecutor_Execute(t *testing.T) {
 		})
 
 		t.Run("when list rooms has error returns with error", func(t *testing.T) {
-			executor, _, roomsManager, _ := testSetup(t)
+			executor, _, roomsManager, operationManager := testSetup(t)
 
 			definition := &Definition{Amount: 2}
 			op := &operation.Operation{ID: "random-uuid", SchedulerName: uuid.NewString()}
+			operationManager.EXPECT().StartOperationProgress(gomock.Any(), op, 2)
 
 			roomsManager.EXPECT().ListRoomsWithDeletionPriority(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
 
@@ -140,18 +150,19 @@ func TestExecutor_Execute(t *testing.T) {
 
 	t.Run("RemoveRoom by RoomsIDs", func(t *testing.T) {
 		t.Run("should succeed - no rooms to be removed => returns without error", func(t *testing.T) {
-			executor, _, _, _ := testSetup(t)
+			executor, _, _, operationManager := testSetup(t)
 
 			schedulerName := uuid.NewString()
 			definition := &Definition{RoomsIDs: []string{}}
 			op := &operation.Operation{ID: "random-uuid", SchedulerName: schedulerName}
+			operationManager.EXPECT().StartOperationProgress(gomock.Any(), op, 0)
 
 			err := executor.Execute(context.Background(), op, definition)
 			require.Nil(t, err)
 		})
 
 		t.Run("should succeed - rooms are successfully removed => returns without error", func(t *testing.T) {
-			executor, _, roomsManager, _ := testSetup(t)
+			executor, _, roomsManager, operationManager := testSetup(t)
 
 			firstRoomID := "first-room-id"
 			secondRoomID := "second-room-id"
@@ -159,6 +170,8 @@ func TestExecutor_Execute(t *testing.T) {
 			schedulerName := uuid.NewString()
 			definition := &Definition{RoomsIDs: []string{firstRoomID, secondRoomID}, Reason: "reason"}
 			op := &operation.Operation{ID: "random-uuid", SchedulerName: schedulerName}
+			operationManager.EXPECT().StartOperationProgress(gomock.Any(), op, 2)
+			operationManager.EXPECT().UpdateOperationProgress(gomock.Any(), op, 1, 0).Times(2)
 
 			room := &game_room.GameRoom{
 				ID:          firstRoomID,
@@ -185,6 +198,9 @@ func TestExecutor_Execute(t *testing.T) {
 			schedulerName := uuid.NewString()
 			definition := &Definition{RoomsIDs: []string{firstRoomID, secondRoomID}, Reason: "reason"}
 			op := &operation.Operation{ID: "random-uuid", SchedulerName: schedulerName}
+			operationManager.EXPECT().StartOperationProgress(gomock.Any(), op, 2)
+			operationManager.EXPECT().UpdateOperationProgress(gomock.Any(), op, 1, 0)
+			operationManager.EXPECT().UpdateOperationProgress(gomock.Any(), op, 0, 1)
 
 			room := &game_room.GameRoom{
 				ID:          firstRoomID,
@@ -213,6 +229,8 @@ func TestExecutor_Execute(t *testing.T) {
 			schedulerName := uuid.NewString()
 			definition := &Definition{RoomsIDs: []string{firstRoomID, secondRoomID}}
 			op := &operation.Operation{ID: "random-uuid", SchedulerName: schedulerName}
+			operationManager.EXPECT().StartOperationProgress(gomock.Any(), op, 2)
+			operationManager.EXPECT().UpdateOperationProgress(gomock.Any(), op, 1, 0).Times(2)
 
 			room := &game_room.GameRoom{
 				ID:          firstRoomID,
@@ -240,6 +258,9 @@ func TestExecutor_Execute(t *testing.T) {
 			schedulerName := uuid.NewString()
 			definition := &Definition{RoomsIDs: []string{firstRoomID, secondRoomID}, Reason: "reason"}
 			op := &operation.Operation{ID: "random-uuid", SchedulerName: schedulerName}
+			operationManager.EXPECT().StartOperationProgress(gomock.Any(), op, 2)
+			operationManager.EXPECT().UpdateOperationProgress(gomock.Any(), op, 1, 0)
+			operationManager.EXPECT().UpdateOperationProgress(gomock.Any(), op, 0, 1)
 
 			room := &game_room.GameRoom{
 				ID:          firstRoomID,
@@ -261,18 +282,19 @@ func TestExecutor_Execute(t *testing.T) {
 	})
 
 	t.Run("should succeed - no rooms to be removed => returns without error", func(t *testing.T) {
-		executor, _, _, _ := testSetup(t)
+		executor, _, _, operationManager := testSetup(t)
 
 		schedulerName := uuid.NewString()
 		definition := &Definition{}
 		op := &operation.Operation{ID: "random-uuid", SchedulerName: schedulerName}
+		operationManager.EXPECT().StartOperationProgress(gomock.Any(), op, 0)
 
 		err := executor.Execute(context.Background(), op, definition)
 		require.Nil(t, err)
 	})
 
 	t.Run("should succeed - there are ids and amount => return without error", func(t *testing.T) {
-		executor, _, roomsManager, _ := testSetup(t)
+		executor, _, roomsManager, operationManager := testSetup(t)
 
 		firstRoomID := "first-room-id"
 		secondRoomID := "second-room-id"
@@ -286,6 +308,8 @@ func TestExecutor_Execute(t *testing.T) {
 			Reason:   "reason",
 		}
 		op := &operation.Operation{ID: "random-uuid", SchedulerName: schedulerName}
+		operationManager.EXPECT().StartOperationProgress(gomock.Any(), op, 4)
+		operationManager.EXPECT().UpdateOperationProgress(gomock.Any(), op, 1, 0).Times(4)
 
 		thirdRoom := &game_room.GameRoom{
 			ID:          thirdRoomID,
@@ -0,0 +1,255 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package bluegreen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/topfreegames/maestro/internal/core/entities"
+	"github.com/topfreegames/maestro/internal/core/entities/game_room"
+	"github.com/topfreegames/maestro/internal/core/entities/operation"
+	"github.com/topfreegames/maestro/internal/core/logs"
+	"github.com/topfreegames/maestro/internal/core/operations"
+	"github.com/topfreegames/maestro/internal/core/operations/rooms/remove"
+	"github.com/topfreegames/maestro/internal/core/ports"
+	porterrors "github.com/topfreegames/maestro/internal/core/ports/errors"
+	"go.uber.org/zap"
+)
+
+// Config defines configurations for the Executor.
+type Config struct {
+	RoomInitializationTimeout time.Duration
+}
+
+// Executor holds the dependencies to execute the blue/green switch to a new
+// scheduler version.
+type Executor struct {
+	roomManager      ports.RoomManager
+	schedulerManager ports.SchedulerManager
+	operationManager ports.OperationManager
+	autoscaler       ports.Autoscaler
+	config           Config
+}
+
+var _ operations.Executor = (*Executor)(nil)
+
+// NewExecutor instantiate a new blue/green switch executor.
+func NewExecutor(roomManager ports.RoomManager, schedulerManager ports.SchedulerManager, operationManager ports.OperationManager, autoscaler ports.Autoscaler, config Config) *Executor {
+	return &Executor{
+		roomManager:      roomManager,
+		schedulerManager: schedulerManager,
+		operationManager: operationManager,
+		autoscaler:       autoscaler,
+		config:           config,
+	}
+}
+
+// Execute creates the desired amount of rooms on the new version while the
+// current rooms keep serving, and waits for all of them to be ready. Then it
+// switches the active version, which makes only the new rooms allocatable.
+// The rooms on the previous version are drained by the health controller once
+// they are not occupied.
+func (ex *Executor) Execute(ctx context.Context, op *operation.Operation, definition operations.Definition) error {
+	logger := zap.L().With(
+		zap.String(logs.LogFieldSchedulerName, op.SchedulerName),
+		zap.String(logs.LogFieldOperationDefinition, op.DefinitionName),
+		zap.String(logs.LogFieldOperationPhase, "Execute"),
+		zap.String(logs.LogFieldOperationID, op.ID),
+	)
+	opDef, ok := definition.(*Definition)
+	if !ok {
+		return fmt.Errorf("invalid operation definition for %s operation", ex.Name())
+	}
+
+	newScheduler, err := ex.schedulerManager.GetSchedulerByVersion(ctx, op.SchedulerName, opDef.NewVersion)
+	if err != nil {
+		logger.Error("error getting scheduler new version", zap.Error(err))
+		return fmt.Errorf("error getting scheduler new version: %w", err)
+	}
+
+	if !newScheduler.RolloutStrategy.IsBlueGreen() {
+		return fmt.Errorf("scheduler version %s has no blue/green rollout strategy", opDef.NewVersion)
+	}
+
+	activeScheduler, err := ex.schedulerManager.GetActiveScheduler(ctx, op.SchedulerName)
+	if err != nil {
+		logger.Error("error getting active scheduler", zap.Error(err))
+		return fmt.Errorf("error getting active scheduler: %w", err)
+	}
+
+	desiredNumberOfRooms, err := ex.getDesiredNumberOfRooms(ctx, activeScheduler)
+	if err != nil {
+		logger.Error("error getting the desired number of rooms", zap.Error(err))
+		return fmt.Errorf("error getting the desired number of rooms: %w", err)
+	}
+
+	// The green rooms are the rooms on the new version, so the ones created
+	// before the operation was interrupted are kept.
+	greenRooms, err := ex.roomManager.ListRoomsByVersion(ctx, op.SchedulerName, opDef.NewVersion)
+	if err != nil {
+		logger.Error("error listing rooms on the new version", zap.Error(err))
+		return fmt.Errorf("error listing rooms on the new version: %w", err)
+	}
+
+	ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, fmt.Sprintf(startingBlueGreenMessageTemplate, opDef.NewVersion, desiredNumberOfRooms))
+
+	for i := len(greenRooms); i < desiredNumberOfRooms; i++ {
+		_, _, err := ex.roomManager.CreateRoom(ctx, *newScheduler, false)
+		if err != nil {
+			logger.Error("error creating room on the new version", zap.Error(err))
+			ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, fmt.Sprintf(createRoomsFailedMessageTemplate, err.Error()))
+			return fmt.Errorf("error creating room on the new version: %w", err)
+		}
+	}
+
+	readyRooms, err := ex.waitGreenRoomsReady(ctx, op.SchedulerName, opDef.NewVersion)
+	if err != nil {
+		logger.Error("rooms on the new version did not become ready", zap.Error(err))
+		ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, fmt.Sprintf(roomsNotReadyMessageTemplate, err.Error()))
+		return err
+	}
+
+	newScheduler.State = entities.StateInSync
+	err = ex.schedulerManager.UpdateScheduler(ctx, newScheduler)
+	if err != nil {
+		logger.Error("error updating scheduler with new active version", zap.Error(err))
+		return fmt.Errorf("error updating scheduler with new active version: %w", err)
+	}
+
+	// From now on the rooms are regular rooms of the active version.
+	ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, fmt.Sprintf(switchedMessageTemplate, readyRooms, opDef.NewVersion))
+
+	// The rooms statuses were held back from the forwarders until the switch,
+	// so their current statuses must be announced now to be allocated.
+	greenRooms, err = ex.roomManager.ListRoomsByVersion(ctx, op.SchedulerName, opDef.NewVersion)
+	if err != nil {
+		logger.Warn("error listing rooms on the new version to forward their statuses", zap.Error(err))
+		ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, fmt.Sprintf(listRoomsFailedMessageTemplate, err.Error()))
+	}
+
+	for _, greenRoom := range greenRooms {
+		ex.forwardRoomStatus(ctx, op, greenRoom, logger)
+	}
+
+	logger.Sugar().Infof("blue/green switch to version %s succeeded", opDef.NewVersion)
+	return nil
+}
+
+// Rollback deletes the rooms created on the new version, keeping the scheduler
+// on its current active version.
+func (ex *Executor) Rollback(ctx context.Context, op *operation.Operation, definition operations.Definition, executeErr error) error {
+	logger := zap.L().With(
+		zap.String(logs.LogFieldSchedulerName, op.SchedulerName),
+		zap.String(logs.LogFieldOperationDefinition, op.DefinitionName),
+		zap.String(logs.LogFieldOperationPhase, "Rollback"),
+		zap.String(logs.LogFieldOperationID, op.ID),
+	)
+
+	opDef, ok := definition.(*Definition)
+	if !ok {
+		return fmt.Errorf("invalid operation definition for %s operation", ex.Name())
+	}
+
+	activeScheduler, err := ex.schedulerManager.GetActiveScheduler(ctx, op.SchedulerName)
+	if err != nil {
+		logger.Error("error getting active scheduler", zap.Error(err))
+		return fmt.Errorf("error getting active scheduler: %w", err)
+	}
+
+	// Once the new version is active the green rooms are regular rooms.
+	if activeScheduler.Spec.Version != opDef.NewVersion {
+		greenRooms, err := ex.roomManager.ListRoomsByVersion(ctx, op.SchedulerName, opDef.NewVersion)
+		if err != nil {
+			logger.Error("error listing rooms on the new version", zap.Error(err))
+			return fmt.Errorf("error in Rollback function execution: %w", err)
+		}
+
+		for _, gameRoom := range greenRooms {
+			err := ex.roomManager.DeleteRoom(ctx, gameRoom, remove.BlueGreenRollback)
+			if err != nil && !errors.Is(err, porterrors.ErrNotFound) {
+				logger.Error("error deleting room on the new version", zap.String(logs.LogFieldRoomID, gameRoom.ID), zap.Error(err))
+				return fmt.Errorf("error in Rollback function execution: %w", err)
+			}
+		}
+	}
+
+	activeScheduler.State = entities.StateInSync
+	err = ex.schedulerManager.UpdateScheduler(ctx, activeScheduler)
+	if err != nil {
+		logger.Error("error updating active scheduler state", zap.Error(err))
+		return fmt.Errorf("error updating active scheduler state: %w", err)
+	}
+
+	ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, fmt.Sprintf(rolledBackMessageTemplate, activeScheduler.Spec.Version))
+	return nil
+}
+
+// Name returns the operation name.
+func (ex *Executor) Name() string {
+	return OperationName
+}
+
+func (ex *Executor) getDesiredNumberOfRooms(ctx context.Context, scheduler *entities.Scheduler) (int, error) {
+	if scheduler.Autoscaling != nil && scheduler.Autoscaling.Enabled {
+		return ex.autoscaler.CalculateDesiredNumberOfRooms(ctx, scheduler)
+	}
+
+	return scheduler.RoomsReplicas, nil
+}
+
+// waitGreenRoomsReady waits until every room created on the new version is
+// ready, failing if any of them goes to error or the room initialization
+// timeout is reached.
+func (ex *Executor) waitGreenRoomsReady(ctx context.Context, schedulerName, version string) (int, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, ex.config.RoomInitializationTimeout)
+	defer cancel()
+
+	greenRooms, err := ex.roomManager.ListRoomsByVersion(waitCtx, schedulerName, version)
+	if err != nil {
+		return 0, fmt.Errorf("error listing rooms on the new version: %w", err)
+	}
+
+	for _, greenRoom := range greenRooms {
+		status, err := ex.roomManager.WaitRoomStatus(waitCtx, greenRoom, []game_room.GameRoomStatus{game_room.GameStatusReady, game_room.GameStatusError})
+		if err != nil {
+			return 0, fmt.Errorf("error waiting room %s to be ready: %w", greenRoom.ID, err)
+		}
+
+		if status == game_room.GameStatusError {
+			return 0, fmt.Errorf("room %s is on error", greenRoom.ID)
+		}
+	}
+
+	return len(greenRooms), nil
+}
+
+func (ex *Executor) forwardRoomStatus(ctx context.Context, op *operation.Operation, greenRoom *game_room.GameRoom, logger *zap.Logger) {
+	err := ex.roomManager.ForwardRoomStatus(ctx, greenRoom)
+	if err != nil {
+		logger.Warn("error forwarding room status", zap.String(logs.LogFieldRoomID, greenRoom.ID), zap.Error(err))
+		ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, fmt.Sprintf(forwardRoomStatusFailedMessageTemplate, greenRoom.ID, err.Error()))
+	}
+}
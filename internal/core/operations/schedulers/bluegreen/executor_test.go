@@ -0,0 +1,253 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build unit
+// +build unit
+
+package bluegreen_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/topfreegames/maestro/internal/core/entities"
+	"github.com/topfreegames/maestro/internal/core/entities/autoscaling"
+	"github.com/topfreegames/maestro/internal/core/entities/game_room"
+	"github.com/topfreegames/maestro/internal/core/entities/operation"
+	"github.com/topfreegames/maestro/internal/core/entities/rollout"
+	"github.com/topfreegames/maestro/internal/core/operations/rooms/remove"
+	"github.com/topfreegames/maestro/internal/core/operations/schedulers/bluegreen"
+	porterrors "github.com/topfreegames/maestro/internal/core/ports/errors"
+	mockports "github.com/topfreegames/maestro/internal/core/ports/mock"
+)
+
+type executorMocks struct {
+	roomManager      *mockports.MockRoomManager
+	schedulerManager *mockports.MockSchedulerManager
+	operationManager *mockports.MockOperationManager
+	autoscaler       *mockports.MockAutoscaler
+}
+
+func newExecutor(t *testing.T) (*bluegreen.Executor, *executorMocks) {
+	mockCtrl := gomock.NewController(t)
+	mocks := &executorMocks{
+		roomManager:      mockports.NewMockRoomManager(mockCtrl),
+		schedulerManager: mockports.NewMockSchedulerManager(mockCtrl),
+		operationManager: mockports.NewMockOperationManager(mockCtrl),
+		autoscaler:       mockports.NewMockAutoscaler(mockCtrl),
+	}
+	config := bluegreen.Config{RoomInitializationTimeout: time.Minute}
+
+	executor := bluegreen.NewExecutor(mocks.roomManager, mocks.schedulerManager, mocks.operationManager, mocks.autoscaler, config)
+	return executor, mocks
+}
+
+func newBlueGreenScheduler() *entities.Scheduler {
+	return &entities.Scheduler{
+		Name:            "scheduler",
+		Game:            "game",
+		State:           entities.StateCreating,
+		RollbackVersion: "v1.0.0",
+		Spec:            game_room.Spec{Version: "v2.0.0"},
+		RolloutStrategy: &rollout.Strategy{Type: rollout.BlueGreen},
+	}
+}
+
+func TestExecutor_Execute(t *testing.T) {
+	op := &operation.Operation{
+		ID:             "op-id",
+		Status:         operation.StatusInProgress,
+		DefinitionName: bluegreen.OperationName,
+		SchedulerName:  "scheduler",
+	}
+	definition := &bluegreen.Definition{NewVersion: "v2.0.0"}
+	activeScheduler := &entities.Scheduler{Name: "scheduler", RoomsReplicas: 2, Spec: game_room.Spec{Version: "v1.0.0"}}
+	readyStatuses := []game_room.GameRoomStatus{game_room.GameStatusReady, game_room.GameStatusError}
+
+	t.Run("switches the active version once every new room is ready", func(t *testing.T) {
+		executor, mocks := newExecutor(t)
+		scheduler := newBlueGreenScheduler()
+		rooms := []*game_room.GameRoom{{ID: "room-1", Version: "v2.0.0"}, {ID: "room-2", Version: "v2.0.0"}}
+		readyRooms := []*game_room.GameRoom{
+			{ID: "room-1", Version: "v2.0.0", Status: game_room.GameStatusReady},
+			{ID: "room-2", Version: "v2.0.0", Status: game_room.GameStatusReady},
+		}
+
+		mocks.schedulerManager.EXPECT().GetSchedulerByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(scheduler, nil)
+		mocks.schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), op.SchedulerName).Return(activeScheduler, nil)
+		gomock.InOrder(
+			mocks.roomManager.EXPECT().ListRoomsByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(nil, nil),
+			mocks.roomManager.EXPECT().CreateRoom(gomock.Any(), *scheduler, false).Return(rooms[0], nil, nil),
+			mocks.roomManager.EXPECT().CreateRoom(gomock.Any(), *scheduler, false).Return(rooms[1], nil, nil),
+			mocks.roomManager.EXPECT().ListRoomsByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(rooms, nil),
+			mocks.roomManager.EXPECT().WaitRoomStatus(gomock.Any(), rooms[0], readyStatuses).Return(game_room.GameStatusReady, nil),
+			mocks.roomManager.EXPECT().WaitRoomStatus(gomock.Any(), rooms[1], readyStatuses).Return(game_room.GameStatusReady, nil),
+			mocks.schedulerManager.EXPECT().UpdateScheduler(gomock.Any(), scheduler).DoAndReturn(
+				func(_ context.Context, scheduler *entities.Scheduler) error {
+					require.Equal(t, entities.StateInSync, scheduler.State)
+					return nil
+				},
+			),
+			mocks.roomManager.EXPECT().ListRoomsByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(readyRooms, nil),
+		)
+		mocks.roomManager.EXPECT().ForwardRoomStatus(gomock.Any(), readyRooms[0]).Return(nil)
+		mocks.roomManager.EXPECT().ForwardRoomStatus(gomock.Any(), readyRooms[1]).Return(porterrors.NewErrUnexpected("some error"))
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "Starting blue/green switch to version v2.0.0, creating 2 rooms")
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "All 2 rooms on version v2.0.0 are ready, switched the active version")
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "Failed to forward the status of room room-2: some error")
+
+		err := executor.Execute(context.Background(), op, definition)
+		require.NoError(t, err)
+
+		// The new rooms become regular rooms, so the rollback must not delete them.
+		mocks.schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), op.SchedulerName).Return(&entities.Scheduler{Spec: game_room.Spec{Version: "v2.0.0"}}, nil)
+		mocks.schedulerManager.EXPECT().UpdateScheduler(gomock.Any(), gomock.Any()).Return(nil)
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, gomock.Any())
+		err = executor.Rollback(context.Background(), op, definition, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("keeps the rooms created before the operation was interrupted", func(t *testing.T) {
+		executor, mocks := newExecutor(t)
+		scheduler := newBlueGreenScheduler()
+		rooms := []*game_room.GameRoom{{ID: "room-1", Version: "v2.0.0"}, {ID: "room-2", Version: "v2.0.0"}}
+
+		mocks.schedulerManager.EXPECT().GetSchedulerByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(scheduler, nil)
+		mocks.schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), op.SchedulerName).Return(activeScheduler, nil)
+		gomock.InOrder(
+			mocks.roomManager.EXPECT().ListRoomsByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(rooms[:1], nil),
+			mocks.roomManager.EXPECT().CreateRoom(gomock.Any(), *scheduler, false).Return(rooms[1], nil, nil),
+			mocks.roomManager.EXPECT().ListRoomsByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(rooms, nil).Times(2),
+		)
+		mocks.roomManager.EXPECT().WaitRoomStatus(gomock.Any(), gomock.Any(), readyStatuses).Return(game_room.GameStatusReady, nil).Times(2)
+		mocks.schedulerManager.EXPECT().UpdateScheduler(gomock.Any(), scheduler).Return(nil)
+		mocks.roomManager.EXPECT().ForwardRoomStatus(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, gomock.Any())
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "All 2 rooms on version v2.0.0 are ready, switched the active version")
+
+		err := executor.Execute(context.Background(), op, definition)
+		require.NoError(t, err)
+	})
+
+	t.Run("uses the autoscaling policy to get the desired number of rooms", func(t *testing.T) {
+		executor, mocks := newExecutor(t)
+		scheduler := newBlueGreenScheduler()
+		autoscalingScheduler := &entities.Scheduler{Name: "scheduler", Autoscaling: &autoscaling.Autoscaling{Enabled: true}}
+		room := &game_room.GameRoom{ID: "room-1", Version: "v2.0.0"}
+
+		mocks.schedulerManager.EXPECT().GetSchedulerByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(scheduler, nil)
+		mocks.schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), op.SchedulerName).Return(autoscalingScheduler, nil)
+		mocks.autoscaler.EXPECT().CalculateDesiredNumberOfRooms(gomock.Any(), autoscalingScheduler).Return(1, nil)
+		gomock.InOrder(
+			mocks.roomManager.EXPECT().ListRoomsByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(nil, nil),
+			mocks.roomManager.EXPECT().CreateRoom(gomock.Any(), *scheduler, false).Return(room, nil, nil),
+			mocks.roomManager.EXPECT().ListRoomsByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return([]*game_room.GameRoom{room}, nil).Times(2),
+		)
+		mocks.roomManager.EXPECT().WaitRoomStatus(gomock.Any(), room, readyStatuses).Return(game_room.GameStatusReady, nil)
+		mocks.schedulerManager.EXPECT().UpdateScheduler(gomock.Any(), scheduler).Return(nil)
+		mocks.roomManager.EXPECT().ForwardRoomStatus(gomock.Any(), room).Return(nil)
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "Starting blue/green switch to version v2.0.0, creating 1 rooms")
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, gomock.Any())
+
+		err := executor.Execute(context.Background(), op, definition)
+		require.NoError(t, err)
+	})
+
+	t.Run("fails and keeps the active version when a new room goes to error", func(t *testing.T) {
+		executor, mocks := newExecutor(t)
+		scheduler := newBlueGreenScheduler()
+		rooms := []*game_room.GameRoom{{ID: "room-1", Version: "v2.0.0"}, {ID: "room-2", Version: "v2.0.0"}}
+
+		mocks.schedulerManager.EXPECT().GetSchedulerByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(scheduler, nil)
+		mocks.schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), op.SchedulerName).Return(activeScheduler, nil)
+		gomock.InOrder(
+			mocks.roomManager.EXPECT().ListRoomsByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(nil, nil),
+			mocks.roomManager.EXPECT().CreateRoom(gomock.Any(), *scheduler, false).Return(rooms[0], nil, nil),
+			mocks.roomManager.EXPECT().CreateRoom(gomock.Any(), *scheduler, false).Return(rooms[1], nil, nil),
+			mocks.roomManager.EXPECT().ListRoomsByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(rooms, nil),
+			mocks.roomManager.EXPECT().WaitRoomStatus(gomock.Any(), rooms[0], readyStatuses).Return(game_room.GameStatusError, nil),
+		)
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, gomock.Any())
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "Rooms on the new version did not become ready: room room-1 is on error")
+
+		err := executor.Execute(context.Background(), op, definition)
+		require.Error(t, err)
+
+		mocks.schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), op.SchedulerName).Return(&entities.Scheduler{State: entities.StateCreating, Spec: game_room.Spec{Version: "v1.0.0"}}, nil)
+		mocks.roomManager.EXPECT().ListRoomsByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(rooms, nil)
+		mocks.roomManager.EXPECT().DeleteRoom(gomock.Any(), rooms[0], remove.BlueGreenRollback).Return(nil)
+		mocks.roomManager.EXPECT().DeleteRoom(gomock.Any(), rooms[1], remove.BlueGreenRollback).Return(porterrors.NewErrNotFound("room not found"))
+		mocks.schedulerManager.EXPECT().UpdateScheduler(gomock.Any(), &entities.Scheduler{State: entities.StateInSync, Spec: game_room.Spec{Version: "v1.0.0"}}).Return(nil)
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "Rooms on the new version deleted, scheduler kept on version v1.0.0")
+
+		err = executor.Rollback(context.Background(), op, definition, err)
+		require.NoError(t, err)
+	})
+
+	t.Run("fails when a new room doesn't become ready in time", func(t *testing.T) {
+		executor, mocks := newExecutor(t)
+		scheduler := newBlueGreenScheduler()
+		room := &game_room.GameRoom{ID: "room-1", Version: "v2.0.0"}
+
+		mocks.schedulerManager.EXPECT().GetSchedulerByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(scheduler, nil)
+		mocks.schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), op.SchedulerName).Return(activeScheduler, nil)
+		gomock.InOrder(
+			mocks.roomManager.EXPECT().ListRoomsByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(nil, nil),
+			mocks.roomManager.EXPECT().CreateRoom(gomock.Any(), *scheduler, false).Return(room, nil, nil).Times(2),
+			mocks.roomManager.EXPECT().ListRoomsByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return([]*game_room.GameRoom{room}, nil),
+			mocks.roomManager.EXPECT().WaitRoomStatus(gomock.Any(), room, readyStatuses).Return(game_room.GameStatusPending, context.DeadlineExceeded),
+		)
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, gomock.Any()).Times(2)
+
+		err := executor.Execute(context.Background(), op, definition)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("fails when a new room can't be created", func(t *testing.T) {
+		executor, mocks := newExecutor(t)
+		scheduler := newBlueGreenScheduler()
+
+		mocks.schedulerManager.EXPECT().GetSchedulerByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(scheduler, nil)
+		mocks.schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), op.SchedulerName).Return(activeScheduler, nil)
+		mocks.roomManager.EXPECT().ListRoomsByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(nil, nil)
+		mocks.roomManager.EXPECT().CreateRoom(gomock.Any(), *scheduler, false).Return(nil, nil, porterrors.NewErrUnexpected("some error"))
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, gomock.Any())
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "Failed to create rooms on the new version: some error")
+
+		err := executor.Execute(context.Background(), op, definition)
+		require.Error(t, err)
+	})
+
+	t.Run("fails when the new version has no blue/green rollout strategy", func(t *testing.T) {
+		executor, mocks := newExecutor(t)
+		scheduler := newBlueGreenScheduler()
+		scheduler.RolloutStrategy = nil
+
+		mocks.schedulerManager.EXPECT().GetSchedulerByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(scheduler, nil)
+
+		err := executor.Execute(context.Background(), op, definition)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,39 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package bluegreen
+
+const (
+	startingBlueGreenMessageTemplate = "Starting blue/green switch to version %s, creating %d rooms"
+
+	createRoomsFailedMessageTemplate = "Failed to create rooms on the new version: %s"
+
+	roomsNotReadyMessageTemplate = "Rooms on the new version did not become ready: %s"
+
+	switchedMessageTemplate = "All %d rooms on version %s are ready, switched the active version"
+
+	listRoomsFailedMessageTemplate = "Failed to list the rooms on the new version to forward their statuses: %s"
+
+	forwardRoomStatusFailedMessageTemplate = "Failed to forward the status of room %s: %s"
+
+	rolledBackMessageTemplate = "Rooms on the new version deleted, scheduler kept on version %s"
+)
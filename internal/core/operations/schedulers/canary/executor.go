@@ -0,0 +1,260 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package canary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/topfreegames/maestro/internal/core/entities"
+	"github.com/topfreegames/maestro/internal/core/entities/game_room"
+	"github.com/topfreegames/maestro/internal/core/entities/operation"
+	"github.com/topfreegames/maestro/internal/core/entities/rollout"
+	"github.com/topfreegames/maestro/internal/core/logs"
+	"github.com/topfreegames/maestro/internal/core/operations"
+	"github.com/topfreegames/maestro/internal/core/operations/rooms/remove"
+	"github.com/topfreegames/maestro/internal/core/ports"
+	porterrors "github.com/topfreegames/maestro/internal/core/ports/errors"
+	"go.uber.org/zap"
+)
+
+// Config defines configurations for the Executor.
+type Config struct {
+	// CheckInterval is the interval between each canary rooms check during
+	// the bake period.
+	CheckInterval time.Duration
+}
+
+// Executor holds the dependencies to execute the canary rollout of a new
+// scheduler version.
+type Executor struct {
+	roomManager      ports.RoomManager
+	roomStorage      ports.RoomStorage
+	schedulerManager ports.SchedulerManager
+	operationManager ports.OperationManager
+	config           Config
+}
+
+var _ operations.Executor = (*Executor)(nil)
+
+// NewExecutor instantiate a new canary rollout executor.
+func NewExecutor(roomManager ports.RoomManager, roomStorage ports.RoomStorage, schedulerManager ports.SchedulerManager, operationManager ports.OperationManager, config Config) *Executor {
+	return &Executor{
+		roomManager:      roomManager,
+		roomStorage:      roomStorage,
+		schedulerManager: schedulerManager,
+		operationManager: operationManager,
+		config:           config,
+	}
+}
+
+// Execute creates the canary rooms on the new version and watches them during
+// the bake period. If the rate of canary rooms on error or unready at its end
+// is under the threshold, the switch to the new version is enqueued, otherwise
+// the operation fails and the rollback deletes the canary rooms.
+func (ex *Executor) Execute(ctx context.Context, op *operation.Operation, definition operations.Definition) error {
+	logger := zap.L().With(
+		zap.String(logs.LogFieldSchedulerName, op.SchedulerName),
+		zap.String(logs.LogFieldOperationDefinition, op.DefinitionName),
+		zap.String(logs.LogFieldOperationPhase, "Execute"),
+		zap.String(logs.LogFieldOperationID, op.ID),
+	)
+	opDef, ok := definition.(*Definition)
+	if !ok {
+		return fmt.Errorf("invalid operation definition for %s operation", ex.Name())
+	}
+
+	newScheduler, err := ex.schedulerManager.GetSchedulerByVersion(ctx, op.SchedulerName, opDef.NewVersion)
+	if err != nil {
+		logger.Error("error getting scheduler new version", zap.Error(err))
+		return fmt.Errorf("error getting scheduler new version: %w", err)
+	}
+
+	if !newScheduler.RolloutStrategy.IsCanary() || newScheduler.RolloutStrategy.Canary == nil {
+		return fmt.Errorf("scheduler version %s has no canary rollout strategy", opDef.NewVersion)
+	}
+	params := newScheduler.RolloutStrategy.Canary
+
+	currentRoomsAmount, err := ex.roomStorage.GetRoomCount(ctx, op.SchedulerName)
+	if err != nil {
+		logger.Error("error getting scheduler rooms count", zap.Error(err))
+		return fmt.Errorf("error getting scheduler rooms count: %w", err)
+	}
+
+	// The canary rooms are the rooms on the new version, so the ones created
+	// before the operation was interrupted are kept.
+	canaryRooms, err := ex.roomManager.ListRoomsByVersion(ctx, op.SchedulerName, opDef.NewVersion)
+	if err != nil {
+		logger.Error("error listing canary rooms", zap.Error(err))
+		return fmt.Errorf("error listing canary rooms: %w", err)
+	}
+
+	canaryRoomsAmount := params.CanaryRoomsAmount(currentRoomsAmount - len(canaryRooms))
+	ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, fmt.Sprintf(startingCanaryMessageTemplate, opDef.NewVersion, canaryRoomsAmount, params.BakeDuration))
+
+	for i := len(canaryRooms); i < canaryRoomsAmount; i++ {
+		_, _, err := ex.roomManager.CreateRoom(ctx, *newScheduler, false)
+		if err != nil {
+			logger.Error("error creating canary room", zap.Error(err))
+			ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, fmt.Sprintf(createRoomsFailedMessageTemplate, err.Error()))
+			return fmt.Errorf("error creating canary room: %w", err)
+		}
+	}
+
+	failedRooms, err := ex.bake(ctx, op, opDef.NewVersion, canaryRoomsAmount, params, logger)
+	if err != nil {
+		return err
+	}
+
+	switchOp, err := ex.schedulerManager.EnqueueSwitchActiveVersionOperation(ctx, op.SchedulerName, opDef.NewVersion)
+	if err != nil {
+		logger.Error("error enqueuing switch active version operation", zap.Error(err))
+		return fmt.Errorf("error enqueuing switch active version operation: %w", err)
+	}
+
+	ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, fmt.Sprintf(canarySucceededMessageTemplate, failedRooms, canaryRoomsAmount, switchOp.ID))
+	logger.Sugar().Infof("canary of version %s succeeded, switch active version operation enqueued", opDef.NewVersion)
+	return nil
+}
+
+// Rollback deletes the canary rooms, keeping the scheduler on its current
+// active version.
+func (ex *Executor) Rollback(ctx context.Context, op *operation.Operation, definition operations.Definition, executeErr error) error {
+	logger := zap.L().With(
+		zap.String(logs.LogFieldSchedulerName, op.SchedulerName),
+		zap.String(logs.LogFieldOperationDefinition, op.DefinitionName),
+		zap.String(logs.LogFieldOperationPhase, "Rollback"),
+		zap.String(logs.LogFieldOperationID, op.ID),
+	)
+
+	opDef, ok := definition.(*Definition)
+	if !ok {
+		return fmt.Errorf("invalid operation definition for %s operation", ex.Name())
+	}
+
+	activeScheduler, err := ex.schedulerManager.GetActiveScheduler(ctx, op.SchedulerName)
+	if err != nil {
+		logger.Error("error getting active scheduler", zap.Error(err))
+		return fmt.Errorf("error getting active scheduler: %w", err)
+	}
+
+	// Once the new version is active the canary rooms are regular rooms.
+	if activeScheduler.Spec.Version != opDef.NewVersion {
+		canaryRooms, err := ex.roomManager.ListRoomsByVersion(ctx, op.SchedulerName, opDef.NewVersion)
+		if err != nil {
+			logger.Error("error listing canary rooms", zap.Error(err))
+			return fmt.Errorf("error in Rollback function execution: %w", err)
+		}
+
+		for _, gameRoom := range canaryRooms {
+			err := ex.roomManager.DeleteRoom(ctx, gameRoom, remove.CanaryRollback)
+			if err != nil && !errors.Is(err, porterrors.ErrNotFound) {
+				logger.Error("error deleting canary room", zap.String(logs.LogFieldRoomID, gameRoom.ID), zap.Error(err))
+				return fmt.Errorf("error in Rollback function execution: %w", err)
+			}
+		}
+	}
+
+	activeScheduler.State = entities.StateInSync
+	err = ex.schedulerManager.UpdateScheduler(ctx, activeScheduler)
+	if err != nil {
+		logger.Error("error updating active scheduler state", zap.Error(err))
+		return fmt.Errorf("error updating active scheduler state: %w", err)
+	}
+
+	ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, fmt.Sprintf(rolledBackMessageTemplate, activeScheduler.Spec.Version))
+	return nil
+}
+
+// Name returns the operation name.
+func (ex *Executor) Name() string {
+	return OperationName
+}
+
+// bake checks the canary rooms until the bake period ends, returning the
+// amount of failed rooms. Rooms recover from transient errors, so only the
+// rooms still on error or unready when it ends count as failed.
+func (ex *Executor) bake(ctx context.Context, op *operation.Operation, version string, canaryRoomsAmount int, params *rollout.CanaryParams, logger *zap.Logger) (int, error) {
+	failedRooms := 0
+	lastFailedAmount := -1
+
+	bakeEnd := time.Now().Add(params.BakeDuration)
+	ticker := time.NewTicker(ex.config.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		currentFailedRooms, err := ex.countFailedCanaryRooms(ctx, op.SchedulerName, version)
+		if err != nil {
+			// We can check the rooms again on the next iteration.
+			logger.Warn("error checking canary rooms", zap.Error(err))
+		} else {
+			failedRooms = currentFailedRooms
+		}
+
+		remaining := time.Until(bakeEnd)
+		if remaining <= 0 {
+			failureRate := float64(failedRooms) / float64(canaryRoomsAmount)
+			if failureRate > params.FailureThreshold {
+				ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, fmt.Sprintf(canaryFailedMessageTemplate, failedRooms, canaryRoomsAmount, failureRate, params.FailureThreshold))
+				return failedRooms, fmt.Errorf("canary failure rate %.2f is above the threshold %.2f", failureRate, params.FailureThreshold)
+			}
+
+			return failedRooms, nil
+		}
+
+		if failedRooms != lastFailedAmount {
+			lastFailedAmount = failedRooms
+			ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, fmt.Sprintf(canaryProgressMessageTemplate, failedRooms, canaryRoomsAmount, remaining.Round(time.Second)))
+		}
+
+		select {
+		case <-ctx.Done():
+			return failedRooms, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (ex *Executor) countFailedCanaryRooms(ctx context.Context, schedulerName, version string) (int, error) {
+	canaryRooms, err := ex.roomManager.ListRoomsByVersion(ctx, schedulerName, version)
+	if err != nil {
+		return 0, err
+	}
+
+	failedRooms := 0
+	for _, room := range canaryRooms {
+		if isRoomFailed(room) {
+			failedRooms++
+		}
+	}
+
+	return failedRooms, nil
+}
+
+// isRoomFailed checks only the room status, deleted and terminating rooms
+// are not failures of the new version.
+func isRoomFailed(room *game_room.GameRoom) bool {
+	return room.Status == game_room.GameStatusError || room.Status == game_room.GameStatusUnready
+}
@@ -0,0 +1,240 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build unit
+// +build unit
+
+package canary_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/topfreegames/maestro/internal/core/entities"
+	"github.com/topfreegames/maestro/internal/core/entities/game_room"
+	"github.com/topfreegames/maestro/internal/core/entities/operation"
+	"github.com/topfreegames/maestro/internal/core/entities/rollout"
+	"github.com/topfreegames/maestro/internal/core/operations/rooms/remove"
+	"github.com/topfreegames/maestro/internal/core/operations/schedulers/canary"
+	porterrors "github.com/topfreegames/maestro/internal/core/ports/errors"
+	mockports "github.com/topfreegames/maestro/internal/core/ports/mock"
+)
+
+type executorMocks struct {
+	roomManager      *mockports.MockRoomManager
+	roomStorage      *mockports.MockRoomStorage
+	schedulerManager *mockports.MockSchedulerManager
+	operationManager *mockports.MockOperationManager
+}
+
+func newExecutor(t *testing.T) (*canary.Executor, *executorMocks) {
+	mockCtrl := gomock.NewController(t)
+	mocks := &executorMocks{
+		roomManager:      mockports.NewMockRoomManager(mockCtrl),
+		roomStorage:      mockports.NewMockRoomStorage(mockCtrl),
+		schedulerManager: mockports.NewMockSchedulerManager(mockCtrl),
+		operationManager: mockports.NewMockOperationManager(mockCtrl),
+	}
+	config := canary.Config{
+		CheckInterval: time.Millisecond,
+	}
+
+	executor := canary.NewExecutor(mocks.roomManager, mocks.roomStorage, mocks.schedulerManager, mocks.operationManager, config)
+	return executor, mocks
+}
+
+func newCanaryScheduler(params *rollout.CanaryParams) *entities.Scheduler {
+	return &entities.Scheduler{
+		Name:            "scheduler",
+		Game:            "game",
+		State:           entities.StateCreating,
+		RollbackVersion: "v1.0.0",
+		Spec:            game_room.Spec{Version: "v2.0.0"},
+		RolloutStrategy: &rollout.Strategy{Type: rollout.Canary, Canary: params},
+	}
+}
+
+func TestExecutor_Execute(t *testing.T) {
+	op := &operation.Operation{
+		ID:             "op-id",
+		Status:         operation.StatusInProgress,
+		DefinitionName: canary.OperationName,
+		SchedulerName:  "scheduler",
+	}
+	definition := &canary.Definition{NewVersion: "v2.0.0"}
+
+	t.Run("promotes the new version when the canary rooms stay healthy", func(t *testing.T) {
+		executor, mocks := newExecutor(t)
+		scheduler := newCanaryScheduler(&rollout.CanaryParams{Percentage: 10, BakeDuration: 5 * time.Millisecond})
+		rooms := []*game_room.GameRoom{
+			{ID: "room-1", Version: "v2.0.0", Status: game_room.GameStatusReady},
+			{ID: "room-2", Version: "v2.0.0", Status: game_room.GameStatusReady},
+		}
+
+		mocks.schedulerManager.EXPECT().GetSchedulerByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(scheduler, nil)
+		mocks.roomStorage.EXPECT().GetRoomCount(gomock.Any(), op.SchedulerName).Return(15, nil)
+		mocks.roomManager.EXPECT().ListRoomsByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(nil, nil)
+		mocks.roomManager.EXPECT().CreateRoom(gomock.Any(), *scheduler, false).Return(rooms[0], nil, nil)
+		mocks.roomManager.EXPECT().CreateRoom(gomock.Any(), *scheduler, false).Return(rooms[1], nil, nil)
+		mocks.roomManager.EXPECT().ListRoomsByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(rooms, nil).MinTimes(2)
+		mocks.schedulerManager.EXPECT().EnqueueSwitchActiveVersionOperation(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(&operation.Operation{ID: "switch-op-id"}, nil)
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "Starting canary rollout of version v2.0.0 with 2 rooms, baking for 5ms")
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, gomock.Any())
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "Canary succeeded: 0 of 2 rooms on error or unready, enqueued switch active version operation with id: switch-op-id")
+
+		err := executor.Execute(context.Background(), op, definition)
+		require.NoError(t, err)
+
+		// The canary rooms become regular rooms, so the rollback must not delete them.
+		mocks.schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), op.SchedulerName).Return(&entities.Scheduler{Spec: game_room.Spec{Version: "v2.0.0"}}, nil)
+		mocks.schedulerManager.EXPECT().UpdateScheduler(gomock.Any(), gomock.Any()).Return(nil)
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, gomock.Any())
+		err = executor.Rollback(context.Background(), op, definition, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("fails when the canary rooms failure rate is above the threshold at the end of the bake period", func(t *testing.T) {
+		executor, mocks := newExecutor(t)
+		scheduler := newCanaryScheduler(&rollout.CanaryParams{Rooms: 2, BakeDuration: 5 * time.Millisecond, FailureThreshold: 0.4})
+		rooms := []*game_room.GameRoom{
+			{ID: "room-1", Version: "v2.0.0", Status: game_room.GameStatusError},
+			{ID: "room-2", Version: "v2.0.0", Status: game_room.GameStatusReady},
+		}
+
+		mocks.schedulerManager.EXPECT().GetSchedulerByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(scheduler, nil)
+		mocks.roomStorage.EXPECT().GetRoomCount(gomock.Any(), op.SchedulerName).Return(10, nil)
+		mocks.roomManager.EXPECT().ListRoomsByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(nil, nil)
+		mocks.roomManager.EXPECT().CreateRoom(gomock.Any(), *scheduler, false).Return(rooms[0], nil, nil)
+		mocks.roomManager.EXPECT().CreateRoom(gomock.Any(), *scheduler, false).Return(rooms[1], nil, nil)
+		mocks.roomManager.EXPECT().ListRoomsByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(rooms, nil).MinTimes(2)
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "Starting canary rollout of version v2.0.0 with 2 rooms, baking for 5ms")
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, gomock.Any())
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "Canary failed: 1 of 2 rooms on error or unready, rate 0.50 is above the threshold 0.40")
+
+		err := executor.Execute(context.Background(), op, definition)
+		require.Error(t, err)
+
+		// The rooms are listed by the ListRoomsByVersion expectation above.
+		mocks.schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), op.SchedulerName).Return(&entities.Scheduler{State: entities.StateCreating, Spec: game_room.Spec{Version: "v1.0.0"}}, nil)
+		mocks.roomManager.EXPECT().DeleteRoom(gomock.Any(), rooms[0], remove.CanaryRollback).Return(nil)
+		mocks.roomManager.EXPECT().DeleteRoom(gomock.Any(), rooms[1], remove.CanaryRollback).Return(porterrors.NewErrNotFound("room not found"))
+		mocks.schedulerManager.EXPECT().UpdateScheduler(gomock.Any(), &entities.Scheduler{State: entities.StateInSync, Spec: game_room.Spec{Version: "v1.0.0"}}).Return(nil)
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "Canary rooms deleted, scheduler kept on version v1.0.0")
+
+		err = executor.Rollback(context.Background(), op, definition, err)
+		require.NoError(t, err)
+	})
+
+	t.Run("counts only the rooms on error or unready as failed", func(t *testing.T) {
+		executor, mocks := newExecutor(t)
+		scheduler := newCanaryScheduler(&rollout.CanaryParams{Rooms: 4, BakeDuration: 5 * time.Millisecond, FailureThreshold: 0.5})
+		rooms := []*game_room.GameRoom{
+			{ID: "room-1", Version: "v2.0.0", Status: game_room.GameStatusError},
+			{ID: "room-2", Version: "v2.0.0", Status: game_room.GameStatusUnready},
+			{ID: "room-3", Version: "v2.0.0", Status: game_room.GameStatusTerminating},
+		}
+
+		mocks.schedulerManager.EXPECT().GetSchedulerByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(scheduler, nil)
+		mocks.roomStorage.EXPECT().GetRoomCount(gomock.Any(), op.SchedulerName).Return(10, nil)
+		mocks.roomManager.EXPECT().ListRoomsByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(nil, nil)
+		mocks.roomManager.EXPECT().CreateRoom(gomock.Any(), *scheduler, false).Return(&game_room.GameRoom{}, nil, nil).Times(4)
+		// The fourth room was deleted, so it isn't listed anymore.
+		mocks.roomManager.EXPECT().ListRoomsByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(rooms, nil).MinTimes(2)
+		mocks.schedulerManager.EXPECT().EnqueueSwitchActiveVersionOperation(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(&operation.Operation{ID: "switch-op-id"}, nil)
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, gomock.Any()).Times(2)
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "Canary succeeded: 2 of 4 rooms on error or unready, enqueued switch active version operation with id: switch-op-id")
+
+		err := executor.Execute(context.Background(), op, definition)
+		require.NoError(t, err)
+	})
+
+	t.Run("keeps the canary rooms created before the operation was interrupted", func(t *testing.T) {
+		executor, mocks := newExecutor(t)
+		scheduler := newCanaryScheduler(&rollout.CanaryParams{Rooms: 2, BakeDuration: 5 * time.Millisecond})
+		rooms := []*game_room.GameRoom{
+			{ID: "room-1", Version: "v2.0.0", Status: game_room.GameStatusReady},
+			{ID: "room-2", Version: "v2.0.0", Status: game_room.GameStatusReady},
+		}
+
+		mocks.schedulerManager.EXPECT().GetSchedulerByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(scheduler, nil)
+		mocks.roomStorage.EXPECT().GetRoomCount(gomock.Any(), op.SchedulerName).Return(11, nil)
+		mocks.roomManager.EXPECT().ListRoomsByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(rooms[:1], nil)
+		mocks.roomManager.EXPECT().CreateRoom(gomock.Any(), *scheduler, false).Return(rooms[1], nil, nil)
+		mocks.roomManager.EXPECT().ListRoomsByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(rooms, nil).MinTimes(2)
+		mocks.schedulerManager.EXPECT().EnqueueSwitchActiveVersionOperation(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(&operation.Operation{ID: "switch-op-id"}, nil)
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, gomock.Any()).Times(3)
+
+		err := executor.Execute(context.Background(), op, definition)
+		require.NoError(t, err)
+	})
+
+	t.Run("fails when a canary room can't be created", func(t *testing.T) {
+		executor, mocks := newExecutor(t)
+		scheduler := newCanaryScheduler(&rollout.CanaryParams{Rooms: 1, BakeDuration: time.Hour})
+
+		mocks.schedulerManager.EXPECT().GetSchedulerByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(scheduler, nil)
+		mocks.roomStorage.EXPECT().GetRoomCount(gomock.Any(), op.SchedulerName).Return(10, nil)
+		mocks.roomManager.EXPECT().ListRoomsByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(nil, nil)
+		mocks.roomManager.EXPECT().CreateRoom(gomock.Any(), *scheduler, false).Return(nil, nil, porterrors.NewErrUnexpected("some error"))
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, gomock.Any())
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "Failed to create canary rooms: some error")
+
+		err := executor.Execute(context.Background(), op, definition)
+		require.Error(t, err)
+	})
+
+	t.Run("fails when the new version has no canary rollout strategy", func(t *testing.T) {
+		executor, mocks := newExecutor(t)
+		scheduler := newCanaryScheduler(nil)
+		scheduler.RolloutStrategy = nil
+
+		mocks.schedulerManager.EXPECT().GetSchedulerByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(scheduler, nil)
+
+		err := executor.Execute(context.Background(), op, definition)
+		require.Error(t, err)
+	})
+
+	t.Run("fails when the context is canceled during the bake period", func(t *testing.T) {
+		executor, mocks := newExecutor(t)
+		scheduler := newCanaryScheduler(&rollout.CanaryParams{Rooms: 1, BakeDuration: time.Hour})
+		ctx, cancel := context.WithCancel(context.Background())
+		room := &game_room.GameRoom{ID: "room-1", Version: "v2.0.0", Status: game_room.GameStatusReady}
+
+		mocks.schedulerManager.EXPECT().GetSchedulerByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(scheduler, nil)
+		mocks.roomStorage.EXPECT().GetRoomCount(gomock.Any(), op.SchedulerName).Return(10, nil)
+		mocks.roomManager.EXPECT().ListRoomsByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).Return(nil, nil)
+		mocks.roomManager.EXPECT().CreateRoom(gomock.Any(), *scheduler, false).Return(room, nil, nil)
+		mocks.roomManager.EXPECT().ListRoomsByVersion(gomock.Any(), op.SchedulerName, definition.NewVersion).DoAndReturn(
+			func(_ context.Context, _, _ string) ([]*game_room.GameRoom, error) {
+				cancel()
+				return []*game_room.GameRoom{room}, nil
+			},
+		)
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, gomock.Any()).Times(2)
+
+		err := executor.Execute(ctx, op, definition)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -0,0 +1,37 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package canary
+
+const (
+	startingCanaryMessageTemplate = "Starting canary rollout of version %s with %d rooms, baking for %s"
+
+	createRoomsFailedMessageTemplate = "Failed to create canary rooms: %s"
+
+	canaryProgressMessageTemplate = "Canary check: %d of %d rooms on error or unready, %s of bake time remaining"
+
+	canaryFailedMessageTemplate = "Canary failed: %d of %d rooms on error or unready, rate %.2f is above the threshold %.2f"
+
+	canarySucceededMessageTemplate = "Canary succeeded: %d of %d rooms on error or unready, enqueued switch active version operation with id: %s"
+
+	rolledBackMessageTemplate = "Canary rooms deleted, scheduler kept on version %s"
+)
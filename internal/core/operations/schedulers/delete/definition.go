@@ -69,3 +69,7 @@ func (d *Definition) Unmarshal(raw []byte) error {
 func (d *Definition) HasNoAction() bool {
 	return false
 }
+
+func (d *Definition) Priority() operation.Priority {
+	return operation.PriorityCritical
+}
@@ -47,6 +47,7 @@ type Executor struct {
 	operationStorage ports.OperationStorage
 	operationManager ports.OperationManager
 	runtime          ports.Runtime
+	eventsOutbox     ports.EventsOutbox
 }
 
 var _ operations.Executor = (*Executor)(nil)
@@ -58,6 +59,7 @@ func NewExecutor(
 	operationStorage ports.OperationStorage,
 	operationManager ports.OperationManager,
 	runtime ports.Runtime,
+	eventsOutbox ports.EventsOutbox,
 ) *Executor {
 	return &Executor{
 		schedulerStorage: schedulerStorage,
@@ -66,6 +68,7 @@ func NewExecutor(
 		operationStorage: operationStorage,
 		operationManager: operationManager,
 		runtime:          runtime,
+		eventsOutbox:     eventsOutbox,
 	}
 }
 
@@ -122,6 +125,11 @@ func (e *Executor) Execute(ctx context.Context, op *operation.Operation, definit
 			logger.Warn("failed to clean operations history", zap.Error(err))
 		}
 
+		err = e.eventsOutbox.DeleteDeadLetters(ctx, schedulerName)
+		if err != nil {
+			logger.Warn("failed to delete dead-letter events", zap.Error(err))
+		}
+
 		return nil
 	})
 
@@ -47,7 +47,7 @@ func TestExecutor_Execute(t *testing.T) {
 
 	t.Run("returns no error", func(t *testing.T) {
 		t.Run("when no internal error occurs with 0 running instances", func(t *testing.T) {
-			executor, schedulerStorage, schedulerCache, instanceStorage, operationStorage, _, runtime := prepareMocks(t)
+			executor, schedulerStorage, schedulerCache, instanceStorage, operationStorage, _, runtime, eventsOutbox := prepareMocks(t)
 			ctx := context.Background()
 
 			definition := &Definition{}
@@ -64,6 +64,7 @@ func TestExecutor_Execute(t *testing.T) {
 			instanceStorage.EXPECT().GetInstanceCount(ctx, scheduler.Name).Return(0, nil)
 			schedulerCache.EXPECT().DeleteScheduler(ctx, scheduler.Name)
 			operationStorage.EXPECT().CleanOperationsHistory(ctx, scheduler.Name)
+			eventsOutbox.EXPECT().DeleteDeadLetters(ctx, scheduler.Name)
 
 			err := executor.Execute(ctx, op, definition)
 
@@ -71,7 +72,7 @@ func TestExecutor_Execute(t *testing.T) {
 		})
 
 		t.Run("when no internal error occurs with 20 running instances", func(t *testing.T) {
-			executor, schedulerStorage, schedulerCache, instanceStorage, operationStorage, operationManager, runtime := prepareMocks(t)
+			executor, schedulerStorage, schedulerCache, instanceStorage, operationStorage, operationManager, runtime, eventsOutbox := prepareMocks(t)
 			ctx := context.Background()
 
 			definition := &Definition{}
@@ -93,6 +94,7 @@ func TestExecutor_Execute(t *testing.T) {
 
 			schedulerCache.EXPECT().DeleteScheduler(ctx, scheduler.Name)
 			operationStorage.EXPECT().CleanOperationsHistory(ctx, scheduler.Name)
+			eventsOutbox.EXPECT().DeleteDeadLetters(ctx, scheduler.Name)
 
 			err := executor.Execute(ctx, op, definition)
 
@@ -100,7 +102,7 @@ func TestExecutor_Execute(t *testing.T) {
 		})
 
 		t.Run("when it fails to get scheduler from cache the first time", func(t *testing.T) {
-			executor, schedulerStorage, schedulerCache, instanceStorage, operationStorage, _, runtime := prepareMocks(t)
+			executor, schedulerStorage, schedulerCache, instanceStorage, operationStorage, _, runtime, eventsOutbox := prepareMocks(t)
 			ctx := context.Background()
 
 			definition := &Definition{}
@@ -118,6 +120,7 @@ func TestExecutor_Execute(t *testing.T) {
 			instanceStorage.EXPECT().GetInstanceCount(ctx, scheduler.Name).Return(0, nil)
 			schedulerCache.EXPECT().DeleteScheduler(ctx, scheduler.Name)
 			operationStorage.EXPECT().CleanOperationsHistory(ctx, scheduler.Name)
+			eventsOutbox.EXPECT().DeleteDeadLetters(ctx, scheduler.Name)
 
 			err := executor.Execute(ctx, op, definition)
 
@@ -125,7 +128,7 @@ func TestExecutor_Execute(t *testing.T) {
 		})
 
 		t.Run("when it fails to wait for all instances to be deleted error", func(t *testing.T) {
-			executor, schedulerStorage, schedulerCache, instanceStorage, operationStorage, _, runtime := prepareMocks(t)
+			executor, schedulerStorage, schedulerCache, instanceStorage, operationStorage, _, runtime, eventsOutbox := prepareMocks(t)
 			ctx := context.Background()
 
 			definition := &Definition{}
@@ -142,6 +145,7 @@ func TestExecutor_Execute(t *testing.T) {
 			instanceStorage.EXPECT().GetInstanceCount(ctx, scheduler.Name).Return(0, errors.New("some error instance storage"))
 			schedulerCache.EXPECT().DeleteScheduler(ctx, scheduler.Name)
 			operationStorage.EXPECT().CleanOperationsHistory(ctx, scheduler.Name)
+			eventsOutbox.EXPECT().DeleteDeadLetters(ctx, scheduler.Name)
 
 			err := executor.Execute(ctx, op, definition)
 
@@ -149,7 +153,7 @@ func TestExecutor_Execute(t *testing.T) {
 		})
 
 		t.Run("when it fails to delete scheduler from cache", func(t *testing.T) {
-			executor, schedulerStorage, schedulerCache, instanceStorage, operationStorage, _, runtime := prepareMocks(t)
+			executor, schedulerStorage, schedulerCache, instanceStorage, operationStorage, _, runtime, eventsOutbox := prepareMocks(t)
 			ctx := context.Background()
 
 			definition := &Definition{}
@@ -166,6 +170,7 @@ func TestExecutor_Execute(t *testing.T) {
 			instanceStorage.EXPECT().GetInstanceCount(ctx, scheduler.Name).Return(0, nil)
 			schedulerCache.EXPECT().DeleteScheduler(ctx, scheduler.Name).Return(errors.New("failed to delete scheduler from cache"))
 			operationStorage.EXPECT().CleanOperationsHistory(ctx, scheduler.Name)
+			eventsOutbox.EXPECT().DeleteDeadLetters(ctx, scheduler.Name)
 
 			err := executor.Execute(ctx, op, definition)
 
@@ -173,7 +178,7 @@ func TestExecutor_Execute(t *testing.T) {
 		})
 
 		t.Run("when it fails to clean operations history", func(t *testing.T) {
-			executor, schedulerStorage, schedulerCache, instanceStorage, operationStorage, _, runtime := prepareMocks(t)
+			executor, schedulerStorage, schedulerCache, instanceStorage, operationStorage, _, runtime, eventsOutbox := prepareMocks(t)
 			ctx := context.Background()
 
 			definition := &Definition{}
@@ -190,6 +195,32 @@ func TestExecutor_Execute(t *testing.T) {
 			instanceStorage.EXPECT().GetInstanceCount(ctx, scheduler.Name).Return(0, nil)
 			schedulerCache.EXPECT().DeleteScheduler(ctx, scheduler.Name)
 			operationStorage.EXPECT().CleanOperationsHistory(ctx, scheduler.Name).Return(errors.New("failed to clean operations history"))
+			eventsOutbox.EXPECT().DeleteDeadLetters(ctx, scheduler.Name)
+
+			err := executor.Execute(ctx, op, definition)
+
+			require.Nil(t, err)
+		})
+
+		t.Run("when it fails to delete the dead-letter events", func(t *testing.T) {
+			executor, schedulerStorage, schedulerCache, instanceStorage, operationStorage, _, runtime, eventsOutbox := prepareMocks(t)
+			ctx := context.Background()
+
+			definition := &Definition{}
+			op := &operation.Operation{SchedulerName: scheduler.Name}
+
+			schedulerCache.EXPECT().GetScheduler(ctx, scheduler.Name).Return(scheduler, nil)
+			schedulerStorage.EXPECT().RunWithTransaction(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, f func(transactionId ports.TransactionID) error) error {
+					return f("transactionID")
+				})
+			schedulerStorage.EXPECT().DeleteScheduler(ctx, ports.TransactionID("transactionID"), scheduler)
+			runtime.EXPECT().DeleteScheduler(ctx, scheduler)
+
+			instanceStorage.EXPECT().GetInstanceCount(ctx, scheduler.Name).Return(0, nil)
+			schedulerCache.EXPECT().DeleteScheduler(ctx, scheduler.Name)
+			operationStorage.EXPECT().CleanOperationsHistory(ctx, scheduler.Name)
+			eventsOutbox.EXPECT().DeleteDeadLetters(ctx, scheduler.Name).Return(errors.New("failed to delete dead-letter events"))
 
 			err := executor.Execute(ctx, op, definition)
 
@@ -197,7 +228,7 @@ func TestExecutor_Execute(t *testing.T) {
 		})
 
 		t.Run("when some error occurs when waiting for instances to be deleted", func(t *testing.T) {
-			executor, schedulerStorage, schedulerCache, instanceStorage, operationStorage, _, runtime := prepareMocks(t)
+			executor, schedulerStorage, schedulerCache, instanceStorage, operationStorage, _, runtime, eventsOutbox := prepareMocks(t)
 			ctx := context.Background()
 
 			definition := &Definition{}
@@ -216,6 +247,7 @@ func TestExecutor_Execute(t *testing.T) {
 
 			schedulerCache.EXPECT().DeleteScheduler(ctx, scheduler.Name)
 			operationStorage.EXPECT().CleanOperationsHistory(ctx, scheduler.Name)
+			eventsOutbox.EXPECT().DeleteDeadLetters(ctx, scheduler.Name)
 
 			err := executor.Execute(ctx, op, definition)
 
@@ -223,7 +255,7 @@ func TestExecutor_Execute(t *testing.T) {
 		})
 
 		t.Run("when timeout waiting for instances to be deleted", func(t *testing.T) {
-			executor, schedulerStorage, schedulerCache, instanceStorage, operationStorage, operationManager, runtime := prepareMocks(t)
+			executor, schedulerStorage, schedulerCache, instanceStorage, operationStorage, operationManager, runtime, eventsOutbox := prepareMocks(t)
 			ctx := context.Background()
 
 			definition := &Definition{}
@@ -242,6 +274,7 @@ func TestExecutor_Execute(t *testing.T) {
 
 			schedulerCache.EXPECT().DeleteScheduler(ctx, scheduler.Name)
 			operationStorage.EXPECT().CleanOperationsHistory(ctx, scheduler.Name)
+			eventsOutbox.EXPECT().DeleteDeadLetters(ctx, scheduler.Name)
 
 			err := executor.Execute(ctx, op, definition)
 
@@ -251,7 +284,7 @@ func TestExecutor_Execute(t *testing.T) {
 
 	t.Run("returns error", func(t *testing.T) {
 		t.Run("when it fails to load the scheduler from storage the first time", func(t *testing.T) {
-			executor, schedulerStorage, schedulerCache, _, _, operationManager, _ := prepareMocks(t)
+			executor, schedulerStorage, schedulerCache, _, _, operationManager, _, _ := prepareMocks(t)
 			ctx := context.Background()
 
 			definition := &Definition{}
@@ -267,7 +300,7 @@ func TestExecutor_Execute(t *testing.T) {
 		})
 
 		t.Run("when it fails to delete scheduler in storage", func(t *testing.T) {
-			executor, schedulerStorage, schedulerCache, _, _, operationManager, _ := prepareMocks(t)
+			executor, schedulerStorage, schedulerCache, _, _, operationManager, _, _ := prepareMocks(t)
 			ctx := context.Background()
 
 			definition := &Definition{}
@@ -287,7 +320,7 @@ func TestExecutor_Execute(t *testing.T) {
 		})
 
 		t.Run("when it fails to delete scheduler in runtime", func(t *testing.T) {
-			executor, schedulerStorage, schedulerCache, _, _, operationManager, runtime := prepareMocks(t)
+			executor, schedulerStorage, schedulerCache, _, _, operationManager, runtime, _ := prepareMocks(t)
 
 			ctx := context.Background()
 
@@ -317,6 +350,7 @@ func prepareMocks(t *testing.T) (
 	*mockports.MockOperationStorage,
 	*mockports.MockOperationManager,
 	*mockports.MockRuntime,
+	*mockports.MockEventsOutbox,
 ) {
 	mockCtrl := gomock.NewController(t)
 	schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
@@ -325,6 +359,7 @@ func prepareMocks(t *testing.T) (
 	operationStorage := mockports.NewMockOperationStorage(mockCtrl)
 	operationManager := mockports.NewMockOperationManager(mockCtrl)
 	runtime := mockports.NewMockRuntime(mockCtrl)
+	eventsOutbox := mockports.NewMockEventsOutbox(mockCtrl)
 
 	op := NewExecutor(
 		schedulerStorage,
@@ -333,7 +368,8 @@ func prepareMocks(t *testing.T) (
 		operationStorage,
 		operationManager,
 		runtime,
+		eventsOutbox,
 	)
 
-	return op, schedulerStorage, schedulerCache, instanceStorage, operationStorage, operationManager, runtime
+	return op, schedulerStorage, schedulerCache, instanceStorage, operationStorage, operationManager, runtime, eventsOutbox
 }
@@ -68,3 +68,7 @@ func (def *Definition) Unmarshal(raw []byte) error {
 func (d *Definition) HasNoAction() bool {
 	return false
 }
+
+func (d *Definition) Priority() operation.Priority {
+	return operation.PriorityNormal
+}
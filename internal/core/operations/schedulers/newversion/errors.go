@@ -24,6 +24,7 @@ package newversion
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/topfreegames/maestro/internal/core/entities/game_room"
 )
@@ -76,3 +77,72 @@ func (e *ValidationPodInErrorError) Is(other error) bool {
 func (e ValidationPodInErrorError) Error() string {
 	return fmt.Sprintf("error validating game room with ID %s, instance is entering in error: %s, %s", e.GameRoomID, e.StatusDescription, e.Err)
 }
+
+type ValidationRoomNotReadyError struct {
+	GameRoomID       string
+	Status           string
+	MinReadyDuration time.Duration
+}
+
+func NewValidationRoomNotReadyError(gameRoomID, status string, minReadyDuration time.Duration) *ValidationRoomNotReadyError {
+	return &ValidationRoomNotReadyError{GameRoomID: gameRoomID, Status: status, MinReadyDuration: minReadyDuration}
+}
+
+func (e *ValidationRoomNotReadyError) Is(other error) bool {
+	if _, ok := other.(*ValidationRoomNotReadyError); ok {
+		return true
+	}
+	return false
+}
+
+func (e ValidationRoomNotReadyError) Error() string {
+	return fmt.Sprintf("error validating game room with ID %s, room left the ready status to %s before %s", e.GameRoomID, e.Status, e.MinReadyDuration)
+}
+
+type ValidationHealthCheckError struct {
+	Err        error
+	GameRoomID string
+}
+
+func NewValidationHealthCheckError(gameRoomID string, err error) *ValidationHealthCheckError {
+	return &ValidationHealthCheckError{Err: err, GameRoomID: gameRoomID}
+}
+
+func (e *ValidationHealthCheckError) Unwrap() error {
+	return e.Err
+}
+
+func (e *ValidationHealthCheckError) Is(other error) bool {
+	if _, ok := other.(*ValidationHealthCheckError); ok {
+		return true
+	}
+	return false
+}
+
+func (e ValidationHealthCheckError) Error() string {
+	return fmt.Sprintf("error validating game room with ID %s, health check failed: %s", e.GameRoomID, e.Err)
+}
+
+type ValidationSmokeTestError struct {
+	Err        error
+	GameRoomID string
+}
+
+func NewValidationSmokeTestError(gameRoomID string, err error) *ValidationSmokeTestError {
+	return &ValidationSmokeTestError{Err: err, GameRoomID: gameRoomID}
+}
+
+func (e *ValidationSmokeTestError) Unwrap() error {
+	return e.Err
+}
+
+func (e *ValidationSmokeTestError) Is(other error) bool {
+	if _, ok := other.(*ValidationSmokeTestError); ok {
+		return true
+	}
+	return false
+}
+
+func (e ValidationSmokeTestError) Error() string {
+	return fmt.Sprintf("error validating game room with ID %s, smoke test failed: %s", e.GameRoomID, e.Err)
+}
@@ -26,20 +26,28 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/avast/retry-go/v4"
 	"github.com/topfreegames/maestro/internal/core/entities"
+	"github.com/topfreegames/maestro/internal/core/entities/events"
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
 	"github.com/topfreegames/maestro/internal/core/entities/game_room"
 	"github.com/topfreegames/maestro/internal/core/entities/operation"
+	"github.com/topfreegames/maestro/internal/core/entities/rollout"
 	"github.com/topfreegames/maestro/internal/core/logs"
 	"github.com/topfreegames/maestro/internal/core/operations"
 	"github.com/topfreegames/maestro/internal/core/operations/rooms/remove"
+	"github.com/topfreegames/maestro/internal/core/operations/schedulers/bluegreen"
+	"github.com/topfreegames/maestro/internal/core/operations/schedulers/canary"
 	"github.com/topfreegames/maestro/internal/core/operations/schedulers/switchversion"
 	"github.com/topfreegames/maestro/internal/core/ports"
 	serviceerrors "github.com/topfreegames/maestro/internal/core/services/errors"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
 )
 
 // Config defines configurations for the Executor.
@@ -53,19 +61,24 @@ type Executor struct {
 	roomManager          ports.RoomManager
 	schedulerManager     ports.SchedulerManager
 	operationManager     ports.OperationManager
-	validationRoomIdsMap map[string]*game_room.GameRoom
+	roomHealthChecker    ports.RoomHealthChecker
+	eventsForwarder      ports.EventsForwarder
+	validationRoomIdsMap map[string][]*game_room.GameRoom
+	validationRoomsLock  sync.Mutex
 	config               Config
 }
 
 var _ operations.Executor = (*Executor)(nil)
 
 // NewExecutor instantiate a new scheduler version executor.
-func NewExecutor(roomManager ports.RoomManager, schedulerManager ports.SchedulerManager, operationManager ports.OperationManager, config Config) *Executor {
+func NewExecutor(roomManager ports.RoomManager, schedulerManager ports.SchedulerManager, operationManager ports.OperationManager, roomHealthChecker ports.RoomHealthChecker, eventsForwarder ports.EventsForwarder, config Config) *Executor {
 	return &Executor{
 		roomManager:          roomManager,
 		schedulerManager:     schedulerManager,
 		operationManager:     operationManager,
-		validationRoomIdsMap: map[string]*game_room.GameRoom{},
+		roomHealthChecker:    roomHealthChecker,
+		eventsForwarder:      eventsForwarder,
+		validationRoomIdsMap: map[string][]*game_room.GameRoom{},
 		config:               config,
 	}
 }
@@ -109,8 +122,8 @@ func (ex *Executor) Execute(ctx context.Context, op *operation.Operation, defini
 		currentAttempt := 0
 		retryError := retry.Do(func() error {
 			currentAttempt++
-			validationError := ex.validateGameRoomCreation(ctx, newScheduler, logger)
-			return ex.treatValidationError(ctx, op, validationError, currentAttempt)
+			validationResults := ex.validateGameRoomsCreation(ctx, newScheduler, logger)
+			return ex.treatValidationResults(ctx, op, validationResults, currentAttempt)
 		}, retry.Attempts(uint(ex.config.RoomValidationAttempts)), retry.Context(ctx))
 		if retryError != nil {
 			logger.Error("game room validation failed after all attempts", zap.Error(retryError))
@@ -119,6 +132,30 @@ func (ex *Executor) Execute(ctx context.Context, op *operation.Operation, defini
 		}
 	}
 
+	if isSchedulerMajorVersion && newScheduler.RolloutStrategy.IsCanary() {
+		canaryOpID, err := ex.schedulerManager.CreateNewSchedulerVersionAndEnqueueCanaryRollout(ctx, newScheduler)
+		if err != nil {
+			logger.Error("error creating new scheduler version in db", zap.Error(err))
+			return fmt.Errorf("error creating new scheduler version in db: %w", err)
+		}
+
+		ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, fmt.Sprintf(enqueuedCanaryRolloutMessageTemplate, canaryOpID))
+		logger.Sugar().Infof("%s operation succeded, %s operation enqueued to roll out version %s", opDef.Name(), canary.OperationName, newScheduler.Spec.Version)
+		return nil
+	}
+
+	if isSchedulerMajorVersion && newScheduler.RolloutStrategy.IsBlueGreen() {
+		blueGreenOpID, err := ex.schedulerManager.CreateNewSchedulerVersionAndEnqueueBlueGreenSwitch(ctx, newScheduler)
+		if err != nil {
+			logger.Error("error creating new scheduler version in db", zap.Error(err))
+			return fmt.Errorf("error creating new scheduler version in db: %w", err)
+		}
+
+		ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, fmt.Sprintf(enqueuedBlueGreenSwitchMessageTemplate, blueGreenOpID))
+		logger.Sugar().Infof("%s operation succeded, %s operation enqueued to roll out version %s", opDef.Name(), bluegreen.OperationName, newScheduler.Spec.Version)
+		return nil
+	}
+
 	switchOpID, err := ex.createNewSchedulerVersionAndEnqueueSwitchVersionOp(ctx, newScheduler, logger, isSchedulerMajorVersion)
 	if err != nil {
 		return err
@@ -138,13 +175,13 @@ func (ex *Executor) Rollback(ctx context.Context, op *operation.Operation, defin
 		zap.String(logs.LogFieldOperationPhase, "Rollback"),
 		zap.String(logs.LogFieldOperationID, op.ID),
 	)
-	if gameRoom, ok := ex.validationRoomIdsMap[op.SchedulerName]; ok {
+	for _, gameRoom := range ex.getValidationRooms(op.SchedulerName) {
 		err := ex.roomManager.DeleteRoom(ctx, gameRoom, remove.NewVersionRollback)
 		if err != nil {
 			logger.Error("error deleting new game room created for validation", zap.Error(err))
 			return fmt.Errorf("error in Rollback function execution: %w", err)
 		}
-		ex.RemoveValidationRoomID(op.SchedulerName)
+		ex.RemoveValidationRoomID(op.SchedulerName, gameRoom)
 	}
 
 	opDef, ok := definition.(*Definition)
@@ -173,14 +210,43 @@ func (ex *Executor) Name() string {
 	return OperationName
 }
 
-func (ex *Executor) validateGameRoomCreation(ctx context.Context, scheduler *entities.Scheduler, logger *zap.Logger) error {
+// validationResult holds the outcome of a single validation room.
+type validationResult struct {
+	gameRoomID string
+	checks     []string
+	err        error
+}
+
+// validateGameRoomsCreation creates the validation rooms in parallel and
+// returns the result of each one of them.
+func (ex *Executor) validateGameRoomsCreation(ctx context.Context, scheduler *entities.Scheduler, logger *zap.Logger) []*validationResult {
+	validation := scheduler.RolloutStrategy.GetValidation()
+	results := make([]*validationResult, validation.RoomsAmount())
+
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = ex.validateGameRoomCreation(ctx, scheduler, validation, logger)
+		}(i)
+	}
+	wg.Wait()
+
+	return results
+}
+
+func (ex *Executor) validateGameRoomCreation(ctx context.Context, scheduler *entities.Scheduler, validation *rollout.ValidationParams, logger *zap.Logger) *validationResult {
+	result := &validationResult{}
 	gameRoom, _, err := ex.roomManager.CreateRoom(ctx, *scheduler, true)
 	if err != nil {
 		basicErrorMessage := "error creating new game room for validating new version"
 		logger.Error(basicErrorMessage, zap.Error(err))
 
-		return err
+		result.err = err
+		return result
 	}
+	result.gameRoomID = gameRoom.ID
 	ex.AddValidationRoomID(scheduler.Name, gameRoom)
 
 	defer func() {
@@ -188,9 +254,54 @@ func (ex *Executor) validateGameRoomCreation(ctx context.Context, scheduler *ent
 		if err != nil {
 			logger.Error("error deleting new game room created for validation", zap.Error(err))
 		}
-		ex.RemoveValidationRoomID(scheduler.Name)
+		ex.RemoveValidationRoomID(scheduler.Name, gameRoom)
 	}()
 
+	result.err = ex.waitGameRoomReady(ctx, scheduler, gameRoom, logger)
+	if result.err != nil || validation == nil {
+		return result
+	}
+	result.checks = append(result.checks, "ready")
+
+	if validation.MinReadyDuration > 0 {
+		result.err = ex.checkGameRoomStaysReady(ctx, gameRoom, validation.MinReadyDuration)
+		if result.err != nil {
+			return result
+		}
+		result.checks = append(result.checks, fmt.Sprintf("stayed ready for %s", validation.MinReadyDuration))
+	}
+
+	if validation.HealthCheck == nil && validation.SmokeTest == nil {
+		return result
+	}
+
+	instance, err := ex.roomManager.GetRoomInstance(ctx, scheduler.Name, gameRoom.ID)
+	if err != nil {
+		result.err = fmt.Errorf("error getting validation room instance: %w", err)
+		return result
+	}
+
+	if validation.HealthCheck != nil {
+		result.err = ex.checkGameRoomHealth(ctx, gameRoom, instance, validation.HealthCheck)
+		if result.err != nil {
+			return result
+		}
+		result.checks = append(result.checks, fmt.Sprintf("%s health check", validation.HealthCheck.Protocol))
+	}
+
+	if validation.SmokeTest != nil {
+		result.err = ex.runGameRoomSmokeTest(ctx, scheduler, gameRoom, instance, validation.SmokeTest)
+		if result.err != nil {
+			return result
+		}
+		result.checks = append(result.checks, fmt.Sprintf("%s smoke test", validation.SmokeTest.EventName))
+	}
+
+	return result
+}
+
+func (ex *Executor) waitGameRoomReady(ctx context.Context, scheduler *entities.Scheduler, gameRoom *game_room.GameRoom, logger *zap.Logger) error {
+
 	duration := ex.config.RoomInitializationTimeout
 	timeoutContext, cancelFunc := context.WithTimeout(ctx, duration)
 	defer cancelFunc()
@@ -229,30 +340,166 @@ func (ex *Executor) validateGameRoomCreation(ctx context.Context, scheduler *ent
 	return nil
 }
 
+// checkGameRoomStaysReady fails if the room leaves the ready status before
+// the duration is elapsed.
+func (ex *Executor) checkGameRoomStaysReady(ctx context.Context, gameRoom *game_room.GameRoom, duration time.Duration) error {
+	timeoutContext, cancelFunc := context.WithTimeout(ctx, duration)
+	defer cancelFunc()
+
+	roomStatus, err := ex.roomManager.WaitRoomStatus(
+		timeoutContext,
+		gameRoom,
+		[]game_room.GameRoomStatus{game_room.GameStatusPending, game_room.GameStatusUnready, game_room.GameStatusError, game_room.GameStatusTerminating, game_room.GameStatusTerminated},
+	)
+	if errors.Is(err, serviceerrors.ErrGameRoomStatusWaitingTimeout) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return NewValidationRoomNotReadyError(gameRoom.ID, roomStatus.String(), duration)
+}
+
+func (ex *Executor) checkGameRoomHealth(ctx context.Context, gameRoom *game_room.GameRoom, instance *game_room.Instance, healthCheck *rollout.HealthCheckParams) error {
+	address, err := gameRoomAddress(instance, healthCheck.PortName)
+	if err != nil {
+		return NewValidationHealthCheckError(gameRoom.ID, err)
+	}
+
+	err = ex.roomHealthChecker.Check(ctx, address, healthCheck)
+	if err != nil {
+		return NewValidationHealthCheckError(gameRoom.ID, err)
+	}
+
+	return nil
+}
+
+func (ex *Executor) runGameRoomSmokeTest(ctx context.Context, scheduler *entities.Scheduler, gameRoom *game_room.GameRoom, instance *game_room.Instance, smokeTest *rollout.SmokeTestParams) error {
+	var smokeTestForwarder *forwarder.Forwarder
+	for _, schedulerForwarder := range scheduler.Forwarders {
+		if schedulerForwarder.Name == smokeTest.ForwarderName {
+			smokeTestForwarder = schedulerForwarder
+			break
+		}
+	}
+	if smokeTestForwarder == nil {
+		return NewValidationSmokeTestError(gameRoom.ID, fmt.Errorf("forwarder %s not found on scheduler", smokeTest.ForwarderName))
+	}
+
+	eventAttributes := events.RoomEventAttributes{
+		Game:        scheduler.Game,
+		SchedulerID: scheduler.Name,
+		RoomId:      gameRoom.ID,
+		EventType:   events.Arbitrary,
+		Other:       map[string]interface{}{"roomEvent": smokeTest.EventName},
+	}
+	if instance.Address != nil {
+		eventAttributes.Host = instance.Address.Host
+		if len(instance.Address.Ports) > 0 {
+			eventAttributes.Port = instance.Address.Ports[0].Port
+		}
+	}
+
+	code, err := ex.eventsForwarder.ForwardRoomEvent(ctx, eventAttributes, *smokeTestForwarder)
+	if err != nil {
+		return NewValidationSmokeTestError(gameRoom.ID, err)
+	}
+	if code != codes.OK {
+		return NewValidationSmokeTestError(gameRoom.ID, fmt.Errorf("forwarder responded with code %s", code))
+	}
+
+	return nil
+}
+
+func gameRoomAddress(instance *game_room.Instance, portName string) (string, error) {
+	if instance.Address == nil {
+		return "", fmt.Errorf("room has no address")
+	}
+
+	for _, port := range instance.Address.Ports {
+		if port.Name == portName {
+			return fmt.Sprintf("%s:%d", instance.Address.Host, port.Port), nil
+		}
+	}
+
+	return "", fmt.Errorf("room has no port named %s", portName)
+}
+
 func (ex *Executor) AddValidationRoomID(schedulerName string, gameRoom *game_room.GameRoom) {
-	ex.validationRoomIdsMap[schedulerName] = gameRoom
+	ex.validationRoomsLock.Lock()
+	defer ex.validationRoomsLock.Unlock()
+	ex.validationRoomIdsMap[schedulerName] = append(ex.validationRoomIdsMap[schedulerName], gameRoom)
 }
 
-func (ex *Executor) RemoveValidationRoomID(schedulerName string) {
-	delete(ex.validationRoomIdsMap, schedulerName)
+func (ex *Executor) RemoveValidationRoomID(schedulerName string, gameRoom *game_room.GameRoom) {
+	ex.validationRoomsLock.Lock()
+	defer ex.validationRoomsLock.Unlock()
+	var gameRooms []*game_room.GameRoom
+	for _, validationRoom := range ex.validationRoomIdsMap[schedulerName] {
+		if validationRoom != gameRoom {
+			gameRooms = append(gameRooms, validationRoom)
+		}
+	}
+
+	if len(gameRooms) == 0 {
+		delete(ex.validationRoomIdsMap, schedulerName)
+		return
+	}
+	ex.validationRoomIdsMap[schedulerName] = gameRooms
+}
+
+func (ex *Executor) getValidationRooms(schedulerName string) []*game_room.GameRoom {
+	ex.validationRoomsLock.Lock()
+	defer ex.validationRoomsLock.Unlock()
+	return append([]*game_room.GameRoom{}, ex.validationRoomIdsMap[schedulerName]...)
+}
+
+// treatValidationResults reports every validation room result in the
+// operation history, returning the first validation error found.
+func (ex *Executor) treatValidationResults(ctx context.Context, op *operation.Operation, results []*validationResult, currentAttempt int) error {
+	var validationError error
+	for _, result := range results {
+		if result.err != nil {
+			ex.appendValidationErrorEvent(ctx, op, result.err, currentAttempt)
+			if validationError == nil {
+				validationError = result.err
+			}
+			continue
+		}
+
+		if len(result.checks) > 0 {
+			ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, fmt.Sprintf(validationRoomChecksPassedMessageTemplate, currentAttempt, result.gameRoomID, strings.Join(result.checks, ", ")))
+		}
+	}
+
+	if validationError != nil {
+		return validationError
+	}
+
+	ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, fmt.Sprintf(validationSuccessMessageTemplate, currentAttempt))
+	return nil
 }
 
-func (ex *Executor) treatValidationError(ctx context.Context, op *operation.Operation, validationError error, currentAttempt int) error {
+func (ex *Executor) appendValidationErrorEvent(ctx context.Context, op *operation.Operation, validationError error, currentAttempt int) {
 	switch {
 	case errors.Is(validationError, &ValidationPodInErrorError{}):
 		err := validationError.(*ValidationPodInErrorError)
 		ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, fmt.Sprintf(validationPodInErrorMessageTemplate, currentAttempt, err.GameRoomID, err.StatusDescription))
-		return validationError
 	case errors.Is(validationError, &ValidationTimeoutError{}):
 		ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, fmt.Sprintf(validationTimeoutMessageTemplate, currentAttempt, validationError.(*ValidationTimeoutError).GameRoom.ID))
-		return validationError
-	case validationError != nil:
+	case errors.Is(validationError, &ValidationRoomNotReadyError{}):
+		err := validationError.(*ValidationRoomNotReadyError)
+		ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, fmt.Sprintf(validationRoomNotReadyMessageTemplate, currentAttempt, err.GameRoomID, err.Status, err.MinReadyDuration))
+	case errors.Is(validationError, &ValidationHealthCheckError{}):
+		err := validationError.(*ValidationHealthCheckError)
+		ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, fmt.Sprintf(validationHealthCheckFailedMessageTemplate, currentAttempt, err.GameRoomID, err.Err.Error()))
+	case errors.Is(validationError, &ValidationSmokeTestError{}):
+		err := validationError.(*ValidationSmokeTestError)
+		ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, fmt.Sprintf(validationSmokeTestFailedMessageTemplate, currentAttempt, err.GameRoomID, err.Err.Error()))
+	default:
 		ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, fmt.Sprintf(validationUnexpectedErrorMessageTemplate, currentAttempt, validationError.Error()))
-		return validationError
 	}
-
-	ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, fmt.Sprintf(validationSuccessMessageTemplate, currentAttempt))
-	return nil
 }
 
 func (ex *Executor) createNewSchedulerVersionAndEnqueueSwitchVersionOp(ctx context.Context, newScheduler *entities.Scheduler, logger *zap.Logger, replacePods bool) (string, error) {
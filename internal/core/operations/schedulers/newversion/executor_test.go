@@ -37,13 +37,17 @@ import (
 	"github.com/topfreegames/maestro/internal/core/ports/errors"
 	serviceerrors "github.com/topfreegames/maestro/internal/core/services/errors"
 	"github.com/topfreegames/maestro/internal/validations"
+	"google.golang.org/grpc/codes"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/topfreegames/maestro/internal/core/entities"
+	"github.com/topfreegames/maestro/internal/core/entities/events"
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
 	"github.com/topfreegames/maestro/internal/core/entities/game_room"
 	"github.com/topfreegames/maestro/internal/core/entities/operation"
 	"github.com/topfreegames/maestro/internal/core/entities/port"
+	"github.com/topfreegames/maestro/internal/core/entities/rollout"
 	mockports "github.com/topfreegames/maestro/internal/core/ports/mock"
 )
 
@@ -75,7 +79,7 @@ func TestExecutor_Execute(t *testing.T) {
 			RoomValidationAttempts:    1,
 		}
 
-		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, config)
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
 
 		schedulerVersions := []*entities.SchedulerVersion{{Version: "v1.0.0"}, {Version: "v1.1.0"}, {Version: "v1.2.0"}}
 		gameRoom := &game_room.GameRoom{ID: "id-1"}
@@ -104,6 +108,150 @@ func TestExecutor_Execute(t *testing.T) {
 		require.Nil(t, result)
 	})
 
+	t.Run("should succeed - major version update with canary rollout strategy, game room is valid, returns no error -> enqueue canary rollout op", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+
+		currentActiveScheduler := newValidSchedulerWithImageVersion("image-v1")
+		newScheduler := *newValidSchedulerWithImageVersion("image-v2")
+		newScheduler.RolloutStrategy = &rollout.Strategy{
+			Type:   rollout.Canary,
+			Canary: &rollout.CanaryParams{Percentage: 10, BakeDuration: time.Minute},
+		}
+		newSchedulerExpectedVersion := "v2.0.0"
+		op := &operation.Operation{
+			ID:             "123",
+			Status:         operation.StatusInProgress,
+			DefinitionName: newversion.OperationName,
+			SchedulerName:  newScheduler.Name,
+		}
+		operationDef := &newversion.Definition{NewScheduler: &newScheduler}
+		roomManager := mockports.NewMockRoomManager(mockCtrl)
+		schedulerManager := mockports.NewMockSchedulerManager(mockCtrl)
+		operationsManager := mockports.NewMockOperationManager(mockCtrl)
+		canaryOpID := "canary-rollout-op-id"
+		config := newversion.Config{
+			RoomInitializationTimeout: time.Duration(120000),
+			RoomValidationAttempts:    1,
+		}
+
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
+
+		schedulerVersions := []*entities.SchedulerVersion{{Version: "v1.0.0"}}
+		gameRoom := &game_room.GameRoom{ID: "id-1"}
+
+		roomManager.EXPECT().CreateRoom(gomock.Any(), gomock.Any(), true).Return(gameRoom, nil, nil)
+		roomManager.EXPECT().WaitRoomStatus(gomock.Any(), gameRoom, []game_room.GameRoomStatus{game_room.GameStatusReady, game_room.GameStatusError}).Return(game_room.GameStatusReady, nil)
+		roomManager.EXPECT().DeleteRoom(gomock.Any(), gomock.Any(), remove.NewVersionValidationFinished).Return(nil)
+
+		schedulerManager.
+			EXPECT().
+			CreateNewSchedulerVersionAndEnqueueCanaryRollout(gomock.Any(), gomock.Any()).
+			DoAndReturn(
+				func(ctx context.Context, scheduler *entities.Scheduler) (string, error) {
+					require.Equal(t, newSchedulerExpectedVersion, scheduler.Spec.Version)
+					return canaryOpID, nil
+				})
+		schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), newScheduler.Name).Return(currentActiveScheduler, nil)
+		schedulerManager.EXPECT().GetSchedulerVersions(gomock.Any(), newScheduler.Name).Return(schedulerVersions, nil)
+		schedulerManager.EXPECT().UpdateScheduler(gomock.Any(), currentActiveScheduler).Return(nil)
+		operationsManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "Major version detected, starting game room validation process...")
+		operationsManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "1º Attempt: Game room validation success!")
+		operationsManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, fmt.Sprintf("enqueued canary rollout operation with id: %s", canaryOpID))
+
+		result := executor.Execute(context.Background(), op, operationDef)
+
+		require.Nil(t, result)
+	})
+
+	t.Run("should succeed - major version update with blue/green rollout strategy, game room is valid, returns no error -> enqueue blue/green switch op", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+
+		currentActiveScheduler := newValidSchedulerWithImageVersion("image-v1")
+		newScheduler := *newValidSchedulerWithImageVersion("image-v2")
+		newScheduler.RolloutStrategy = &rollout.Strategy{Type: rollout.BlueGreen}
+		op := &operation.Operation{
+			ID:             "123",
+			Status:         operation.StatusInProgress,
+			DefinitionName: newversion.OperationName,
+			SchedulerName:  newScheduler.Name,
+		}
+		operationDef := &newversion.Definition{NewScheduler: &newScheduler}
+		roomManager := mockports.NewMockRoomManager(mockCtrl)
+		schedulerManager := mockports.NewMockSchedulerManager(mockCtrl)
+		operationsManager := mockports.NewMockOperationManager(mockCtrl)
+		blueGreenOpID := "blue-green-switch-op-id"
+		config := newversion.Config{
+			RoomInitializationTimeout: time.Duration(120000),
+			RoomValidationAttempts:    1,
+		}
+
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
+
+		schedulerVersions := []*entities.SchedulerVersion{{Version: "v1.0.0"}}
+		gameRoom := &game_room.GameRoom{ID: "id-1"}
+
+		roomManager.EXPECT().CreateRoom(gomock.Any(), gomock.Any(), true).Return(gameRoom, nil, nil)
+		roomManager.EXPECT().WaitRoomStatus(gomock.Any(), gameRoom, []game_room.GameRoomStatus{game_room.GameStatusReady, game_room.GameStatusError}).Return(game_room.GameStatusReady, nil)
+		roomManager.EXPECT().DeleteRoom(gomock.Any(), gomock.Any(), remove.NewVersionValidationFinished).Return(nil)
+
+		schedulerManager.EXPECT().CreateNewSchedulerVersionAndEnqueueBlueGreenSwitch(gomock.Any(), gomock.Any()).Return(blueGreenOpID, nil)
+		schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), newScheduler.Name).Return(currentActiveScheduler, nil)
+		schedulerManager.EXPECT().GetSchedulerVersions(gomock.Any(), newScheduler.Name).Return(schedulerVersions, nil)
+		schedulerManager.EXPECT().UpdateScheduler(gomock.Any(), currentActiveScheduler).Return(nil)
+		operationsManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "Major version detected, starting game room validation process...")
+		operationsManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "1º Attempt: Game room validation success!")
+		operationsManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, fmt.Sprintf("enqueued blue/green switch operation with id: %s", blueGreenOpID))
+
+		result := executor.Execute(context.Background(), op, operationDef)
+
+		require.Nil(t, result)
+	})
+
+	t.Run("should fail - major version update with canary rollout strategy, error creating new version and enqueueing canary rollout op -> returns error", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+
+		currentActiveScheduler := newValidSchedulerWithImageVersion("image-v1")
+		newScheduler := *newValidSchedulerWithImageVersion("image-v2")
+		newScheduler.RolloutStrategy = &rollout.Strategy{
+			Type:   rollout.Canary,
+			Canary: &rollout.CanaryParams{Rooms: 1, BakeDuration: time.Minute},
+		}
+		op := &operation.Operation{
+			ID:             "123",
+			Status:         operation.StatusInProgress,
+			DefinitionName: newversion.OperationName,
+			SchedulerName:  newScheduler.Name,
+		}
+		operationDef := &newversion.Definition{NewScheduler: &newScheduler}
+		roomManager := mockports.NewMockRoomManager(mockCtrl)
+		schedulerManager := mockports.NewMockSchedulerManager(mockCtrl)
+		operationsManager := mockports.NewMockOperationManager(mockCtrl)
+		config := newversion.Config{
+			RoomInitializationTimeout: time.Duration(120000),
+			RoomValidationAttempts:    1,
+		}
+
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
+
+		schedulerVersions := []*entities.SchedulerVersion{{Version: "v1.0.0"}}
+		gameRoom := &game_room.GameRoom{ID: "id-1"}
+
+		roomManager.EXPECT().CreateRoom(gomock.Any(), gomock.Any(), true).Return(gameRoom, nil, nil)
+		roomManager.EXPECT().WaitRoomStatus(gomock.Any(), gameRoom, []game_room.GameRoomStatus{game_room.GameStatusReady, game_room.GameStatusError}).Return(game_room.GameStatusReady, nil)
+		roomManager.EXPECT().DeleteRoom(gomock.Any(), gomock.Any(), remove.NewVersionValidationFinished).Return(nil)
+
+		schedulerManager.EXPECT().CreateNewSchedulerVersionAndEnqueueCanaryRollout(gomock.Any(), gomock.Any()).Return("", errors.NewErrUnexpected("some error"))
+		schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), newScheduler.Name).Return(currentActiveScheduler, nil)
+		schedulerManager.EXPECT().GetSchedulerVersions(gomock.Any(), newScheduler.Name).Return(schedulerVersions, nil)
+		schedulerManager.EXPECT().UpdateScheduler(gomock.Any(), currentActiveScheduler).Return(nil)
+		operationsManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "Major version detected, starting game room validation process...")
+		operationsManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "1º Attempt: Game room validation success!")
+
+		result := executor.Execute(context.Background(), op, operationDef)
+
+		require.Error(t, result)
+	})
+
 	t.Run("should succeed - major version update, game room is valid, validation succeeds in the configured max attempt, returns no error -> enqueue switch active version op", func(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 
@@ -126,7 +274,7 @@ func TestExecutor_Execute(t *testing.T) {
 			RoomValidationAttempts:    3,
 		}
 
-		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, config)
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
 
 		schedulerVersions := []*entities.SchedulerVersion{{Version: "v1.0.0"}, {Version: "v1.1.0"}, {Version: "v1.2.0"}}
 		gameRoom := &game_room.GameRoom{ID: "id-1"}
@@ -180,7 +328,7 @@ func TestExecutor_Execute(t *testing.T) {
 			RoomValidationAttempts:    1,
 		}
 
-		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, config)
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
 
 		schedulerVersions := []*entities.SchedulerVersion{{Version: "v2.0.0"}, {Version: "v3.1.0"}, {Version: "v1.2.0"}}
 		gameRoom := &game_room.GameRoom{ID: "id-1"}
@@ -231,7 +379,7 @@ func TestExecutor_Execute(t *testing.T) {
 			RoomValidationAttempts:    1,
 		}
 
-		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, config)
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
 
 		schedulerVersions := []*entities.SchedulerVersion{{Version: "v1.1.0"}, {Version: "v1.2.0"}, {Version: "v1.3.0"}}
 		gameRoom := &game_room.GameRoom{ID: "id-1"}
@@ -282,7 +430,7 @@ func TestExecutor_Execute(t *testing.T) {
 			RoomValidationAttempts:    1,
 		}
 
-		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, config)
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
 
 		schedulerVersions := []*entities.SchedulerVersion{{Version: "v1.1.0"}, {Version: "v1.2.0"}, {Version: "v1.3.0"}}
 		gameRoom := &game_room.GameRoom{ID: "id-1"}
@@ -331,7 +479,7 @@ func TestExecutor_Execute(t *testing.T) {
 			RoomValidationAttempts:    1,
 		}
 
-		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, config)
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
 
 		schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), newScheduler.Name).Return(currentActiveScheduler, nil)
 		schedulerManager.EXPECT().GetSchedulerVersions(gomock.Any(), newScheduler.Name).Return([]*entities.SchedulerVersion{}, errors.NewErrUnexpected("some_error"))
@@ -364,7 +512,7 @@ func TestExecutor_Execute(t *testing.T) {
 			RoomValidationAttempts:    1,
 		}
 
-		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, config)
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
 
 		schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), newScheduler.Name).Return(currentActiveScheduler, nil)
 		schedulerManager.EXPECT().GetSchedulerVersions(gomock.Any(), newScheduler.Name).Return(schedulerVersions, nil)
@@ -396,7 +544,7 @@ func TestExecutor_Execute(t *testing.T) {
 			RoomValidationAttempts:    1,
 		}
 
-		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, config)
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
 		schedulerVersions := []*entities.SchedulerVersion{{Version: "v2.0.0"}, {Version: "v3.1.0"}, {Version: "v1.2.0"}}
 
 		newSchedulerWithNewVersion := newScheduler
@@ -438,7 +586,7 @@ func TestExecutor_Execute(t *testing.T) {
 			RoomValidationAttempts:    3,
 		}
 
-		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, config)
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
 		schedulerVersions := []*entities.SchedulerVersion{{Version: "v2.0.0"}, {Version: "v3.1.0"}, {Version: "v1.2.0"}}
 
 		newSchedulerWithNewVersion := newScheduler
@@ -486,7 +634,7 @@ func TestExecutor_Execute(t *testing.T) {
 		}
 		ctx, cancelFn := context.WithCancel(context.Background())
 
-		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, config)
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
 
 		schedulerVersions := []*entities.SchedulerVersion{{Version: "v1.0.0"}, {Version: "v1.1.0"}, {Version: "v1.2.0"}}
 
@@ -527,7 +675,7 @@ func TestExecutor_Execute(t *testing.T) {
 			RoomValidationAttempts:    1,
 		}
 
-		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, config)
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
 		schedulerVersions := []*entities.SchedulerVersion{{Version: "v2.0.0"}, {Version: "v3.1.0"}, {Version: "v1.2.0"}}
 
 		newSchedulerWithNewVersion := newScheduler
@@ -571,7 +719,7 @@ func TestExecutor_Execute(t *testing.T) {
 			RoomValidationAttempts:    1,
 		}
 
-		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, config)
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
 		schedulerVersions := []*entities.SchedulerVersion{{Version: "v2.0.0"}, {Version: "v3.1.0"}, {Version: "v1.2.0"}}
 
 		newSchedulerWithNewVersion := newScheduler
@@ -622,7 +770,7 @@ func TestExecutor_Execute(t *testing.T) {
 			RoomValidationAttempts:    1,
 		}
 
-		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, config)
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
 		schedulerVersions := []*entities.SchedulerVersion{{Version: "v2.0.0"}, {Version: "v3.1.0"}, {Version: "v1.2.0"}}
 
 		newSchedulerWithNewVersion := newScheduler
@@ -669,7 +817,7 @@ func TestExecutor_Execute(t *testing.T) {
 			RoomValidationAttempts:    1,
 		}
 
-		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, config)
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
 
 		schedulerVersions := []*entities.SchedulerVersion{{Version: "v2.0.0"}, {Version: "v3.1.0"}, {Version: "v4.2.0"}}
 
@@ -713,7 +861,7 @@ func TestExecutor_Execute(t *testing.T) {
 			RoomValidationAttempts:    1,
 		}
 
-		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, config)
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
 
 		schedulerVersions := []*entities.SchedulerVersion{{Version: "v2.0.0"}, {Version: "v1.3.0"}, {Version: "v1.5.0"}}
 
@@ -757,7 +905,7 @@ func TestExecutor_Execute(t *testing.T) {
 			RoomValidationAttempts:    1,
 		}
 
-		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, config)
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
 
 		schedulerVersions := []*entities.SchedulerVersion{{Version: "v2.0.0"}, {Version: "v2.1.0"}, {Version: "v3.5.0"}}
 
@@ -799,7 +947,7 @@ func TestExecutor_Execute(t *testing.T) {
 			RoomValidationAttempts:    1,
 		}
 
-		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, config)
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
 
 		schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), newScheduler.Name).Return(currentActiveScheduler, nil)
 		schedulerManager.EXPECT().GetSchedulerVersions(gomock.Any(), newScheduler.Name).Return([]*entities.SchedulerVersion{}, errors.NewErrUnexpected("some_error"))
@@ -831,7 +979,7 @@ func TestExecutor_Execute(t *testing.T) {
 			RoomValidationAttempts:    1,
 		}
 
-		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, config)
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
 
 		schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), newScheduler.Name).Return(currentActiveScheduler, nil)
 		schedulerManager.EXPECT().GetSchedulerVersions(gomock.Any(), newScheduler.Name).Return(schedulerVersions, nil)
@@ -862,7 +1010,7 @@ func TestExecutor_Execute(t *testing.T) {
 			RoomValidationAttempts:    1,
 		}
 
-		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, config)
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
 		newSchedulerWithNewVersion := newScheduler
 		newSchedulerWithNewVersion.Spec.Version = "v1.1.0"
 		newSchedulerWithNewVersion.RollbackVersion = "v1.0.0"
@@ -899,7 +1047,7 @@ func TestExecutor_Execute(t *testing.T) {
 			RoomValidationAttempts:    1,
 		}
 
-		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, config)
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
 		newSchedulerWithNewVersion := newScheduler
 		newSchedulerWithNewVersion.Spec.Version = "v1.1.0"
 		newSchedulerWithNewVersion.RollbackVersion = "v1.0.0"
@@ -930,7 +1078,7 @@ func TestExecutor_Execute(t *testing.T) {
 			RoomValidationAttempts:    1,
 		}
 
-		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, config)
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
 		newSchedulerWithNewVersion := newScheduler
 		newSchedulerWithNewVersion.Spec.Version = "v1.1.0"
 		newSchedulerWithNewVersion.RollbackVersion = "v1.0.0"
@@ -961,7 +1109,7 @@ func TestExecutor_Execute(t *testing.T) {
 			RoomValidationAttempts:    1,
 		}
 
-		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, config)
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
 
 		// mocks for SchedulerManager GetActiveScheduler method
 		schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), newScheduler.Name).Return(currentActiveScheduler, nil)
@@ -972,6 +1120,224 @@ func TestExecutor_Execute(t *testing.T) {
 		require.EqualError(t, result, "failed to parse scheduler current version: Invalid Semantic Version")
 	})
 
+	t.Run("should succeed - major version update with validation checks, every validation room passes the checks -> enqueue switch active version op", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+
+		currentActiveScheduler := newValidSchedulerWithImageVersion("image-v1")
+		newScheduler := *newValidSchedulerWithImageVersion("image-v2")
+		healthCheck := &rollout.HealthCheckParams{Protocol: rollout.HealthCheckHTTP, PortName: "http", Path: "/healthz"}
+		newScheduler.Forwarders = []*forwarder.Forwarder{{Name: "matchmaker", Enabled: true, ForwardType: forwarder.TypeGrpc, Address: "matchmaker:8080"}}
+		newScheduler.RolloutStrategy = &rollout.Strategy{
+			Type: rollout.RollingUpdate,
+			Validation: &rollout.ValidationParams{
+				Rooms:            2,
+				MinReadyDuration: time.Millisecond,
+				HealthCheck:      healthCheck,
+				SmokeTest:        &rollout.SmokeTestParams{ForwarderName: "matchmaker", EventName: "smokeTest"},
+			},
+		}
+		op := &operation.Operation{
+			ID:             "123",
+			Status:         operation.StatusInProgress,
+			DefinitionName: newversion.OperationName,
+			SchedulerName:  newScheduler.Name,
+		}
+		operationDef := &newversion.Definition{NewScheduler: &newScheduler}
+		roomManager := mockports.NewMockRoomManager(mockCtrl)
+		schedulerManager := mockports.NewMockSchedulerManager(mockCtrl)
+		operationsManager := mockports.NewMockOperationManager(mockCtrl)
+		roomHealthChecker := mockports.NewMockRoomHealthChecker(mockCtrl)
+		eventsForwarder := mockports.NewMockEventsForwarder(mockCtrl)
+		switchOpID := "switch-active-version-op-id"
+		config := newversion.Config{
+			RoomInitializationTimeout: time.Duration(120000),
+			RoomValidationAttempts:    1,
+		}
+
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, roomHealthChecker, eventsForwarder, config)
+
+		gameRoom1 := &game_room.GameRoom{ID: "id-1"}
+		gameRoom2 := &game_room.GameRoom{ID: "id-2"}
+		instance := &game_room.Instance{Address: &game_room.Address{Host: "10.0.0.1", Ports: []game_room.Port{{Name: "http", Port: 8080}}}}
+
+		gomock.InOrder(
+			roomManager.EXPECT().CreateRoom(gomock.Any(), gomock.Any(), true).Return(gameRoom1, nil, nil),
+			roomManager.EXPECT().CreateRoom(gomock.Any(), gomock.Any(), true).Return(gameRoom2, nil, nil),
+		)
+		for _, gameRoom := range []*game_room.GameRoom{gameRoom1, gameRoom2} {
+			roomManager.EXPECT().WaitRoomStatus(gomock.Any(), gameRoom, []game_room.GameRoomStatus{game_room.GameStatusReady, game_room.GameStatusError}).Return(game_room.GameStatusReady, nil)
+			roomManager.EXPECT().WaitRoomStatus(gomock.Any(), gameRoom, gomock.Any()).Return(game_room.GameStatusReady, serviceerrors.NewErrGameRoomStatusWaitingTimeout(""))
+			roomManager.EXPECT().GetRoomInstance(gomock.Any(), newScheduler.Name, gameRoom.ID).Return(instance, nil)
+			roomManager.EXPECT().DeleteRoom(gomock.Any(), gameRoom, remove.NewVersionValidationFinished).Return(nil)
+		}
+		roomHealthChecker.EXPECT().Check(gomock.Any(), "10.0.0.1:8080", healthCheck).Return(nil).Times(2)
+		eventsForwarder.EXPECT().ForwardRoomEvent(gomock.Any(), gomock.Any(), *newScheduler.Forwarders[0]).Return(codes.OK, nil).Times(2)
+
+		schedulerManager.EXPECT().CreateNewSchedulerVersionAndEnqueueSwitchVersion(gomock.Any(), gomock.Any()).Return(switchOpID, nil)
+		schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), newScheduler.Name).Return(currentActiveScheduler, nil)
+		schedulerManager.EXPECT().GetSchedulerVersions(gomock.Any(), newScheduler.Name).Return([]*entities.SchedulerVersion{{Version: "v1.0.0"}}, nil)
+		schedulerManager.EXPECT().UpdateScheduler(gomock.Any(), currentActiveScheduler).Return(nil)
+		operationsManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "Major version detected, starting game room validation process...")
+		operationsManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "1º Attempt: Validation room with ID id-1 passed the checks: ready, stayed ready for 1ms, http health check, smokeTest smoke test")
+		operationsManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "1º Attempt: Validation room with ID id-2 passed the checks: ready, stayed ready for 1ms, http health check, smokeTest smoke test")
+		operationsManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "1º Attempt: Game room validation success!")
+		operationsManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, fmt.Sprintf("enqueued switch active version operation with id: %s", switchOpID))
+
+		result := executor.Execute(context.Background(), op, operationDef)
+
+		require.Nil(t, result)
+	})
+
+	t.Run("should fail - major version update with validation checks, validation room leaves ready status before the min ready duration -> returns error", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+
+		currentActiveScheduler := newValidSchedulerWithImageVersion("image-v1")
+		newScheduler := *newValidSchedulerWithImageVersion("image-v2")
+		newScheduler.RolloutStrategy = &rollout.Strategy{
+			Type:       rollout.RollingUpdate,
+			Validation: &rollout.ValidationParams{MinReadyDuration: time.Minute},
+		}
+		op := &operation.Operation{
+			ID:             "123",
+			Status:         operation.StatusInProgress,
+			DefinitionName: newversion.OperationName,
+			SchedulerName:  newScheduler.Name,
+		}
+		operationDef := &newversion.Definition{NewScheduler: &newScheduler}
+		roomManager := mockports.NewMockRoomManager(mockCtrl)
+		schedulerManager := mockports.NewMockSchedulerManager(mockCtrl)
+		operationsManager := mockports.NewMockOperationManager(mockCtrl)
+		config := newversion.Config{
+			RoomInitializationTimeout: time.Duration(120000),
+			RoomValidationAttempts:    1,
+		}
+
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
+
+		gameRoom := &game_room.GameRoom{ID: "id-1"}
+
+		roomManager.EXPECT().CreateRoom(gomock.Any(), gomock.Any(), true).Return(gameRoom, nil, nil)
+		roomManager.EXPECT().WaitRoomStatus(gomock.Any(), gameRoom, []game_room.GameRoomStatus{game_room.GameStatusReady, game_room.GameStatusError}).Return(game_room.GameStatusReady, nil)
+		roomManager.EXPECT().WaitRoomStatus(gomock.Any(), gameRoom, gomock.Any()).Return(game_room.GameStatusError, nil)
+		roomManager.EXPECT().DeleteRoom(gomock.Any(), gameRoom, remove.NewVersionValidationFinished).Return(nil)
+
+		schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), newScheduler.Name).Return(currentActiveScheduler, nil)
+		schedulerManager.EXPECT().GetSchedulerVersions(gomock.Any(), newScheduler.Name).Return([]*entities.SchedulerVersion{{Version: "v1.0.0"}}, nil)
+		schedulerManager.EXPECT().UpdateScheduler(gomock.Any(), currentActiveScheduler).Return(nil)
+		operationsManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "Major version detected, starting game room validation process...")
+		operationsManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, `1º Attempt: The room created for validation with ID id-1 left the ready status to error before
+		being ready for 1m0s. You can check if the GRU image is stable on its logs using the provided room id.`)
+		operationsManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "All validation attempts have failed, operation aborted!")
+
+		result := executor.Execute(context.Background(), op, operationDef)
+
+		require.ErrorContains(t, result, "room left the ready status to error")
+	})
+
+	t.Run("should fail - major version update with validation checks, health check fails -> returns error", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+
+		currentActiveScheduler := newValidSchedulerWithImageVersion("image-v1")
+		newScheduler := *newValidSchedulerWithImageVersion("image-v2")
+		healthCheck := &rollout.HealthCheckParams{Protocol: rollout.HealthCheckGRPC, PortName: "grpc"}
+		newScheduler.RolloutStrategy = &rollout.Strategy{
+			Type:       rollout.RollingUpdate,
+			Validation: &rollout.ValidationParams{HealthCheck: healthCheck},
+		}
+		op := &operation.Operation{
+			ID:             "123",
+			Status:         operation.StatusInProgress,
+			DefinitionName: newversion.OperationName,
+			SchedulerName:  newScheduler.Name,
+		}
+		operationDef := &newversion.Definition{NewScheduler: &newScheduler}
+		roomManager := mockports.NewMockRoomManager(mockCtrl)
+		schedulerManager := mockports.NewMockSchedulerManager(mockCtrl)
+		operationsManager := mockports.NewMockOperationManager(mockCtrl)
+		roomHealthChecker := mockports.NewMockRoomHealthChecker(mockCtrl)
+		config := newversion.Config{
+			RoomInitializationTimeout: time.Duration(120000),
+			RoomValidationAttempts:    1,
+		}
+
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, roomHealthChecker, nil, config)
+
+		gameRoom := &game_room.GameRoom{ID: "id-1"}
+		instance := &game_room.Instance{Address: &game_room.Address{Host: "10.0.0.1", Ports: []game_room.Port{{Name: "grpc", Port: 9090}}}}
+
+		roomManager.EXPECT().CreateRoom(gomock.Any(), gomock.Any(), true).Return(gameRoom, nil, nil)
+		roomManager.EXPECT().WaitRoomStatus(gomock.Any(), gameRoom, []game_room.GameRoomStatus{game_room.GameStatusReady, game_room.GameStatusError}).Return(game_room.GameStatusReady, nil)
+		roomManager.EXPECT().GetRoomInstance(gomock.Any(), newScheduler.Name, gameRoom.ID).Return(instance, nil)
+		roomManager.EXPECT().DeleteRoom(gomock.Any(), gameRoom, remove.NewVersionValidationFinished).Return(nil)
+		roomHealthChecker.EXPECT().Check(gomock.Any(), "10.0.0.1:9090", healthCheck).Return(errors.NewErrUnexpected("NOT_SERVING"))
+
+		schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), newScheduler.Name).Return(currentActiveScheduler, nil)
+		schedulerManager.EXPECT().GetSchedulerVersions(gomock.Any(), newScheduler.Name).Return([]*entities.SchedulerVersion{{Version: "v1.0.0"}}, nil)
+		schedulerManager.EXPECT().UpdateScheduler(gomock.Any(), currentActiveScheduler).Return(nil)
+		operationsManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "Major version detected, starting game room validation process...")
+		operationsManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, `1º Attempt: The room created for validation with ID id-1 failed the health check: "NOT_SERVING"`)
+		operationsManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "All validation attempts have failed, operation aborted!")
+
+		result := executor.Execute(context.Background(), op, operationDef)
+
+		require.ErrorContains(t, result, "health check failed: NOT_SERVING")
+	})
+
+	t.Run("should fail - major version update with validation checks, smoke test forwarder fails -> returns error", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+
+		currentActiveScheduler := newValidSchedulerWithImageVersion("image-v1")
+		newScheduler := *newValidSchedulerWithImageVersion("image-v2")
+		newScheduler.Forwarders = []*forwarder.Forwarder{{Name: "matchmaker", Enabled: true, ForwardType: forwarder.TypeGrpc, Address: "matchmaker:8080"}}
+		newScheduler.RolloutStrategy = &rollout.Strategy{
+			Type:       rollout.RollingUpdate,
+			Validation: &rollout.ValidationParams{SmokeTest: &rollout.SmokeTestParams{ForwarderName: "matchmaker", EventName: "smokeTest"}},
+		}
+		op := &operation.Operation{
+			ID:             "123",
+			Status:         operation.StatusInProgress,
+			DefinitionName: newversion.OperationName,
+			SchedulerName:  newScheduler.Name,
+		}
+		operationDef := &newversion.Definition{NewScheduler: &newScheduler}
+		roomManager := mockports.NewMockRoomManager(mockCtrl)
+		schedulerManager := mockports.NewMockSchedulerManager(mockCtrl)
+		operationsManager := mockports.NewMockOperationManager(mockCtrl)
+		eventsForwarder := mockports.NewMockEventsForwarder(mockCtrl)
+		config := newversion.Config{
+			RoomInitializationTimeout: time.Duration(120000),
+			RoomValidationAttempts:    1,
+		}
+
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, eventsForwarder, config)
+
+		gameRoom := &game_room.GameRoom{ID: "id-1"}
+		instance := &game_room.Instance{Address: &game_room.Address{Host: "10.0.0.1", Ports: []game_room.Port{{Name: "game", Port: 7000}}}}
+
+		roomManager.EXPECT().CreateRoom(gomock.Any(), gomock.Any(), true).Return(gameRoom, nil, nil)
+		roomManager.EXPECT().WaitRoomStatus(gomock.Any(), gameRoom, []game_room.GameRoomStatus{game_room.GameStatusReady, game_room.GameStatusError}).Return(game_room.GameStatusReady, nil)
+		roomManager.EXPECT().GetRoomInstance(gomock.Any(), newScheduler.Name, gameRoom.ID).Return(instance, nil)
+		roomManager.EXPECT().DeleteRoom(gomock.Any(), gameRoom, remove.NewVersionValidationFinished).Return(nil)
+		eventsForwarder.EXPECT().ForwardRoomEvent(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, eventAttributes events.RoomEventAttributes, _ forwarder.Forwarder) (codes.Code, error) {
+				require.Equal(t, "id-1", eventAttributes.RoomId)
+				require.Equal(t, "10.0.0.1", eventAttributes.Host)
+				require.Equal(t, int32(7000), eventAttributes.Port)
+				require.Equal(t, "smokeTest", eventAttributes.Other["roomEvent"])
+				return codes.Unavailable, nil
+			})
+
+		schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), newScheduler.Name).Return(currentActiveScheduler, nil)
+		schedulerManager.EXPECT().GetSchedulerVersions(gomock.Any(), newScheduler.Name).Return([]*entities.SchedulerVersion{{Version: "v1.0.0"}}, nil)
+		schedulerManager.EXPECT().UpdateScheduler(gomock.Any(), currentActiveScheduler).Return(nil)
+		operationsManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "Major version detected, starting game room validation process...")
+		operationsManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, `1º Attempt: The room created for validation with ID id-1 failed the smoke test: "forwarder responded with code Unavailable"`)
+		operationsManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), op, "All validation attempts have failed, operation aborted!")
+
+		result := executor.Execute(context.Background(), op, operationDef)
+
+		require.ErrorContains(t, result, "smoke test failed")
+	})
 }
 
 func TestExecutor_Rollback(t *testing.T) {
@@ -999,7 +1365,7 @@ func TestExecutor_Rollback(t *testing.T) {
 			RoomValidationAttempts:    1,
 		}
 
-		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, config)
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
 		executor.AddValidationRoomID(newScheduler.Name, &game_room.GameRoom{ID: "room1"})
 		roomManager.EXPECT().DeleteRoom(gomock.Any(), gomock.Any(), remove.NewVersionRollback).Return(nil)
 		schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), newScheduler.Name).Return(&newScheduler, nil)
@@ -1028,7 +1394,7 @@ func TestExecutor_Rollback(t *testing.T) {
 			RoomValidationAttempts:    1,
 		}
 
-		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, config)
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
 		executor.AddValidationRoomID(newScheduler.Name, &game_room.GameRoom{ID: "room1"})
 		roomManager.EXPECT().DeleteRoom(gomock.Any(), gomock.Any(), remove.NewVersionRollback).Return(errors.NewErrUnexpected("some error"))
 		result := executor.Rollback(context.Background(), op, operationDef, nil)
@@ -1055,7 +1421,7 @@ func TestExecutor_Rollback(t *testing.T) {
 			RoomValidationAttempts:    1,
 		}
 
-		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, config)
+		executor := newversion.NewExecutor(roomManager, schedulerManager, operationsManager, nil, nil, config)
 		schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), newScheduler.Name).Return(&newScheduler, nil)
 		schedulerManager.EXPECT().UpdateScheduler(gomock.Any(), &newScheduler).Return(nil)
 		result := executor.Rollback(context.Background(), op, operationDef, nil)
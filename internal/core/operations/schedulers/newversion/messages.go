@@ -27,6 +27,10 @@ const (
 
 	enqueuedSwitchVersionMessageTemplate = "enqueued switch active version operation with id: %s"
 
+	enqueuedCanaryRolloutMessageTemplate = "enqueued canary rollout operation with id: %s"
+
+	enqueuedBlueGreenSwitchMessageTemplate = "enqueued blue/green switch operation with id: %s"
+
 	validationSuccessMessageTemplate = "%dº Attempt: Game room validation success!"
 
 	allAttemptsFailedMessageTemplate = "All validation attempts have failed, operation aborted!"
@@ -37,5 +41,14 @@ const (
 	validationPodInErrorMessageTemplate = `%dº Attempt: The room created for validation with ID %s is entering in error state. You can check if
 		the GRU image is stable on its logs using the provided room id. Last event in the game room: %q`
 
+	validationRoomChecksPassedMessageTemplate = "%dº Attempt: Validation room with ID %s passed the checks: %s"
+
+	validationRoomNotReadyMessageTemplate = `%dº Attempt: The room created for validation with ID %s left the ready status to %s before
+		being ready for %s. You can check if the GRU image is stable on its logs using the provided room id.`
+
+	validationHealthCheckFailedMessageTemplate = `%dº Attempt: The room created for validation with ID %s failed the health check: %q`
+
+	validationSmokeTestFailedMessageTemplate = `%dº Attempt: The room created for validation with ID %s failed the smoke test: %q`
+
 	validationUnexpectedErrorMessageTemplate = `%dº Attempt: Unexpected Error, contact the Maestro's responsible team for helping: %q`
 )
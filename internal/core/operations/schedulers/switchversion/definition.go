@@ -35,6 +35,9 @@ const OperationName = "switch_active_version"
 
 type Definition struct {
 	NewActiveVersion string `json:"newActiveVersion"`
+	// RolledBackFrom is set when the switch is an automatic rollback from
+	// the version that failed to roll out.
+	RolledBackFrom string `json:"rolledBackFrom,omitempty"`
 }
 
 func (d *Definition) ShouldExecute(_ context.Context, _ []*operation.Operation) bool {
@@ -67,3 +70,7 @@ func (d *Definition) Unmarshal(raw []byte) error {
 func (d *Definition) HasNoAction() bool {
 	return false
 }
+
+func (d *Definition) Priority() operation.Priority {
+	return operation.PriorityNormal
+}
@@ -31,6 +31,7 @@ import (
 
 	"github.com/topfreegames/maestro/internal/core/entities"
 	"github.com/topfreegames/maestro/internal/core/entities/operation"
+	"github.com/topfreegames/maestro/internal/core/entities/rollout"
 	"github.com/topfreegames/maestro/internal/core/operations"
 	"go.uber.org/zap"
 )
@@ -71,8 +72,30 @@ func (ex *Executor) Execute(ctx context.Context, op *operation.Operation, defini
 		return getSchedulerErr
 	}
 
+	// Checked here, and not only when the operation is requested, since it is
+	// also enqueued by the automatic rollback.
+	blocked, err := ex.isVersionBlocked(ctx, op.SchedulerName, updateDefinition.NewActiveVersion)
+	if err != nil {
+		logger.Error("error fetching scheduler versions", zap.Error(err))
+		getVersionsErr := fmt.Errorf("error fetching scheduler versions: %w", err)
+		ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, getVersionsErr.Error())
+		return getVersionsErr
+	}
+	if blocked {
+		logger.Warn("scheduler version to be switched to is blocked")
+		blockedErr := fmt.Errorf("version %s is blocked and can not be activated", updateDefinition.NewActiveVersion)
+		ex.operationManager.AppendOperationEventToExecutionHistory(ctx, op, blockedErr.Error())
+		return blockedErr
+	}
+
 	logger.Sugar().Debugf("switching version to %v", scheduler.Spec.Version)
 	scheduler.State = entities.StateInSync
+	scheduler.RolloutHealth = nil
+	if updateDefinition.RolledBackFrom != "" {
+		// Keeps the health controller from rolling back the rollback.
+		scheduler.RolloutHealth = rollout.NewHealth(scheduler.Spec.Version)
+		scheduler.RolloutHealth.RolledBackFrom = updateDefinition.RolledBackFrom
+	}
 	err = ex.schedulerManager.UpdateScheduler(ctx, scheduler)
 	if err != nil {
 		logger.Error("error updating scheduler with new active version")
@@ -85,6 +108,21 @@ func (ex *Executor) Execute(ctx context.Context, op *operation.Operation, defini
 	return nil
 }
 
+func (ex *Executor) isVersionBlocked(ctx context.Context, schedulerName, version string) (bool, error) {
+	schedulerVersions, err := ex.schedulerManager.GetSchedulerVersions(ctx, schedulerName)
+	if err != nil {
+		return false, err
+	}
+
+	for _, schedulerVersion := range schedulerVersions {
+		if schedulerVersion.Version == version {
+			return schedulerVersion.Blocked, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (ex *Executor) Rollback(ctx context.Context, op *operation.Operation, definition operations.Definition, executeErr error) error {
 	return nil
 }
@@ -40,6 +40,7 @@ import (
 	"github.com/topfreegames/maestro/internal/core/entities/game_room"
 	"github.com/topfreegames/maestro/internal/core/entities/operation"
 	"github.com/topfreegames/maestro/internal/core/entities/port"
+	"github.com/topfreegames/maestro/internal/core/entities/rollout"
 	mockports "github.com/topfreegames/maestro/internal/core/ports/mock"
 	"github.com/topfreegames/maestro/internal/validations"
 )
@@ -72,6 +73,7 @@ func TestExecutor_Execute(t *testing.T) {
 		mocks := newMockRoomAndSchedulerManager(mockCtrl)
 
 		mocks.schedulerManager.EXPECT().GetSchedulerByVersion(context.Background(), newMajorScheduler.Name, definition.NewActiveVersion).Return(newMajorScheduler, nil)
+		mocks.schedulerManager.EXPECT().GetSchedulerVersions(gomock.Any(), newMajorScheduler.Name).Return([]*entities.SchedulerVersion{{Version: definition.NewActiveVersion}}, nil)
 		mocks.schedulerManager.EXPECT().UpdateScheduler(gomock.Any(), newMajorScheduler).Return(nil)
 
 		executor := switchversion.NewExecutor(mocks.schedulerManager, mocks.operationManager)
@@ -80,6 +82,23 @@ func TestExecutor_Execute(t *testing.T) {
 		require.Nil(t, execErr)
 	})
 
+	t.Run("should succeed - Execute automatic rollback keeps the version from being rolled back", func(t *testing.T) {
+		definition := &switchversion.Definition{NewActiveVersion: newMajorScheduler.Spec.Version, RolledBackFrom: "v3.0.0"}
+		mocks := newMockRoomAndSchedulerManager(mockCtrl)
+
+		mocks.schedulerManager.EXPECT().GetSchedulerByVersion(context.Background(), newMajorScheduler.Name, definition.NewActiveVersion).Return(newMajorScheduler, nil)
+		mocks.schedulerManager.EXPECT().GetSchedulerVersions(gomock.Any(), newMajorScheduler.Name).Return([]*entities.SchedulerVersion{{Version: definition.NewActiveVersion}}, nil)
+		mocks.schedulerManager.EXPECT().UpdateScheduler(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, scheduler *entities.Scheduler) error {
+			require.Equal(t, &rollout.Health{Version: newMajorScheduler.Spec.Version, Rooms: map[string]bool{}, RolledBackFrom: "v3.0.0"}, scheduler.RolloutHealth)
+			return nil
+		})
+
+		executor := switchversion.NewExecutor(mocks.schedulerManager, mocks.operationManager)
+		execErr := executor.Execute(context.Background(), &operation.Operation{SchedulerName: newMajorScheduler.Name}, definition)
+
+		require.Nil(t, execErr)
+	})
+
 	t.Run("should fail - Invalid definition received", func(t *testing.T) {
 		invalidDef := &add.Definition{Amount: 2}
 		mocks := newMockRoomAndSchedulerManager(mockCtrl)
@@ -105,12 +124,43 @@ func TestExecutor_Execute(t *testing.T) {
 		require.ErrorIs(t, execErr, getSchedErr)
 	})
 
+	t.Run("should fail - Version is blocked", func(t *testing.T) {
+		definition := &switchversion.Definition{NewActiveVersion: newMajorScheduler.Spec.Version, RolledBackFrom: "v3.0.0"}
+		mocks := newMockRoomAndSchedulerManager(mockCtrl)
+
+		mocks.schedulerManager.EXPECT().GetSchedulerByVersion(context.Background(), newMajorScheduler.Name, definition.NewActiveVersion).Return(newMajorScheduler, nil)
+		mocks.schedulerManager.EXPECT().GetSchedulerVersions(gomock.Any(), newMajorScheduler.Name).Return([]*entities.SchedulerVersion{{Version: definition.NewActiveVersion, Blocked: true}}, nil)
+		mocks.schedulerManager.EXPECT().UpdateScheduler(gomock.Any(), gomock.Any()).Times(0)
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), gomock.Any(), "version v2 is blocked and can not be activated")
+
+		executor := switchversion.NewExecutor(mocks.schedulerManager, mocks.operationManager)
+		execErr := executor.Execute(context.Background(), &operation.Operation{SchedulerName: newMajorScheduler.Name}, definition)
+
+		require.EqualError(t, execErr, "version v2 is blocked and can not be activated")
+	})
+
+	t.Run("should fail - Can not fetch scheduler versions", func(t *testing.T) {
+		getVersionsErr := errors.New("foobar")
+		definition := &switchversion.Definition{NewActiveVersion: newMajorScheduler.Spec.Version}
+		mocks := newMockRoomAndSchedulerManager(mockCtrl)
+
+		mocks.schedulerManager.EXPECT().GetSchedulerByVersion(context.Background(), newMajorScheduler.Name, definition.NewActiveVersion).Return(newMajorScheduler, nil)
+		mocks.schedulerManager.EXPECT().GetSchedulerVersions(gomock.Any(), newMajorScheduler.Name).Return(nil, getVersionsErr)
+		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+
+		executor := switchversion.NewExecutor(mocks.schedulerManager, mocks.operationManager)
+		execErr := executor.Execute(context.Background(), &operation.Operation{SchedulerName: newMajorScheduler.Name}, definition)
+
+		require.ErrorIs(t, execErr, getVersionsErr)
+	})
+
 	t.Run("should fail - Can not update scheduler", func(t *testing.T) {
 		updateSchedErr := errors.New("foobar")
 		definition := &switchversion.Definition{NewActiveVersion: newMajorScheduler.Spec.Version}
 		mocks := newMockRoomAndSchedulerManager(mockCtrl)
 
 		mocks.schedulerManager.EXPECT().GetSchedulerByVersion(context.Background(), newMajorScheduler.Name, definition.NewActiveVersion).Return(newMajorScheduler, nil)
+		mocks.schedulerManager.EXPECT().GetSchedulerVersions(gomock.Any(), newMajorScheduler.Name).Return([]*entities.SchedulerVersion{{Version: definition.NewActiveVersion}}, nil)
 		mocks.schedulerManager.EXPECT().UpdateScheduler(gomock.Any(), gomock.Any()).Return(updateSchedErr)
 		mocks.operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
 
@@ -75,3 +75,7 @@ func (d *Definition) Unmarshal(raw []byte) error {
 func (d *Definition) HasNoAction() bool {
 	return true
 }
+
+func (d *Definition) Priority() operation.Priority {
+	return operation.PriorityLow
+}
@@ -33,17 +33,28 @@ import (
 	"go.uber.org/zap"
 )
 
+// Config have the configs to execute the storage clean up operation.
+type Config struct {
+	// KeepLastVersions is the amount of unpinned scheduler versions kept by
+	// the clean up, the older ones are removed. Zero disables the retention.
+	KeepLastVersions int
+}
+
 // Executor implements the interface operations.Executor with the storage clean up operation.
 type Executor struct {
 	operationStorage ports.OperationStorage
+	schedulerStorage ports.SchedulerStorage
+	config           Config
 }
 
 var _ operations.Executor = (*Executor)(nil)
 
 // NewExecutor returns a new instance of storagecleanup.Executor.
-func NewExecutor(operationStorage ports.OperationStorage) *Executor {
+func NewExecutor(operationStorage ports.OperationStorage, schedulerStorage ports.SchedulerStorage, config Config) *Executor {
 	return &Executor{
 		operationStorage: operationStorage,
+		schedulerStorage: schedulerStorage,
+		config:           config,
 	}
 }
 
@@ -61,9 +72,56 @@ func (e *Executor) Execute(ctx context.Context, op *operation.Operation, definit
 		return fmt.Errorf("failed to clean expired operations references on storage clean up operation: %w", err)
 	}
 
+	if err := e.cleanOldSchedulerVersions(ctx, op.SchedulerName, logger); err != nil {
+		logger.Warn("failed to clean old scheduler versions on storage clean up operation", zap.Error(err))
+		return fmt.Errorf("failed to clean old scheduler versions on storage clean up operation: %w", err)
+	}
+
 	return nil
 }
 
+// cleanOldSchedulerVersions removes the unpinned versions older than the last
+// KeepLastVersions ones. The active version and the version used to roll back
+// the scheduler are always kept.
+func (e *Executor) cleanOldSchedulerVersions(ctx context.Context, schedulerName string, logger *zap.Logger) error {
+	if e.config.KeepLastVersions <= 0 {
+		return nil
+	}
+
+	scheduler, err := e.schedulerStorage.GetScheduler(ctx, schedulerName)
+	if err != nil {
+		return err
+	}
+
+	// versions are sorted from the newest to the oldest.
+	versions, err := e.schedulerStorage.GetSchedulerVersions(ctx, schedulerName)
+	if err != nil {
+		return err
+	}
+
+	var versionsToDelete []string
+	keptVersions := 0
+	for _, version := range versions {
+		if version.Pinned || version.IsActive || version.Version == scheduler.RollbackVersion {
+			continue
+		}
+
+		if keptVersions < e.config.KeepLastVersions {
+			keptVersions++
+			continue
+		}
+
+		versionsToDelete = append(versionsToDelete, version.Version)
+	}
+
+	if len(versionsToDelete) == 0 {
+		return nil
+	}
+
+	logger.Info("removing old scheduler versions", zap.Strings("versions", versionsToDelete))
+	return e.schedulerStorage.DeleteSchedulerVersions(ctx, schedulerName, versionsToDelete)
+}
+
 // Rollback will do nothing.
 func (e *Executor) Rollback(_ context.Context, _ *operation.Operation, _ operations.Definition, _ error) error {
 	return nil
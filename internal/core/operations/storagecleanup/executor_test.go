@@ -36,6 +36,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"github.com/topfreegames/maestro/internal/core/entities"
 	"github.com/topfreegames/maestro/internal/core/entities/operation"
 	mockports "github.com/topfreegames/maestro/internal/core/ports/mock"
 )
@@ -54,7 +55,47 @@ func TestExecutor_Execute(t *testing.T) {
 
 			operationStorage.EXPECT().CleanExpiredOperations(context.Background(), operation.SchedulerName).Return(nil)
 
-			executor := storagecleanup.NewExecutor(operationStorage)
+			executor := storagecleanup.NewExecutor(operationStorage, nil, storagecleanup.Config{})
+			err := executor.Execute(context.Background(), operation, definition)
+
+			require.NoError(t, err)
+		})
+
+		t.Run("keep last versions configured => remove only the old unpinned versions", func(t *testing.T) {
+			operationStorage := mockports.NewMockOperationStorage(mockCtrl)
+			schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+
+			operationStorage.EXPECT().CleanExpiredOperations(context.Background(), operation.SchedulerName).Return(nil)
+			schedulerStorage.EXPECT().GetScheduler(context.Background(), operation.SchedulerName).Return(&entities.Scheduler{Name: operation.SchedulerName, RollbackVersion: "v2"}, nil)
+			schedulerStorage.EXPECT().GetSchedulerVersions(context.Background(), operation.SchedulerName).Return([]*entities.SchedulerVersion{
+				{Version: "v7"},
+				{Version: "v6", IsActive: true},
+				{Version: "v5"},
+				{Version: "v4", Pinned: true},
+				{Version: "v3"},
+				{Version: "v2"},
+				{Version: "v1"},
+			}, nil)
+			schedulerStorage.EXPECT().DeleteSchedulerVersions(context.Background(), operation.SchedulerName, []string{"v3", "v1"}).Return(nil)
+
+			executor := storagecleanup.NewExecutor(operationStorage, schedulerStorage, storagecleanup.Config{KeepLastVersions: 2})
+			err := executor.Execute(context.Background(), operation, definition)
+
+			require.NoError(t, err)
+		})
+
+		t.Run("no versions above the limit => remove nothing", func(t *testing.T) {
+			operationStorage := mockports.NewMockOperationStorage(mockCtrl)
+			schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+
+			operationStorage.EXPECT().CleanExpiredOperations(context.Background(), operation.SchedulerName).Return(nil)
+			schedulerStorage.EXPECT().GetScheduler(context.Background(), operation.SchedulerName).Return(&entities.Scheduler{Name: operation.SchedulerName}, nil)
+			schedulerStorage.EXPECT().GetSchedulerVersions(context.Background(), operation.SchedulerName).Return([]*entities.SchedulerVersion{
+				{Version: "v2", IsActive: true},
+				{Version: "v1"},
+			}, nil)
+
+			executor := storagecleanup.NewExecutor(operationStorage, schedulerStorage, storagecleanup.Config{KeepLastVersions: 2})
 			err := executor.Execute(context.Background(), operation, definition)
 
 			require.NoError(t, err)
@@ -62,12 +103,30 @@ func TestExecutor_Execute(t *testing.T) {
 	})
 
 	t.Run("should fail", func(t *testing.T) {
+		t.Run("scheduler storage DeleteSchedulerVersions fails return error", func(t *testing.T) {
+			operationStorage := mockports.NewMockOperationStorage(mockCtrl)
+			schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+
+			operationStorage.EXPECT().CleanExpiredOperations(context.Background(), operation.SchedulerName).Return(nil)
+			schedulerStorage.EXPECT().GetScheduler(context.Background(), operation.SchedulerName).Return(&entities.Scheduler{Name: operation.SchedulerName}, nil)
+			schedulerStorage.EXPECT().GetSchedulerVersions(context.Background(), operation.SchedulerName).Return([]*entities.SchedulerVersion{
+				{Version: "v3", IsActive: true},
+				{Version: "v2"},
+				{Version: "v1"},
+			}, nil)
+			schedulerStorage.EXPECT().DeleteSchedulerVersions(context.Background(), operation.SchedulerName, []string{"v1"}).Return(errors.New("error"))
+
+			executor := storagecleanup.NewExecutor(operationStorage, schedulerStorage, storagecleanup.Config{KeepLastVersions: 1})
+			err := executor.Execute(context.Background(), operation, definition)
+			require.ErrorContains(t, err, "failed to clean old scheduler versions on storage clean up operation: ")
+		})
+
 		t.Run("operation storage CleanExpiredOperations fails return unexpected error", func(t *testing.T) {
 			operationStorage := mockports.NewMockOperationStorage(mockCtrl)
 
 			operationStorage.EXPECT().CleanExpiredOperations(context.Background(), operation.SchedulerName).Return(errors.New("error"))
 
-			executor := storagecleanup.NewExecutor(operationStorage)
+			executor := storagecleanup.NewExecutor(operationStorage, nil, storagecleanup.Config{})
 			err := executor.Execute(context.Background(), operation, definition)
 			require.ErrorContains(t, err, "failed to clean expired operations references on storage clean up operation: ")
 		})
@@ -79,7 +138,7 @@ func TestExecutor_Rollback(t *testing.T) {
 	definition := &storagecleanup.Definition{}
 
 	t.Run("does nothing and return nil", func(t *testing.T) {
-		executor := storagecleanup.NewExecutor((ports.OperationStorage)(nil))
+		executor := storagecleanup.NewExecutor((ports.OperationStorage)(nil), nil, storagecleanup.Config{})
 
 		err := executor.Rollback(context.Background(), operation, definition, nil)
 		require.Nil(t, err)
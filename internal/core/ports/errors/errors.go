@@ -33,6 +33,7 @@ const (
 	errEncoding
 	errInvalidArgument
 	errConflict
+	errUnavailable
 )
 
 var (
@@ -42,6 +43,7 @@ var (
 	ErrEncoding        = &portsError{kind: errEncoding}
 	ErrInvalidArgument = &portsError{kind: errInvalidArgument}
 	ErrConflict        = &portsError{kind: errConflict}
+	ErrUnavailable     = &portsError{kind: errUnavailable}
 )
 
 type portsError struct {
@@ -116,3 +118,10 @@ func NewErrConflict(format string, args ...interface{}) *portsError {
 		message: fmt.Sprintf(format, args...),
 	}
 }
+
+func NewErrUnavailable(format string, args ...interface{}) *portsError {
+	return &portsError{
+		kind:    errUnavailable,
+		message: fmt.Sprintf(format, args...),
+	}
+}
@@ -24,6 +24,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
 	pb "github.com/topfreegames/protos/maestro/grpc/generated"
@@ -39,6 +40,38 @@ type EventsService interface {
 	ProduceEvent(ctx context.Context, event *events.Event) error
 }
 
+// EventsDispatcher delivers the events written to the events outbox.
+type EventsDispatcher interface {
+	// DispatchEvents forwards the outbox events, retrying the failed ones,
+	// until the context is done.
+	DispatchEvents(ctx context.Context) error
+}
+
+// DeadLetterEventsManager manages the events that couldn't be forwarded.
+type DeadLetterEventsManager interface {
+	// ListDeadLetterEvents returns the scheduler dead-letter events.
+	ListDeadLetterEvents(ctx context.Context, schedulerName string) ([]*events.EventDelivery, error)
+	// ReplayDeadLetterEvents adds the dead-letter events back to the outbox,
+	// all of them when no ID is given. It returns the replayed events.
+	ReplayDeadLetterEvents(ctx context.Context, schedulerName string, ids []string) ([]*events.EventDelivery, error)
+}
+
+// ForwardersHealthManager reports the health of the forwarders.
+type ForwardersHealthManager interface {
+	// ListForwardersHealth returns the health of each scheduler forwarder.
+	ListForwardersHealth(ctx context.Context, schedulerName string) ([]*forwarder.Health, error)
+}
+
+// ForwardersTester checks the connectivity of the forwarders.
+type ForwardersTester interface {
+	// TestForwarders sends a synthetic room event and player event of the
+	// game to each forwarder, returning their answers.
+	TestForwarders(ctx context.Context, game string, forwarders []*forwarder.Forwarder) ([]*forwarder.TestResult, error)
+	// TestSchedulerForwarders sends the synthetic events to the forwarders
+	// of the scheduler.
+	TestSchedulerForwarders(ctx context.Context, schedulerName string) ([]*forwarder.TestResult, error)
+}
+
 // Secondary ports (output, driven ports)
 
 type EventsForwarder interface {
@@ -50,6 +83,52 @@ type EventsForwarder interface {
 	Name() string
 }
 
+// EventsPublisher publishes events to a message broker, used by the broker
+// events forwarder.
+type EventsPublisher interface {
+	// Publish publishes the payload on the topic, with the headers (e.g. the
+	// CloudEvents attributes) when there are any. Messages with the same key
+	// must be delivered in the order they were published.
+	Publish(ctx context.Context, topic string, key string, payload []byte, headers map[string]string) error
+}
+
+// EventsOutbox keeps the events deliveries until they're forwarded.
+type EventsOutbox interface {
+	// Enqueue adds the deliveries to the outbox.
+	Enqueue(ctx context.Context, deliveries []*events.EventDelivery) error
+	// Next returns up to count deliveries to be dispatched, including the
+	// retries that are due, waiting for a while when there are none.
+	Next(ctx context.Context, count int) ([]*events.EventDelivery, error)
+	// Renew keeps the deliveries returned by Next with the dispatcher, so
+	// they're not handed to other dispatchers while being dispatched.
+	Renew(ctx context.Context, deliveries []*events.EventDelivery) error
+	// Ack removes a dispatched delivery from the outbox.
+	Ack(ctx context.Context, delivery *events.EventDelivery) error
+	// Retry removes the delivery from the outbox, adding it back at the given time.
+	Retry(ctx context.Context, delivery *events.EventDelivery, at time.Time) error
+	// DeadLetter removes the delivery from the outbox, adding it to the
+	// scheduler dead-letter events.
+	DeadLetter(ctx context.Context, delivery *events.EventDelivery) error
+	// ListDeadLetters returns the scheduler dead-letter events, oldest first.
+	ListDeadLetters(ctx context.Context, schedulerName string) ([]*events.EventDelivery, error)
+	// RemoveDeadLetters removes the scheduler dead-letter events with the given IDs.
+	RemoveDeadLetters(ctx context.Context, schedulerName string, ids []string) error
+	// DeleteDeadLetters removes all the scheduler dead-letter events.
+	DeleteDeadLetters(ctx context.Context, schedulerName string) error
+}
+
+// ForwarderHealthStorage keeps the health of the forwarders addresses seen by
+// each Maestro instance forwarding events.
+type ForwarderHealthStorage interface {
+	// SetForwarderHealth saves the health of the forwarder address seen by
+	// this instance.
+	SetForwarderHealth(ctx context.Context, health *forwarder.Health) error
+	// GetForwarderHealth returns the health of the forwarder address
+	// aggregated across the instances, or a not found error when there is no
+	// health saved.
+	GetForwarderHealth(ctx context.Context, address string) (*forwarder.Health, error)
+}
+
 type ForwarderClient interface {
 	SendRoomEvent(ctx context.Context, forwarder forwarder.Forwarder, in *pb.RoomEvent) (*pb.Response, error)
 	SendRoomReSync(ctx context.Context, forwarder forwarder.Forwarder, in *pb.RoomStatus) (*pb.Response, error)
@@ -7,6 +7,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	events "github.com/topfreegames/maestro/internal/core/entities/events"
@@ -52,6 +53,187 @@ func (mr *MockEventsServiceMockRecorder) ProduceEvent(ctx, event interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProduceEvent", reflect.TypeOf((*MockEventsService)(nil).ProduceEvent), ctx, event)
 }
 
+// MockEventsDispatcher is a mock of EventsDispatcher interface.
+type MockEventsDispatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventsDispatcherMockRecorder
+}
+
+// MockEventsDispatcherMockRecorder is the mock recorder for MockEventsDispatcher.
+type MockEventsDispatcherMockRecorder struct {
+	mock *MockEventsDispatcher
+}
+
+// NewMockEventsDispatcher creates a new mock instance.
+func NewMockEventsDispatcher(ctrl *gomock.Controller) *MockEventsDispatcher {
+	mock := &MockEventsDispatcher{ctrl: ctrl}
+	mock.recorder = &MockEventsDispatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventsDispatcher) EXPECT() *MockEventsDispatcherMockRecorder {
+	return m.recorder
+}
+
+// DispatchEvents mocks base method.
+func (m *MockEventsDispatcher) DispatchEvents(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispatchEvents", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DispatchEvents indicates an expected call of DispatchEvents.
+func (mr *MockEventsDispatcherMockRecorder) DispatchEvents(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispatchEvents", reflect.TypeOf((*MockEventsDispatcher)(nil).DispatchEvents), ctx)
+}
+
+// MockDeadLetterEventsManager is a mock of DeadLetterEventsManager interface.
+type MockDeadLetterEventsManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeadLetterEventsManagerMockRecorder
+}
+
+// MockDeadLetterEventsManagerMockRecorder is the mock recorder for MockDeadLetterEventsManager.
+type MockDeadLetterEventsManagerMockRecorder struct {
+	mock *MockDeadLetterEventsManager
+}
+
+// NewMockDeadLetterEventsManager creates a new mock instance.
+func NewMockDeadLetterEventsManager(ctrl *gomock.Controller) *MockDeadLetterEventsManager {
+	mock := &MockDeadLetterEventsManager{ctrl: ctrl}
+	mock.recorder = &MockDeadLetterEventsManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeadLetterEventsManager) EXPECT() *MockDeadLetterEventsManagerMockRecorder {
+	return m.recorder
+}
+
+// ListDeadLetterEvents mocks base method.
+func (m *MockDeadLetterEventsManager) ListDeadLetterEvents(ctx context.Context, schedulerName string) ([]*events.EventDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLetterEvents", ctx, schedulerName)
+	ret0, _ := ret[0].([]*events.EventDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetterEvents indicates an expected call of ListDeadLetterEvents.
+func (mr *MockDeadLetterEventsManagerMockRecorder) ListDeadLetterEvents(ctx, schedulerName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetterEvents", reflect.TypeOf((*MockDeadLetterEventsManager)(nil).ListDeadLetterEvents), ctx, schedulerName)
+}
+
+// ReplayDeadLetterEvents mocks base method.
+func (m *MockDeadLetterEventsManager) ReplayDeadLetterEvents(ctx context.Context, schedulerName string, ids []string) ([]*events.EventDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayDeadLetterEvents", ctx, schedulerName, ids)
+	ret0, _ := ret[0].([]*events.EventDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayDeadLetterEvents indicates an expected call of ReplayDeadLetterEvents.
+func (mr *MockDeadLetterEventsManagerMockRecorder) ReplayDeadLetterEvents(ctx, schedulerName, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayDeadLetterEvents", reflect.TypeOf((*MockDeadLetterEventsManager)(nil).ReplayDeadLetterEvents), ctx, schedulerName, ids)
+}
+
+// MockForwardersHealthManager is a mock of ForwardersHealthManager interface.
+type MockForwardersHealthManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockForwardersHealthManagerMockRecorder
+}
+
+// MockForwardersHealthManagerMockRecorder is the mock recorder for MockForwardersHealthManager.
+type MockForwardersHealthManagerMockRecorder struct {
+	mock *MockForwardersHealthManager
+}
+
+// NewMockForwardersHealthManager creates a new mock instance.
+func NewMockForwardersHealthManager(ctrl *gomock.Controller) *MockForwardersHealthManager {
+	mock := &MockForwardersHealthManager{ctrl: ctrl}
+	mock.recorder = &MockForwardersHealthManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForwardersHealthManager) EXPECT() *MockForwardersHealthManagerMockRecorder {
+	return m.recorder
+}
+
+// ListForwardersHealth mocks base method.
+func (m *MockForwardersHealthManager) ListForwardersHealth(ctx context.Context, schedulerName string) ([]*forwarder.Health, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForwardersHealth", ctx, schedulerName)
+	ret0, _ := ret[0].([]*forwarder.Health)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForwardersHealth indicates an expected call of ListForwardersHealth.
+func (mr *MockForwardersHealthManagerMockRecorder) ListForwardersHealth(ctx, schedulerName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForwardersHealth", reflect.TypeOf((*MockForwardersHealthManager)(nil).ListForwardersHealth), ctx, schedulerName)
+}
+
+// MockForwardersTester is a mock of ForwardersTester interface.
+type MockForwardersTester struct {
+	ctrl     *gomock.Controller
+	recorder *MockForwardersTesterMockRecorder
+}
+
+// MockForwardersTesterMockRecorder is the mock recorder for MockForwardersTester.
+type MockForwardersTesterMockRecorder struct {
+	mock *MockForwardersTester
+}
+
+// NewMockForwardersTester creates a new mock instance.
+func NewMockForwardersTester(ctrl *gomock.Controller) *MockForwardersTester {
+	mock := &MockForwardersTester{ctrl: ctrl}
+	mock.recorder = &MockForwardersTesterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForwardersTester) EXPECT() *MockForwardersTesterMockRecorder {
+	return m.recorder
+}
+
+// TestForwarders mocks base method.
+func (m *MockForwardersTester) TestForwarders(ctx context.Context, game string, forwarders []*forwarder.Forwarder) ([]*forwarder.TestResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestForwarders", ctx, game, forwarders)
+	ret0, _ := ret[0].([]*forwarder.TestResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestForwarders indicates an expected call of TestForwarders.
+func (mr *MockForwardersTesterMockRecorder) TestForwarders(ctx, game, forwarders interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestForwarders", reflect.TypeOf((*MockForwardersTester)(nil).TestForwarders), ctx, game, forwarders)
+}
+
+// TestSchedulerForwarders mocks base method.
+func (m *MockForwardersTester) TestSchedulerForwarders(ctx context.Context, schedulerName string) ([]*forwarder.TestResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestSchedulerForwarders", ctx, schedulerName)
+	ret0, _ := ret[0].([]*forwarder.TestResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestSchedulerForwarders indicates an expected call of TestSchedulerForwarders.
+func (mr *MockForwardersTesterMockRecorder) TestSchedulerForwarders(ctx, schedulerName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestSchedulerForwarders", reflect.TypeOf((*MockForwardersTester)(nil).TestSchedulerForwarders), ctx, schedulerName)
+}
+
 // MockEventsForwarder is a mock of EventsForwarder interface.
 type MockEventsForwarder struct {
 	ctrl     *gomock.Controller
@@ -119,6 +301,246 @@ func (mr *MockEventsForwarderMockRecorder) Name() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockEventsForwarder)(nil).Name))
 }
 
+// MockEventsPublisher is a mock of EventsPublisher interface.
+type MockEventsPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventsPublisherMockRecorder
+}
+
+// MockEventsPublisherMockRecorder is the mock recorder for MockEventsPublisher.
+type MockEventsPublisherMockRecorder struct {
+	mock *MockEventsPublisher
+}
+
+// NewMockEventsPublisher creates a new mock instance.
+func NewMockEventsPublisher(ctrl *gomock.Controller) *MockEventsPublisher {
+	mock := &MockEventsPublisher{ctrl: ctrl}
+	mock.recorder = &MockEventsPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventsPublisher) EXPECT() *MockEventsPublisherMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockEventsPublisher) Publish(ctx context.Context, topic, key string, payload []byte, headers map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, topic, key, payload, headers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockEventsPublisherMockRecorder) Publish(ctx, topic, key, payload, headers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockEventsPublisher)(nil).Publish), ctx, topic, key, payload, headers)
+}
+
+// MockEventsOutbox is a mock of EventsOutbox interface.
+type MockEventsOutbox struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventsOutboxMockRecorder
+}
+
+// MockEventsOutboxMockRecorder is the mock recorder for MockEventsOutbox.
+type MockEventsOutboxMockRecorder struct {
+	mock *MockEventsOutbox
+}
+
+// NewMockEventsOutbox creates a new mock instance.
+func NewMockEventsOutbox(ctrl *gomock.Controller) *MockEventsOutbox {
+	mock := &MockEventsOutbox{ctrl: ctrl}
+	mock.recorder = &MockEventsOutboxMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventsOutbox) EXPECT() *MockEventsOutboxMockRecorder {
+	return m.recorder
+}
+
+// Ack mocks base method.
+func (m *MockEventsOutbox) Ack(ctx context.Context, delivery *events.EventDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ack", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ack indicates an expected call of Ack.
+func (mr *MockEventsOutboxMockRecorder) Ack(ctx, delivery interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ack", reflect.TypeOf((*MockEventsOutbox)(nil).Ack), ctx, delivery)
+}
+
+// DeadLetter mocks base method.
+func (m *MockEventsOutbox) DeadLetter(ctx context.Context, delivery *events.EventDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeadLetter", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeadLetter indicates an expected call of DeadLetter.
+func (mr *MockEventsOutboxMockRecorder) DeadLetter(ctx, delivery interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeadLetter", reflect.TypeOf((*MockEventsOutbox)(nil).DeadLetter), ctx, delivery)
+}
+
+// DeleteDeadLetters mocks base method.
+func (m *MockEventsOutbox) DeleteDeadLetters(ctx context.Context, schedulerName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDeadLetters", ctx, schedulerName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDeadLetters indicates an expected call of DeleteDeadLetters.
+func (mr *MockEventsOutboxMockRecorder) DeleteDeadLetters(ctx, schedulerName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeadLetters", reflect.TypeOf((*MockEventsOutbox)(nil).DeleteDeadLetters), ctx, schedulerName)
+}
+
+// Enqueue mocks base method.
+func (m *MockEventsOutbox) Enqueue(ctx context.Context, deliveries []*events.EventDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", ctx, deliveries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockEventsOutboxMockRecorder) Enqueue(ctx, deliveries interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockEventsOutbox)(nil).Enqueue), ctx, deliveries)
+}
+
+// ListDeadLetters mocks base method.
+func (m *MockEventsOutbox) ListDeadLetters(ctx context.Context, schedulerName string) ([]*events.EventDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLetters", ctx, schedulerName)
+	ret0, _ := ret[0].([]*events.EventDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetters indicates an expected call of ListDeadLetters.
+func (mr *MockEventsOutboxMockRecorder) ListDeadLetters(ctx, schedulerName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetters", reflect.TypeOf((*MockEventsOutbox)(nil).ListDeadLetters), ctx, schedulerName)
+}
+
+// Next mocks base method.
+func (m *MockEventsOutbox) Next(ctx context.Context, count int) ([]*events.EventDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Next", ctx, count)
+	ret0, _ := ret[0].([]*events.EventDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Next indicates an expected call of Next.
+func (mr *MockEventsOutboxMockRecorder) Next(ctx, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Next", reflect.TypeOf((*MockEventsOutbox)(nil).Next), ctx, count)
+}
+
+// RemoveDeadLetters mocks base method.
+func (m *MockEventsOutbox) RemoveDeadLetters(ctx context.Context, schedulerName string, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDeadLetters", ctx, schedulerName, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveDeadLetters indicates an expected call of RemoveDeadLetters.
+func (mr *MockEventsOutboxMockRecorder) RemoveDeadLetters(ctx, schedulerName, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDeadLetters", reflect.TypeOf((*MockEventsOutbox)(nil).RemoveDeadLetters), ctx, schedulerName, ids)
+}
+
+// Renew mocks base method.
+func (m *MockEventsOutbox) Renew(ctx context.Context, deliveries []*events.EventDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Renew", ctx, deliveries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Renew indicates an expected call of Renew.
+func (mr *MockEventsOutboxMockRecorder) Renew(ctx, deliveries interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Renew", reflect.TypeOf((*MockEventsOutbox)(nil).Renew), ctx, deliveries)
+}
+
+// Retry mocks base method.
+func (m *MockEventsOutbox) Retry(ctx context.Context, delivery *events.EventDelivery, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retry", ctx, delivery, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Retry indicates an expected call of Retry.
+func (mr *MockEventsOutboxMockRecorder) Retry(ctx, delivery, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retry", reflect.TypeOf((*MockEventsOutbox)(nil).Retry), ctx, delivery, at)
+}
+
+// MockForwarderHealthStorage is a mock of ForwarderHealthStorage interface.
+type MockForwarderHealthStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockForwarderHealthStorageMockRecorder
+}
+
+// MockForwarderHealthStorageMockRecorder is the mock recorder for MockForwarderHealthStorage.
+type MockForwarderHealthStorageMockRecorder struct {
+	mock *MockForwarderHealthStorage
+}
+
+// NewMockForwarderHealthStorage creates a new mock instance.
+func NewMockForwarderHealthStorage(ctrl *gomock.Controller) *MockForwarderHealthStorage {
+	mock := &MockForwarderHealthStorage{ctrl: ctrl}
+	mock.recorder = &MockForwarderHealthStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForwarderHealthStorage) EXPECT() *MockForwarderHealthStorageMockRecorder {
+	return m.recorder
+}
+
+// GetForwarderHealth mocks base method.
+func (m *MockForwarderHealthStorage) GetForwarderHealth(ctx context.Context, address string) (*forwarder.Health, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForwarderHealth", ctx, address)
+	ret0, _ := ret[0].(*forwarder.Health)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForwarderHealth indicates an expected call of GetForwarderHealth.
+func (mr *MockForwarderHealthStorageMockRecorder) GetForwarderHealth(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForwarderHealth", reflect.TypeOf((*MockForwarderHealthStorage)(nil).GetForwarderHealth), ctx, address)
+}
+
+// SetForwarderHealth mocks base method.
+func (m *MockForwarderHealthStorage) SetForwarderHealth(ctx context.Context, health *forwarder.Health) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetForwarderHealth", ctx, health)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetForwarderHealth indicates an expected call of SetForwarderHealth.
+func (mr *MockForwarderHealthStorageMockRecorder) SetForwarderHealth(ctx, health interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetForwarderHealth", reflect.TypeOf((*MockForwarderHealthStorage)(nil).SetForwarderHealth), ctx, health)
+}
+
 // MockForwarderClient is a mock of ForwarderClient interface.
 type MockForwarderClient struct {
 	ctrl     *gomock.Controller
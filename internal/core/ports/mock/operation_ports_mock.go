@@ -65,19 +65,19 @@ func (mr *MockOperationManagerMockRecorder) CreateOperation(ctx, schedulerName,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOperation", reflect.TypeOf((*MockOperationManager)(nil).CreateOperation), ctx, schedulerName, definition)
 }
 
-// CreatePriorityOperation mocks base method.
-func (m *MockOperationManager) CreatePriorityOperation(ctx context.Context, schedulerName string, definition operations.Definition) (*operation.Operation, error) {
+// CreateOperationWithIdempotencyKey mocks base method.
+func (m *MockOperationManager) CreateOperationWithIdempotencyKey(ctx context.Context, schedulerName, idempotencyKey string, definition operations.Definition) (*operation.Operation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePriorityOperation", ctx, schedulerName, definition)
+	ret := m.ctrl.Call(m, "CreateOperationWithIdempotencyKey", ctx, schedulerName, idempotencyKey, definition)
 	ret0, _ := ret[0].(*operation.Operation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreatePriorityOperation indicates an expected call of CreatePriorityOperation.
-func (mr *MockOperationManagerMockRecorder) CreatePriorityOperation(ctx, schedulerName, definition interface{}) *gomock.Call {
+// CreateOperationWithIdempotencyKey indicates an expected call of CreateOperationWithIdempotencyKey.
+func (mr *MockOperationManagerMockRecorder) CreateOperationWithIdempotencyKey(ctx, schedulerName, idempotencyKey, definition interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePriorityOperation", reflect.TypeOf((*MockOperationManager)(nil).CreatePriorityOperation), ctx, schedulerName, definition)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOperationWithIdempotencyKey", reflect.TypeOf((*MockOperationManager)(nil).CreateOperationWithIdempotencyKey), ctx, schedulerName, idempotencyKey, definition)
 }
 
 // EnqueueOperationCancellationRequest mocks base method.
@@ -124,6 +124,21 @@ func (mr *MockOperationManagerMockRecorder) GetOperation(ctx, schedulerName, ope
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperation", reflect.TypeOf((*MockOperationManager)(nil).GetOperation), ctx, schedulerName, operationID)
 }
 
+// GetSchedulerOperationsPause mocks base method.
+func (m *MockOperationManager) GetSchedulerOperationsPause(ctx context.Context, schedulerName string) (*operation.OperationsPause, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchedulerOperationsPause", ctx, schedulerName)
+	ret0, _ := ret[0].(*operation.OperationsPause)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchedulerOperationsPause indicates an expected call of GetSchedulerOperationsPause.
+func (mr *MockOperationManagerMockRecorder) GetSchedulerOperationsPause(ctx, schedulerName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedulerOperationsPause", reflect.TypeOf((*MockOperationManager)(nil).GetSchedulerOperationsPause), ctx, schedulerName)
+}
+
 // GrantLease mocks base method.
 func (m *MockOperationManager) GrantLease(ctx context.Context, operation *operation.Operation) error {
 	m.ctrl.T.Helper()
@@ -138,6 +153,21 @@ func (mr *MockOperationManagerMockRecorder) GrantLease(ctx, operation interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantLease", reflect.TypeOf((*MockOperationManager)(nil).GrantLease), ctx, operation)
 }
 
+// HoldOperation mocks base method.
+func (m *MockOperationManager) HoldOperation(ctx context.Context, op *operation.Operation) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HoldOperation", ctx, op)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HoldOperation indicates an expected call of HoldOperation.
+func (mr *MockOperationManagerMockRecorder) HoldOperation(ctx, op interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HoldOperation", reflect.TypeOf((*MockOperationManager)(nil).HoldOperation), ctx, op)
+}
+
 // ListSchedulerActiveOperations mocks base method.
 func (m *MockOperationManager) ListSchedulerActiveOperations(ctx context.Context, schedulerName string) ([]*operation.Operation, error) {
 	m.ctrl.T.Helper()
@@ -184,6 +214,20 @@ func (mr *MockOperationManagerMockRecorder) ListSchedulerPendingOperations(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchedulerPendingOperations", reflect.TypeOf((*MockOperationManager)(nil).ListSchedulerPendingOperations), ctx, schedulerName)
 }
 
+// PauseSchedulerOperations mocks base method.
+func (m *MockOperationManager) PauseSchedulerOperations(ctx context.Context, schedulerName, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseSchedulerOperations", ctx, schedulerName, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseSchedulerOperations indicates an expected call of PauseSchedulerOperations.
+func (mr *MockOperationManagerMockRecorder) PauseSchedulerOperations(ctx, schedulerName, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseSchedulerOperations", reflect.TypeOf((*MockOperationManager)(nil).PauseSchedulerOperations), ctx, schedulerName, reason)
+}
+
 // PendingOperationsChan mocks base method.
 func (m *MockOperationManager) PendingOperationsChan(ctx context.Context, schedulerName string) <-chan string {
 	m.ctrl.T.Helper()
@@ -198,6 +242,20 @@ func (mr *MockOperationManagerMockRecorder) PendingOperationsChan(ctx, scheduler
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingOperationsChan", reflect.TypeOf((*MockOperationManager)(nil).PendingOperationsChan), ctx, schedulerName)
 }
 
+// ResumeSchedulerOperations mocks base method.
+func (m *MockOperationManager) ResumeSchedulerOperations(ctx context.Context, schedulerName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeSchedulerOperations", ctx, schedulerName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeSchedulerOperations indicates an expected call of ResumeSchedulerOperations.
+func (mr *MockOperationManagerMockRecorder) ResumeSchedulerOperations(ctx, schedulerName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeSchedulerOperations", reflect.TypeOf((*MockOperationManager)(nil).ResumeSchedulerOperations), ctx, schedulerName)
+}
+
 // RevokeLease mocks base method.
 func (m *MockOperationManager) RevokeLease(ctx context.Context, operation *operation.Operation) error {
 	m.ctrl.T.Helper()
@@ -238,6 +296,45 @@ func (mr *MockOperationManagerMockRecorder) StartOperation(ctx, op, cancelFuncti
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartOperation", reflect.TypeOf((*MockOperationManager)(nil).StartOperation), ctx, op, cancelFunction)
 }
 
+// StartOperationProgress mocks base method.
+func (m *MockOperationManager) StartOperationProgress(ctx context.Context, op *operation.Operation, totalUnits int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "StartOperationProgress", ctx, op, totalUnits)
+}
+
+// StartOperationProgress indicates an expected call of StartOperationProgress.
+func (mr *MockOperationManagerMockRecorder) StartOperationProgress(ctx, op, totalUnits interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartOperationProgress", reflect.TypeOf((*MockOperationManager)(nil).StartOperationProgress), ctx, op, totalUnits)
+}
+
+// UpdateOperationProgress mocks base method.
+func (m *MockOperationManager) UpdateOperationProgress(ctx context.Context, op *operation.Operation, completedUnits, failedUnits int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateOperationProgress", ctx, op, completedUnits, failedUnits)
+}
+
+// UpdateOperationProgress indicates an expected call of UpdateOperationProgress.
+func (mr *MockOperationManagerMockRecorder) UpdateOperationProgress(ctx, op, completedUnits, failedUnits interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOperationProgress", reflect.TypeOf((*MockOperationManager)(nil).UpdateOperationProgress), ctx, op, completedUnits, failedUnits)
+}
+
+// WatchOperation mocks base method.
+func (m *MockOperationManager) WatchOperation(ctx context.Context, schedulerName, operationID string) (<-chan *operation.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchOperation", ctx, schedulerName, operationID)
+	ret0, _ := ret[0].(<-chan *operation.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchOperation indicates an expected call of WatchOperation.
+func (mr *MockOperationManagerMockRecorder) WatchOperation(ctx, schedulerName, operationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchOperation", reflect.TypeOf((*MockOperationManager)(nil).WatchOperation), ctx, schedulerName, operationID)
+}
+
 // WatchOperationCancellationRequests mocks base method.
 func (m *MockOperationManager) WatchOperationCancellationRequests(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -252,6 +349,21 @@ func (mr *MockOperationManagerMockRecorder) WatchOperationCancellationRequests(c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchOperationCancellationRequests", reflect.TypeOf((*MockOperationManager)(nil).WatchOperationCancellationRequests), ctx)
 }
 
+// WatchSchedulerOperations mocks base method.
+func (m *MockOperationManager) WatchSchedulerOperations(ctx context.Context, schedulerName string) (<-chan *operation.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchSchedulerOperations", ctx, schedulerName)
+	ret0, _ := ret[0].(<-chan *operation.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchSchedulerOperations indicates an expected call of WatchSchedulerOperations.
+func (mr *MockOperationManagerMockRecorder) WatchSchedulerOperations(ctx, schedulerName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchSchedulerOperations", reflect.TypeOf((*MockOperationManager)(nil).WatchSchedulerOperations), ctx, schedulerName)
+}
+
 // MockOperationFlow is a mock of OperationFlow interface.
 type MockOperationFlow struct {
 	ctrl     *gomock.Controller
@@ -289,32 +401,48 @@ func (mr *MockOperationFlowMockRecorder) EnqueueOperationCancellationRequest(ctx
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueOperationCancellationRequest", reflect.TypeOf((*MockOperationFlow)(nil).EnqueueOperationCancellationRequest), ctx, request)
 }
 
-// InsertOperationID mocks base method.
-func (m *MockOperationFlow) InsertOperationID(ctx context.Context, schedulerName, operationID string) error {
+// GetOperationsPause mocks base method.
+func (m *MockOperationFlow) GetOperationsPause(ctx context.Context, schedulerName string) (*operation.OperationsPause, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InsertOperationID", ctx, schedulerName, operationID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetOperationsPause", ctx, schedulerName)
+	ret0, _ := ret[0].(*operation.OperationsPause)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// InsertOperationID indicates an expected call of InsertOperationID.
-func (mr *MockOperationFlowMockRecorder) InsertOperationID(ctx, schedulerName, operationID interface{}) *gomock.Call {
+// GetOperationsPause indicates an expected call of GetOperationsPause.
+func (mr *MockOperationFlowMockRecorder) GetOperationsPause(ctx, schedulerName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertOperationID", reflect.TypeOf((*MockOperationFlow)(nil).InsertOperationID), ctx, schedulerName, operationID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationsPause", reflect.TypeOf((*MockOperationFlow)(nil).GetOperationsPause), ctx, schedulerName)
 }
 
-// InsertPriorityOperationID mocks base method.
-func (m *MockOperationFlow) InsertPriorityOperationID(ctx context.Context, schedulerName, operationID string) error {
+// HoldOperationID mocks base method.
+func (m *MockOperationFlow) HoldOperationID(ctx context.Context, schedulerName, operationID string, priority operation.Priority) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InsertPriorityOperationID", ctx, schedulerName, operationID)
+	ret := m.ctrl.Call(m, "HoldOperationID", ctx, schedulerName, operationID, priority)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HoldOperationID indicates an expected call of HoldOperationID.
+func (mr *MockOperationFlowMockRecorder) HoldOperationID(ctx, schedulerName, operationID, priority interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HoldOperationID", reflect.TypeOf((*MockOperationFlow)(nil).HoldOperationID), ctx, schedulerName, operationID, priority)
+}
+
+// InsertOperationID mocks base method.
+func (m *MockOperationFlow) InsertOperationID(ctx context.Context, schedulerName, operationID string, priority operation.Priority) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertOperationID", ctx, schedulerName, operationID, priority)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// InsertPriorityOperationID indicates an expected call of InsertPriorityOperationID.
-func (mr *MockOperationFlowMockRecorder) InsertPriorityOperationID(ctx, schedulerName, operationID interface{}) *gomock.Call {
+// InsertOperationID indicates an expected call of InsertOperationID.
+func (mr *MockOperationFlowMockRecorder) InsertOperationID(ctx, schedulerName, operationID, priority interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertPriorityOperationID", reflect.TypeOf((*MockOperationFlow)(nil).InsertPriorityOperationID), ctx, schedulerName, operationID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertOperationID", reflect.TypeOf((*MockOperationFlow)(nil).InsertOperationID), ctx, schedulerName, operationID, priority)
 }
 
 // ListSchedulerPendingOperationIDs mocks base method.
@@ -347,6 +475,20 @@ func (mr *MockOperationFlowMockRecorder) NextOperationID(ctx, schedulerName inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextOperationID", reflect.TypeOf((*MockOperationFlow)(nil).NextOperationID), ctx, schedulerName)
 }
 
+// PauseOperations mocks base method.
+func (m *MockOperationFlow) PauseOperations(ctx context.Context, schedulerName, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseOperations", ctx, schedulerName, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseOperations indicates an expected call of PauseOperations.
+func (mr *MockOperationFlowMockRecorder) PauseOperations(ctx, schedulerName, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseOperations", reflect.TypeOf((*MockOperationFlow)(nil).PauseOperations), ctx, schedulerName, reason)
+}
+
 // RemoveNextOperation mocks base method.
 func (m *MockOperationFlow) RemoveNextOperation(ctx context.Context, schedulerName string) error {
 	m.ctrl.T.Helper()
@@ -361,6 +503,20 @@ func (mr *MockOperationFlowMockRecorder) RemoveNextOperation(ctx, schedulerName
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNextOperation", reflect.TypeOf((*MockOperationFlow)(nil).RemoveNextOperation), ctx, schedulerName)
 }
 
+// ResumeOperations mocks base method.
+func (m *MockOperationFlow) ResumeOperations(ctx context.Context, schedulerName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeOperations", ctx, schedulerName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeOperations indicates an expected call of ResumeOperations.
+func (mr *MockOperationFlowMockRecorder) ResumeOperations(ctx, schedulerName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeOperations", reflect.TypeOf((*MockOperationFlow)(nil).ResumeOperations), ctx, schedulerName)
+}
+
 // WatchOperationCancellationRequests mocks base method.
 func (m *MockOperationFlow) WatchOperationCancellationRequests(ctx context.Context) chan ports.OperationCancellationRequest {
 	m.ctrl.T.Helper()
@@ -440,6 +596,21 @@ func (mr *MockOperationStorageMockRecorder) CreateOperation(ctx, operation inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOperation", reflect.TypeOf((*MockOperationStorage)(nil).CreateOperation), ctx, operation)
 }
 
+// CreateOperationWithIdempotencyKey mocks base method.
+func (m *MockOperationStorage) CreateOperationWithIdempotencyKey(ctx context.Context, op *operation.Operation, idempotencyKey string, ttl time.Duration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOperationWithIdempotencyKey", ctx, op, idempotencyKey, ttl)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOperationWithIdempotencyKey indicates an expected call of CreateOperationWithIdempotencyKey.
+func (mr *MockOperationStorageMockRecorder) CreateOperationWithIdempotencyKey(ctx, op, idempotencyKey, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOperationWithIdempotencyKey", reflect.TypeOf((*MockOperationStorage)(nil).CreateOperationWithIdempotencyKey), ctx, op, idempotencyKey, ttl)
+}
+
 // GetOperation mocks base method.
 func (m *MockOperationStorage) GetOperation(ctx context.Context, schedulerName, operationID string) (*operation.Operation, error) {
 	m.ctrl.T.Helper()
@@ -514,6 +685,20 @@ func (mr *MockOperationStorageMockRecorder) UpdateOperationExecutionHistory(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOperationExecutionHistory", reflect.TypeOf((*MockOperationStorage)(nil).UpdateOperationExecutionHistory), ctx, op)
 }
 
+// UpdateOperationProgress mocks base method.
+func (m *MockOperationStorage) UpdateOperationProgress(ctx context.Context, op *operation.Operation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOperationProgress", ctx, op)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOperationProgress indicates an expected call of UpdateOperationProgress.
+func (mr *MockOperationStorageMockRecorder) UpdateOperationProgress(ctx, op interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOperationProgress", reflect.TypeOf((*MockOperationStorage)(nil).UpdateOperationProgress), ctx, op)
+}
+
 // UpdateOperationStatus mocks base method.
 func (m *MockOperationStorage) UpdateOperationStatus(ctx context.Context, schedulerName, operationID string, status operation.Status) error {
 	m.ctrl.T.Helper()
@@ -528,6 +713,21 @@ func (mr *MockOperationStorageMockRecorder) UpdateOperationStatus(ctx, scheduler
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOperationStatus", reflect.TypeOf((*MockOperationStorage)(nil).UpdateOperationStatus), ctx, schedulerName, operationID, status)
 }
 
+// WatchSchedulerOperationsUpdates mocks base method.
+func (m *MockOperationStorage) WatchSchedulerOperationsUpdates(ctx context.Context, schedulerName string) (chan string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchSchedulerOperationsUpdates", ctx, schedulerName)
+	ret0, _ := ret[0].(chan string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchSchedulerOperationsUpdates indicates an expected call of WatchSchedulerOperationsUpdates.
+func (mr *MockOperationStorageMockRecorder) WatchSchedulerOperationsUpdates(ctx, schedulerName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchSchedulerOperationsUpdates", reflect.TypeOf((*MockOperationStorage)(nil).WatchSchedulerOperationsUpdates), ctx, schedulerName)
+}
+
 // MockOperationLeaseStorage is a mock of OperationLeaseStorage interface.
 type MockOperationLeaseStorage struct {
 	ctrl     *gomock.Controller
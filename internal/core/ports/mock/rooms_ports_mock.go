@@ -13,6 +13,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	entities "github.com/topfreegames/maestro/internal/core/entities"
 	game_room "github.com/topfreegames/maestro/internal/core/entities/game_room"
+	rollout "github.com/topfreegames/maestro/internal/core/entities/rollout"
 	ports "github.com/topfreegames/maestro/internal/core/ports"
 )
 
@@ -83,6 +84,20 @@ func (mr *MockRoomManagerMockRecorder) DeleteRoom(ctx, gameRoom, reason interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoom", reflect.TypeOf((*MockRoomManager)(nil).DeleteRoom), ctx, gameRoom, reason)
 }
 
+// ForwardRoomStatus mocks base method.
+func (m *MockRoomManager) ForwardRoomStatus(ctx context.Context, gameRoom *game_room.GameRoom) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForwardRoomStatus", ctx, gameRoom)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForwardRoomStatus indicates an expected call of ForwardRoomStatus.
+func (mr *MockRoomManagerMockRecorder) ForwardRoomStatus(ctx, gameRoom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForwardRoomStatus", reflect.TypeOf((*MockRoomManager)(nil).ForwardRoomStatus), ctx, gameRoom)
+}
+
 // GetRoomInstance mocks base method.
 func (m *MockRoomManager) GetRoomInstance(ctx context.Context, scheduler, roomID string) (*game_room.Instance, error) {
 	m.ctrl.T.Helper()
@@ -98,6 +113,21 @@ func (mr *MockRoomManagerMockRecorder) GetRoomInstance(ctx, scheduler, roomID in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomInstance", reflect.TypeOf((*MockRoomManager)(nil).GetRoomInstance), ctx, scheduler, roomID)
 }
 
+// ListRoomsByVersion mocks base method.
+func (m *MockRoomManager) ListRoomsByVersion(ctx context.Context, schedulerName, version string) ([]*game_room.GameRoom, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoomsByVersion", ctx, schedulerName, version)
+	ret0, _ := ret[0].([]*game_room.GameRoom)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoomsByVersion indicates an expected call of ListRoomsByVersion.
+func (mr *MockRoomManagerMockRecorder) ListRoomsByVersion(ctx, schedulerName, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoomsByVersion", reflect.TypeOf((*MockRoomManager)(nil).ListRoomsByVersion), ctx, schedulerName, version)
+}
+
 // ListRoomsWithDeletionPriority mocks base method.
 func (m *MockRoomManager) ListRoomsWithDeletionPriority(ctx context.Context, schedulerName, ignoredVersion string, amount int, roomsBeingReplaced *sync.Map) ([]*game_room.GameRoom, error) {
 	m.ctrl.T.Helper()
@@ -128,6 +158,21 @@ func (mr *MockRoomManagerMockRecorder) SchedulerMaxSurge(ctx, scheduler interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulerMaxSurge", reflect.TypeOf((*MockRoomManager)(nil).SchedulerMaxSurge), ctx, scheduler)
 }
 
+// SchedulerMaxUnavailable mocks base method.
+func (m *MockRoomManager) SchedulerMaxUnavailable(ctx context.Context, scheduler *entities.Scheduler) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchedulerMaxUnavailable", ctx, scheduler)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SchedulerMaxUnavailable indicates an expected call of SchedulerMaxUnavailable.
+func (mr *MockRoomManagerMockRecorder) SchedulerMaxUnavailable(ctx, scheduler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulerMaxUnavailable", reflect.TypeOf((*MockRoomManager)(nil).SchedulerMaxUnavailable), ctx, scheduler)
+}
+
 // UpdateGameRoomStatus mocks base method.
 func (m *MockRoomManager) UpdateGameRoomStatus(ctx context.Context, schedulerId, gameRoomId string) error {
 	m.ctrl.T.Helper()
@@ -185,6 +230,56 @@ func (mr *MockRoomManagerMockRecorder) WaitRoomStatus(ctx, gameRoom, status inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitRoomStatus", reflect.TypeOf((*MockRoomManager)(nil).WaitRoomStatus), ctx, gameRoom, status)
 }
 
+// MockRoomTimelineManager is a mock of RoomTimelineManager interface.
+type MockRoomTimelineManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoomTimelineManagerMockRecorder
+}
+
+// MockRoomTimelineManagerMockRecorder is the mock recorder for MockRoomTimelineManager.
+type MockRoomTimelineManagerMockRecorder struct {
+	mock *MockRoomTimelineManager
+}
+
+// NewMockRoomTimelineManager creates a new mock instance.
+func NewMockRoomTimelineManager(ctrl *gomock.Controller) *MockRoomTimelineManager {
+	mock := &MockRoomTimelineManager{ctrl: ctrl}
+	mock.recorder = &MockRoomTimelineManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoomTimelineManager) EXPECT() *MockRoomTimelineManagerMockRecorder {
+	return m.recorder
+}
+
+// GetRoomTimeline mocks base method.
+func (m *MockRoomTimelineManager) GetRoomTimeline(ctx context.Context, schedulerName, roomID string) ([]*game_room.TimelineEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoomTimeline", ctx, schedulerName, roomID)
+	ret0, _ := ret[0].([]*game_room.TimelineEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoomTimeline indicates an expected call of GetRoomTimeline.
+func (mr *MockRoomTimelineManagerMockRecorder) GetRoomTimeline(ctx, schedulerName, roomID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomTimeline", reflect.TypeOf((*MockRoomTimelineManager)(nil).GetRoomTimeline), ctx, schedulerName, roomID)
+}
+
+// RecordRoomTimelineEntry mocks base method.
+func (m *MockRoomTimelineManager) RecordRoomTimelineEntry(ctx context.Context, schedulerName, roomID string, entry *game_room.TimelineEntry) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordRoomTimelineEntry", ctx, schedulerName, roomID, entry)
+}
+
+// RecordRoomTimelineEntry indicates an expected call of RecordRoomTimelineEntry.
+func (mr *MockRoomTimelineManagerMockRecorder) RecordRoomTimelineEntry(ctx, schedulerName, roomID, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordRoomTimelineEntry", reflect.TypeOf((*MockRoomTimelineManager)(nil).RecordRoomTimelineEntry), ctx, schedulerName, roomID, entry)
+}
+
 // MockRoomStorage is a mock of RoomStorage interface.
 type MockRoomStorage struct {
 	ctrl     *gomock.Controller
@@ -369,6 +464,58 @@ func (mr *MockRoomStorageMockRecorder) WatchRoomStatus(ctx, room interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchRoomStatus", reflect.TypeOf((*MockRoomStorage)(nil).WatchRoomStatus), ctx, room)
 }
 
+// MockRoomTimelineStorage is a mock of RoomTimelineStorage interface.
+type MockRoomTimelineStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoomTimelineStorageMockRecorder
+}
+
+// MockRoomTimelineStorageMockRecorder is the mock recorder for MockRoomTimelineStorage.
+type MockRoomTimelineStorageMockRecorder struct {
+	mock *MockRoomTimelineStorage
+}
+
+// NewMockRoomTimelineStorage creates a new mock instance.
+func NewMockRoomTimelineStorage(ctrl *gomock.Controller) *MockRoomTimelineStorage {
+	mock := &MockRoomTimelineStorage{ctrl: ctrl}
+	mock.recorder = &MockRoomTimelineStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoomTimelineStorage) EXPECT() *MockRoomTimelineStorageMockRecorder {
+	return m.recorder
+}
+
+// AddRoomTimelineEntry mocks base method.
+func (m *MockRoomTimelineStorage) AddRoomTimelineEntry(ctx context.Context, scheduler, roomID string, entry *game_room.TimelineEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRoomTimelineEntry", ctx, scheduler, roomID, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRoomTimelineEntry indicates an expected call of AddRoomTimelineEntry.
+func (mr *MockRoomTimelineStorageMockRecorder) AddRoomTimelineEntry(ctx, scheduler, roomID, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRoomTimelineEntry", reflect.TypeOf((*MockRoomTimelineStorage)(nil).AddRoomTimelineEntry), ctx, scheduler, roomID, entry)
+}
+
+// GetRoomTimeline mocks base method.
+func (m *MockRoomTimelineStorage) GetRoomTimeline(ctx context.Context, scheduler, roomID string) ([]*game_room.TimelineEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoomTimeline", ctx, scheduler, roomID)
+	ret0, _ := ret[0].([]*game_room.TimelineEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoomTimeline indicates an expected call of GetRoomTimeline.
+func (mr *MockRoomTimelineStorageMockRecorder) GetRoomTimeline(ctx, scheduler, roomID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomTimeline", reflect.TypeOf((*MockRoomTimelineStorage)(nil).GetRoomTimeline), ctx, scheduler, roomID)
+}
+
 // MockRoomStorageStatusWatcher is a mock of RoomStorageStatusWatcher interface.
 type MockRoomStorageStatusWatcher struct {
 	ctrl     *gomock.Controller
@@ -417,3 +564,40 @@ func (mr *MockRoomStorageStatusWatcherMockRecorder) Stop() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockRoomStorageStatusWatcher)(nil).Stop))
 }
+
+// MockRoomHealthChecker is a mock of RoomHealthChecker interface.
+type MockRoomHealthChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoomHealthCheckerMockRecorder
+}
+
+// MockRoomHealthCheckerMockRecorder is the mock recorder for MockRoomHealthChecker.
+type MockRoomHealthCheckerMockRecorder struct {
+	mock *MockRoomHealthChecker
+}
+
+// NewMockRoomHealthChecker creates a new mock instance.
+func NewMockRoomHealthChecker(ctrl *gomock.Controller) *MockRoomHealthChecker {
+	mock := &MockRoomHealthChecker{ctrl: ctrl}
+	mock.recorder = &MockRoomHealthCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoomHealthChecker) EXPECT() *MockRoomHealthCheckerMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockRoomHealthChecker) Check(ctx context.Context, address string, healthCheck *rollout.HealthCheckParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", ctx, address, healthCheck)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockRoomHealthCheckerMockRecorder) Check(ctx, address, healthCheck interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockRoomHealthChecker)(nil).Check), ctx, address, healthCheck)
+}
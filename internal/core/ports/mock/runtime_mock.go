@@ -109,6 +109,20 @@ func (mr *MockRuntimeMockRecorder) DeleteScheduler(ctx, scheduler interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScheduler", reflect.TypeOf((*MockRuntime)(nil).DeleteScheduler), ctx, scheduler)
 }
 
+// DryRunGameRoomInstance mocks base method.
+func (m *MockRuntime) DryRunGameRoomInstance(ctx context.Context, scheduler *entities.Scheduler, spec game_room.Spec) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRunGameRoomInstance", ctx, scheduler, spec)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DryRunGameRoomInstance indicates an expected call of DryRunGameRoomInstance.
+func (mr *MockRuntimeMockRecorder) DryRunGameRoomInstance(ctx, scheduler, spec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRunGameRoomInstance", reflect.TypeOf((*MockRuntime)(nil).DryRunGameRoomInstance), ctx, scheduler, spec)
+}
+
 // MitigateDisruption mocks base method.
 func (m *MockRuntime) MitigateDisruption(ctx context.Context, scheduler *entities.Scheduler, roomAmount int, safetyPercentage float64) error {
 	m.ctrl.T.Helper()
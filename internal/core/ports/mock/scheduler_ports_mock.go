@@ -39,6 +39,21 @@ func (m *MockSchedulerManager) EXPECT() *MockSchedulerManagerMockRecorder {
 	return m.recorder
 }
 
+// ApplySchedulers mocks base method.
+func (m *MockSchedulerManager) ApplySchedulers(ctx context.Context, game string, schedulers []*entities.Scheduler, prune bool) ([]*entities.SchedulerApplyStep, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplySchedulers", ctx, game, schedulers, prune)
+	ret0, _ := ret[0].([]*entities.SchedulerApplyStep)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplySchedulers indicates an expected call of ApplySchedulers.
+func (mr *MockSchedulerManagerMockRecorder) ApplySchedulers(ctx, game, schedulers, prune interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplySchedulers", reflect.TypeOf((*MockSchedulerManager)(nil).ApplySchedulers), ctx, game, schedulers, prune)
+}
+
 // CreateNewSchedulerVersion mocks base method.
 func (m *MockSchedulerManager) CreateNewSchedulerVersion(ctx context.Context, scheduler *entities.Scheduler) error {
 	m.ctrl.T.Helper()
@@ -53,6 +68,36 @@ func (mr *MockSchedulerManagerMockRecorder) CreateNewSchedulerVersion(ctx, sched
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNewSchedulerVersion", reflect.TypeOf((*MockSchedulerManager)(nil).CreateNewSchedulerVersion), ctx, scheduler)
 }
 
+// CreateNewSchedulerVersionAndEnqueueBlueGreenSwitch mocks base method.
+func (m *MockSchedulerManager) CreateNewSchedulerVersionAndEnqueueBlueGreenSwitch(ctx context.Context, scheduler *entities.Scheduler) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNewSchedulerVersionAndEnqueueBlueGreenSwitch", ctx, scheduler)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNewSchedulerVersionAndEnqueueBlueGreenSwitch indicates an expected call of CreateNewSchedulerVersionAndEnqueueBlueGreenSwitch.
+func (mr *MockSchedulerManagerMockRecorder) CreateNewSchedulerVersionAndEnqueueBlueGreenSwitch(ctx, scheduler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNewSchedulerVersionAndEnqueueBlueGreenSwitch", reflect.TypeOf((*MockSchedulerManager)(nil).CreateNewSchedulerVersionAndEnqueueBlueGreenSwitch), ctx, scheduler)
+}
+
+// CreateNewSchedulerVersionAndEnqueueCanaryRollout mocks base method.
+func (m *MockSchedulerManager) CreateNewSchedulerVersionAndEnqueueCanaryRollout(ctx context.Context, scheduler *entities.Scheduler) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNewSchedulerVersionAndEnqueueCanaryRollout", ctx, scheduler)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNewSchedulerVersionAndEnqueueCanaryRollout indicates an expected call of CreateNewSchedulerVersionAndEnqueueCanaryRollout.
+func (mr *MockSchedulerManagerMockRecorder) CreateNewSchedulerVersionAndEnqueueCanaryRollout(ctx, scheduler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNewSchedulerVersionAndEnqueueCanaryRollout", reflect.TypeOf((*MockSchedulerManager)(nil).CreateNewSchedulerVersionAndEnqueueCanaryRollout), ctx, scheduler)
+}
+
 // CreateNewSchedulerVersionAndEnqueueSwitchVersion mocks base method.
 func (m *MockSchedulerManager) CreateNewSchedulerVersionAndEnqueueSwitchVersion(ctx context.Context, scheduler *entities.Scheduler) (string, error) {
 	m.ctrl.T.Helper()
@@ -97,6 +142,36 @@ func (mr *MockSchedulerManagerMockRecorder) DeleteScheduler(ctx, schedulerName i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScheduler", reflect.TypeOf((*MockSchedulerManager)(nil).DeleteScheduler), ctx, schedulerName)
 }
 
+// DryRunNewSchedulerVersion mocks base method.
+func (m *MockSchedulerManager) DryRunNewSchedulerVersion(ctx context.Context, scheduler *entities.Scheduler) (*entities.SchedulerDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRunNewSchedulerVersion", ctx, scheduler)
+	ret0, _ := ret[0].(*entities.SchedulerDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DryRunNewSchedulerVersion indicates an expected call of DryRunNewSchedulerVersion.
+func (mr *MockSchedulerManagerMockRecorder) DryRunNewSchedulerVersion(ctx, scheduler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRunNewSchedulerVersion", reflect.TypeOf((*MockSchedulerManager)(nil).DryRunNewSchedulerVersion), ctx, scheduler)
+}
+
+// DryRunPatchScheduler mocks base method.
+func (m *MockSchedulerManager) DryRunPatchScheduler(ctx context.Context, schedulerName string, patchMap map[string]interface{}) (*entities.SchedulerDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRunPatchScheduler", ctx, schedulerName, patchMap)
+	ret0, _ := ret[0].(*entities.SchedulerDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DryRunPatchScheduler indicates an expected call of DryRunPatchScheduler.
+func (mr *MockSchedulerManagerMockRecorder) DryRunPatchScheduler(ctx, schedulerName, patchMap interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRunPatchScheduler", reflect.TypeOf((*MockSchedulerManager)(nil).DryRunPatchScheduler), ctx, schedulerName, patchMap)
+}
+
 // EnqueueDeleteSchedulerOperation mocks base method.
 func (m *MockSchedulerManager) EnqueueDeleteSchedulerOperation(ctx context.Context, schedulerName string) (*operation.Operation, error) {
 	m.ctrl.T.Helper()
@@ -113,18 +188,18 @@ func (mr *MockSchedulerManagerMockRecorder) EnqueueDeleteSchedulerOperation(ctx,
 }
 
 // EnqueueNewSchedulerVersionOperation mocks base method.
-func (m *MockSchedulerManager) EnqueueNewSchedulerVersionOperation(ctx context.Context, scheduler *entities.Scheduler) (*operation.Operation, error) {
+func (m *MockSchedulerManager) EnqueueNewSchedulerVersionOperation(ctx context.Context, scheduler *entities.Scheduler, idempotencyKey string) (*operation.Operation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnqueueNewSchedulerVersionOperation", ctx, scheduler)
+	ret := m.ctrl.Call(m, "EnqueueNewSchedulerVersionOperation", ctx, scheduler, idempotencyKey)
 	ret0, _ := ret[0].(*operation.Operation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // EnqueueNewSchedulerVersionOperation indicates an expected call of EnqueueNewSchedulerVersionOperation.
-func (mr *MockSchedulerManagerMockRecorder) EnqueueNewSchedulerVersionOperation(ctx, scheduler interface{}) *gomock.Call {
+func (mr *MockSchedulerManagerMockRecorder) EnqueueNewSchedulerVersionOperation(ctx, scheduler, idempotencyKey interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueNewSchedulerVersionOperation", reflect.TypeOf((*MockSchedulerManager)(nil).EnqueueNewSchedulerVersionOperation), ctx, scheduler)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueNewSchedulerVersionOperation", reflect.TypeOf((*MockSchedulerManager)(nil).EnqueueNewSchedulerVersionOperation), ctx, scheduler, idempotencyKey)
 }
 
 // EnqueueSwitchActiveVersionOperation mocks base method.
@@ -202,6 +277,21 @@ func (mr *MockSchedulerManagerMockRecorder) GetSchedulerVersions(ctx, schedulerN
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedulerVersions", reflect.TypeOf((*MockSchedulerManager)(nil).GetSchedulerVersions), ctx, schedulerName)
 }
 
+// GetSchedulerVersionsDiff mocks base method.
+func (m *MockSchedulerManager) GetSchedulerVersionsDiff(ctx context.Context, schedulerName, fromVersion, toVersion string) (*entities.SchedulerDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchedulerVersionsDiff", ctx, schedulerName, fromVersion, toVersion)
+	ret0, _ := ret[0].(*entities.SchedulerDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchedulerVersionsDiff indicates an expected call of GetSchedulerVersionsDiff.
+func (mr *MockSchedulerManagerMockRecorder) GetSchedulerVersionsDiff(ctx, schedulerName, fromVersion, toVersion interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedulerVersionsDiff", reflect.TypeOf((*MockSchedulerManager)(nil).GetSchedulerVersionsDiff), ctx, schedulerName, fromVersion, toVersion)
+}
+
 // GetSchedulersInfo mocks base method.
 func (m *MockSchedulerManager) GetSchedulersInfo(ctx context.Context, filter *filters.SchedulerFilter) ([]*entities.SchedulerInfo, error) {
 	m.ctrl.T.Helper()
@@ -233,18 +323,48 @@ func (mr *MockSchedulerManagerMockRecorder) GetSchedulersWithFilter(ctx, schedul
 }
 
 // PatchSchedulerAndCreateNewSchedulerVersionOperation mocks base method.
-func (m *MockSchedulerManager) PatchSchedulerAndCreateNewSchedulerVersionOperation(ctx context.Context, schedulerName string, patchMap map[string]interface{}) (*operation.Operation, error) {
+func (m *MockSchedulerManager) PatchSchedulerAndCreateNewSchedulerVersionOperation(ctx context.Context, schedulerName string, patchMap map[string]interface{}, idempotencyKey string) (*operation.Operation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PatchSchedulerAndCreateNewSchedulerVersionOperation", ctx, schedulerName, patchMap)
+	ret := m.ctrl.Call(m, "PatchSchedulerAndCreateNewSchedulerVersionOperation", ctx, schedulerName, patchMap, idempotencyKey)
 	ret0, _ := ret[0].(*operation.Operation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PatchSchedulerAndCreateNewSchedulerVersionOperation indicates an expected call of PatchSchedulerAndCreateNewSchedulerVersionOperation.
-func (mr *MockSchedulerManagerMockRecorder) PatchSchedulerAndCreateNewSchedulerVersionOperation(ctx, schedulerName, patchMap interface{}) *gomock.Call {
+func (mr *MockSchedulerManagerMockRecorder) PatchSchedulerAndCreateNewSchedulerVersionOperation(ctx, schedulerName, patchMap, idempotencyKey interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchSchedulerAndCreateNewSchedulerVersionOperation", reflect.TypeOf((*MockSchedulerManager)(nil).PatchSchedulerAndCreateNewSchedulerVersionOperation), ctx, schedulerName, patchMap)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchSchedulerAndCreateNewSchedulerVersionOperation", reflect.TypeOf((*MockSchedulerManager)(nil).PatchSchedulerAndCreateNewSchedulerVersionOperation), ctx, schedulerName, patchMap, idempotencyKey)
+}
+
+// PatchSchedulerVersion mocks base method.
+func (m *MockSchedulerManager) PatchSchedulerVersion(ctx context.Context, schedulerName, version string, pinned, blocked *bool) (*entities.SchedulerVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchSchedulerVersion", ctx, schedulerName, version, pinned, blocked)
+	ret0, _ := ret[0].(*entities.SchedulerVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchSchedulerVersion indicates an expected call of PatchSchedulerVersion.
+func (mr *MockSchedulerManagerMockRecorder) PatchSchedulerVersion(ctx, schedulerName, version, pinned, blocked interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchSchedulerVersion", reflect.TypeOf((*MockSchedulerManager)(nil).PatchSchedulerVersion), ctx, schedulerName, version, pinned, blocked)
+}
+
+// PlanSchedulersApply mocks base method.
+func (m *MockSchedulerManager) PlanSchedulersApply(ctx context.Context, game string, schedulers []*entities.Scheduler, prune bool) ([]*entities.SchedulerApplyStep, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanSchedulersApply", ctx, game, schedulers, prune)
+	ret0, _ := ret[0].([]*entities.SchedulerApplyStep)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanSchedulersApply indicates an expected call of PlanSchedulersApply.
+func (mr *MockSchedulerManagerMockRecorder) PlanSchedulersApply(ctx, game, schedulers, prune interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanSchedulersApply", reflect.TypeOf((*MockSchedulerManager)(nil).PlanSchedulersApply), ctx, game, schedulers, prune)
 }
 
 // UpdateScheduler mocks base method.
@@ -326,6 +446,20 @@ func (mr *MockSchedulerStorageMockRecorder) DeleteScheduler(ctx, transactionID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScheduler", reflect.TypeOf((*MockSchedulerStorage)(nil).DeleteScheduler), ctx, transactionID, scheduler)
 }
 
+// DeleteSchedulerVersions mocks base method.
+func (m *MockSchedulerStorage) DeleteSchedulerVersions(ctx context.Context, schedulerName string, versions []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSchedulerVersions", ctx, schedulerName, versions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSchedulerVersions indicates an expected call of DeleteSchedulerVersions.
+func (mr *MockSchedulerStorageMockRecorder) DeleteSchedulerVersions(ctx, schedulerName, versions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSchedulerVersions", reflect.TypeOf((*MockSchedulerStorage)(nil).DeleteSchedulerVersions), ctx, schedulerName, versions)
+}
+
 // GetAllSchedulers mocks base method.
 func (m *MockSchedulerStorage) GetAllSchedulers(ctx context.Context) ([]*entities.Scheduler, error) {
 	m.ctrl.T.Helper()
@@ -444,6 +578,20 @@ func (mr *MockSchedulerStorageMockRecorder) UpdateScheduler(ctx, scheduler inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScheduler", reflect.TypeOf((*MockSchedulerStorage)(nil).UpdateScheduler), ctx, scheduler)
 }
 
+// UpdateSchedulerVersion mocks base method.
+func (m *MockSchedulerStorage) UpdateSchedulerVersion(ctx context.Context, schedulerName string, schedulerVersion *entities.SchedulerVersion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSchedulerVersion", ctx, schedulerName, schedulerVersion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSchedulerVersion indicates an expected call of UpdateSchedulerVersion.
+func (mr *MockSchedulerStorageMockRecorder) UpdateSchedulerVersion(ctx, schedulerName, schedulerVersion interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedulerVersion", reflect.TypeOf((*MockSchedulerStorage)(nil).UpdateSchedulerVersion), ctx, schedulerName, schedulerVersion)
+}
+
 // MockSchedulerCache is a mock of SchedulerCache interface.
 type MockSchedulerCache struct {
 	ctrl     *gomock.Controller
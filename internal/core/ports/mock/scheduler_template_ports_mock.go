@@ -0,0 +1,238 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../internal/core/ports/scheduler_template_ports.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entities "github.com/topfreegames/maestro/internal/core/entities"
+	operation "github.com/topfreegames/maestro/internal/core/entities/operation"
+)
+
+// MockSchedulerTemplateManager is a mock of SchedulerTemplateManager interface.
+type MockSchedulerTemplateManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockSchedulerTemplateManagerMockRecorder
+}
+
+// MockSchedulerTemplateManagerMockRecorder is the mock recorder for MockSchedulerTemplateManager.
+type MockSchedulerTemplateManagerMockRecorder struct {
+	mock *MockSchedulerTemplateManager
+}
+
+// NewMockSchedulerTemplateManager creates a new mock instance.
+func NewMockSchedulerTemplateManager(ctrl *gomock.Controller) *MockSchedulerTemplateManager {
+	mock := &MockSchedulerTemplateManager{ctrl: ctrl}
+	mock.recorder = &MockSchedulerTemplateManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSchedulerTemplateManager) EXPECT() *MockSchedulerTemplateManagerMockRecorder {
+	return m.recorder
+}
+
+// CreateSchedulerFromTemplate mocks base method.
+func (m *MockSchedulerTemplateManager) CreateSchedulerFromTemplate(ctx context.Context, templateName, schedulerName, game string, overrides []*entities.TemplateContainerOverride) (*entities.Scheduler, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSchedulerFromTemplate", ctx, templateName, schedulerName, game, overrides)
+	ret0, _ := ret[0].(*entities.Scheduler)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSchedulerFromTemplate indicates an expected call of CreateSchedulerFromTemplate.
+func (mr *MockSchedulerTemplateManagerMockRecorder) CreateSchedulerFromTemplate(ctx, templateName, schedulerName, game, overrides interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedulerFromTemplate", reflect.TypeOf((*MockSchedulerTemplateManager)(nil).CreateSchedulerFromTemplate), ctx, templateName, schedulerName, game, overrides)
+}
+
+// CreateSchedulerTemplate mocks base method.
+func (m *MockSchedulerTemplateManager) CreateSchedulerTemplate(ctx context.Context, template *entities.SchedulerTemplate) (*entities.SchedulerTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSchedulerTemplate", ctx, template)
+	ret0, _ := ret[0].(*entities.SchedulerTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSchedulerTemplate indicates an expected call of CreateSchedulerTemplate.
+func (mr *MockSchedulerTemplateManagerMockRecorder) CreateSchedulerTemplate(ctx, template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedulerTemplate", reflect.TypeOf((*MockSchedulerTemplateManager)(nil).CreateSchedulerTemplate), ctx, template)
+}
+
+// GetAllSchedulerTemplates mocks base method.
+func (m *MockSchedulerTemplateManager) GetAllSchedulerTemplates(ctx context.Context) ([]*entities.SchedulerTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllSchedulerTemplates", ctx)
+	ret0, _ := ret[0].([]*entities.SchedulerTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllSchedulerTemplates indicates an expected call of GetAllSchedulerTemplates.
+func (mr *MockSchedulerTemplateManagerMockRecorder) GetAllSchedulerTemplates(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSchedulerTemplates", reflect.TypeOf((*MockSchedulerTemplateManager)(nil).GetAllSchedulerTemplates), ctx)
+}
+
+// GetDerivedSchedulers mocks base method.
+func (m *MockSchedulerTemplateManager) GetDerivedSchedulers(ctx context.Context, templateName string) ([]*entities.DerivedScheduler, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDerivedSchedulers", ctx, templateName)
+	ret0, _ := ret[0].([]*entities.DerivedScheduler)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDerivedSchedulers indicates an expected call of GetDerivedSchedulers.
+func (mr *MockSchedulerTemplateManagerMockRecorder) GetDerivedSchedulers(ctx, templateName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDerivedSchedulers", reflect.TypeOf((*MockSchedulerTemplateManager)(nil).GetDerivedSchedulers), ctx, templateName)
+}
+
+// GetSchedulerTemplate mocks base method.
+func (m *MockSchedulerTemplateManager) GetSchedulerTemplate(ctx context.Context, name string) (*entities.SchedulerTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchedulerTemplate", ctx, name)
+	ret0, _ := ret[0].(*entities.SchedulerTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchedulerTemplate indicates an expected call of GetSchedulerTemplate.
+func (mr *MockSchedulerTemplateManagerMockRecorder) GetSchedulerTemplate(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedulerTemplate", reflect.TypeOf((*MockSchedulerTemplateManager)(nil).GetSchedulerTemplate), ctx, name)
+}
+
+// PatchSchedulerFromTemplate mocks base method.
+func (m *MockSchedulerTemplateManager) PatchSchedulerFromTemplate(ctx context.Context, schedulerName, templateName string, overrides []*entities.TemplateContainerOverride) (*operation.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchSchedulerFromTemplate", ctx, schedulerName, templateName, overrides)
+	ret0, _ := ret[0].(*operation.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchSchedulerFromTemplate indicates an expected call of PatchSchedulerFromTemplate.
+func (mr *MockSchedulerTemplateManagerMockRecorder) PatchSchedulerFromTemplate(ctx, schedulerName, templateName, overrides interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchSchedulerFromTemplate", reflect.TypeOf((*MockSchedulerTemplateManager)(nil).PatchSchedulerFromTemplate), ctx, schedulerName, templateName, overrides)
+}
+
+// PropagateSchedulerTemplate mocks base method.
+func (m *MockSchedulerTemplateManager) PropagateSchedulerTemplate(ctx context.Context, templateName string) ([]*entities.SchedulerTemplatePropagation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PropagateSchedulerTemplate", ctx, templateName)
+	ret0, _ := ret[0].([]*entities.SchedulerTemplatePropagation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PropagateSchedulerTemplate indicates an expected call of PropagateSchedulerTemplate.
+func (mr *MockSchedulerTemplateManagerMockRecorder) PropagateSchedulerTemplate(ctx, templateName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PropagateSchedulerTemplate", reflect.TypeOf((*MockSchedulerTemplateManager)(nil).PropagateSchedulerTemplate), ctx, templateName)
+}
+
+// UpdateSchedulerTemplate mocks base method.
+func (m *MockSchedulerTemplateManager) UpdateSchedulerTemplate(ctx context.Context, template *entities.SchedulerTemplate) (*entities.SchedulerTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSchedulerTemplate", ctx, template)
+	ret0, _ := ret[0].(*entities.SchedulerTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSchedulerTemplate indicates an expected call of UpdateSchedulerTemplate.
+func (mr *MockSchedulerTemplateManagerMockRecorder) UpdateSchedulerTemplate(ctx, template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedulerTemplate", reflect.TypeOf((*MockSchedulerTemplateManager)(nil).UpdateSchedulerTemplate), ctx, template)
+}
+
+// MockSchedulerTemplateStorage is a mock of SchedulerTemplateStorage interface.
+type MockSchedulerTemplateStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockSchedulerTemplateStorageMockRecorder
+}
+
+// MockSchedulerTemplateStorageMockRecorder is the mock recorder for MockSchedulerTemplateStorage.
+type MockSchedulerTemplateStorageMockRecorder struct {
+	mock *MockSchedulerTemplateStorage
+}
+
+// NewMockSchedulerTemplateStorage creates a new mock instance.
+func NewMockSchedulerTemplateStorage(ctrl *gomock.Controller) *MockSchedulerTemplateStorage {
+	mock := &MockSchedulerTemplateStorage{ctrl: ctrl}
+	mock.recorder = &MockSchedulerTemplateStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSchedulerTemplateStorage) EXPECT() *MockSchedulerTemplateStorageMockRecorder {
+	return m.recorder
+}
+
+// CreateSchedulerTemplate mocks base method.
+func (m *MockSchedulerTemplateStorage) CreateSchedulerTemplate(ctx context.Context, template *entities.SchedulerTemplate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSchedulerTemplate", ctx, template)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSchedulerTemplate indicates an expected call of CreateSchedulerTemplate.
+func (mr *MockSchedulerTemplateStorageMockRecorder) CreateSchedulerTemplate(ctx, template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedulerTemplate", reflect.TypeOf((*MockSchedulerTemplateStorage)(nil).CreateSchedulerTemplate), ctx, template)
+}
+
+// GetAllSchedulerTemplates mocks base method.
+func (m *MockSchedulerTemplateStorage) GetAllSchedulerTemplates(ctx context.Context) ([]*entities.SchedulerTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllSchedulerTemplates", ctx)
+	ret0, _ := ret[0].([]*entities.SchedulerTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllSchedulerTemplates indicates an expected call of GetAllSchedulerTemplates.
+func (mr *MockSchedulerTemplateStorageMockRecorder) GetAllSchedulerTemplates(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSchedulerTemplates", reflect.TypeOf((*MockSchedulerTemplateStorage)(nil).GetAllSchedulerTemplates), ctx)
+}
+
+// GetSchedulerTemplate mocks base method.
+func (m *MockSchedulerTemplateStorage) GetSchedulerTemplate(ctx context.Context, name string) (*entities.SchedulerTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchedulerTemplate", ctx, name)
+	ret0, _ := ret[0].(*entities.SchedulerTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchedulerTemplate indicates an expected call of GetSchedulerTemplate.
+func (mr *MockSchedulerTemplateStorageMockRecorder) GetSchedulerTemplate(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedulerTemplate", reflect.TypeOf((*MockSchedulerTemplateStorage)(nil).GetSchedulerTemplate), ctx, name)
+}
+
+// UpdateSchedulerTemplate mocks base method.
+func (m *MockSchedulerTemplateStorage) UpdateSchedulerTemplate(ctx context.Context, template *entities.SchedulerTemplate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSchedulerTemplate", ctx, template)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSchedulerTemplate indicates an expected call of UpdateSchedulerTemplate.
+func (mr *MockSchedulerTemplateStorageMockRecorder) UpdateSchedulerTemplate(ctx, template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedulerTemplate", reflect.TypeOf((*MockSchedulerTemplateStorage)(nil).UpdateSchedulerTemplate), ctx, template)
+}
@@ -34,11 +34,12 @@ import (
 // Primary ports (input, driving ports)
 
 type OperationManager interface {
-	// CreateOperation creates a new operation for the given scheduler and includes it in the execution process.
+	// CreateOperation creates a new operation for the given scheduler and includes it in the execution process,
+	// enqueued according to the definition priority.
 	CreateOperation(ctx context.Context, schedulerName string, definition operations.Definition) (*operation.Operation, error)
-	// CreatePriorityOperation creates a new priority operation (it'll run before other enqueued operation)
-	// for the given scheduler and includes it in the execution process.
-	CreatePriorityOperation(ctx context.Context, schedulerName string, definition operations.Definition) (*operation.Operation, error)
+	// CreateOperationWithIdempotencyKey creates a new operation like CreateOperation, unless an operation was already
+	// created for the scheduler with the same idempotency key within the retention window, returning it instead.
+	CreateOperationWithIdempotencyKey(ctx context.Context, schedulerName, idempotencyKey string, definition operations.Definition) (*operation.Operation, error)
 	// GetOperation retrieves the operation and its definition.
 	GetOperation(ctx context.Context, schedulerName, operationID string) (*operation.Operation, operations.Definition, error)
 	// PendingOperationsChan returns a read-only channel of pending operations.
@@ -47,7 +48,8 @@ type OperationManager interface {
 	StartOperation(ctx context.Context, op *operation.Operation, cancelFunction context.CancelFunc) error
 	// FinishOperation used when an operation has finished executing, with error or not.
 	FinishOperation(ctx context.Context, op *operation.Operation, def operations.Definition) error
-	// ListSchedulerPendingOperations returns a list of operations with pending status for the given scheduler.
+	// ListSchedulerPendingOperations returns a list of operations with pending status for the given scheduler,
+	// in the order they will be executed.
 	ListSchedulerPendingOperations(ctx context.Context, schedulerName string) ([]*operation.Operation, error)
 	// ListSchedulerActiveOperations returns a list of operations with active status for the given scheduler.
 	ListSchedulerActiveOperations(ctx context.Context, schedulerName string) ([]*operation.Operation, error)
@@ -65,24 +67,54 @@ type OperationManager interface {
 	StartLeaseRenewGoRoutine(ctx context.Context, op *operation.Operation)
 	// AppendOperationEventToExecutionHistory add operation event to execution history
 	AppendOperationEventToExecutionHistory(ctx context.Context, op *operation.Operation, eventMessage string)
+	// StartOperationProgress sets the total units of work the operation will process and persists it.
+	StartOperationProgress(ctx context.Context, op *operation.Operation, totalUnits int)
+	// UpdateOperationProgress adds completed and failed units to the operation progress and persists it.
+	UpdateOperationProgress(ctx context.Context, op *operation.Operation, completedUnits, failedUnits int)
+	// WatchOperation returns a channel that receives the operation every time it changes, until it reaches a final status.
+	WatchOperation(ctx context.Context, schedulerName, operationID string) (<-chan *operation.Operation, error)
+	// WatchSchedulerOperations returns a channel that receives the scheduler operations every time they are created or changed.
+	WatchSchedulerOperations(ctx context.Context, schedulerName string) (<-chan *operation.Operation, error)
+	// PauseSchedulerOperations pauses the scheduler operations processing, only allowed operations are executed until it is resumed.
+	PauseSchedulerOperations(ctx context.Context, schedulerName, reason string) error
+	// ResumeSchedulerOperations resumes the scheduler operations processing, including the operations held while it was paused.
+	ResumeSchedulerOperations(ctx context.Context, schedulerName string) error
+	// GetSchedulerOperationsPause returns the scheduler operations pause, or nil if the scheduler operations processing is not paused.
+	GetSchedulerOperationsPause(ctx context.Context, schedulerName string) (*operation.OperationsPause, error)
+	// HoldOperation keeps the operation pending until the scheduler operations processing is resumed, returning false when it was already resumed.
+	HoldOperation(ctx context.Context, op *operation.Operation) (bool, error)
 }
 
 // Secondary ports (output, driven ports)
 
 type OperationFlow interface {
-	InsertOperationID(ctx context.Context, schedulerName, operationID string) error
-	// InsertPriorityOperationID inserts the operationID on the top of pending operations list.
-	InsertPriorityOperationID(ctx context.Context, schedulerName, operationID string) error
+	// InsertOperationID inserts the operationID at the end of the pending
+	// operations of the given priority.
+	InsertOperationID(ctx context.Context, schedulerName, operationID string, priority operation.Priority) error
 	// NextOperationID fetches the next scheduler operation to be processed and return its ID.
 	NextOperationID(ctx context.Context, schedulerName string) (string, error)
 	// RemoveNextOperation removes the next operation from the operation flow.
 	RemoveNextOperation(ctx context.Context, schedulerName string) error
-	// ListSchedulerPendingOperationIDs list scheduler pending operation IDs.
+	// ListSchedulerPendingOperationIDs list scheduler pending operation IDs, in the order they will be processed.
 	ListSchedulerPendingOperationIDs(ctx context.Context, schedulerName string) ([]string, error)
 	// EnqueueOperationCancellationRequest enqueue a operation cancellation request
 	EnqueueOperationCancellationRequest(ctx context.Context, request OperationCancellationRequest) error
 	// WatchOperationCancellationRequests watches for operation cancellation requests
 	WatchOperationCancellationRequests(ctx context.Context) chan OperationCancellationRequest
+	// PauseOperations pauses the scheduler operations processing with the given reason.
+	PauseOperations(ctx context.Context, schedulerName, reason string) error
+	// ResumeOperations resumes the scheduler operations processing, moving the
+	// held operations back to the top of the pending operations list of their
+	// priority.
+	ResumeOperations(ctx context.Context, schedulerName string) error
+	// GetOperationsPause returns the scheduler operations pause, or nil if the
+	// scheduler operations processing is not paused.
+	GetOperationsPause(ctx context.Context, schedulerName string) (*operation.OperationsPause, error)
+	// HoldOperationID keeps the operation ID aside, with its priority, until
+	// the scheduler operations processing is resumed. It is checked on the
+	// same step that the processing is still paused, returning false without
+	// holding the operation when it isn't.
+	HoldOperationID(ctx context.Context, schedulerName, operationID string, priority operation.Priority) (bool, error)
 }
 
 type OperationStorage interface {
@@ -98,12 +130,23 @@ type OperationStorage interface {
 	UpdateOperationStatus(ctx context.Context, schedulerName, operationID string, status operation.Status) error
 	// UpdateOperationExecutionHistory updates the operation execution history.
 	UpdateOperationExecutionHistory(ctx context.Context, op *operation.Operation) error
+	// UpdateOperationProgress updates the operation progress.
+	UpdateOperationProgress(ctx context.Context, op *operation.Operation) error
+	// CreateOperationWithIdempotencyKey atomically creates the operation and
+	// associates the idempotency key to it for the given TTL. If the key is
+	// already associated to an operation, nothing is created and the
+	// associated operation ID is returned.
+	CreateOperationWithIdempotencyKey(ctx context.Context, op *operation.Operation, idempotencyKey string, ttl time.Duration) (string, error)
 	// CleanOperationsHistory clears the operation execution history.
 	CleanOperationsHistory(ctx context.Context, schedulerName string) error
 	// CleanExpiredOperations remove from storage all references to the expired operations.
 	CleanExpiredOperations(ctx context.Context, schedulerName string) error
 	// UpdateOperationDefinition updates the operation definition.
 	UpdateOperationDefinition(ctx context.Context, schedulerName string, operationID string, def operations.Definition) error
+	// WatchSchedulerOperationsUpdates returns a channel that receives the ID of
+	// the scheduler operations every time one is created or updated. The
+	// channel is closed when the context is done.
+	WatchSchedulerOperationsUpdates(ctx context.Context, schedulerName string) (chan string, error)
 }
 
 type OperationLeaseStorage interface {
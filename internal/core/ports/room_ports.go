@@ -29,6 +29,7 @@ import (
 
 	"github.com/topfreegames/maestro/internal/core/entities"
 	"github.com/topfreegames/maestro/internal/core/entities/game_room"
+	"github.com/topfreegames/maestro/internal/core/entities/rollout"
 )
 
 // Primary ports (input, driving ports)
@@ -41,6 +42,9 @@ type RoomManager interface {
 	// SchedulerMaxSurge calculates the current scheduler max surge based on
 	// the number of rooms the scheduler has.
 	SchedulerMaxSurge(ctx context.Context, scheduler *entities.Scheduler) (int, error)
+	// SchedulerMaxUnavailable calculates the current scheduler max unavailable
+	// rooms based on the number of rooms the scheduler has.
+	SchedulerMaxUnavailable(ctx context.Context, scheduler *entities.Scheduler) (int, error)
 	// ListRoomsWithDeletionPriority returns a specified number of rooms, following
 	// the priority of it being deleted and filtering the ignored version,
 	// the function will return rooms discarding such filter option.
@@ -56,6 +60,9 @@ type RoomManager interface {
 	// This function can return less rooms than the `amount` since it might not have
 	// enough rooms on the scheduler.
 	ListRoomsWithDeletionPriority(ctx context.Context, schedulerName, ignoredVersion string, amount int, roomsBeingReplaced *sync.Map) ([]*game_room.GameRoom, error)
+	// ListRoomsByVersion returns every scheduler room created on the given
+	// version, as they are in the room storage.
+	ListRoomsByVersion(ctx context.Context, schedulerName, version string) ([]*game_room.GameRoom, error)
 	// CleanRoomState cleans the remaining state of a room. This function is
 	// intended to be used after a `DeleteRoom`, where the room instance is
 	// signaled to terminate.
@@ -66,6 +73,9 @@ type RoomManager interface {
 	UpdateRoomInstance(ctx context.Context, gameRoomInstance *game_room.Instance) error
 	// UpdateRoom updates the game room information.
 	UpdateRoom(ctx context.Context, gameRoom *game_room.GameRoom) error
+	// ForwardRoomStatus forwards the current ping status of the game room,
+	// even if it didn't change since the last forwarded event.
+	ForwardRoomStatus(ctx context.Context, gameRoom *game_room.GameRoom) error
 	// CreateRoom creates a game room in maestro runtime and storages without waiting the room to reach ready status.
 	CreateRoom(ctx context.Context, scheduler entities.Scheduler, isValidationRoom bool) (*game_room.GameRoom, *game_room.Instance, error)
 	// GetRoomInstance returns the game room instance.
@@ -77,6 +87,15 @@ type RoomManager interface {
 	WaitRoomStatus(ctx context.Context, gameRoom *game_room.GameRoom, status []game_room.GameRoomStatus) (game_room.GameRoomStatus, error)
 }
 
+// RoomTimelineManager keeps the timeline of what happened to each room.
+type RoomTimelineManager interface {
+	// RecordRoomTimelineEntry adds the entry to the room timeline. It is
+	// best-effort, failures are only logged.
+	RecordRoomTimelineEntry(ctx context.Context, schedulerName, roomID string, entry *game_room.TimelineEntry)
+	// GetRoomTimeline returns the room timeline, oldest entry first.
+	GetRoomTimeline(ctx context.Context, schedulerName, roomID string) ([]*game_room.TimelineEntry, error)
+}
+
 // Secondary Ports (output, driven ports)
 
 // RoomStorage is an interface for retrieving and updating room status and ping information
@@ -107,6 +126,16 @@ type RoomStorage interface {
 	WatchRoomStatus(ctx context.Context, room *game_room.GameRoom) (RoomStorageStatusWatcher, error)
 }
 
+// RoomTimelineStorage keeps the rooms timeline, bounding the entries kept for
+// each room and expiring the timelines of rooms that stop changing.
+type RoomTimelineStorage interface {
+	// AddRoomTimelineEntry appends the entry to the room timeline.
+	AddRoomTimelineEntry(ctx context.Context, scheduler, roomID string, entry *game_room.TimelineEntry) error
+	// GetRoomTimeline returns the room timeline, oldest entry first, which is
+	// empty when there's none.
+	GetRoomTimeline(ctx context.Context, scheduler, roomID string) ([]*game_room.TimelineEntry, error)
+}
+
 // RoomStorageStatusWatcher defines a process of watcher, it will have a chan
 // with the game rooms status changes.
 type RoomStorageStatusWatcher interface {
@@ -115,3 +144,10 @@ type RoomStorageStatusWatcher interface {
 	// Stop stops the watcher.
 	Stop()
 }
+
+// RoomHealthChecker checks if a game room is serving requests on its address.
+type RoomHealthChecker interface {
+	// Check sends the health check to the game room listening on the address
+	// (host:port), returning an error if the room is not healthy.
+	Check(ctx context.Context, address string, healthCheck *rollout.HealthCheckParams) error
+}
@@ -39,6 +39,9 @@ type Runtime interface {
 	// CreateGameRoomInstance Creates a game room instance on the runtime using
 	// the specification provided.
 	CreateGameRoomInstance(ctx context.Context, scheduler *entities.Scheduler, gameRoomName string, spec game_room.Spec) (*game_room.Instance, error)
+	// DryRunGameRoomInstance Validates a game room instance on the runtime
+	// using the specification provided, without creating it.
+	DryRunGameRoomInstance(ctx context.Context, scheduler *entities.Scheduler, spec game_room.Spec) error
 	// DeleteGameRoomInstance Deletes a game room instance on the runtime.
 	DeleteGameRoomInstance(ctx context.Context, gameRoomInstance *game_room.Instance, reason string) error
 	// WatchGameRoomInstances Watches for changes of a scheduler game room instances.
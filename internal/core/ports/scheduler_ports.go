@@ -38,17 +38,25 @@ type SchedulerManager interface {
 	GetActiveScheduler(ctx context.Context, schedulerName string) (*entities.Scheduler, error)
 	GetSchedulerByVersion(ctx context.Context, schedulerName, schedulerVersion string) (*entities.Scheduler, error)
 	CreateNewSchedulerVersionAndEnqueueSwitchVersion(ctx context.Context, scheduler *entities.Scheduler) (string, error)
+	CreateNewSchedulerVersionAndEnqueueCanaryRollout(ctx context.Context, scheduler *entities.Scheduler) (string, error)
+	CreateNewSchedulerVersionAndEnqueueBlueGreenSwitch(ctx context.Context, scheduler *entities.Scheduler) (string, error)
 	CreateNewSchedulerVersion(ctx context.Context, scheduler *entities.Scheduler) error
+	PatchSchedulerVersion(ctx context.Context, schedulerName, version string, pinned, blocked *bool) (*entities.SchedulerVersion, error)
 	EnqueueSwitchActiveVersionOperation(ctx context.Context, schedulerName, newVersion string) (*operation.Operation, error)
 	EnqueueDeleteSchedulerOperation(ctx context.Context, schedulerName string) (*operation.Operation, error)
 	GetSchedulersInfo(ctx context.Context, filter *filters.SchedulerFilter) ([]*entities.SchedulerInfo, error)
 	GetSchedulerVersions(ctx context.Context, schedulerName string) ([]*entities.SchedulerVersion, error)
+	GetSchedulerVersionsDiff(ctx context.Context, schedulerName, fromVersion, toVersion string) (*entities.SchedulerDiff, error)
 	DeleteScheduler(ctx context.Context, schedulerName string) error
-	PatchSchedulerAndCreateNewSchedulerVersionOperation(ctx context.Context, schedulerName string, patchMap map[string]interface{}) (*operation.Operation, error)
+	PatchSchedulerAndCreateNewSchedulerVersionOperation(ctx context.Context, schedulerName string, patchMap map[string]interface{}, idempotencyKey string) (*operation.Operation, error)
 	GetSchedulersWithFilter(ctx context.Context, schedulerFilter *filters.SchedulerFilter) ([]*entities.Scheduler, error)
 	GetScheduler(ctx context.Context, schedulerName, version string) (*entities.Scheduler, error)
-	EnqueueNewSchedulerVersionOperation(ctx context.Context, scheduler *entities.Scheduler) (*operation.Operation, error)
+	EnqueueNewSchedulerVersionOperation(ctx context.Context, scheduler *entities.Scheduler, idempotencyKey string) (*operation.Operation, error)
+	DryRunNewSchedulerVersion(ctx context.Context, scheduler *entities.Scheduler) (*entities.SchedulerDiff, error)
+	DryRunPatchScheduler(ctx context.Context, schedulerName string, patchMap map[string]interface{}) (*entities.SchedulerDiff, error)
 	CreateScheduler(ctx context.Context, scheduler *entities.Scheduler) (*entities.Scheduler, error)
+	PlanSchedulersApply(ctx context.Context, game string, schedulers []*entities.Scheduler, prune bool) ([]*entities.SchedulerApplyStep, error)
+	ApplySchedulers(ctx context.Context, game string, schedulers []*entities.Scheduler, prune bool) ([]*entities.SchedulerApplyStep, error)
 }
 
 // Secondary ports (output, driven ports)
@@ -57,6 +65,8 @@ type SchedulerStorage interface {
 	GetScheduler(ctx context.Context, name string) (*entities.Scheduler, error)
 	GetSchedulerWithFilter(ctx context.Context, schedulerFilter *filters.SchedulerFilter) (*entities.Scheduler, error)
 	GetSchedulerVersions(ctx context.Context, name string) ([]*entities.SchedulerVersion, error)
+	UpdateSchedulerVersion(ctx context.Context, schedulerName string, schedulerVersion *entities.SchedulerVersion) error
+	DeleteSchedulerVersions(ctx context.Context, schedulerName string, versions []string) error
 	GetSchedulers(ctx context.Context, names []string) ([]*entities.Scheduler, error)
 	GetSchedulersWithFilter(ctx context.Context, schedulerFilter *filters.SchedulerFilter) ([]*entities.Scheduler, error)
 	GetAllSchedulers(ctx context.Context) ([]*entities.Scheduler, error)
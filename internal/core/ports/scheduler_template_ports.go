@@ -0,0 +1,52 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package ports
+
+import (
+	"context"
+
+	"github.com/topfreegames/maestro/internal/core/entities"
+	"github.com/topfreegames/maestro/internal/core/entities/operation"
+)
+
+// Primary ports (input, driving ports)
+
+type SchedulerTemplateManager interface {
+	GetSchedulerTemplate(ctx context.Context, name string) (*entities.SchedulerTemplate, error)
+	GetAllSchedulerTemplates(ctx context.Context) ([]*entities.SchedulerTemplate, error)
+	CreateSchedulerTemplate(ctx context.Context, template *entities.SchedulerTemplate) (*entities.SchedulerTemplate, error)
+	UpdateSchedulerTemplate(ctx context.Context, template *entities.SchedulerTemplate) (*entities.SchedulerTemplate, error)
+	CreateSchedulerFromTemplate(ctx context.Context, templateName, schedulerName, game string, overrides []*entities.TemplateContainerOverride) (*entities.Scheduler, error)
+	PatchSchedulerFromTemplate(ctx context.Context, schedulerName, templateName string, overrides []*entities.TemplateContainerOverride) (*operation.Operation, error)
+	GetDerivedSchedulers(ctx context.Context, templateName string) ([]*entities.DerivedScheduler, error)
+	PropagateSchedulerTemplate(ctx context.Context, templateName string) ([]*entities.SchedulerTemplatePropagation, error)
+}
+
+// Secondary ports (output, driven ports)
+
+type SchedulerTemplateStorage interface {
+	GetSchedulerTemplate(ctx context.Context, name string) (*entities.SchedulerTemplate, error)
+	GetAllSchedulerTemplates(ctx context.Context) ([]*entities.SchedulerTemplate, error)
+	CreateSchedulerTemplate(ctx context.Context, template *entities.SchedulerTemplate) error
+	UpdateSchedulerTemplate(ctx context.Context, template *entities.SchedulerTemplate) error
+}
@@ -0,0 +1,108 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package events
+
+import (
+	"context"
+	"strings"
+
+	"github.com/topfreegames/maestro/internal/core/entities/events"
+	"github.com/topfreegames/maestro/internal/core/ports"
+	"github.com/topfreegames/maestro/internal/core/ports/errors"
+)
+
+var (
+	_ ports.DeadLetterEventsManager = (*DeadLetterEventsManager)(nil)
+)
+
+// DeadLetterEventsManager lists and replays the events the dispatcher
+// couldn't forward.
+type DeadLetterEventsManager struct {
+	outbox           ports.EventsOutbox
+	schedulerStorage ports.SchedulerStorage
+}
+
+func NewDeadLetterEventsManager(outbox ports.EventsOutbox, schedulerStorage ports.SchedulerStorage) ports.DeadLetterEventsManager {
+	return &DeadLetterEventsManager{
+		outbox:           outbox,
+		schedulerStorage: schedulerStorage,
+	}
+}
+
+func (m *DeadLetterEventsManager) ListDeadLetterEvents(ctx context.Context, schedulerName string) ([]*events.EventDelivery, error) {
+	if _, err := m.schedulerStorage.GetScheduler(ctx, schedulerName); err != nil {
+		return nil, err
+	}
+
+	return m.outbox.ListDeadLetters(ctx, schedulerName)
+}
+
+// ReplayDeadLetterEvents enqueues the dead-letter events again, with their
+// attempts reset, and removes them from the dead-letter events.
+func (m *DeadLetterEventsManager) ReplayDeadLetterEvents(ctx context.Context, schedulerName string, ids []string) ([]*events.EventDelivery, error) {
+	deadLetters, err := m.ListDeadLetterEvents(ctx, schedulerName)
+	if err != nil {
+		return nil, err
+	}
+
+	replayed := deadLetters
+	if len(ids) > 0 {
+		deadLettersByID := make(map[string]*events.EventDelivery, len(deadLetters))
+		for _, deadLetter := range deadLetters {
+			deadLettersByID[deadLetter.ID] = deadLetter
+		}
+
+		replayed = make([]*events.EventDelivery, 0, len(ids))
+		var notFound []string
+		for _, id := range ids {
+			deadLetter, ok := deadLettersByID[id]
+			if !ok {
+				notFound = append(notFound, id)
+				continue
+			}
+			replayed = append(replayed, deadLetter)
+		}
+		if len(notFound) > 0 {
+			return nil, errors.NewErrNotFound("dead-letter events not found: %s", strings.Join(notFound, ", "))
+		}
+	}
+	if len(replayed) == 0 {
+		return replayed, nil
+	}
+
+	replayedIDs := make([]string, len(replayed))
+	deliveries := make([]*events.EventDelivery, len(replayed))
+	for i, deadLetter := range replayed {
+		replayedIDs[i] = deadLetter.ID
+		deliveries[i] = &events.EventDelivery{Event: deadLetter.Event, ForwarderName: deadLetter.ForwarderName}
+	}
+
+	if err = m.outbox.Enqueue(ctx, deliveries); err != nil {
+		return nil, err
+	}
+	if err = m.outbox.RemoveDeadLetters(ctx, schedulerName, replayedIDs); err != nil {
+		return nil, err
+	}
+
+	return replayed, nil
+}
@@ -0,0 +1,132 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build unit
+// +build unit
+
+package events_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/topfreegames/maestro/internal/core/entities"
+	"github.com/topfreegames/maestro/internal/core/entities/events"
+	portsErrors "github.com/topfreegames/maestro/internal/core/ports/errors"
+	mockports "github.com/topfreegames/maestro/internal/core/ports/mock"
+	eventsservice "github.com/topfreegames/maestro/internal/core/services/events"
+)
+
+func TestDeadLetterEventsManager_ListDeadLetterEvents(t *testing.T) {
+	t.Run("returns the scheduler dead-letter events", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		outbox := mockports.NewMockEventsOutbox(mockCtrl)
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		manager := eventsservice.NewDeadLetterEventsManager(outbox, schedulerStorage)
+
+		deadLetters := []*events.EventDelivery{{ID: "1-0", Event: &events.Event{SchedulerID: "scheduler"}, ForwarderName: "fwd", Attempts: 5}}
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), "scheduler").Return(&entities.Scheduler{Name: "scheduler"}, nil)
+		outbox.EXPECT().ListDeadLetters(gomock.Any(), "scheduler").Return(deadLetters, nil)
+
+		result, err := manager.ListDeadLetterEvents(context.Background(), "scheduler")
+		require.NoError(t, err)
+		require.Equal(t, deadLetters, result)
+	})
+
+	t.Run("fails when the scheduler doesn't exist", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		outbox := mockports.NewMockEventsOutbox(mockCtrl)
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		manager := eventsservice.NewDeadLetterEventsManager(outbox, schedulerStorage)
+
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), "scheduler").Return(nil, portsErrors.NewErrNotFound("scheduler not found"))
+
+		_, err := manager.ListDeadLetterEvents(context.Background(), "scheduler")
+		require.ErrorIs(t, err, portsErrors.ErrNotFound)
+	})
+}
+
+func TestDeadLetterEventsManager_ReplayDeadLetterEvents(t *testing.T) {
+	event := &events.Event{Name: events.PlayerEvent, SchedulerID: "scheduler", RoomID: "room"}
+	deadLetters := []*events.EventDelivery{
+		{ID: "1-0", Event: event, ForwarderName: "fwd-1", Attempts: 5, LastError: "error"},
+		{ID: "2-0", Event: event, ForwarderName: "fwd-2", Attempts: 5, LastError: "error"},
+	}
+
+	setup := func(t *testing.T) (*mockports.MockEventsOutbox, *eventsservice.DeadLetterEventsManager) {
+		mockCtrl := gomock.NewController(t)
+		outbox := mockports.NewMockEventsOutbox(mockCtrl)
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), "scheduler").Return(&entities.Scheduler{Name: "scheduler"}, nil)
+		outbox.EXPECT().ListDeadLetters(gomock.Any(), "scheduler").Return(deadLetters, nil)
+		return outbox, eventsservice.NewDeadLetterEventsManager(outbox, schedulerStorage).(*eventsservice.DeadLetterEventsManager)
+	}
+
+	t.Run("replays every dead-letter event when no id is informed", func(t *testing.T) {
+		outbox, manager := setup(t)
+
+		outbox.EXPECT().Enqueue(gomock.Any(), []*events.EventDelivery{
+			{Event: event, ForwarderName: "fwd-1"},
+			{Event: event, ForwarderName: "fwd-2"},
+		}).Return(nil)
+		outbox.EXPECT().RemoveDeadLetters(gomock.Any(), "scheduler", []string{"1-0", "2-0"}).Return(nil)
+
+		replayed, err := manager.ReplayDeadLetterEvents(context.Background(), "scheduler", nil)
+		require.NoError(t, err)
+		require.Equal(t, deadLetters, replayed)
+	})
+
+	t.Run("replays only the informed dead-letter events", func(t *testing.T) {
+		outbox, manager := setup(t)
+
+		outbox.EXPECT().Enqueue(gomock.Any(), []*events.EventDelivery{{Event: event, ForwarderName: "fwd-2"}}).Return(nil)
+		outbox.EXPECT().RemoveDeadLetters(gomock.Any(), "scheduler", []string{"2-0"}).Return(nil)
+
+		replayed, err := manager.ReplayDeadLetterEvents(context.Background(), "scheduler", []string{"2-0"})
+		require.NoError(t, err)
+		require.Equal(t, deadLetters[1:], replayed)
+	})
+
+	t.Run("fails when an informed dead-letter event doesn't exist", func(t *testing.T) {
+		outbox, manager := setup(t)
+
+		outbox.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := manager.ReplayDeadLetterEvents(context.Background(), "scheduler", []string{"2-0", "3-0"})
+		require.ErrorIs(t, err, portsErrors.ErrNotFound)
+		require.Contains(t, err.Error(), "3-0")
+	})
+
+	t.Run("keeps the dead-letter events when they can't be enqueued", func(t *testing.T) {
+		outbox, manager := setup(t)
+
+		outbox.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(errors.New("error"))
+		outbox.EXPECT().RemoveDeadLetters(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := manager.ReplayDeadLetterEvents(context.Background(), "scheduler", nil)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,193 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/topfreegames/maestro/internal/core/entities/events"
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
+	"github.com/topfreegames/maestro/internal/core/logs"
+	"github.com/topfreegames/maestro/internal/core/ports"
+	portsErrors "github.com/topfreegames/maestro/internal/core/ports/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	dispatchBatchSize     = 10
+	dispatchErrorInterval = time.Second
+	// dispatchRenewInterval is the interval in which the deliveries not
+	// dispatched yet are renewed, well below the minute after which the
+	// outbox hands them to other dispatchers.
+	dispatchRenewInterval = 15 * time.Second
+)
+
+var (
+	_ ports.EventsDispatcher = (*EventsDispatcher)(nil)
+)
+
+// EventsDispatcher forwards the events written to the outbox. Each delivery
+// is retried with exponential backoff until it reaches the max attempts, when
+// it is moved to the scheduler dead-letter events. Deliveries rejected by an
+// open circuit breaker wait for it without spending attempts.
+type EventsDispatcher struct {
+	forwarderService *EventsForwarderService
+	outbox           ports.EventsOutbox
+	config           OutboxConfig
+	logger           *zap.Logger
+}
+
+func NewEventsDispatcher(
+	eventsForwarder ports.EventsForwarder,
+	schedulerStorage ports.SchedulerStorage,
+	instanceStorage ports.GameRoomInstanceStorage,
+	roomStorage ports.RoomStorage,
+	schedulerCache ports.SchedulerCache,
+	outbox ports.EventsOutbox,
+	roomTimeline ports.RoomTimelineManager,
+	config EventsForwarderConfig,
+) ports.EventsDispatcher {
+	return &EventsDispatcher{
+		forwarderService: newEventsForwarderService(eventsForwarder, schedulerStorage, instanceStorage, roomStorage, schedulerCache, outbox, roomTimeline, config),
+		outbox:           outbox,
+		config:           config.Outbox,
+		logger:           zap.L().With(zap.String(logs.LogFieldComponent, "service"), zap.String(logs.LogFieldServiceName, "events_dispatcher")),
+	}
+}
+
+// DispatchEvents forwards the outbox events until the context is done.
+func (d *EventsDispatcher) DispatchEvents(ctx context.Context) error {
+	for {
+		deliveries, err := d.outbox.Next(ctx, dispatchBatchSize)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			d.logger.Error("failed to get events from the outbox", zap.Error(err))
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(dispatchErrorInterval):
+			}
+			continue
+		}
+
+		renewedAt := time.Now()
+		for i, delivery := range deliveries {
+			if time.Since(renewedAt) >= dispatchRenewInterval {
+				if err = d.outbox.Renew(ctx, deliveries[i:]); err != nil {
+					d.logger.Warn("failed to renew events from the outbox", zap.Error(err))
+				}
+				renewedAt = time.Now()
+			}
+			d.dispatch(ctx, delivery)
+		}
+	}
+}
+
+func (d *EventsDispatcher) dispatch(ctx context.Context, delivery *events.EventDelivery) {
+	logger := d.logger.With(
+		zap.String(logs.LogFieldSchedulerName, delivery.Event.SchedulerID),
+		zap.String(logs.LogFieldRoomID, delivery.Event.RoomID),
+		zap.String("forwarder", delivery.ForwarderName),
+	)
+
+	err := d.forward(ctx, delivery)
+	if err == nil {
+		if err = d.outbox.Ack(ctx, delivery); err != nil {
+			logger.Error("failed to remove forwarded event from the outbox", zap.Error(err))
+		}
+		return
+	}
+
+	// Events rejected by an open circuit didn't reach the forwarder, so
+	// they're retried once it lets events through without spending attempts.
+	var circuitOpenErr *forwarder.CircuitOpenError
+	if errors.As(err, &circuitOpenErr) {
+		delivery.LastError = err.Error()
+		if err = d.outbox.Retry(ctx, delivery, circuitOpenErr.RetryAt); err != nil {
+			logger.Error("failed to schedule event retry", zap.Error(err))
+		}
+		return
+	}
+
+	delivery.Attempts++
+	delivery.LastError = err.Error()
+	if delivery.Attempts >= d.config.MaxAttempts {
+		delivery.FailedAt = time.Now()
+		logger.Warn(fmt.Sprintf("moving event to the dead-letter events after %d attempts", delivery.Attempts), zap.Error(err))
+		if err = d.outbox.DeadLetter(ctx, delivery); err != nil {
+			logger.Error("failed to move event to the dead-letter events", zap.Error(err))
+			return
+		}
+		reportEventDeadLettered(delivery.Event.SchedulerID, delivery.ForwarderName)
+		return
+	}
+
+	if err = d.outbox.Retry(ctx, delivery, time.Now().Add(d.backoff(delivery.Attempts))); err != nil {
+		logger.Error("failed to schedule event retry", zap.Error(err))
+	}
+}
+
+// forward forwards the delivery event. Events of deleted schedulers or of
+// forwarders that were removed or disabled are discarded.
+func (d *EventsDispatcher) forward(ctx context.Context, delivery *events.EventDelivery) error {
+	scheduler, err := d.forwarderService.getScheduler(ctx, delivery.Event.SchedulerID)
+	if errors.Is(err, portsErrors.ErrNotFound) {
+		d.logger.Info(fmt.Sprintf("discarding event of scheduler \"%s\" since it doesn't exist", delivery.Event.SchedulerID))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var _forwarder *forwarder.Forwarder
+	for _, schedulerForwarder := range scheduler.Forwarders {
+		if schedulerForwarder.Name == delivery.ForwarderName && schedulerForwarder.Enabled {
+			_forwarder = schedulerForwarder
+			break
+		}
+	}
+	if _forwarder == nil {
+		d.logger.Info(fmt.Sprintf("discarding event of scheduler \"%s\" since forwarder \"%s\" is not enabled", scheduler.Name, delivery.ForwarderName))
+		return nil
+	}
+
+	return d.forwarderService.forwardEvent(ctx, delivery.Event, scheduler, _forwarder)
+}
+
+// backoff returns the delay before the next attempt, doubling the initial
+// backoff on each failed attempt up to the max backoff.
+func (d *EventsDispatcher) backoff(attempts int) time.Duration {
+	backoff := d.config.InitialBackoff
+	for i := 1; i < attempts && backoff < d.config.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > d.config.MaxBackoff {
+		return d.config.MaxBackoff
+	}
+	return backoff
+}
@@ -0,0 +1,202 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build unit
+// +build unit
+
+package events_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/topfreegames/maestro/internal/core/entities"
+	"github.com/topfreegames/maestro/internal/core/entities/events"
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
+	"github.com/topfreegames/maestro/internal/core/ports"
+	portsErrors "github.com/topfreegames/maestro/internal/core/ports/errors"
+	mockports "github.com/topfreegames/maestro/internal/core/ports/mock"
+	eventsservice "github.com/topfreegames/maestro/internal/core/services/events"
+)
+
+func TestEventsDispatcher_DispatchEvents(t *testing.T) {
+	scheduler := &entities.Scheduler{
+		Name: "scheduler",
+		Game: "game",
+		Forwarders: []*forwarder.Forwarder{
+			{Name: "fwd", Enabled: true, ForwardType: forwarder.TypeGrpc, Address: "address"},
+			{Name: "fwd-disabled", Enabled: false, ForwardType: forwarder.TypeGrpc, Address: "address"},
+		},
+	}
+	outboxConfig := eventsservice.OutboxConfig{
+		Enabled:        true,
+		MaxAttempts:    3,
+		InitialBackoff: time.Second,
+		MaxBackoff:     3 * time.Second,
+	}
+
+	newDelivery := func(forwarderName string, attempts int) *events.EventDelivery {
+		return &events.EventDelivery{
+			ID: "1-0",
+			Event: &events.Event{
+				Name:        events.PlayerEvent,
+				SchedulerID: scheduler.Name,
+				RoomID:      "room",
+				Attributes: map[string]interface{}{
+					"eventType": "playerLeft",
+					"playerId":  "player",
+				},
+			},
+			ForwarderName: forwarderName,
+			Attempts:      attempts,
+		}
+	}
+
+	// setup returns a dispatcher whose outbox returns the delivery once and
+	// then stops the dispatching.
+	setup := func(t *testing.T, delivery *events.EventDelivery) (ports.EventsDispatcher, context.Context, *mockports.MockEventsForwarder, *mockports.MockSchedulerCache, *mockports.MockSchedulerStorage, *mockports.MockEventsOutbox) {
+		mockCtrl := gomock.NewController(t)
+		eventsForwarder := mockports.NewMockEventsForwarder(mockCtrl)
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
+		outbox := mockports.NewMockEventsOutbox(mockCtrl)
+		roomTimeline := mockports.NewMockRoomTimelineManager(mockCtrl)
+		roomTimeline.EXPECT().RecordRoomTimelineEntry(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		config := eventsservice.EventsForwarderConfig{SchedulerCacheTtl: time.Minute, Outbox: outboxConfig}
+
+		dispatcher := eventsservice.NewEventsDispatcher(
+			eventsForwarder,
+			schedulerStorage,
+			mockports.NewMockGameRoomInstanceStorage(mockCtrl),
+			mockports.NewMockRoomStorage(mockCtrl),
+			schedulerCache,
+			outbox,
+			roomTimeline,
+			config,
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+		gomock.InOrder(
+			outbox.EXPECT().Next(gomock.Any(), gomock.Any()).Return([]*events.EventDelivery{delivery}, nil),
+			outbox.EXPECT().Next(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ int) ([]*events.EventDelivery, error) {
+				cancel()
+				return nil, nil
+			}),
+		)
+		return dispatcher, ctx, eventsForwarder, schedulerCache, schedulerStorage, outbox
+	}
+
+	t.Run("acks the delivery when it is forwarded", func(t *testing.T) {
+		delivery := newDelivery("fwd", 0)
+		dispatcher, ctx, eventsForwarder, schedulerCache, _, outbox := setup(t, delivery)
+
+		schedulerCache.EXPECT().GetScheduler(gomock.Any(), scheduler.Name).Return(scheduler, nil)
+		eventsForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), gomock.Any(), *scheduler.Forwarders[0]).Return(codes.OK, nil)
+		outbox.EXPECT().Ack(gomock.Any(), delivery).Return(nil)
+
+		err := dispatcher.DispatchEvents(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("retries the delivery when the circuit opens without spending attempts", func(t *testing.T) {
+		delivery := newDelivery("fwd", 2)
+		dispatcher, ctx, eventsForwarder, schedulerCache, _, outbox := setup(t, delivery)
+
+		retryAt := time.Now().Add(30 * time.Second)
+		schedulerCache.EXPECT().GetScheduler(gomock.Any(), scheduler.Name).Return(scheduler, nil)
+		eventsForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(codes.Unavailable, &forwarder.CircuitOpenError{ForwarderName: "fwd", Address: "address", RetryAt: retryAt})
+		outbox.EXPECT().Retry(gomock.Any(), delivery, retryAt).DoAndReturn(func(_ context.Context, d *events.EventDelivery, _ time.Time) error {
+			require.Equal(t, 2, d.Attempts)
+			require.Equal(t, "circuit breaker of forwarder \"fwd\" at \"address\" is open", d.LastError)
+			return nil
+		})
+
+		err := dispatcher.DispatchEvents(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("retries the delivery with backoff when forwarding fails", func(t *testing.T) {
+		delivery := newDelivery("fwd", 1)
+		dispatcher, ctx, eventsForwarder, schedulerCache, _, outbox := setup(t, delivery)
+
+		schedulerCache.EXPECT().GetScheduler(gomock.Any(), scheduler.Name).Return(scheduler, nil)
+		eventsForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), gomock.Any(), gomock.Any()).Return(codes.Unavailable, errors.New("unavailable"))
+		outbox.EXPECT().Retry(gomock.Any(), delivery, gomock.Any()).DoAndReturn(func(_ context.Context, d *events.EventDelivery, at time.Time) error {
+			require.Equal(t, 2, d.Attempts)
+			require.Equal(t, "unavailable", d.LastError)
+			require.WithinDuration(t, time.Now().Add(2*time.Second), at, 500*time.Millisecond)
+			return nil
+		})
+
+		err := dispatcher.DispatchEvents(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("moves the delivery to the dead-letter events when it reaches the max attempts", func(t *testing.T) {
+		delivery := newDelivery("fwd", 2)
+		dispatcher, ctx, eventsForwarder, schedulerCache, _, outbox := setup(t, delivery)
+
+		schedulerCache.EXPECT().GetScheduler(gomock.Any(), scheduler.Name).Return(scheduler, nil)
+		eventsForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), gomock.Any(), gomock.Any()).Return(codes.Unavailable, errors.New("unavailable"))
+		outbox.EXPECT().DeadLetter(gomock.Any(), delivery).DoAndReturn(func(_ context.Context, d *events.EventDelivery) error {
+			require.Equal(t, 3, d.Attempts)
+			require.Equal(t, "unavailable", d.LastError)
+			require.False(t, d.FailedAt.IsZero())
+			return nil
+		})
+
+		err := dispatcher.DispatchEvents(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("discards the delivery when the scheduler doesn't exist", func(t *testing.T) {
+		delivery := newDelivery("fwd", 0)
+		dispatcher, ctx, _, schedulerCache, schedulerStorage, outbox := setup(t, delivery)
+
+		schedulerCache.EXPECT().GetScheduler(gomock.Any(), scheduler.Name).Return(nil, nil)
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), scheduler.Name).Return(nil, portsErrors.NewErrNotFound("scheduler not found"))
+		outbox.EXPECT().Ack(gomock.Any(), delivery).Return(nil)
+
+		err := dispatcher.DispatchEvents(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("discards the delivery when the forwarder is disabled", func(t *testing.T) {
+		delivery := newDelivery("fwd-disabled", 0)
+		dispatcher, ctx, eventsForwarder, schedulerCache, _, outbox := setup(t, delivery)
+
+		schedulerCache.EXPECT().GetScheduler(gomock.Any(), scheduler.Name).Return(scheduler, nil)
+		eventsForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		outbox.EXPECT().Ack(gomock.Any(), delivery).Return(nil)
+
+		err := dispatcher.DispatchEvents(ctx)
+		require.NoError(t, err)
+	})
+}
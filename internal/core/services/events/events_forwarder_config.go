@@ -26,4 +26,26 @@ import "time"
 
 type EventsForwarderConfig struct {
 	SchedulerCacheTtl time.Duration
+	Outbox            OutboxConfig
+}
+
+// OutboxConfig configures the events outbox. When enabled, the events are
+// written to the outbox and forwarded asynchronously by the events
+// dispatcher, which retries each failed forwarder with exponential backoff.
+type OutboxConfig struct {
+	Enabled bool
+	// MaxAttempts is the number of times an event is forwarded before being
+	// moved to the dead-letter events.
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// ForwardersTesterConfig configures the connectivity test of the forwarders
+// that aren't on a scheduler.
+type ForwardersTesterConfig struct {
+	// AllowedAddresses are the hosts those forwarders can be tested at, a
+	// leading "*." allows the subdomains of the host. None of them can be
+	// tested when it is empty.
+	AllowedAddresses []string
 }
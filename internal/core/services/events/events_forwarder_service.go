@@ -27,6 +27,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/topfreegames/maestro/internal/core/logs"
 
@@ -52,6 +56,8 @@ type EventsForwarderService struct {
 	instanceStorage  ports.GameRoomInstanceStorage
 	roomStorage      ports.RoomStorage
 	schedulerCache   ports.SchedulerCache
+	outbox           ports.EventsOutbox
+	roomTimeline     ports.RoomTimelineManager
 	config           EventsForwarderConfig
 }
 
@@ -61,8 +67,23 @@ func NewEventsForwarderService(
 	instanceStorage ports.GameRoomInstanceStorage,
 	roomStorage ports.RoomStorage,
 	schedulerCache ports.SchedulerCache,
+	outbox ports.EventsOutbox,
+	roomTimeline ports.RoomTimelineManager,
 	config EventsForwarderConfig,
 ) ports.EventsService {
+	return newEventsForwarderService(eventsForwarder, schedulerStorage, instanceStorage, roomStorage, schedulerCache, outbox, roomTimeline, config)
+}
+
+func newEventsForwarderService(
+	eventsForwarder ports.EventsForwarder,
+	schedulerStorage ports.SchedulerStorage,
+	instanceStorage ports.GameRoomInstanceStorage,
+	roomStorage ports.RoomStorage,
+	schedulerCache ports.SchedulerCache,
+	outbox ports.EventsOutbox,
+	roomTimeline ports.RoomTimelineManager,
+	config EventsForwarderConfig,
+) *EventsForwarderService {
 	return &EventsForwarderService{
 		eventsForwarder,
 		zap.L().With(zap.String(logs.LogFieldComponent, "service"), zap.String(logs.LogFieldServiceName, "events_forwarder")),
@@ -70,6 +91,8 @@ func NewEventsForwarderService(
 		instanceStorage,
 		roomStorage,
 		schedulerCache,
+		outbox,
+		roomTimeline,
 		config,
 	}
 }
@@ -78,50 +101,170 @@ func (es *EventsForwarderService) ProduceEvent(ctx context.Context, event *event
 	if _, ok := event.Attributes["eventType"].(string); !ok {
 		return errors.New("eventAttributes must contain key \"eventType\"")
 	}
-	eventType := event.Attributes["eventType"].(string)
+	if event.ID == "" {
+		event.ID = uuid.NewString()
+	}
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now().UTC()
+	}
 
 	scheduler, err := es.getScheduler(ctx, event.SchedulerID)
 	if err != nil {
 		return err
 	}
 
-	if forwarderList := scheduler.Forwarders; len(forwarderList) > 0 {
-		for _, _forwarder := range forwarderList {
-			if _forwarder.Enabled {
-				switch event.Name {
-				case events.RoomEvent:
-					err = es.forwardRoomEvent(ctx, event, eventType, scheduler, _forwarder)
-					if err != nil {
-						return err
-					}
-				case events.PlayerEvent:
-					err = es.forwardPlayerEvent(ctx, event, eventType, scheduler, _forwarder)
-					if err != nil {
-						return err
-					}
-				}
-			}
-		}
-	} else {
+	if len(scheduler.Forwarders) == 0 {
 		es.logger.Debug(fmt.Sprintf("scheduler \"%v\" do not have forwarders configured", event.SchedulerID))
+		return nil
+	}
+
+	if es.config.Outbox.Enabled {
+		return es.enqueueEvent(ctx, event, scheduler)
+	}
+
+	var forwarders []*forwarder.Forwarder
+	for _, _forwarder := range scheduler.Forwarders {
+		if _forwarder.Enabled && es.isEventAllowed(event, _forwarder) {
+			forwarders = append(forwarders, _forwarder)
+		}
+	}
+	if len(forwarders) == 0 {
+		return nil
+	}
+
+	send, err := es.prepareEvent(ctx, event, scheduler)
+	if err != nil || send == nil {
+		return err
+	}
+
+	return es.fanOutEvent(ctx, event, forwarders, send)
+}
+
+// enqueueEvent writes one delivery of the event to the outbox for each
+// enabled forwarder, so they're forwarded (and retried) independently.
+func (es *EventsForwarderService) enqueueEvent(ctx context.Context, event *events.Event, scheduler *entities.Scheduler) error {
+	deliveries := make([]*events.EventDelivery, 0, len(scheduler.Forwarders))
+	for _, _forwarder := range scheduler.Forwarders {
+		if _forwarder.Enabled && es.isEventAllowed(event, _forwarder) {
+			deliveries = append(deliveries, &events.EventDelivery{Event: event, ForwarderName: _forwarder.Name})
+		}
+	}
+	if len(deliveries) == 0 {
+		return nil
 	}
 
+	err := es.outbox.Enqueue(ctx, deliveries)
+	if err != nil {
+		es.logger.Error(fmt.Sprintf("Failed to enqueue events for room %s and scheduler %s", event.RoomID, event.SchedulerID), zap.Error(err))
+		return err
+	}
 	return nil
 }
 
-func (es *EventsForwarderService) forwardRoomEvent(
+// fanOutEvent sends the event to the forwarders concurrently. Every forwarder
+// receives the event even when others fail, and the failures of the required
+// forwarders are reported together. Failures of best-effort forwarders are
+// only logged.
+func (es *EventsForwarderService) fanOutEvent(ctx context.Context, event *events.Event, forwarders []*forwarder.Forwarder, send sendEventFunc) error {
+	errs := make([]error, len(forwarders))
+	var wg sync.WaitGroup
+	for i, _forwarder := range forwarders {
+		wg.Add(1)
+		go func(i int, _forwarder *forwarder.Forwarder) {
+			defer wg.Done()
+			errs[i] = send(ctx, _forwarder)
+		}(i, _forwarder)
+	}
+	wg.Wait()
+
+	failures := map[string]error{}
+	for i, _forwarder := range forwarders {
+		if errs[i] == nil {
+			continue
+		}
+		if _forwarder.Options != nil && _forwarder.Options.BestEffort {
+			es.logger.Warn(fmt.Sprintf("Failed to forward event for room %s and scheduler %s to best-effort forwarder %s", event.RoomID, event.SchedulerID, _forwarder.Name), zap.Error(errs[i]))
+			continue
+		}
+		failures[_forwarder.Name] = errs[i]
+	}
+	if len(failures) > 0 {
+		return &events.ForwardingError{Failures: failures}
+	}
+	return nil
+}
+
+func (es *EventsForwarderService) forwardEvent(ctx context.Context, event *events.Event, scheduler *entities.Scheduler, _forwarder *forwarder.Forwarder) error {
+	if !es.isEventAllowed(event, _forwarder) {
+		return nil
+	}
+
+	send, err := es.prepareEvent(ctx, event, scheduler)
+	if err != nil || send == nil {
+		return err
+	}
+	return send(ctx, _forwarder)
+}
+
+// sendEventFunc sends a prepared event to a forwarder, it is safe to call
+// concurrently for different forwarders.
+type sendEventFunc func(ctx context.Context, _forwarder *forwarder.Forwarder) error
+
+// prepareEvent does the lookups needed to forward the event once for all the
+// forwarders, returning nil when the event must not be forwarded.
+func (es *EventsForwarderService) prepareEvent(ctx context.Context, event *events.Event, scheduler *entities.Scheduler) (sendEventFunc, error) {
+	eventType, ok := event.Attributes["eventType"].(string)
+	if !ok {
+		return nil, errors.New("eventAttributes must contain key \"eventType\"")
+	}
+
+	var send sendEventFunc
+	var err error
+	switch event.Name {
+	case events.RoomEvent:
+		send, err = es.prepareRoomEvent(ctx, event, eventType, scheduler)
+	case events.PlayerEvent:
+		send, err = es.preparePlayerEvent(event, eventType, scheduler)
+	}
+	if err != nil || send == nil {
+		return nil, err
+	}
+	return es.recordForwardingOnTimeline(event, eventType, send), nil
+}
+
+// recordForwardingOnTimeline records the outcome of each event sending on the
+// room timeline.
+func (es *EventsForwarderService) recordForwardingOnTimeline(event *events.Event, eventType string, send sendEventFunc) sendEventFunc {
+	return func(ctx context.Context, _forwarder *forwarder.Forwarder) error {
+		err := send(ctx, _forwarder)
+
+		entry := &game_room.TimelineEntry{
+			Kind:          game_room.TimelineEventForwarded,
+			EventName:     string(event.Name),
+			EventType:     eventType,
+			ForwarderName: _forwarder.Name,
+		}
+		if err != nil {
+			entry.Error = err.Error()
+		}
+		es.roomTimeline.RecordRoomTimelineEntry(ctx, event.SchedulerID, event.RoomID, entry)
+
+		return err
+	}
+}
+
+func (es *EventsForwarderService) prepareRoomEvent(
 	ctx context.Context,
 	event *events.Event,
 	eventType string,
 	scheduler *entities.Scheduler,
-	_forwarder *forwarder.Forwarder,
-) error {
+) (sendEventFunc, error) {
 	var instance *game_room.Instance
 
 	if es.isRoomInUnreliableState(event) {
 		isValidationRoom, err := es.isValidationRoom(ctx, event)
 		if err == nil && isValidationRoom {
-			return nil
+			return nil, nil
 		}
 
 		instance, err = es.instanceStorage.GetInstance(ctx, event.SchedulerID, event.RoomID)
@@ -130,103 +273,148 @@ func (es *EventsForwarderService) forwardRoomEvent(
 		}
 
 	} else {
-		isValidationRoom, err := es.isValidationRoom(ctx, event)
+		isAllocatable, err := es.isRoomAllocatable(ctx, event, scheduler)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		if isValidationRoom {
-			return nil
+		if !isAllocatable {
+			return nil, nil
 		}
 
 		instance, err = es.instanceStorage.GetInstance(ctx, event.SchedulerID, event.RoomID)
 		if err != nil {
 			es.logger.Error(fmt.Sprintf("Failed to get instance for room \"%v\" from scheduler \"%v\" info", event.RoomID, event.SchedulerID), zap.Error(err))
-			return err
+			return nil, err
 		}
 	}
 
 	selectedPort, err := es.selectPort(instance.Address)
 	if err != nil {
-		return fmt.Errorf("no room port found to forward roomEvent. Forwarder name: \"%v\", Scheduler: \"%v\"", _forwarder.Name, event.SchedulerID)
+		return nil, fmt.Errorf("no room port found to forward roomEvent. Scheduler: \"%v\"", event.SchedulerID)
 	}
 	err = es.incrementEventAttributesWithPortsInfo(event, instance.Address.Ports)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	roomEvent, err := events.ConvertToRoomEventType(eventType)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var pingType events.RoomStatusType
 	if roomEvent == events.Ping || roomEvent == events.Status {
 		if _, ok := event.Attributes["pingType"]; !ok {
-			return errors.New("roomEvent of type ping or status must contain key \"pingType\" in eventAttributes")
+			return nil, errors.New("roomEvent of type ping or status must contain key \"pingType\" in eventAttributes")
 		}
 		pingType, err = events.ConvertToRoomPingEventType(event.Attributes["pingType"].(string))
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	roomAttributes := events.RoomEventAttributes{
-		Game:           scheduler.Game,
-		RoomId:         event.RoomID,
-		Host:           instance.Address.Host,
-		Port:           selectedPort,
-		EventType:      roomEvent,
-		RoomStatusType: &pingType,
-		Other:          event.Attributes,
-	}
-	code, err := es.eventsForwarder.ForwardRoomEvent(ctx, roomAttributes, *_forwarder)
-	if err != nil {
-		reportRoomEventForwardingFailed(scheduler.Game, event.SchedulerID, code.String())
-		es.logger.Error(fmt.Sprintf("Failed to forward room events for room %s and scheduler %s", event.RoomID, event.SchedulerID),
-			zap.Error(err),
-			zap.Any("code", code))
+	return func(ctx context.Context, _forwarder *forwarder.Forwarder) error {
+		roomAttributes := events.RoomEventAttributes{
+			Game:           scheduler.Game,
+			SchedulerID:    event.SchedulerID,
+			RoomId:         event.RoomID,
+			Host:           instance.Address.Host,
+			Port:           selectedPort,
+			EventType:      roomEvent,
+			RoomStatusType: &pingType,
+			Other:          es.transformEventAttributes(event, _forwarder),
+			EventID:        event.ID,
+			CreatedAt:      event.CreatedAt,
+		}
+		code, err := es.eventsForwarder.ForwardRoomEvent(ctx, roomAttributes, *_forwarder)
+		if err != nil {
+			reportRoomEventForwardingFailed(scheduler.Game, event.SchedulerID, code.String())
+			es.logger.Error(fmt.Sprintf("Failed to forward room events for room %s and scheduler %s", event.RoomID, event.SchedulerID),
+				zap.Error(err),
+				zap.Any("code", code))
 
-		return err
-	}
+			return err
+		}
 
-	reportRoomEventForwardingSuccess(scheduler.Game, event.SchedulerID, code.String())
-	return nil
+		reportRoomEventForwardingSuccess(scheduler.Game, event.SchedulerID, code.String())
+		return nil
+	}, nil
 }
 
-func (es *EventsForwarderService) forwardPlayerEvent(
-	ctx context.Context,
+func (es *EventsForwarderService) preparePlayerEvent(
 	event *events.Event,
 	eventType string,
 	scheduler *entities.Scheduler,
-	_forwarder *forwarder.Forwarder,
-) error {
+) (sendEventFunc, error) {
 	playerId, err := es.getPlayerInfo(event)
 	if err != nil {
-		return fmt.Errorf("eventAttributes must contain key \"playerId\" in playerEvent events. Forwarder name: \"%v\", Scheduler: \"%v\"", _forwarder.Name, event.SchedulerID)
+		return nil, fmt.Errorf("eventAttributes must contain key \"playerId\" in playerEvent events. Scheduler: \"%v\"", event.SchedulerID)
 	}
 
 	playerEvent, err := events.ConvertToPlayerEventType(eventType)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	playerAttributes := events.PlayerEventAttributes{
-		RoomId:    event.RoomID,
-		PlayerId:  playerId,
-		EventType: playerEvent,
-		Game:      scheduler.Game,
-		Other:     event.Attributes,
+	return func(ctx context.Context, _forwarder *forwarder.Forwarder) error {
+		playerAttributes := events.PlayerEventAttributes{
+			RoomId:      event.RoomID,
+			PlayerId:    playerId,
+			EventType:   playerEvent,
+			Game:        scheduler.Game,
+			SchedulerID: event.SchedulerID,
+			Other:       es.transformEventAttributes(event, _forwarder),
+			EventID:     event.ID,
+			CreatedAt:   event.CreatedAt,
+		}
+
+		code, err := es.eventsForwarder.ForwardPlayerEvent(ctx, playerAttributes, *_forwarder)
+		if err != nil {
+			reportPlayerEventForwardingFailed(scheduler.Game, event.SchedulerID, code.String())
+			es.logger.Error(fmt.Sprintf("Failed to forward player events for room %s and scheduler %s", event.RoomID, event.SchedulerID), zap.Error(err))
+			return err
+		}
+		reportPlayerEventForwardingSuccess(scheduler.Game, event.SchedulerID, code.String())
+		return nil
+	}, nil
+}
+
+// isEventAllowed returns whether the forwarder filter allows the event. Room
+// events are matched by their type and by their room status.
+func (es *EventsForwarderService) isEventAllowed(event *events.Event, _forwarder *forwarder.Forwarder) bool {
+	if _forwarder.Options == nil || _forwarder.Options.Filter == nil {
+		return true
 	}
 
-	code, err := es.eventsForwarder.ForwardPlayerEvent(ctx, playerAttributes, *_forwarder)
-	if err != nil {
-		reportPlayerEventForwardingFailed(scheduler.Game, event.SchedulerID, code.String())
-		es.logger.Error(fmt.Sprintf("Failed to forward player events for room %s and scheduler %s", event.RoomID, event.SchedulerID), zap.Error(err))
-		return err
+	eventType, _ := event.Attributes["eventType"].(string)
+	eventTypes := []string{eventType}
+	if pingType, ok := event.Attributes["pingType"].(string); ok && event.Name == events.RoomEvent {
+		if roomStatusType, err := events.ConvertToRoomPingEventType(pingType); err == nil {
+			eventTypes = append(eventTypes, string(roomStatusType))
+		}
 	}
-	reportPlayerEventForwardingSuccess(scheduler.Game, event.SchedulerID, code.String())
-	return nil
+
+	allowed := _forwarder.Options.Filter.Allows(string(event.Name), eventTypes...)
+	if !allowed {
+		es.logger.Debug(fmt.Sprintf("event \"%s\" of room \"%s\" filtered out by forwarder \"%s\"", eventType, event.RoomID, _forwarder.Name))
+	}
+	return allowed
+}
+
+// transformEventAttributes returns the event attributes sent to the
+// forwarder as metadata. It is always a copy, since the events are sent
+// concurrently and the adapters may change the metadata.
+func (es *EventsForwarderService) transformEventAttributes(event *events.Event, _forwarder *forwarder.Forwarder) map[string]interface{} {
+	if _forwarder.Options != nil && _forwarder.Options.Transform != nil {
+		return _forwarder.Options.Transform.Apply(event.Attributes, forwarder.ReservedAttributes...)
+	}
+
+	attributes := make(map[string]interface{}, len(event.Attributes))
+	for key, value := range event.Attributes {
+		attributes[key] = value
+	}
+	return attributes
 }
 
 func (es *EventsForwarderService) getScheduler(ctx context.Context, schedulerName string) (*entities.Scheduler, error) {
@@ -314,6 +502,28 @@ func (es *EventsForwarderService) isValidationRoom(ctx context.Context, event *e
 	return gameRoom.IsValidationRoom, nil
 }
 
+// isRoomAllocatable returns false for validation rooms and, on schedulers
+// with the blue/green rollout strategy, for the rooms that are not on the
+// active version, since they must not be allocated before the switch.
+func (es *EventsForwarderService) isRoomAllocatable(ctx context.Context, event *events.Event, scheduler *entities.Scheduler) (bool, error) {
+	gameRoom, err := es.roomStorage.GetRoom(ctx, event.SchedulerID, event.RoomID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get room %s from storage: %v", event.RoomID, err)
+	}
+
+	if gameRoom.IsValidationRoom {
+		es.logger.Info(fmt.Sprintf("not producing events for room \"%s\", scheduler \"%s\" since it's a validation room", gameRoom.ID, gameRoom.SchedulerID))
+		return false, nil
+	}
+
+	if scheduler.RolloutStrategy.IsBlueGreen() && gameRoom.Version != scheduler.Spec.Version {
+		es.logger.Info(fmt.Sprintf("not producing events for room \"%s\", scheduler \"%s\" since it's not on the active version", gameRoom.ID, gameRoom.SchedulerID))
+		return false, nil
+	}
+
+	return true, nil
+}
+
 func (es *EventsForwarderService) isRoomInUnreliableState(event *events.Event) bool {
 	if roomEvent, ok := event.Attributes["roomEvent"].(string); ok {
 		if roomEvent == game_room.GameRoomPingStatusTerminating.String() ||
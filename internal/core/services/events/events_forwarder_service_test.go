@@ -44,6 +44,7 @@ import (
 	"github.com/topfreegames/maestro/internal/core/entities/events"
 	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
 	"github.com/topfreegames/maestro/internal/core/entities/game_room"
+	"github.com/topfreegames/maestro/internal/core/entities/rollout"
 	mockports "github.com/topfreegames/maestro/internal/core/ports/mock"
 )
 
@@ -240,6 +241,8 @@ func TestEventsForwarderService_ProduceEvent(t *testing.T) {
 		eventsForwarderService, config, eventsForwarder, schedulerStorage, roomStorage, instanceStorage, schedulerCache := testSetup(t)
 
 		event := &events.Event{
+			ID:          "event",
+			CreatedAt:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
 			Name:        events.RoomEvent,
 			SchedulerID: expectedScheduler.Name,
 			RoomID:      "room",
@@ -262,6 +265,7 @@ func TestEventsForwarderService_ProduceEvent(t *testing.T) {
 		schedulerCache.EXPECT().SetScheduler(context.Background(), expectedScheduler, config.SchedulerCacheTtl).Return(nil)
 		eventsForwarder.EXPECT().ForwardRoomEvent(context.Background(), events.RoomEventAttributes{
 			Game:           "game",
+			SchedulerID:    event.SchedulerID,
 			RoomId:         "room",
 			Host:           "host",
 			Port:           8080,
@@ -272,6 +276,8 @@ func TestEventsForwarderService_ProduceEvent(t *testing.T) {
 				"pingType":  "Ready",
 				"ports":     `[{"name":"clientPort","port":8080,"protocol":"TCP"},{"name":"notClientPort","port":8081,"protocol":"TCP"}]`,
 			},
+			EventID:   event.ID,
+			CreatedAt: event.CreatedAt,
 		}, gomock.Any()).Return(codes.OK, nil)
 
 		err := eventsForwarderService.ProduceEvent(context.Background(), event)
@@ -676,6 +682,36 @@ func TestEventsForwarderService_ProduceEvent(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("should succeed but not produce event when scheduler is blue/green and room is not on the active version", func(t *testing.T) {
+		eventsForwarderService, _, _, _, roomStorage, _, schedulerCache := testSetup(t)
+
+		blueGreenScheduler := *expectedScheduler
+		blueGreenScheduler.Spec = game_room.Spec{Version: "v1.0.0"}
+		blueGreenScheduler.RolloutStrategy = &rollout.Strategy{Type: rollout.BlueGreen}
+
+		event := &events.Event{
+			Name:        events.RoomEvent,
+			SchedulerID: expectedScheduler.Name,
+			RoomID:      "room",
+			Attributes: map[string]interface{}{
+				"eventType": "ping",
+				"pingType":  "ready",
+			},
+		}
+
+		room := &game_room.GameRoom{
+			ID:          event.RoomID,
+			SchedulerID: event.SchedulerID,
+			Version:     "v2.0.0",
+		}
+
+		schedulerCache.EXPECT().GetScheduler(gomock.Any(), event.SchedulerID).Return(&blueGreenScheduler, nil)
+		roomStorage.EXPECT().GetRoom(gomock.Any(), event.SchedulerID, event.RoomID).Return(room, nil)
+
+		err := eventsForwarderService.ProduceEvent(context.Background(), event)
+		require.NoError(t, err)
+	})
+
 	t.Run("should fail if room cannot be found since we would be producing event for non-registered room", func(t *testing.T) {
 		eventsForwarderService, config, _, schedulerStorage, roomStorage, _, schedulerCache := testSetup(t)
 
@@ -700,6 +736,336 @@ func TestEventsForwarderService_ProduceEvent(t *testing.T) {
 
 }
 
+func TestEventsForwarderService_ProduceEventWithForwarderFilterAndTransform(t *testing.T) {
+	newForwarder := func(name string, filter *forwarder.FilterOptions, transform *forwarder.TransformOptions) *forwarder.Forwarder {
+		return &forwarder.Forwarder{
+			Name:        name,
+			Enabled:     true,
+			ForwardType: forwarder.TypeGrpc,
+			Address:     "address",
+			Options: &forwarder.ForwardOptions{
+				Timeout:   time.Second * 5,
+				Filter:    filter,
+				Transform: transform,
+			},
+		}
+	}
+
+	t.Run("should forward player events only to the forwarders that allow them, with their metadata transformed", func(t *testing.T) {
+		eventsForwarderService, _, eventsForwarder, _, _, _, schedulerCache := testSetup(t)
+
+		joinOnly := newForwarder("join-only", &forwarder.FilterOptions{EventTypes: []string{"playerJoin"}}, nil)
+		transformed := newForwarder("transformed", &forwarder.FilterOptions{EventNames: []string{"PlayerEvent"}}, &forwarder.TransformOptions{
+			DropMetadata: []string{"token", "playerId"},
+			Attributes:   map[string]string{"team": "matchmaking"},
+		})
+		scheduler := &entities.Scheduler{Name: "scheduler", Game: "game", Forwarders: []*forwarder.Forwarder{joinOnly, transformed}}
+		event := &events.Event{
+			ID:          "event",
+			CreatedAt:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			Name:        events.PlayerEvent,
+			SchedulerID: scheduler.Name,
+			RoomID:      "room",
+			Attributes: map[string]interface{}{
+				"eventType": "playerLeft",
+				"playerId":  "player",
+				"token":     "secret",
+			},
+		}
+
+		schedulerCache.EXPECT().GetScheduler(gomock.Any(), scheduler.Name).Return(scheduler, nil)
+		eventsForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), events.PlayerEventAttributes{
+			RoomId:      "room",
+			PlayerId:    "player",
+			EventType:   events.PlayerLeft,
+			Game:        "game",
+			SchedulerID: "scheduler",
+			Other: map[string]interface{}{
+				"eventType": "playerLeft",
+				"playerId":  "player",
+				"team":      "matchmaking",
+			},
+			EventID:   event.ID,
+			CreatedAt: event.CreatedAt,
+		}, *transformed).Return(codes.OK, nil)
+
+		err := eventsForwarderService.ProduceEvent(context.Background(), event)
+		require.NoError(t, err)
+		require.Equal(t, "secret", event.Attributes["token"])
+	})
+
+	t.Run("should match room events by their room status", func(t *testing.T) {
+		eventsForwarderService, _, eventsForwarder, _, roomStorage, instanceStorage, schedulerCache := testSetup(t)
+
+		terminatedOnly := newForwarder("terminated-only", &forwarder.FilterOptions{EventTypes: []string{"roomTerminated"}}, nil)
+		readyOnly := newForwarder("ready-only", &forwarder.FilterOptions{EventTypes: []string{"roomReady"}}, nil)
+		scheduler := &entities.Scheduler{Name: "scheduler", Game: "game", Forwarders: []*forwarder.Forwarder{terminatedOnly, readyOnly}}
+		event := &events.Event{
+			Name:        events.RoomEvent,
+			SchedulerID: scheduler.Name,
+			RoomID:      "room",
+			Attributes: map[string]interface{}{
+				"eventType": "status",
+				"pingType":  "ready",
+			},
+		}
+
+		schedulerCache.EXPECT().GetScheduler(gomock.Any(), scheduler.Name).Return(scheduler, nil)
+		roomStorage.EXPECT().GetRoom(gomock.Any(), scheduler.Name, "room").Return(&game_room.GameRoom{ID: "room", SchedulerID: scheduler.Name}, nil)
+		instanceStorage.EXPECT().GetInstance(gomock.Any(), scheduler.Name, "room").Return(&game_room.Instance{
+			ID:      "room",
+			Address: &game_room.Address{Host: "host", Ports: []game_room.Port{{Name: "clientPort", Port: 8080, Protocol: "TCP"}}},
+		}, nil)
+		eventsForwarder.EXPECT().ForwardRoomEvent(gomock.Any(), gomock.Any(), *readyOnly).Return(codes.OK, nil)
+
+		err := eventsForwarderService.ProduceEvent(context.Background(), event)
+		require.NoError(t, err)
+	})
+}
+
+func TestEventsForwarderService_ProduceEventFanOut(t *testing.T) {
+	newForwarder := func(name string, bestEffort bool) *forwarder.Forwarder {
+		return &forwarder.Forwarder{
+			Name:        name,
+			Enabled:     true,
+			ForwardType: forwarder.TypeGrpc,
+			Address:     "address",
+			Options:     &forwarder.ForwardOptions{Timeout: time.Second * 5, BestEffort: bestEffort},
+		}
+	}
+	newEvent := func() *events.Event {
+		return &events.Event{
+			Name:        events.PlayerEvent,
+			SchedulerID: "scheduler",
+			RoomID:      "room",
+			Attributes: map[string]interface{}{
+				"eventType": "playerJoin",
+				"playerId":  "player",
+			},
+		}
+	}
+
+	t.Run("should forward the event to every forwarder even when one of them fails", func(t *testing.T) {
+		eventsForwarderService, _, eventsForwarder, _, _, _, schedulerCache := testSetup(t)
+
+		failing := newForwarder("failing", false)
+		healthy := newForwarder("healthy", false)
+		scheduler := &entities.Scheduler{Name: "scheduler", Game: "game", Forwarders: []*forwarder.Forwarder{failing, healthy}}
+
+		schedulerCache.EXPECT().GetScheduler(gomock.Any(), scheduler.Name).Return(scheduler, nil)
+		eventsForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), gomock.Any(), *failing).Return(codes.Unavailable, errors.New("unavailable"))
+		eventsForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), gomock.Any(), *healthy).Return(codes.OK, nil)
+
+		err := eventsForwarderService.ProduceEvent(context.Background(), newEvent())
+		require.Error(t, err)
+
+		var forwardingErr *events.ForwardingError
+		require.ErrorAs(t, err, &forwardingErr)
+		require.Equal(t, []string{"failing"}, forwardingErr.ForwarderNames())
+	})
+
+	t.Run("should report every required forwarder that failed", func(t *testing.T) {
+		eventsForwarderService, _, eventsForwarder, _, _, _, schedulerCache := testSetup(t)
+
+		scheduler := &entities.Scheduler{Name: "scheduler", Game: "game", Forwarders: []*forwarder.Forwarder{
+			newForwarder("fwd-b", false),
+			newForwarder("fwd-a", false),
+		}}
+
+		schedulerCache.EXPECT().GetScheduler(gomock.Any(), scheduler.Name).Return(scheduler, nil)
+		eventsForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), gomock.Any(), gomock.Any()).Return(codes.Unavailable, errors.New("unavailable")).Times(2)
+
+		err := eventsForwarderService.ProduceEvent(context.Background(), newEvent())
+		require.EqualError(t, err, "failed to forward event to 2 forwarder(s): fwd-a: unavailable; fwd-b: unavailable")
+	})
+
+	t.Run("should not return an error when only best-effort forwarders fail", func(t *testing.T) {
+		eventsForwarderService, _, eventsForwarder, _, _, _, schedulerCache := testSetup(t)
+
+		bestEffort := newForwarder("best-effort", true)
+		required := newForwarder("required", false)
+		scheduler := &entities.Scheduler{Name: "scheduler", Game: "game", Forwarders: []*forwarder.Forwarder{bestEffort, required}}
+
+		schedulerCache.EXPECT().GetScheduler(gomock.Any(), scheduler.Name).Return(scheduler, nil)
+		eventsForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), gomock.Any(), *bestEffort).Return(codes.Unavailable, errors.New("unavailable"))
+		eventsForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), gomock.Any(), *required).Return(codes.OK, nil)
+
+		err := eventsForwarderService.ProduceEvent(context.Background(), newEvent())
+		require.NoError(t, err)
+	})
+
+	t.Run("should send the forwarders their own copy of the event metadata", func(t *testing.T) {
+		eventsForwarderService, _, eventsForwarder, _, _, _, schedulerCache := testSetup(t)
+
+		scheduler := &entities.Scheduler{Name: "scheduler", Game: "game", Forwarders: []*forwarder.Forwarder{
+			newForwarder("fwd-1", false),
+			newForwarder("fwd-2", false),
+		}}
+		event := newEvent()
+
+		schedulerCache.EXPECT().GetScheduler(gomock.Any(), scheduler.Name).Return(scheduler, nil)
+		eventsForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, attributes events.PlayerEventAttributes, _ forwarder.Forwarder) (codes.Code, error) {
+				attributes.Other["roomType"] = "changed"
+				return codes.OK, nil
+			}).Times(2)
+
+		err := eventsForwarderService.ProduceEvent(context.Background(), event)
+		require.NoError(t, err)
+		require.NotContains(t, event.Attributes, "roomType")
+	})
+}
+
+func TestEventsForwarderService_ProduceEventRoomTimeline(t *testing.T) {
+	t.Run("should record the outcome of the event forwarding on the room timeline", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		eventsForwarder := mockports.NewMockEventsForwarder(mockCtrl)
+		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
+		roomTimeline := mockports.NewMockRoomTimelineManager(mockCtrl)
+		eventsForwarderService := eventsservice.NewEventsForwarderService(
+			eventsForwarder,
+			mockports.NewMockSchedulerStorage(mockCtrl),
+			mockports.NewMockGameRoomInstanceStorage(mockCtrl),
+			mockports.NewMockRoomStorage(mockCtrl),
+			schedulerCache,
+			mockports.NewMockEventsOutbox(mockCtrl),
+			roomTimeline,
+			eventsservice.EventsForwarderConfig{SchedulerCacheTtl: time.Minute},
+		)
+
+		failing := &forwarder.Forwarder{Name: "failing", Enabled: true, ForwardType: forwarder.TypeGrpc, Address: "failing"}
+		healthy := &forwarder.Forwarder{Name: "healthy", Enabled: true, ForwardType: forwarder.TypeGrpc, Address: "healthy"}
+		scheduler := &entities.Scheduler{Name: "scheduler", Game: "game", Forwarders: []*forwarder.Forwarder{failing, healthy}}
+		event := &events.Event{
+			Name:        events.PlayerEvent,
+			SchedulerID: "scheduler",
+			RoomID:      "room",
+			Attributes: map[string]interface{}{
+				"eventType": "playerLeft",
+				"playerId":  "player",
+			},
+		}
+
+		schedulerCache.EXPECT().GetScheduler(gomock.Any(), scheduler.Name).Return(scheduler, nil)
+		eventsForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), gomock.Any(), *failing).Return(codes.Unavailable, errors.New("unavailable"))
+		eventsForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), gomock.Any(), *healthy).Return(codes.OK, nil)
+		roomTimeline.EXPECT().RecordRoomTimelineEntry(gomock.Any(), "scheduler", "room", &game_room.TimelineEntry{
+			Kind:          game_room.TimelineEventForwarded,
+			EventName:     "PlayerEvent",
+			EventType:     "playerLeft",
+			ForwarderName: "failing",
+			Error:         "unavailable",
+		})
+		roomTimeline.EXPECT().RecordRoomTimelineEntry(gomock.Any(), "scheduler", "room", &game_room.TimelineEntry{
+			Kind:          game_room.TimelineEventForwarded,
+			EventName:     "PlayerEvent",
+			EventType:     "playerLeft",
+			ForwarderName: "healthy",
+		})
+
+		err := eventsForwarderService.ProduceEvent(context.Background(), event)
+		require.Error(t, err)
+	})
+}
+
+func TestEventsForwarderService_ProduceEventWithOutbox(t *testing.T) {
+	scheduler := &entities.Scheduler{
+		Name: "scheduler",
+		Game: "game",
+		Forwarders: []*forwarder.Forwarder{
+			{Name: "fwd-1", Enabled: true, ForwardType: forwarder.TypeGrpc, Address: "address"},
+			{Name: "fwd-2", Enabled: false, ForwardType: forwarder.TypeGrpc, Address: "address"},
+			{Name: "fwd-3", Enabled: true, ForwardType: forwarder.TypeHTTP, Address: "http://address"},
+		},
+	}
+	event := &events.Event{
+		Name:        events.PlayerEvent,
+		SchedulerID: scheduler.Name,
+		RoomID:      "room",
+		Attributes: map[string]interface{}{
+			"eventType": "playerLeft",
+			"playerId":  "player",
+		},
+	}
+
+	setup := func(t *testing.T) (ports.EventsService, *mockports.MockEventsForwarder, *mockports.MockSchedulerCache, *mockports.MockEventsOutbox) {
+		mockCtrl := gomock.NewController(t)
+		eventsForwarder := mockports.NewMockEventsForwarder(mockCtrl)
+		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
+		outbox := mockports.NewMockEventsOutbox(mockCtrl)
+		config := eventsservice.EventsForwarderConfig{SchedulerCacheTtl: time.Minute, Outbox: eventsservice.OutboxConfig{Enabled: true}}
+
+		eventsForwarderService := eventsservice.NewEventsForwarderService(
+			eventsForwarder,
+			mockports.NewMockSchedulerStorage(mockCtrl),
+			mockports.NewMockGameRoomInstanceStorage(mockCtrl),
+			mockports.NewMockRoomStorage(mockCtrl),
+			schedulerCache,
+			outbox,
+			mockports.NewMockRoomTimelineManager(mockCtrl),
+			config,
+		)
+		return eventsForwarderService, eventsForwarder, schedulerCache, outbox
+	}
+
+	t.Run("should enqueue a delivery for each enabled forwarder instead of forwarding", func(t *testing.T) {
+		eventsForwarderService, eventsForwarder, schedulerCache, outbox := setup(t)
+
+		schedulerCache.EXPECT().GetScheduler(gomock.Any(), scheduler.Name).Return(scheduler, nil)
+		outbox.EXPECT().Enqueue(gomock.Any(), []*events.EventDelivery{
+			{Event: event, ForwarderName: "fwd-1"},
+			{Event: event, ForwarderName: "fwd-3"},
+		}).Return(nil)
+		eventsForwarder.EXPECT().ForwardPlayerEvent(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := eventsForwarderService.ProduceEvent(context.Background(), event)
+		require.NoError(t, err)
+		require.NotEmpty(t, event.ID)
+		require.False(t, event.CreatedAt.IsZero())
+	})
+
+	t.Run("should fail when the events can't be enqueued", func(t *testing.T) {
+		eventsForwarderService, _, schedulerCache, outbox := setup(t)
+
+		schedulerCache.EXPECT().GetScheduler(gomock.Any(), scheduler.Name).Return(scheduler, nil)
+		outbox.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(errors.New("error"))
+
+		err := eventsForwarderService.ProduceEvent(context.Background(), event)
+		require.Error(t, err)
+	})
+
+	t.Run("should not enqueue deliveries for forwarders that filter out the event", func(t *testing.T) {
+		eventsForwarderService, _, schedulerCache, outbox := setup(t)
+
+		filteredScheduler := &entities.Scheduler{
+			Name: scheduler.Name,
+			Forwarders: []*forwarder.Forwarder{
+				{Name: "rooms-only", Enabled: true, Options: &forwarder.ForwardOptions{Filter: &forwarder.FilterOptions{EventNames: []string{"RoomEvent"}}}},
+				{Name: "players", Enabled: true, Options: &forwarder.ForwardOptions{Filter: &forwarder.FilterOptions{EventNames: []string{"PlayerEvent"}}}},
+			},
+		}
+		schedulerCache.EXPECT().GetScheduler(gomock.Any(), scheduler.Name).Return(filteredScheduler, nil)
+		outbox.EXPECT().Enqueue(gomock.Any(), []*events.EventDelivery{{Event: event, ForwarderName: "players"}}).Return(nil)
+
+		err := eventsForwarderService.ProduceEvent(context.Background(), event)
+		require.NoError(t, err)
+	})
+
+	t.Run("should not enqueue when every forwarder is disabled", func(t *testing.T) {
+		eventsForwarderService, _, schedulerCache, outbox := setup(t)
+
+		disabledScheduler := &entities.Scheduler{
+			Name:       scheduler.Name,
+			Forwarders: []*forwarder.Forwarder{{Name: "fwd", Enabled: false}},
+		}
+		schedulerCache.EXPECT().GetScheduler(gomock.Any(), scheduler.Name).Return(disabledScheduler, nil)
+		outbox.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Times(0)
+
+		err := eventsForwarderService.ProduceEvent(context.Background(), event)
+		require.NoError(t, err)
+	})
+}
+
 func testSetup(t *testing.T) (ports.EventsService, eventsservice.EventsForwarderConfig, *mockports.MockEventsForwarder, *mockports.MockSchedulerStorage, *mockports.MockRoomStorage, *mockports.MockGameRoomInstanceStorage, *mockports.MockSchedulerCache) {
 	mockCtrl := gomock.NewController(t)
 
@@ -708,9 +1074,12 @@ func testSetup(t *testing.T) (ports.EventsService, eventsservice.EventsForwarder
 	instanceStorage := mockports.NewMockGameRoomInstanceStorage(mockCtrl)
 	schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
 	roomStorage := mockports.NewMockRoomStorage(mockCtrl)
+	outbox := mockports.NewMockEventsOutbox(mockCtrl)
+	roomTimeline := mockports.NewMockRoomTimelineManager(mockCtrl)
+	roomTimeline.EXPECT().RecordRoomTimelineEntry(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	config := eventsservice.EventsForwarderConfig{SchedulerCacheTtl: time.Minute}
 
-	eventsForwarderService := eventsservice.NewEventsForwarderService(eventsForwarder, schedulerStorage, instanceStorage, roomStorage, schedulerCache, config)
+	eventsForwarderService := eventsservice.NewEventsForwarderService(eventsForwarder, schedulerStorage, instanceStorage, roomStorage, schedulerCache, outbox, roomTimeline, config)
 
 	return eventsForwarderService, config, eventsForwarder, schedulerStorage, roomStorage, instanceStorage, schedulerCache
 }
@@ -0,0 +1,74 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package events
+
+import (
+	"context"
+	"errors"
+
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
+	"github.com/topfreegames/maestro/internal/core/ports"
+	portsErrors "github.com/topfreegames/maestro/internal/core/ports/errors"
+)
+
+var (
+	_ ports.ForwardersHealthManager = (*ForwardersHealthManager)(nil)
+)
+
+// ForwardersHealthManager reports the circuit breaker health of the
+// scheduler forwarders.
+type ForwardersHealthManager struct {
+	healthStorage    ports.ForwarderHealthStorage
+	schedulerStorage ports.SchedulerStorage
+}
+
+func NewForwardersHealthManager(healthStorage ports.ForwarderHealthStorage, schedulerStorage ports.SchedulerStorage) ports.ForwardersHealthManager {
+	return &ForwardersHealthManager{
+		healthStorage:    healthStorage,
+		schedulerStorage: schedulerStorage,
+	}
+}
+
+// ListForwardersHealth returns the health of each scheduler forwarder. The
+// forwarders without health saved haven't failed, so they're healthy.
+func (m *ForwardersHealthManager) ListForwardersHealth(ctx context.Context, schedulerName string) ([]*forwarder.Health, error) {
+	scheduler, err := m.schedulerStorage.GetScheduler(ctx, schedulerName)
+	if err != nil {
+		return nil, err
+	}
+
+	forwardersHealth := make([]*forwarder.Health, 0, len(scheduler.Forwarders))
+	for _, _forwarder := range scheduler.Forwarders {
+		health, err := m.healthStorage.GetForwarderHealth(ctx, _forwarder.Address)
+		if err != nil {
+			if !errors.Is(err, portsErrors.ErrNotFound) {
+				return nil, err
+			}
+			health = forwarder.NewHealthyForwarderHealth(_forwarder.Address)
+		}
+
+		health.ForwarderName = _forwarder.Name
+		forwardersHealth = append(forwardersHealth, health)
+	}
+	return forwardersHealth, nil
+}
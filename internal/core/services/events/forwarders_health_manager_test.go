@@ -0,0 +1,98 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build unit
+// +build unit
+
+package events_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/topfreegames/maestro/internal/core/entities"
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
+	"github.com/topfreegames/maestro/internal/core/ports"
+	portsErrors "github.com/topfreegames/maestro/internal/core/ports/errors"
+	mockports "github.com/topfreegames/maestro/internal/core/ports/mock"
+	eventsservice "github.com/topfreegames/maestro/internal/core/services/events"
+)
+
+func TestForwardersHealthManager_ListForwardersHealth(t *testing.T) {
+	scheduler := &entities.Scheduler{
+		Name: "scheduler",
+		Forwarders: []*forwarder.Forwarder{
+			{Name: "failing", Enabled: true, ForwardType: forwarder.TypeGrpc, Address: "failing:8080"},
+			{Name: "healthy", Enabled: true, ForwardType: forwarder.TypeHTTP, Address: "http://healthy"},
+		},
+	}
+
+	setup := func(t *testing.T) (*mockports.MockForwarderHealthStorage, *mockports.MockSchedulerStorage, ports.ForwardersHealthManager) {
+		mockCtrl := gomock.NewController(t)
+		healthStorage := mockports.NewMockForwarderHealthStorage(mockCtrl)
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		return healthStorage, schedulerStorage, eventsservice.NewForwardersHealthManager(healthStorage, schedulerStorage)
+	}
+
+	t.Run("returns the health of each scheduler forwarder, healthy when there's none saved", func(t *testing.T) {
+		healthStorage, schedulerStorage, manager := setup(t)
+
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), "scheduler").Return(scheduler, nil)
+		healthStorage.EXPECT().GetForwarderHealth(gomock.Any(), "failing:8080").Return(&forwarder.Health{
+			Address:             "failing:8080",
+			State:               forwarder.CircuitOpen,
+			ConsecutiveFailures: 5,
+			LastError:           "unavailable",
+		}, nil)
+		healthStorage.EXPECT().GetForwarderHealth(gomock.Any(), "http://healthy").Return(nil, portsErrors.NewErrNotFound("not found"))
+
+		result, err := manager.ListForwardersHealth(context.Background(), "scheduler")
+		require.NoError(t, err)
+		require.Equal(t, []*forwarder.Health{
+			{ForwarderName: "failing", Address: "failing:8080", State: forwarder.CircuitOpen, ConsecutiveFailures: 5, LastError: "unavailable"},
+			{ForwarderName: "healthy", Address: "http://healthy", State: forwarder.CircuitClosed},
+		}, result)
+	})
+
+	t.Run("fails when the scheduler doesn't exist", func(t *testing.T) {
+		_, schedulerStorage, manager := setup(t)
+
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), "scheduler").Return(nil, portsErrors.NewErrNotFound("scheduler not found"))
+
+		_, err := manager.ListForwardersHealth(context.Background(), "scheduler")
+		require.ErrorIs(t, err, portsErrors.ErrNotFound)
+	})
+
+	t.Run("fails when the health can't be fetched", func(t *testing.T) {
+		healthStorage, schedulerStorage, manager := setup(t)
+
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), "scheduler").Return(scheduler, nil)
+		healthStorage.EXPECT().GetForwarderHealth(gomock.Any(), "failing:8080").Return(nil, errors.New("error"))
+
+		_, err := manager.ListForwardersHealth(context.Background(), "scheduler")
+		require.Error(t, err)
+	})
+}
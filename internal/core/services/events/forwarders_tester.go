@@ -0,0 +1,223 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	pb "github.com/topfreegames/protos/maestro/grpc/generated"
+
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
+	"github.com/topfreegames/maestro/internal/core/ports"
+	portsErrors "github.com/topfreegames/maestro/internal/core/ports/errors"
+	"github.com/topfreegames/maestro/internal/validations"
+)
+
+const (
+	// TestRoomID is the room of the synthetic events sent by the
+	// connectivity test, so the forwarders can ignore them.
+	TestRoomID = "maestro-connectivity-test"
+	// TestPlayerID is the player of the synthetic player event.
+	TestPlayerID = "maestro-connectivity-test"
+	// TestRoomEventType is the event type of the synthetic room event.
+	TestRoomEventType = "connectivityTest"
+)
+
+var (
+	_ ports.ForwardersTester = (*ForwardersTester)(nil)
+)
+
+// ForwardersTester checks the connectivity of the forwarders, sending them
+// synthetic events through the forwarder client.
+type ForwardersTester struct {
+	forwarderClient  ports.ForwarderClient
+	schedulerStorage ports.SchedulerStorage
+	clock            ports.Clock
+	config           ForwardersTesterConfig
+}
+
+func NewForwardersTester(forwarderClient ports.ForwarderClient, schedulerStorage ports.SchedulerStorage, clock ports.Clock, config ForwardersTesterConfig) ports.ForwardersTester {
+	return &ForwardersTester{
+		forwarderClient:  forwarderClient,
+		schedulerStorage: schedulerStorage,
+		clock:            clock,
+		config:           config,
+	}
+}
+
+// TestForwarders sends a synthetic room event and player event of the game to
+// each forwarder, returning their answers. As the forwarders aren't on a
+// scheduler, they can't reference secrets and are only tested at the allowed
+// addresses.
+func (t *ForwardersTester) TestForwarders(ctx context.Context, game string, forwarders []*forwarder.Forwarder) ([]*forwarder.TestResult, error) {
+	if len(forwarders) == 0 {
+		return nil, portsErrors.NewErrInvalidArgument("no forwarder informed")
+	}
+
+	for _, _forwarder := range forwarders {
+		if err := validations.Validate.Struct(_forwarder); err != nil {
+			return nil, portsErrors.NewErrInvalidArgument("invalid forwarder \"%s\": %s", _forwarder.Name, err)
+		}
+		if hasSecretRefs(_forwarder) {
+			return nil, portsErrors.NewErrInvalidArgument("forwarder \"%s\" references secrets, it can only be tested through its scheduler", _forwarder.Name)
+		}
+		if _forwarder.ForwardType == forwarder.TypeGrpc && !t.isAddressAllowed(_forwarder.Address) {
+			return nil, portsErrors.NewErrInvalidArgument("address \"%s\" of forwarder \"%s\" isn't allowed to be tested", _forwarder.Address, _forwarder.Name)
+		}
+	}
+	return t.testForwarders(ctx, game, forwarders), nil
+}
+
+// TestSchedulerForwarders sends the synthetic events to the forwarders of the
+// scheduler, including the disabled ones.
+func (t *ForwardersTester) TestSchedulerForwarders(ctx context.Context, schedulerName string) ([]*forwarder.TestResult, error) {
+	scheduler, err := t.schedulerStorage.GetScheduler(ctx, schedulerName)
+	if err != nil {
+		return nil, err
+	}
+	return t.testForwarders(ctx, scheduler.Game, scheduler.Forwarders), nil
+}
+
+// isAddressAllowed returns whether the address host is one of the allowed
+// addresses or one of their subdomains.
+func (t *ForwardersTester) isAddressAllowed(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+
+	for _, allowedAddress := range t.config.AllowedAddresses {
+		if domain := strings.TrimPrefix(allowedAddress, "*"); domain != allowedAddress {
+			if strings.HasSuffix(host, domain) {
+				return true
+			}
+			continue
+		}
+		if host == allowedAddress {
+			return true
+		}
+	}
+	return false
+}
+
+func hasSecretRefs(_forwarder *forwarder.Forwarder) bool {
+	options := _forwarder.Options
+	if options == nil {
+		return false
+	}
+	if options.GRPC != nil && (options.GRPC.TLS != nil || options.GRPC.Auth != nil) {
+		return true
+	}
+	return options.HTTP != nil && options.HTTP.SigningSecretRef != ""
+}
+
+func (t *ForwardersTester) testForwarders(ctx context.Context, game string, forwarders []*forwarder.Forwarder) []*forwarder.TestResult {
+	results := make([]*forwarder.TestResult, len(forwarders))
+
+	var wg sync.WaitGroup
+	for i, _forwarder := range forwarders {
+		wg.Add(1)
+		go func(i int, _forwarder forwarder.Forwarder) {
+			defer wg.Done()
+			results[i] = t.testForwarder(ctx, game, _forwarder)
+		}(i, *_forwarder)
+	}
+	wg.Wait()
+
+	return results
+}
+
+func (t *ForwardersTester) testForwarder(ctx context.Context, game string, _forwarder forwarder.Forwarder) *forwarder.TestResult {
+	result := &forwarder.TestResult{
+		ForwarderName: _forwarder.Name,
+		Address:       _forwarder.Address,
+	}
+
+	if _forwarder.ForwardType != forwarder.TypeGrpc {
+		unsupported := &forwarder.EventTestResult{
+			Message: fmt.Sprintf("connectivity test isn't supported by %s forwarders", _forwarder.ForwardType),
+		}
+		result.RoomEvent = unsupported
+		result.PlayerEvent = unsupported
+		return result
+	}
+
+	if _forwarder.Options == nil {
+		_forwarder.Options = forwarder.NewDefaultForwarderOptions()
+	}
+	metadata := make(map[string]string, len(_forwarder.Options.Metadata))
+	for key, value := range _forwarder.Options.Metadata {
+		metadata[key] = fmt.Sprintf("%v", value)
+	}
+
+	roomEvent := &pb.RoomEvent{
+		Room: &pb.Room{
+			Game:     game,
+			RoomId:   TestRoomID,
+			Metadata: metadata,
+		},
+		EventType: TestRoomEventType,
+	}
+	if roomType, ok := _forwarder.Options.Metadata["roomType"].(string); ok {
+		roomEvent.Room.RoomType = roomType
+	}
+	result.RoomEvent = t.sendEvent(func() (*pb.Response, error) {
+		return t.forwarderClient.SendRoomEvent(ctx, _forwarder, roomEvent)
+	})
+
+	// The player leaving is sent, instead of joining, so the forwarders
+	// don't count a player that doesn't exist.
+	playerEvent := &pb.PlayerEvent{
+		PlayerId: TestPlayerID,
+		Room: &pb.Room{
+			Game:   game,
+			RoomId: TestRoomID,
+		},
+		EventType: pb.PlayerEvent_PLAYER_LEFT,
+		Metadata:  metadata,
+	}
+	result.PlayerEvent = t.sendEvent(func() (*pb.Response, error) {
+		return t.forwarderClient.SendPlayerEvent(ctx, _forwarder, playerEvent)
+	})
+
+	return result
+}
+
+func (t *ForwardersTester) sendEvent(send func() (*pb.Response, error)) *forwarder.EventTestResult {
+	start := t.clock.Now()
+	response, err := send()
+	result := &forwarder.EventTestResult{Latency: t.clock.Now().Sub(start)}
+	if err != nil {
+		result.Message = err.Error()
+		return result
+	}
+
+	result.Success = response.Code == 200
+	result.Code = response.Code
+	result.Message = response.Message
+	return result
+}
@@ -0,0 +1,266 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build unit
+// +build unit
+
+package events_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	pb "github.com/topfreegames/protos/maestro/grpc/generated"
+
+	"github.com/topfreegames/maestro/internal/core/entities"
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
+	"github.com/topfreegames/maestro/internal/core/ports"
+	clockmock "github.com/topfreegames/maestro/internal/core/ports/clock_mock.go"
+	portsErrors "github.com/topfreegames/maestro/internal/core/ports/errors"
+	mockports "github.com/topfreegames/maestro/internal/core/ports/mock"
+	eventsservice "github.com/topfreegames/maestro/internal/core/services/events"
+	"github.com/topfreegames/maestro/internal/validations"
+)
+
+func TestForwardersTester_TestForwarders(t *testing.T) {
+	err := validations.RegisterValidations()
+	require.NoError(t, err)
+
+	setup := func(t *testing.T) (*mockports.MockForwarderClient, ports.ForwardersTester) {
+		mockCtrl := gomock.NewController(t)
+		forwarderClient := mockports.NewMockForwarderClient(mockCtrl)
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		config := eventsservice.ForwardersTesterConfig{AllowedAddresses: []string{"matchmaker", "unreachable", "*.example.com"}}
+		return forwarderClient, eventsservice.NewForwardersTester(forwarderClient, schedulerStorage, clockmock.NewFakeClock(time.Now()), config)
+	}
+
+	t.Run("sends the synthetic events to each forwarder and returns their answers", func(t *testing.T) {
+		forwarderClient, tester := setup(t)
+		matchmaking := &forwarder.Forwarder{
+			Name:        "matchmaking",
+			Enabled:     true,
+			ForwardType: forwarder.TypeGrpc,
+			Address:     "matchmaker:8080",
+			Options: &forwarder.ForwardOptions{
+				Timeout:  time.Second,
+				Metadata: map[string]interface{}{"roomType": "red", "priority": 1},
+			},
+		}
+		unreachable := &forwarder.Forwarder{
+			Name:        "unreachable",
+			ForwardType: forwarder.TypeGrpc,
+			Address:     "unreachable:8080",
+			Options:     &forwarder.ForwardOptions{Timeout: time.Second},
+		}
+		analytics := &forwarder.Forwarder{
+			Name:        "analytics",
+			Enabled:     true,
+			ForwardType: forwarder.TypeHTTP,
+			Address:     "http://analytics",
+			Options:     &forwarder.ForwardOptions{Timeout: time.Second},
+		}
+
+		expectedMetadata := map[string]string{"roomType": "red", "priority": "1"}
+		forwarderClient.EXPECT().SendRoomEvent(gomock.Any(), *matchmaking, &pb.RoomEvent{
+			Room: &pb.Room{
+				Game:     "game",
+				RoomId:   eventsservice.TestRoomID,
+				RoomType: "red",
+				Metadata: expectedMetadata,
+			},
+			EventType: eventsservice.TestRoomEventType,
+		}).Return(&pb.Response{Code: 200, Message: "ok"}, nil)
+		forwarderClient.EXPECT().SendPlayerEvent(gomock.Any(), *matchmaking, &pb.PlayerEvent{
+			PlayerId:  eventsservice.TestPlayerID,
+			Room:      &pb.Room{Game: "game", RoomId: eventsservice.TestRoomID},
+			EventType: pb.PlayerEvent_PLAYER_LEFT,
+			Metadata:  expectedMetadata,
+		}).Return(&pb.Response{Code: 404, Message: "player not found"}, nil)
+		forwarderClient.EXPECT().SendRoomEvent(gomock.Any(), *unreachable, gomock.Any()).Return(nil, errors.New("connection refused"))
+		forwarderClient.EXPECT().SendPlayerEvent(gomock.Any(), *unreachable, gomock.Any()).Return(nil, errors.New("connection refused"))
+
+		results, err := tester.TestForwarders(context.Background(), "game", []*forwarder.Forwarder{matchmaking, unreachable, analytics})
+		require.NoError(t, err)
+
+		unsupported := &forwarder.EventTestResult{Message: "connectivity test isn't supported by http forwarders"}
+		require.Equal(t, []*forwarder.TestResult{
+			{
+				ForwarderName: "matchmaking",
+				Address:       "matchmaker:8080",
+				RoomEvent:     &forwarder.EventTestResult{Success: true, Code: 200, Message: "ok"},
+				PlayerEvent:   &forwarder.EventTestResult{Code: 404, Message: "player not found"},
+			},
+			{
+				ForwarderName: "unreachable",
+				Address:       "unreachable:8080",
+				RoomEvent:     &forwarder.EventTestResult{Message: "connection refused"},
+				PlayerEvent:   &forwarder.EventTestResult{Message: "connection refused"},
+			},
+			{
+				ForwarderName: "analytics",
+				Address:       "http://analytics",
+				RoomEvent:     unsupported,
+				PlayerEvent:   unsupported,
+			},
+		}, results)
+	})
+
+	t.Run("sends the events with the default options when the forwarder has none", func(t *testing.T) {
+		forwarderClient, tester := setup(t)
+		matchmaking := &forwarder.Forwarder{Name: "matchmaking", ForwardType: forwarder.TypeGrpc, Address: "matchmaker:8080"}
+
+		forwarderClient.EXPECT().SendRoomEvent(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _forwarder forwarder.Forwarder, _ *pb.RoomEvent) (*pb.Response, error) {
+				require.Equal(t, forwarder.NewDefaultForwarderOptions(), _forwarder.Options)
+				return &pb.Response{Code: 200}, nil
+			})
+		forwarderClient.EXPECT().SendPlayerEvent(gomock.Any(), gomock.Any(), gomock.Any()).Return(&pb.Response{Code: 200}, nil)
+
+		results, err := tester.TestForwarders(context.Background(), "game", []*forwarder.Forwarder{matchmaking})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.True(t, results[0].RoomEvent.Success)
+		require.True(t, results[0].PlayerEvent.Success)
+		require.Nil(t, matchmaking.Options)
+	})
+
+	t.Run("fails when no forwarder is informed", func(t *testing.T) {
+		_, tester := setup(t)
+
+		_, err := tester.TestForwarders(context.Background(), "game", nil)
+		require.ErrorIs(t, err, portsErrors.ErrInvalidArgument)
+	})
+
+	t.Run("fails when a forwarder is invalid", func(t *testing.T) {
+		_, tester := setup(t)
+		invalid := &forwarder.Forwarder{Name: "invalid", ForwardType: "websocket", Address: "matchmaker:8080"}
+
+		_, err := tester.TestForwarders(context.Background(), "game", []*forwarder.Forwarder{invalid})
+		require.ErrorIs(t, err, portsErrors.ErrInvalidArgument)
+	})
+
+	t.Run("sends the events to the subdomains of the allowed addresses", func(t *testing.T) {
+		forwarderClient, tester := setup(t)
+		matchmaking := &forwarder.Forwarder{Name: "matchmaking", ForwardType: forwarder.TypeGrpc, Address: "matchmaker.example.com:443"}
+
+		forwarderClient.EXPECT().SendRoomEvent(gomock.Any(), gomock.Any(), gomock.Any()).Return(&pb.Response{Code: 200}, nil)
+		forwarderClient.EXPECT().SendPlayerEvent(gomock.Any(), gomock.Any(), gomock.Any()).Return(&pb.Response{Code: 200}, nil)
+
+		results, err := tester.TestForwarders(context.Background(), "game", []*forwarder.Forwarder{matchmaking})
+		require.NoError(t, err)
+		require.True(t, results[0].RoomEvent.Success)
+	})
+
+	t.Run("fails when a forwarder address isn't allowed", func(t *testing.T) {
+		_, tester := setup(t)
+		internal := &forwarder.Forwarder{Name: "internal", ForwardType: forwarder.TypeGrpc, Address: "10.0.0.1:8080"}
+
+		_, err := tester.TestForwarders(context.Background(), "game", []*forwarder.Forwarder{internal})
+		require.ErrorIs(t, err, portsErrors.ErrInvalidArgument)
+	})
+
+	t.Run("fails when a forwarder references secrets", func(t *testing.T) {
+		_, tester := setup(t)
+		secured := &forwarder.Forwarder{
+			Name:        "secured",
+			ForwardType: forwarder.TypeGrpc,
+			Address:     "matchmaker:8080",
+			Options: &forwarder.ForwardOptions{
+				Timeout: time.Second,
+				GRPC:    &forwarder.GRPCOptions{TLS: &forwarder.TLSOptions{CASecretRef: "matchmakerCA"}},
+			},
+		}
+		signed := &forwarder.Forwarder{
+			Name:        "signed",
+			ForwardType: forwarder.TypeHTTP,
+			Address:     "https://webhook.example.com/events",
+			Options: &forwarder.ForwardOptions{
+				Timeout: time.Second,
+				HTTP:    &forwarder.HTTPOptions{SigningSecretRef: "webhookKey"},
+			},
+		}
+
+		_, err := tester.TestForwarders(context.Background(), "game", []*forwarder.Forwarder{secured})
+		require.ErrorIs(t, err, portsErrors.ErrInvalidArgument)
+
+		_, err = tester.TestForwarders(context.Background(), "game", []*forwarder.Forwarder{signed})
+		require.ErrorIs(t, err, portsErrors.ErrInvalidArgument)
+	})
+}
+
+func TestForwardersTester_TestSchedulerForwarders(t *testing.T) {
+	setup := func(t *testing.T) (*mockports.MockForwarderClient, *mockports.MockSchedulerStorage, ports.ForwardersTester) {
+		mockCtrl := gomock.NewController(t)
+		forwarderClient := mockports.NewMockForwarderClient(mockCtrl)
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		return forwarderClient, schedulerStorage, eventsservice.NewForwardersTester(forwarderClient, schedulerStorage, clockmock.NewFakeClock(time.Now()), eventsservice.ForwardersTesterConfig{})
+	}
+
+	t.Run("sends the synthetic events of the scheduler game to its forwarders", func(t *testing.T) {
+		forwarderClient, schedulerStorage, tester := setup(t)
+		matchmaking := &forwarder.Forwarder{
+			Name:        "matchmaking",
+			ForwardType: forwarder.TypeGrpc,
+			Address:     "matchmaker:8080",
+			Options:     &forwarder.ForwardOptions{Timeout: time.Second},
+		}
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), "scheduler").Return(&entities.Scheduler{
+			Name:       "scheduler",
+			Game:       "game",
+			Forwarders: []*forwarder.Forwarder{matchmaking},
+		}, nil)
+
+		forwarderClient.EXPECT().SendRoomEvent(gomock.Any(), *matchmaking, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ forwarder.Forwarder, event *pb.RoomEvent) (*pb.Response, error) {
+				require.Equal(t, "game", event.Room.Game)
+				return &pb.Response{Code: 200, Message: "ok"}, nil
+			})
+		forwarderClient.EXPECT().SendPlayerEvent(gomock.Any(), *matchmaking, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ forwarder.Forwarder, event *pb.PlayerEvent) (*pb.Response, error) {
+				require.Equal(t, "game", event.Room.Game)
+				return &pb.Response{Code: 200, Message: "ok"}, nil
+			})
+
+		results, err := tester.TestSchedulerForwarders(context.Background(), "scheduler")
+		require.NoError(t, err)
+		require.Equal(t, []*forwarder.TestResult{
+			{
+				ForwarderName: "matchmaking",
+				Address:       "matchmaker:8080",
+				RoomEvent:     &forwarder.EventTestResult{Success: true, Code: 200, Message: "ok"},
+				PlayerEvent:   &forwarder.EventTestResult{Success: true, Code: 200, Message: "ok"},
+			},
+		}, results)
+	})
+
+	t.Run("fails when the scheduler doesn't exist", func(t *testing.T) {
+		_, schedulerStorage, tester := setup(t)
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), "scheduler").Return(nil, portsErrors.NewErrNotFound("scheduler not found"))
+
+		_, err := tester.TestSchedulerForwarders(context.Background(), "scheduler")
+		require.ErrorIs(t, err, portsErrors.ErrNotFound)
+	})
+}
@@ -97,3 +97,20 @@ func reportRoomEventForwardingFailed(game, schedulerName, code string) {
 func reportPlayerEventForwardingFailed(game, schedulerName, code string) {
 	failedPlayerEventForwardingMetric.WithLabelValues(game, schedulerName, code).Inc()
 }
+
+var (
+	deadLetterEventsMetric = monitoring.CreateCounterMetric(&monitoring.MetricOpts{
+		Namespace: monitoring.Namespace,
+		Subsystem: monitoring.SubsystemWorker,
+		Name:      "dead_letter_events",
+		Help:      "Number of events moved to the dead-letter events after failing to be forwarded",
+		Labels: []string{
+			monitoring.LabelScheduler,
+			monitoring.LabelForwarder,
+		},
+	})
+)
+
+func reportEventDeadLettered(schedulerName, forwarderName string) {
+	deadLetterEventsMetric.WithLabelValues(schedulerName, forwarderName).Inc()
+}
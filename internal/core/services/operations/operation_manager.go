@@ -26,6 +26,7 @@ import (
 	"context"
 	goerrors "errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/topfreegames/maestro/internal/core/logs"
@@ -47,6 +48,7 @@ type OperationManager struct {
 	OperationDefinitionConstructors map[string]operations.DefinitionConstructor
 	SchedulerStorage                ports.SchedulerStorage
 	Logger                          *zap.Logger
+	progressMutex                   sync.Mutex
 }
 
 func New(flow ports.OperationFlow, storage ports.OperationStorage, operationDefinitionConstructors map[string]operations.DefinitionConstructor, leaseStorage ports.OperationLeaseStorage, config OperationManagerConfig, schedulerStorage ports.SchedulerStorage) *OperationManager {
@@ -64,35 +66,60 @@ func New(flow ports.OperationFlow, storage ports.OperationStorage, operationDefi
 
 func (om *OperationManager) CreateOperation(ctx context.Context, schedulerName string, definition operations.Definition) (*operation.Operation, error) {
 	op := operation.New(schedulerName, definition.Name(), definition.Marshal())
+	op.Priority = definition.Priority()
 
-	err := om.Storage.CreateOperation(ctx, op)
+	err := om.createAndEnqueueOperation(ctx, op)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create operation: %w", err)
+		return nil, err
 	}
 
-	err = om.Flow.InsertOperationID(ctx, op.SchedulerName, op.ID)
-	if err != nil {
-		om.Logger.Error(fmt.Sprintf("failed to enqueue %s operation to be executed", op.DefinitionName), zap.Error(err), zap.String(logs.LogFieldOperationID, op.ID), zap.String(logs.LogFieldSchedulerName, op.SchedulerName))
-		return nil, fmt.Errorf("failed to insert operation on flow: %w", err)
-	}
-	om.Logger.Info(fmt.Sprintf("operation %s created and enqueued to be executed", op.DefinitionName), zap.String(logs.LogFieldOperationID, op.ID), zap.String(logs.LogFieldSchedulerName, op.SchedulerName))
 	return op, nil
 }
 
-func (om *OperationManager) CreatePriorityOperation(ctx context.Context, schedulerName string, definition operations.Definition) (*operation.Operation, error) {
-	op := operation.New(schedulerName, definition.Name(), definition.Marshal())
+// CreateOperationWithIdempotencyKey creates a new operation like
+// CreateOperation, unless the idempotency key was already used to create an
+// operation for the scheduler within the retention window. In this case, the
+// existing operation is returned and no operation is created.
+func (om *OperationManager) CreateOperationWithIdempotencyKey(ctx context.Context, schedulerName, idempotencyKey string, definition operations.Definition) (*operation.Operation, error) {
+	if idempotencyKey == "" {
+		return om.CreateOperation(ctx, schedulerName, definition)
+	}
 
-	err := om.Storage.CreateOperation(ctx, op)
+	op := operation.New(schedulerName, definition.Name(), definition.Marshal())
+	op.Priority = definition.Priority()
+	existingOperationID, err := om.Storage.CreateOperationWithIdempotencyKey(ctx, op, idempotencyKey, om.Config.IdempotencyKeyTtl)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create operation: %w", err)
 	}
 
-	err = om.Flow.InsertPriorityOperationID(ctx, op.SchedulerName, op.ID)
+	if existingOperationID != "" {
+		existingOp, err := om.Storage.GetOperation(ctx, schedulerName, existingOperationID)
+		if goerrors.Is(err, errors.ErrNotFound) {
+			// The key outlives its operation, it is kept until it expires so
+			// the request can't be executed twice.
+			return nil, errors.NewErrConflict("the operation %s created with the idempotency key %s is not available anymore", existingOperationID, idempotencyKey)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch operation from storage: %w", err)
+		}
+
+		om.Logger.Info("operation already created with the idempotency key", zap.String(logs.LogFieldOperationID, existingOp.ID), zap.String(logs.LogFieldSchedulerName, schedulerName))
+		return existingOp, nil
+	}
+
+	err = om.Flow.InsertOperationID(ctx, op.SchedulerName, op.ID, op.Priority)
 	if err != nil {
-		om.Logger.Error(fmt.Sprintf("failed to enqueue (priority) %s operation to be executed", op.DefinitionName), zap.Error(err), zap.String(logs.LogFieldOperationID, op.ID), zap.String(logs.LogFieldSchedulerName, op.SchedulerName))
+		om.Logger.Error(fmt.Sprintf("failed to enqueue %s operation to be executed", op.DefinitionName), zap.Error(err), zap.String(logs.LogFieldOperationID, op.ID), zap.String(logs.LogFieldSchedulerName, op.SchedulerName))
+		// The operation stays associated to the key, so it is finished with
+		// error to let retries know it won't be executed.
+		statusErr := om.Storage.UpdateOperationStatus(ctx, op.SchedulerName, op.ID, operation.StatusError)
+		if statusErr != nil {
+			om.Logger.Error("failed to set status of operation not enqueued", zap.Error(statusErr), zap.String(logs.LogFieldOperationID, op.ID), zap.String(logs.LogFieldSchedulerName, op.SchedulerName))
+		}
 		return nil, fmt.Errorf("failed to insert operation on flow: %w", err)
 	}
-	om.Logger.Info(fmt.Sprintf("operation (priority) %s created and enqueued on the top to be executed", op.DefinitionName), zap.String(logs.LogFieldOperationID, op.ID), zap.String(logs.LogFieldSchedulerName, op.SchedulerName))
+	om.Logger.Info(fmt.Sprintf("operation %s created and enqueued to be executed", op.DefinitionName), zap.String(logs.LogFieldOperationID, op.ID), zap.String(logs.LogFieldSchedulerName, op.SchedulerName))
+
 	return op, nil
 }
 
@@ -348,6 +375,230 @@ func (om *OperationManager) AppendOperationEventToExecutionHistory(ctx context.C
 	}
 }
 
+// StartOperationProgress initializes the Operation.Progress with the total
+// units of work the operation is going to process and persists it.
+func (om *OperationManager) StartOperationProgress(ctx context.Context, op *operation.Operation, totalUnits int) {
+	om.progressMutex.Lock()
+	defer om.progressMutex.Unlock()
+
+	now := time.Now().UTC()
+	op.Progress = &operation.OperationProgress{
+		TotalUnits: totalUnits,
+		StartedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	om.persistOperationProgress(ctx, op)
+}
+
+// UpdateOperationProgress adds the completed and failed units to the
+// Operation.Progress and persists it. It is safe to be called concurrently by
+// the executors. If the progress was not started, it is started without a
+// total.
+func (om *OperationManager) UpdateOperationProgress(ctx context.Context, op *operation.Operation, completedUnits, failedUnits int) {
+	om.progressMutex.Lock()
+	defer om.progressMutex.Unlock()
+
+	now := time.Now().UTC()
+	if op.Progress == nil {
+		op.Progress = &operation.OperationProgress{StartedAt: now}
+	}
+	op.Progress.CompletedUnits += completedUnits
+	op.Progress.FailedUnits += failedUnits
+	op.Progress.UpdatedAt = now
+
+	om.persistOperationProgress(ctx, op)
+}
+
+func (om *OperationManager) persistOperationProgress(ctx context.Context, op *operation.Operation) {
+	if err := om.Storage.UpdateOperationProgress(ctx, op); err != nil {
+		om.Logger.Error("Error updating operation progress", zap.Error(err), zap.String(logs.LogFieldOperationID, op.ID), zap.String(logs.LogFieldSchedulerName, op.SchedulerName))
+	}
+}
+
+// WatchOperation returns a channel that receives the operation right away and
+// then every time it is updated (status, execution history or progress). The
+// channel is closed when the operation reaches a final status or when the
+// context is done.
+func (om *OperationManager) WatchOperation(ctx context.Context, schedulerName, operationID string) (<-chan *operation.Operation, error) {
+	watchCtx, cancelWatch := context.WithCancel(ctx)
+
+	// subscribes before fetching the operation, so no update is lost in between.
+	updates, err := om.Storage.WatchSchedulerOperationsUpdates(watchCtx, schedulerName)
+	if err != nil {
+		cancelWatch()
+		return nil, fmt.Errorf("failed to watch operations updates: %w", err)
+	}
+
+	op, err := om.Storage.GetOperation(watchCtx, schedulerName, operationID)
+	if err != nil {
+		cancelWatch()
+		return nil, err
+	}
+
+	opChan := make(chan *operation.Operation)
+	go func() {
+		defer cancelWatch()
+		defer close(opChan)
+
+		if !sendWatchedOperation(watchCtx, opChan, op) || op.Status.IsFinal() {
+			return
+		}
+
+		for updatedOperationID := range updates {
+			if updatedOperationID != operationID {
+				continue
+			}
+
+			op, err := om.Storage.GetOperation(watchCtx, schedulerName, operationID)
+			if err != nil {
+				om.Logger.Error("failed to fetch watched operation", zap.Error(err), zap.String(logs.LogFieldOperationID, operationID), zap.String(logs.LogFieldSchedulerName, schedulerName))
+				continue
+			}
+
+			if !sendWatchedOperation(watchCtx, opChan, op) || op.Status.IsFinal() {
+				return
+			}
+		}
+	}()
+
+	return opChan, nil
+}
+
+// WatchSchedulerOperations returns a channel that receives the scheduler
+// operations every time they are created or updated. The channel is closed
+// when the context is done.
+func (om *OperationManager) WatchSchedulerOperations(ctx context.Context, schedulerName string) (<-chan *operation.Operation, error) {
+	_, err := om.SchedulerStorage.GetScheduler(ctx, schedulerName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch scheduler from storage: %w", err)
+	}
+
+	updates, err := om.Storage.WatchSchedulerOperationsUpdates(ctx, schedulerName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch operations updates: %w", err)
+	}
+
+	opChan := make(chan *operation.Operation)
+	go func() {
+		defer close(opChan)
+
+		for operationID := range updates {
+			op, err := om.Storage.GetOperation(ctx, schedulerName, operationID)
+			if err != nil {
+				om.Logger.Error("failed to fetch watched operation", zap.Error(err), zap.String(logs.LogFieldOperationID, operationID), zap.String(logs.LogFieldSchedulerName, schedulerName))
+				continue
+			}
+
+			if !sendWatchedOperation(ctx, opChan, op) {
+				return
+			}
+		}
+	}()
+
+	return opChan, nil
+}
+
+func sendWatchedOperation(ctx context.Context, opChan chan<- *operation.Operation, op *operation.Operation) bool {
+	select {
+	case opChan <- op:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// PauseSchedulerOperations pauses the scheduler operations processing. While
+// paused, the worker holds the operations that are not allowed to run until
+// the processing is resumed.
+func (om *OperationManager) PauseSchedulerOperations(ctx context.Context, schedulerName, reason string) error {
+	_, err := om.SchedulerStorage.GetScheduler(ctx, schedulerName)
+	if err != nil {
+		return fmt.Errorf("failed to fetch scheduler from storage: %w", err)
+	}
+
+	pause, err := om.Flow.GetOperationsPause(ctx, schedulerName)
+	if err != nil {
+		return fmt.Errorf("failed to fetch scheduler operations pause: %w", err)
+	}
+
+	if pause != nil {
+		return errors.NewErrConflict("scheduler %s operations are already paused", schedulerName)
+	}
+
+	err = om.Flow.PauseOperations(ctx, schedulerName, reason)
+	if err != nil {
+		return fmt.Errorf("failed to pause scheduler operations: %w", err)
+	}
+
+	om.Logger.Info("scheduler operations paused", zap.String(logs.LogFieldSchedulerName, schedulerName), zap.String("reason", reason))
+	return nil
+}
+
+// ResumeSchedulerOperations resumes the scheduler operations processing,
+// putting the operations held while paused back on top of the pending ones.
+func (om *OperationManager) ResumeSchedulerOperations(ctx context.Context, schedulerName string) error {
+	_, err := om.SchedulerStorage.GetScheduler(ctx, schedulerName)
+	if err != nil {
+		return fmt.Errorf("failed to fetch scheduler from storage: %w", err)
+	}
+
+	pause, err := om.Flow.GetOperationsPause(ctx, schedulerName)
+	if err != nil {
+		return fmt.Errorf("failed to fetch scheduler operations pause: %w", err)
+	}
+
+	if pause == nil {
+		return errors.NewErrConflict("scheduler %s operations are not paused", schedulerName)
+	}
+
+	err = om.Flow.ResumeOperations(ctx, schedulerName)
+	if err != nil {
+		return fmt.Errorf("failed to resume scheduler operations: %w", err)
+	}
+
+	om.Logger.Info("scheduler operations resumed", zap.String(logs.LogFieldSchedulerName, schedulerName))
+	return nil
+}
+
+// GetSchedulerOperationsPause returns the scheduler operations pause, or nil
+// if the scheduler operations processing is not paused.
+func (om *OperationManager) GetSchedulerOperationsPause(ctx context.Context, schedulerName string) (*operation.OperationsPause, error) {
+	pause, err := om.Flow.GetOperationsPause(ctx, schedulerName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch scheduler operations pause: %w", err)
+	}
+
+	return pause, nil
+}
+
+// HoldOperation keeps the operation pending until the scheduler operations
+// processing is resumed. It returns false, without holding the operation,
+// when the processing was resumed in the meantime.
+func (om *OperationManager) HoldOperation(ctx context.Context, op *operation.Operation) (bool, error) {
+	held, err := om.Flow.HoldOperationID(ctx, op.SchedulerName, op.ID, op.Priority)
+	if err != nil {
+		return false, fmt.Errorf("failed to hold operation: %w", err)
+	}
+
+	return held, nil
+}
+
+func (om *OperationManager) createAndEnqueueOperation(ctx context.Context, op *operation.Operation) error {
+	err := om.Storage.CreateOperation(ctx, op)
+	if err != nil {
+		return fmt.Errorf("failed to create operation: %w", err)
+	}
+
+	err = om.Flow.InsertOperationID(ctx, op.SchedulerName, op.ID, op.Priority)
+	if err != nil {
+		om.Logger.Error(fmt.Sprintf("failed to enqueue %s operation to be executed", op.DefinitionName), zap.Error(err), zap.String(logs.LogFieldOperationID, op.ID), zap.String(logs.LogFieldSchedulerName, op.SchedulerName))
+		return fmt.Errorf("failed to insert operation on flow: %w", err)
+	}
+	om.Logger.Info(fmt.Sprintf("operation %s created and enqueued to be executed", op.DefinitionName), zap.String(logs.LogFieldOperationID, op.ID), zap.String(logs.LogFieldSchedulerName, op.SchedulerName))
+	return nil
+}
+
 func (om *OperationManager) addOperationsLeaseData(ctx context.Context, schedulerName string, ops []*operation.Operation) error {
 	opMap := make(map[string]*operation.Operation)
 	opIds := make([]string, 0, len(ops))
@@ -368,7 +619,7 @@ func (om *OperationManager) addOperationsLeaseData(ctx context.Context, schedule
 	return nil
 }
 
-func (om OperationManager) cancelOperation(ctx context.Context, schedulerName, operationID string) error {
+func (om *OperationManager) cancelOperation(ctx context.Context, schedulerName, operationID string) error {
 
 	op, err := om.Storage.GetOperation(ctx, schedulerName, operationID)
 	if err != nil {
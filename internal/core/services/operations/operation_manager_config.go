@@ -26,4 +26,7 @@ import "time"
 
 type OperationManagerConfig struct {
 	OperationLeaseTtl time.Duration
+	// IdempotencyKeyTtl is the retention window of the operations idempotency
+	// keys.
+	IdempotencyKeyTtl time.Duration
 }
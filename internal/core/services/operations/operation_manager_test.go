@@ -27,6 +27,7 @@ package operations
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
@@ -54,12 +55,14 @@ import (
 type testOperationDefinition struct {
 	marshalResult   []byte
 	unmarshalResult error
+	priority        operation.Priority
 }
 
-func (d *testOperationDefinition) Marshal() []byte            { return d.marshalResult }
-func (d *testOperationDefinition) Unmarshal(raw []byte) error { return d.unmarshalResult }
-func (d *testOperationDefinition) Name() string               { return "testOperationDefinition" }
-func (d *testOperationDefinition) HasNoAction() bool          { return false }
+func (d *testOperationDefinition) Marshal() []byte              { return d.marshalResult }
+func (d *testOperationDefinition) Unmarshal(raw []byte) error   { return d.unmarshalResult }
+func (d *testOperationDefinition) Name() string                 { return "testOperationDefinition" }
+func (d *testOperationDefinition) HasNoAction() bool            { return false }
+func (d *testOperationDefinition) Priority() operation.Priority { return d.priority }
 func (d *testOperationDefinition) ShouldExecute(_ context.Context, _ []*operation.Operation) bool {
 	return false
 }
@@ -88,6 +91,9 @@ func TestCreateOperation(t *testing.T) {
 		"create without errors": {
 			definition: &testOperationDefinition{marshalResult: []byte("test")},
 		},
+		"create with the definition priority": {
+			definition: &testOperationDefinition{marshalResult: []byte("test"), priority: operation.PriorityHigh},
+		},
 		"create with storage errors": {
 			definition: &testOperationDefinition{},
 			storageErr: porterrors.ErrUnexpected,
@@ -115,7 +121,7 @@ func TestCreateOperation(t *testing.T) {
 			operationStorage.EXPECT().CreateOperation(ctx, &opMatcher{operation.StatusPending, test.definition}).Return(test.storageErr)
 
 			if test.storageErr == nil {
-				operationFlow.EXPECT().InsertOperationID(ctx, schedulerName, gomock.Any()).Return(test.flowErr)
+				operationFlow.EXPECT().InsertOperationID(ctx, schedulerName, gomock.Any(), test.definition.Priority()).Return(test.flowErr)
 			}
 
 			op, err := opManager.CreateOperation(ctx, schedulerName, test.definition)
@@ -134,6 +140,7 @@ func TestCreateOperation(t *testing.T) {
 
 			assert.NotEmpty(t, op.ID)
 			assert.Equal(t, operation.StatusPending, op.Status)
+			assert.Equal(t, test.definition.Priority(), op.Priority)
 			assert.Equal(t, test.definition.Name(), op.DefinitionName)
 			assert.Equal(t, schedulerName, op.SchedulerName)
 			assert.EqualValues(t, test.definition.Marshal(), op.Input)
@@ -141,67 +148,107 @@ func TestCreateOperation(t *testing.T) {
 	}
 }
 
-func TestCreatePriorityOperation(t *testing.T) {
-	cases := map[string]struct {
-		definition operations.Definition
-		storageErr error
-		flowErr    error
-	}{
-		"create without errors": {
-			definition: &testOperationDefinition{marshalResult: []byte("test")},
-		},
-		"create with storage errors": {
-			definition: &testOperationDefinition{},
-			storageErr: porterrors.ErrUnexpected,
-		},
-		"create with flow errors": {
-			definition: &testOperationDefinition{},
-			flowErr:    porterrors.ErrUnexpected,
-		},
+func TestCreateOperationWithIdempotencyKey(t *testing.T) {
+	schedulerName := "scheduler_name"
+	idempotencyKey := "some-key"
+	ttl := 24 * time.Hour
+	definition := &testOperationDefinition{marshalResult: []byte("test")}
+
+	setup := func(mockCtrl *gomock.Controller) (*OperationManager, *mockports.MockOperationStorage, *mockports.MockOperationFlow) {
+		operationFlow := mockports.NewMockOperationFlow(mockCtrl)
+		operationStorage := mockports.NewMockOperationStorage(mockCtrl)
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		definitionConstructors := operations.NewDefinitionConstructors()
+		operationLeaseStorage := mockports.NewMockOperationLeaseStorage(mockCtrl)
+		config := OperationManagerConfig{OperationLeaseTtl: time.Millisecond * 1000, IdempotencyKeyTtl: ttl}
+		opManager := New(operationFlow, operationStorage, definitionConstructors, operationLeaseStorage, config, schedulerStorage)
+		return opManager, operationStorage, operationFlow
 	}
 
-	for name, test := range cases {
-		t.Run(name, func(t *testing.T) {
-			mockCtrl := gomock.NewController(t)
+	t.Run("creates the operation without reserving a key when it is empty", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		opManager, operationStorage, operationFlow := setup(mockCtrl)
+		ctx := context.Background()
 
-			schedulerName := "scheduler_name"
-			operationFlow := mockports.NewMockOperationFlow(mockCtrl)
-			operationStorage := mockports.NewMockOperationStorage(mockCtrl)
-			schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
-			definitionConstructors := operations.NewDefinitionConstructors()
-			operationLeaseStorage := mockports.NewMockOperationLeaseStorage(mockCtrl)
-			config := OperationManagerConfig{OperationLeaseTtl: time.Millisecond * 1000}
-			opManager := New(operationFlow, operationStorage, definitionConstructors, operationLeaseStorage, config, schedulerStorage)
+		operationStorage.EXPECT().CreateOperation(ctx, &opMatcher{operation.StatusPending, definition}).Return(nil)
+		operationFlow.EXPECT().InsertOperationID(ctx, schedulerName, gomock.Any(), operation.PriorityNormal).Return(nil)
 
-			ctx := context.Background()
+		op, err := opManager.CreateOperationWithIdempotencyKey(ctx, schedulerName, "", definition)
+		require.NoError(t, err)
+		require.NotEmpty(t, op.ID)
+	})
 
-			operationStorage.EXPECT().CreateOperation(ctx, &opMatcher{operation.StatusPending, test.definition}).Return(test.storageErr)
+	t.Run("creates the operation along with the key", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		opManager, operationStorage, operationFlow := setup(mockCtrl)
+		ctx := context.Background()
 
-			if test.storageErr == nil {
-				operationFlow.EXPECT().InsertPriorityOperationID(ctx, schedulerName, gomock.Any()).Return(test.flowErr)
-			}
+		var createdID string
+		operationStorage.EXPECT().CreateOperationWithIdempotencyKey(ctx, &opMatcher{operation.StatusPending, definition}, idempotencyKey, ttl).
+			DoAndReturn(func(_ context.Context, op *operation.Operation, _ string, _ time.Duration) (string, error) {
+				createdID = op.ID
+				return "", nil
+			})
+		operationFlow.EXPECT().InsertOperationID(ctx, schedulerName, gomock.Any(), operation.PriorityNormal).Return(nil)
 
-			op, err := opManager.CreatePriorityOperation(ctx, schedulerName, test.definition)
+		op, err := opManager.CreateOperationWithIdempotencyKey(ctx, schedulerName, idempotencyKey, definition)
+		require.NoError(t, err)
+		require.Equal(t, createdID, op.ID)
+		require.Equal(t, schedulerName, op.SchedulerName)
+	})
 
-			if test.storageErr != nil {
-				require.ErrorIs(t, err, test.storageErr)
-				require.Nil(t, op)
-				return
-			}
+	t.Run("returns the existing operation when the key was already used", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		opManager, operationStorage, _ := setup(mockCtrl)
+		ctx := context.Background()
+		existingOp := &operation.Operation{ID: "existing-id", SchedulerName: schedulerName, Status: operation.StatusInProgress}
 
-			if test.flowErr != nil {
-				require.ErrorIs(t, err, test.flowErr)
-				require.Nil(t, op)
-				return
-			}
+		operationStorage.EXPECT().CreateOperationWithIdempotencyKey(ctx, gomock.Any(), idempotencyKey, ttl).Return(existingOp.ID, nil)
+		operationStorage.EXPECT().GetOperation(ctx, schedulerName, existingOp.ID).Return(existingOp, nil)
 
-			assert.NotEmpty(t, op.ID)
-			assert.Equal(t, operation.StatusPending, op.Status)
-			assert.Equal(t, test.definition.Name(), op.DefinitionName)
-			assert.Equal(t, schedulerName, op.SchedulerName)
-			assert.EqualValues(t, test.definition.Marshal(), op.Input)
-		})
-	}
+		op, err := opManager.CreateOperationWithIdempotencyKey(ctx, schedulerName, idempotencyKey, definition)
+		require.NoError(t, err)
+		require.Equal(t, existingOp, op)
+	})
+
+	t.Run("returns conflict when the operation of the key doesn't exist anymore", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		opManager, operationStorage, _ := setup(mockCtrl)
+		ctx := context.Background()
+
+		operationStorage.EXPECT().CreateOperationWithIdempotencyKey(ctx, gomock.Any(), idempotencyKey, ttl).Return("expired-id", nil)
+		operationStorage.EXPECT().GetOperation(ctx, schedulerName, "expired-id").Return(nil, porterrors.NewErrNotFound("not found"))
+
+		op, err := opManager.CreateOperationWithIdempotencyKey(ctx, schedulerName, idempotencyKey, definition)
+		require.ErrorIs(t, err, porterrors.ErrConflict)
+		require.Nil(t, op)
+	})
+
+	t.Run("returns error when it fails to create the operation", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		opManager, operationStorage, _ := setup(mockCtrl)
+		ctx := context.Background()
+
+		operationStorage.EXPECT().CreateOperationWithIdempotencyKey(ctx, gomock.Any(), idempotencyKey, ttl).Return("", porterrors.NewErrUnexpected("error"))
+
+		op, err := opManager.CreateOperationWithIdempotencyKey(ctx, schedulerName, idempotencyKey, definition)
+		require.ErrorIs(t, err, porterrors.ErrUnexpected)
+		require.Nil(t, op)
+	})
+
+	t.Run("finishes the operation with error when it fails to enqueue it", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		opManager, operationStorage, operationFlow := setup(mockCtrl)
+		ctx := context.Background()
+
+		operationStorage.EXPECT().CreateOperationWithIdempotencyKey(ctx, gomock.Any(), idempotencyKey, ttl).Return("", nil)
+		operationFlow.EXPECT().InsertOperationID(ctx, schedulerName, gomock.Any(), operation.PriorityNormal).Return(porterrors.NewErrUnexpected("error"))
+		operationStorage.EXPECT().UpdateOperationStatus(ctx, schedulerName, gomock.Any(), operation.StatusError).Return(nil)
+
+		op, err := opManager.CreateOperationWithIdempotencyKey(ctx, schedulerName, idempotencyKey, definition)
+		require.ErrorIs(t, err, porterrors.ErrUnexpected)
+		require.Nil(t, op)
+	})
 }
 
 func TestGetOperation(t *testing.T) {
@@ -1202,6 +1249,243 @@ func TestAppendOperationEventToExecutionHistory(t *testing.T) {
 	})
 }
 
+func TestStartOperationProgress(t *testing.T) {
+	t.Run("initializes and persists the operation progress", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+
+		operationFlow := mockports.NewMockOperationFlow(mockCtrl)
+		operationStorage := mockports.NewMockOperationStorage(mockCtrl)
+		definitionConstructors := operations.NewDefinitionConstructors()
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		operationLeaseStorage := mockports.NewMockOperationLeaseStorage(mockCtrl)
+		config := OperationManagerConfig{OperationLeaseTtl: time.Millisecond * 1000}
+		opManager := New(operationFlow, operationStorage, definitionConstructors, operationLeaseStorage, config, schedulerStorage)
+
+		ctx := context.Background()
+		op := &operation.Operation{ID: uuid.NewString(), SchedulerName: "test-scheduler"}
+
+		operationStorage.EXPECT().UpdateOperationProgress(ctx, op).Return(nil)
+		opManager.StartOperationProgress(ctx, op, 10)
+
+		require.NotNil(t, op.Progress)
+		require.Equal(t, 10, op.Progress.TotalUnits)
+		require.Equal(t, 0, op.Progress.CompletedUnits)
+		require.Equal(t, 0, op.Progress.FailedUnits)
+		require.False(t, op.Progress.StartedAt.IsZero())
+	})
+}
+
+func TestUpdateOperationProgress(t *testing.T) {
+	t.Run("accumulates completed and failed units concurrently", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+
+		operationFlow := mockports.NewMockOperationFlow(mockCtrl)
+		operationStorage := mockports.NewMockOperationStorage(mockCtrl)
+		definitionConstructors := operations.NewDefinitionConstructors()
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		operationLeaseStorage := mockports.NewMockOperationLeaseStorage(mockCtrl)
+		config := OperationManagerConfig{OperationLeaseTtl: time.Millisecond * 1000}
+		opManager := New(operationFlow, operationStorage, definitionConstructors, operationLeaseStorage, config, schedulerStorage)
+
+		ctx := context.Background()
+		op := &operation.Operation{ID: uuid.NewString(), SchedulerName: "test-scheduler"}
+
+		operationStorage.EXPECT().UpdateOperationProgress(ctx, op).Return(nil).Times(21)
+		opManager.StartOperationProgress(ctx, op, 20)
+
+		wg := sync.WaitGroup{}
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				if i%4 == 0 {
+					opManager.UpdateOperationProgress(ctx, op, 0, 1)
+					return
+				}
+				opManager.UpdateOperationProgress(ctx, op, 1, 0)
+			}(i)
+		}
+		wg.Wait()
+
+		require.Equal(t, 20, op.Progress.TotalUnits)
+		require.Equal(t, 15, op.Progress.CompletedUnits)
+		require.Equal(t, 5, op.Progress.FailedUnits)
+	})
+
+	t.Run("starts the progress without total when it was not started", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+
+		operationFlow := mockports.NewMockOperationFlow(mockCtrl)
+		operationStorage := mockports.NewMockOperationStorage(mockCtrl)
+		definitionConstructors := operations.NewDefinitionConstructors()
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		operationLeaseStorage := mockports.NewMockOperationLeaseStorage(mockCtrl)
+		config := OperationManagerConfig{OperationLeaseTtl: time.Millisecond * 1000}
+		opManager := New(operationFlow, operationStorage, definitionConstructors, operationLeaseStorage, config, schedulerStorage)
+
+		ctx := context.Background()
+		op := &operation.Operation{ID: uuid.NewString(), SchedulerName: "test-scheduler"}
+
+		operationStorage.EXPECT().UpdateOperationProgress(ctx, op).Return(porterrors.NewErrUnexpected("some error"))
+		opManager.UpdateOperationProgress(ctx, op, 1, 0)
+
+		require.NotNil(t, op.Progress)
+		require.Equal(t, 0, op.Progress.TotalUnits)
+		require.Equal(t, 1, op.Progress.CompletedUnits)
+	})
+}
+
+func TestWatchOperation(t *testing.T) {
+	setup := func(t *testing.T) (*OperationManager, *mockports.MockOperationStorage) {
+		mockCtrl := gomock.NewController(t)
+
+		operationFlow := mockports.NewMockOperationFlow(mockCtrl)
+		operationStorage := mockports.NewMockOperationStorage(mockCtrl)
+		definitionConstructors := operations.NewDefinitionConstructors()
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		operationLeaseStorage := mockports.NewMockOperationLeaseStorage(mockCtrl)
+		config := OperationManagerConfig{OperationLeaseTtl: time.Millisecond * 1000}
+		return New(operationFlow, operationStorage, definitionConstructors, operationLeaseStorage, config, schedulerStorage), operationStorage
+	}
+
+	schedulerName := "test-scheduler"
+	operationID := uuid.NewString()
+
+	t.Run("sends the operation on every update until it reaches a final status", func(t *testing.T) {
+		opManager, operationStorage := setup(t)
+
+		updates := make(chan string, 10)
+		operationStorage.EXPECT().WatchSchedulerOperationsUpdates(gomock.Any(), schedulerName).Return(updates, nil)
+		gomock.InOrder(
+			operationStorage.EXPECT().GetOperation(gomock.Any(), schedulerName, operationID).Return(&operation.Operation{ID: operationID, Status: operation.StatusPending}, nil),
+			operationStorage.EXPECT().GetOperation(gomock.Any(), schedulerName, operationID).Return(&operation.Operation{ID: operationID, Status: operation.StatusInProgress}, nil),
+			operationStorage.EXPECT().GetOperation(gomock.Any(), schedulerName, operationID).Return(&operation.Operation{ID: operationID, Status: operation.StatusFinished}, nil),
+		)
+
+		opChan, err := opManager.WatchOperation(context.Background(), schedulerName, operationID)
+		require.NoError(t, err)
+
+		updates <- operationID
+		updates <- "other-operation-id"
+		updates <- operationID
+
+		var statuses []operation.Status
+		for op := range opChan {
+			statuses = append(statuses, op.Status)
+		}
+
+		require.Equal(t, []operation.Status{operation.StatusPending, operation.StatusInProgress, operation.StatusFinished}, statuses)
+	})
+
+	t.Run("closes the channel right away when the operation is already at a final status", func(t *testing.T) {
+		opManager, operationStorage := setup(t)
+
+		operationStorage.EXPECT().WatchSchedulerOperationsUpdates(gomock.Any(), schedulerName).Return(make(chan string), nil)
+		operationStorage.EXPECT().GetOperation(gomock.Any(), schedulerName, operationID).Return(&operation.Operation{ID: operationID, Status: operation.StatusError}, nil)
+
+		opChan, err := opManager.WatchOperation(context.Background(), schedulerName, operationID)
+		require.NoError(t, err)
+
+		op := <-opChan
+		require.Equal(t, operation.StatusError, op.Status)
+		_, ok := <-opChan
+		require.False(t, ok)
+	})
+
+	t.Run("closes the channel when the context is done", func(t *testing.T) {
+		opManager, operationStorage := setup(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		operationStorage.EXPECT().WatchSchedulerOperationsUpdates(gomock.Any(), schedulerName).Return(make(chan string), nil)
+		operationStorage.EXPECT().GetOperation(gomock.Any(), schedulerName, operationID).Return(&operation.Operation{ID: operationID, Status: operation.StatusPending}, nil)
+
+		opChan, err := opManager.WatchOperation(ctx, schedulerName, operationID)
+		require.NoError(t, err)
+
+		cancel()
+		require.Eventually(t, func() bool {
+			_, ok := <-opChan
+			return !ok
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("returns error when the operation does not exist", func(t *testing.T) {
+		opManager, operationStorage := setup(t)
+
+		operationStorage.EXPECT().WatchSchedulerOperationsUpdates(gomock.Any(), schedulerName).Return(make(chan string), nil)
+		operationStorage.EXPECT().GetOperation(gomock.Any(), schedulerName, operationID).Return(nil, porterrors.NewErrNotFound("operation not found"))
+
+		_, err := opManager.WatchOperation(context.Background(), schedulerName, operationID)
+		require.ErrorIs(t, err, porterrors.ErrNotFound)
+	})
+
+	t.Run("returns error when it fails to watch the updates", func(t *testing.T) {
+		opManager, operationStorage := setup(t)
+
+		operationStorage.EXPECT().WatchSchedulerOperationsUpdates(gomock.Any(), schedulerName).Return(nil, porterrors.NewErrUnexpected("some error"))
+
+		_, err := opManager.WatchOperation(context.Background(), schedulerName, operationID)
+		require.ErrorContains(t, err, "failed to watch operations updates: some error")
+	})
+}
+
+func TestWatchSchedulerOperations(t *testing.T) {
+	schedulerName := "test-scheduler"
+
+	t.Run("sends every updated operation of the scheduler", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+
+		operationFlow := mockports.NewMockOperationFlow(mockCtrl)
+		operationStorage := mockports.NewMockOperationStorage(mockCtrl)
+		definitionConstructors := operations.NewDefinitionConstructors()
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		operationLeaseStorage := mockports.NewMockOperationLeaseStorage(mockCtrl)
+		config := OperationManagerConfig{OperationLeaseTtl: time.Millisecond * 1000}
+		opManager := New(operationFlow, operationStorage, definitionConstructors, operationLeaseStorage, config, schedulerStorage)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		updates := make(chan string, 10)
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), schedulerName).Return(newValidScheduler(), nil)
+		operationStorage.EXPECT().WatchSchedulerOperationsUpdates(gomock.Any(), schedulerName).Return(updates, nil)
+		operationStorage.EXPECT().GetOperation(gomock.Any(), schedulerName, "first-op").Return(&operation.Operation{ID: "first-op"}, nil)
+		operationStorage.EXPECT().GetOperation(gomock.Any(), schedulerName, "expired-op").Return(nil, porterrors.NewErrNotFound("operation not found"))
+		operationStorage.EXPECT().GetOperation(gomock.Any(), schedulerName, "second-op").Return(&operation.Operation{ID: "second-op"}, nil)
+
+		opChan, err := opManager.WatchSchedulerOperations(ctx, schedulerName)
+		require.NoError(t, err)
+
+		updates <- "first-op"
+		updates <- "expired-op"
+		updates <- "second-op"
+
+		require.Equal(t, "first-op", (<-opChan).ID)
+		require.Equal(t, "second-op", (<-opChan).ID)
+
+		close(updates)
+		_, ok := <-opChan
+		require.False(t, ok)
+	})
+
+	t.Run("returns error when the scheduler does not exist", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+
+		operationFlow := mockports.NewMockOperationFlow(mockCtrl)
+		operationStorage := mockports.NewMockOperationStorage(mockCtrl)
+		definitionConstructors := operations.NewDefinitionConstructors()
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		operationLeaseStorage := mockports.NewMockOperationLeaseStorage(mockCtrl)
+		config := OperationManagerConfig{OperationLeaseTtl: time.Millisecond * 1000}
+		opManager := New(operationFlow, operationStorage, definitionConstructors, operationLeaseStorage, config, schedulerStorage)
+
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), schedulerName).Return(nil, porterrors.NewErrNotFound("scheduler not found"))
+
+		_, err := opManager.WatchSchedulerOperations(context.Background(), schedulerName)
+		require.ErrorIs(t, err, porterrors.ErrNotFound)
+	})
+}
+
 // newValidScheduler generates a valid scheduler with the required fields.
 func newValidScheduler() *entities.Scheduler {
 	return &entities.Scheduler{
@@ -1241,3 +1525,119 @@ func newValidScheduler() *entities.Scheduler {
 		},
 	}
 }
+
+func TestPauseSchedulerOperations(t *testing.T) {
+	schedulerName := "test-scheduler"
+
+	setup := func(mockCtrl *gomock.Controller) (*OperationManager, *mockports.MockOperationFlow, *mockports.MockSchedulerStorage) {
+		operationFlow := mockports.NewMockOperationFlow(mockCtrl)
+		operationStorage := mockports.NewMockOperationStorage(mockCtrl)
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		operationLeaseStorage := mockports.NewMockOperationLeaseStorage(mockCtrl)
+		config := OperationManagerConfig{OperationLeaseTtl: time.Millisecond * 1000}
+		opManager := New(operationFlow, operationStorage, operations.NewDefinitionConstructors(), operationLeaseStorage, config, schedulerStorage)
+		return opManager, operationFlow, schedulerStorage
+	}
+
+	t.Run("pauses the scheduler operations", func(t *testing.T) {
+		opManager, operationFlow, schedulerStorage := setup(gomock.NewController(t))
+
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), schedulerName).Return(newValidScheduler(), nil)
+		operationFlow.EXPECT().GetOperationsPause(gomock.Any(), schedulerName).Return(nil, nil)
+		operationFlow.EXPECT().PauseOperations(gomock.Any(), schedulerName, "incident").Return(nil)
+
+		err := opManager.PauseSchedulerOperations(context.Background(), schedulerName, "incident")
+		require.NoError(t, err)
+	})
+
+	t.Run("returns conflict when the scheduler operations are already paused", func(t *testing.T) {
+		opManager, operationFlow, schedulerStorage := setup(gomock.NewController(t))
+
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), schedulerName).Return(newValidScheduler(), nil)
+		operationFlow.EXPECT().GetOperationsPause(gomock.Any(), schedulerName).Return(&operation.OperationsPause{Reason: "other"}, nil)
+
+		err := opManager.PauseSchedulerOperations(context.Background(), schedulerName, "incident")
+		require.ErrorIs(t, err, porterrors.ErrConflict)
+	})
+
+	t.Run("returns error when the scheduler does not exist", func(t *testing.T) {
+		opManager, _, schedulerStorage := setup(gomock.NewController(t))
+
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), schedulerName).Return(nil, porterrors.NewErrNotFound("scheduler not found"))
+
+		err := opManager.PauseSchedulerOperations(context.Background(), schedulerName, "incident")
+		require.ErrorIs(t, err, porterrors.ErrNotFound)
+	})
+
+	t.Run("returns error when the flow fails to pause", func(t *testing.T) {
+		opManager, operationFlow, schedulerStorage := setup(gomock.NewController(t))
+
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), schedulerName).Return(newValidScheduler(), nil)
+		operationFlow.EXPECT().GetOperationsPause(gomock.Any(), schedulerName).Return(nil, nil)
+		operationFlow.EXPECT().PauseOperations(gomock.Any(), schedulerName, "incident").Return(porterrors.NewErrUnexpected("error"))
+
+		err := opManager.PauseSchedulerOperations(context.Background(), schedulerName, "incident")
+		require.ErrorIs(t, err, porterrors.ErrUnexpected)
+	})
+}
+
+func TestResumeSchedulerOperations(t *testing.T) {
+	schedulerName := "test-scheduler"
+
+	setup := func(mockCtrl *gomock.Controller) (*OperationManager, *mockports.MockOperationFlow, *mockports.MockSchedulerStorage) {
+		operationFlow := mockports.NewMockOperationFlow(mockCtrl)
+		operationStorage := mockports.NewMockOperationStorage(mockCtrl)
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		operationLeaseStorage := mockports.NewMockOperationLeaseStorage(mockCtrl)
+		config := OperationManagerConfig{OperationLeaseTtl: time.Millisecond * 1000}
+		opManager := New(operationFlow, operationStorage, operations.NewDefinitionConstructors(), operationLeaseStorage, config, schedulerStorage)
+		return opManager, operationFlow, schedulerStorage
+	}
+
+	t.Run("resumes the scheduler operations", func(t *testing.T) {
+		opManager, operationFlow, schedulerStorage := setup(gomock.NewController(t))
+
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), schedulerName).Return(newValidScheduler(), nil)
+		operationFlow.EXPECT().GetOperationsPause(gomock.Any(), schedulerName).Return(&operation.OperationsPause{Reason: "incident"}, nil)
+		operationFlow.EXPECT().ResumeOperations(gomock.Any(), schedulerName).Return(nil)
+
+		err := opManager.ResumeSchedulerOperations(context.Background(), schedulerName)
+		require.NoError(t, err)
+	})
+
+	t.Run("returns conflict when the scheduler operations are not paused", func(t *testing.T) {
+		opManager, operationFlow, schedulerStorage := setup(gomock.NewController(t))
+
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), schedulerName).Return(newValidScheduler(), nil)
+		operationFlow.EXPECT().GetOperationsPause(gomock.Any(), schedulerName).Return(nil, nil)
+
+		err := opManager.ResumeSchedulerOperations(context.Background(), schedulerName)
+		require.ErrorIs(t, err, porterrors.ErrConflict)
+	})
+
+	t.Run("returns error when the flow fails to resume", func(t *testing.T) {
+		opManager, operationFlow, schedulerStorage := setup(gomock.NewController(t))
+
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), schedulerName).Return(newValidScheduler(), nil)
+		operationFlow.EXPECT().GetOperationsPause(gomock.Any(), schedulerName).Return(&operation.OperationsPause{Reason: "incident"}, nil)
+		operationFlow.EXPECT().ResumeOperations(gomock.Any(), schedulerName).Return(porterrors.NewErrUnexpected("error"))
+
+		err := opManager.ResumeSchedulerOperations(context.Background(), schedulerName)
+		require.ErrorIs(t, err, porterrors.ErrUnexpected)
+	})
+}
+
+func TestHoldOperation(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+
+	operationFlow := mockports.NewMockOperationFlow(mockCtrl)
+	config := OperationManagerConfig{OperationLeaseTtl: time.Millisecond * 1000}
+	opManager := New(operationFlow, nil, operations.NewDefinitionConstructors(), nil, config, nil)
+
+	op := &operation.Operation{ID: "some-op-id", SchedulerName: "test-scheduler", Priority: operation.PriorityHigh}
+	operationFlow.EXPECT().HoldOperationID(gomock.Any(), op.SchedulerName, op.ID, operation.PriorityHigh).Return(true, nil)
+
+	held, err := opManager.HoldOperation(context.Background(), op)
+	require.NoError(t, err)
+	require.True(t, held)
+}
@@ -57,13 +57,14 @@ type RoomManager struct {
 	InstanceStorage ports.GameRoomInstanceStorage
 	Runtime         ports.Runtime
 	EventsService   ports.EventsService
+	RoomTimeline    ports.RoomTimelineManager
 	Config          RoomManagerConfig
 	Logger          *zap.Logger
 }
 
 var _ ports.RoomManager = (*RoomManager)(nil)
 
-func New(clock ports.Clock, portAllocator ports.PortAllocator, roomStorage ports.RoomStorage, instanceStorage ports.GameRoomInstanceStorage, runtime ports.Runtime, eventsService ports.EventsService, config RoomManagerConfig) ports.RoomManager {
+func New(clock ports.Clock, portAllocator ports.PortAllocator, roomStorage ports.RoomStorage, instanceStorage ports.GameRoomInstanceStorage, runtime ports.Runtime, eventsService ports.EventsService, roomTimeline ports.RoomTimelineManager, config RoomManagerConfig) ports.RoomManager {
 	return &RoomManager{
 		Clock:           clock,
 		PortAllocator:   portAllocator,
@@ -71,6 +72,7 @@ func New(clock ports.Clock, portAllocator ports.PortAllocator, roomStorage ports
 		InstanceStorage: instanceStorage,
 		Runtime:         runtime,
 		EventsService:   eventsService,
+		RoomTimeline:    roomTimeline,
 		Config:          config,
 		Logger:          zap.L().With(zap.String(logs.LogFieldComponent, "service"), zap.String(logs.LogFieldServiceName, "room_manager")),
 	}
@@ -97,6 +99,7 @@ func (m *RoomManager) DeleteRoom(ctx context.Context, gameRoom *game_room.GameRo
 				m.Logger.With(zap.Error(errDeleteRoom)).Error("failed to delete room in storage")
 			}
 
+			m.recordRoomDeleted(ctx, gameRoom, reason)
 			return nil
 		}
 		return fmt.Errorf("unable to fetch game room instance from storage: %w", err)
@@ -117,6 +120,7 @@ func (m *RoomManager) DeleteRoom(ctx context.Context, gameRoom *game_room.GameRo
 				m.Logger.With(zap.Error(errDeleteInstance)).Error("failed to delete instance in storage")
 			}
 
+			m.recordRoomDeleted(ctx, gameRoom, reason)
 			return nil
 		}
 		return fmt.Errorf("failed to delete instance on the runtime: %w", err)
@@ -128,6 +132,7 @@ func (m *RoomManager) DeleteRoom(ctx context.Context, gameRoom *game_room.GameRo
 	}
 
 	m.forwardStatusTerminatingEvent(ctx, gameRoom)
+	m.recordRoomDeleted(ctx, gameRoom, reason)
 
 	return nil
 }
@@ -139,7 +144,7 @@ func (m *RoomManager) UpdateRoom(ctx context.Context, gameRoom *game_room.GameRo
 		return fmt.Errorf("failed when updating game room in storage with incoming ping data: %w", err)
 	}
 
-	shouldForwardEvent, err := m.updateGameRoomStatus(ctx, gameRoom.SchedulerID, gameRoom.ID)
+	shouldForwardEvent, err := m.updateGameRoomStatus(ctx, gameRoom.SchedulerID, gameRoom.ID, game_room.TimelineSourcePing)
 	if err != nil {
 		return fmt.Errorf("failed to update game room status: %w", err)
 	}
@@ -160,6 +165,21 @@ func (m *RoomManager) UpdateRoom(ctx context.Context, gameRoom *game_room.GameRo
 	return nil
 }
 
+func (m *RoomManager) ForwardRoomStatus(ctx context.Context, gameRoom *game_room.GameRoom) error {
+	if gameRoom.Metadata == nil {
+		gameRoom.Metadata = map[string]interface{}{}
+	}
+	gameRoom.Metadata["eventType"] = events.FromRoomEventTypeToString(events.Ping)
+	gameRoom.Metadata["pingType"] = gameRoom.PingStatus.String()
+
+	err := m.EventsService.ProduceEvent(ctx, events.NewRoomEvent(gameRoom.SchedulerID, gameRoom.ID, gameRoom.Metadata))
+	if err != nil {
+		return fmt.Errorf("failed to forward room status event: %w", err)
+	}
+
+	return nil
+}
+
 func (m *RoomManager) UpdateRoomInstance(ctx context.Context, gameRoomInstance *game_room.Instance) error {
 	if gameRoomInstance == nil {
 		return fmt.Errorf("cannot update room instance since it is nil")
@@ -275,6 +295,32 @@ func (m *RoomManager) ListRoomsWithDeletionPriority(ctx context.Context, schedul
 	return result, nil
 }
 
+func (m *RoomManager) ListRoomsByVersion(ctx context.Context, schedulerName, version string) ([]*game_room.GameRoom, error) {
+	roomIDs, err := m.RoomStorage.GetAllRoomIDs(ctx, schedulerName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scheduler rooms: %w", err)
+	}
+
+	var rooms []*game_room.GameRoom
+	for _, roomID := range roomIDs {
+		room, err := m.RoomStorage.GetRoom(ctx, schedulerName, roomID)
+		if err != nil {
+			// The room may be deleted after its ID was listed.
+			if errors.Is(err, porterrors.ErrNotFound) {
+				continue
+			}
+
+			return nil, fmt.Errorf("failed to fetch room information: %w", err)
+		}
+
+		if room.Version == version {
+			rooms = append(rooms, room)
+		}
+	}
+
+	return rooms, nil
+}
+
 func (m *RoomManager) SchedulerMaxSurge(ctx context.Context, scheduler *entities.Scheduler) (int, error) {
 	if scheduler.MaxSurge == "" {
 		return minSchedulerMaxSurge, nil
@@ -304,15 +350,42 @@ func (m *RoomManager) SchedulerMaxSurge(ctx context.Context, scheduler *entities
 	return int(math.Max(minSchedulerMaxSurge, absoluteNum)), nil
 }
 
+func (m *RoomManager) SchedulerMaxUnavailable(ctx context.Context, scheduler *entities.Scheduler) (int, error) {
+	if scheduler.MaxUnavailable == "" {
+		return 0, nil
+	}
+
+	isRelative := strings.HasSuffix(scheduler.MaxUnavailable, schedulerMaxSurgeRelativeSymbol)
+	maxUnavailableNum, err := strconv.Atoi(strings.TrimSuffix(scheduler.MaxUnavailable, schedulerMaxSurgeRelativeSymbol))
+	if err != nil {
+		return -1, fmt.Errorf("failed to parse max unavailable into a number: %w", err)
+	}
+
+	if !isRelative {
+		return int(math.Max(0, float64(maxUnavailableNum))), nil
+	}
+
+	roomsNum, err := m.RoomStorage.GetRoomCount(ctx, scheduler.Name)
+	if err != nil {
+		return -1, fmt.Errorf("failed to count current number of game rooms: %w", err)
+	}
+
+	// rounds down so the unavailable rooms never go above the percentage.
+	absoluteNum := math.Floor((float64(roomsNum) / 100) * float64(maxUnavailableNum))
+	return int(math.Max(0, absoluteNum)), nil
+}
+
 func (m *RoomManager) UpdateGameRoomStatus(ctx context.Context, schedulerId, gameRoomId string) error {
-	if _, err := m.updateGameRoomStatus(ctx, schedulerId, gameRoomId); err != nil {
+	if _, err := m.updateGameRoomStatus(ctx, schedulerId, gameRoomId, game_room.TimelineSourceRuntime); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (m *RoomManager) updateGameRoomStatus(ctx context.Context, schedulerId, gameRoomId string) (bool, error) {
+// updateGameRoomStatus composes the room status from its instance status,
+// recording the status change on the room timeline with its source.
+func (m *RoomManager) updateGameRoomStatus(ctx context.Context, schedulerId, gameRoomId string, source game_room.TimelineSource) (bool, error) {
 	gameRoom, err := m.RoomStorage.GetRoom(ctx, schedulerId, gameRoomId)
 	if err != nil {
 		return false, fmt.Errorf("failed to get game room: %w", err)
@@ -342,6 +415,13 @@ func (m *RoomManager) updateGameRoomStatus(ctx context.Context, schedulerId, gam
 		if !errors.Is(err, porterrors.ErrNotFound) && instance.Status.Type != game_room.InstanceTerminating {
 			return false, fmt.Errorf("failed to update game room status: %w", err)
 		}
+	} else {
+		m.RoomTimeline.RecordRoomTimelineEntry(ctx, schedulerId, gameRoomId, &game_room.TimelineEntry{
+			Kind:           game_room.TimelineStatusChanged,
+			Source:         source,
+			PreviousStatus: gameRoom.Status.String(),
+			Status:         newStatus.String(),
+		})
 	}
 
 	if instance.Status.Type == game_room.InstanceTerminating {
@@ -477,6 +557,13 @@ func (m *RoomManager) populateSpecWithHostPort(scheduler entities.Scheduler) (*g
 	return spec, nil
 }
 
+func (m *RoomManager) recordRoomDeleted(ctx context.Context, gameRoom *game_room.GameRoom, reason string) {
+	m.RoomTimeline.RecordRoomTimelineEntry(ctx, gameRoom.SchedulerID, gameRoom.ID, &game_room.TimelineEntry{
+		Kind:   game_room.TimelineDeleted,
+		Reason: reason,
+	})
+}
+
 func (m *RoomManager) forwardStatusTerminatingEvent(ctx context.Context, room *game_room.GameRoom) {
 	if room.Metadata == nil {
 		room.Metadata = map[string]interface{}{}
@@ -28,3 +28,8 @@ type RoomManagerConfig struct {
 	RoomPingTimeout     time.Duration
 	RoomDeletionTimeout time.Duration
 }
+
+type RoomTimelineConfig struct {
+	// Enabled turns the rooms timeline recording on.
+	Enabled bool
+}
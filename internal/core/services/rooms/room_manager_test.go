@@ -66,7 +66,7 @@ func TestRoomManager_CreateRoom(t *testing.T) {
 	instanceStorage := mockports.NewMockGameRoomInstanceStorage(mockCtrl)
 	fakeClock := clockmock.NewFakeClock(now)
 	config := RoomManagerConfig{}
-	roomManager := New(fakeClock, portAllocator, roomStorage, instanceStorage, runtime, eventsService, config)
+	roomManager := New(fakeClock, portAllocator, roomStorage, instanceStorage, runtime, eventsService, disabledRoomTimeline(), config)
 
 	container1 := game_room.Container{
 		Name: "container1",
@@ -383,6 +383,7 @@ func TestRoomManager_UpdateRoom(t *testing.T) {
 		instanceStorage,
 		runtime,
 		eventsService,
+		disabledRoomTimeline(),
 		config,
 	)
 	currentInstance := &game_room.Instance{ID: "test-room", SchedulerID: "test-scheduler", Status: game_room.InstanceStatus{Type: game_room.InstanceReady}}
@@ -446,6 +447,43 @@ func TestRoomManager_UpdateRoom(t *testing.T) {
 	})
 }
 
+func TestRoomManager_ForwardRoomStatus(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+
+	eventsService := mockports.NewMockEventsService(mockCtrl)
+	roomManager := New(
+		clockmock.NewFakeClock(time.Now()),
+		mockports.NewMockPortAllocator(mockCtrl),
+		mockports.NewMockRoomStorage(mockCtrl),
+		mockports.NewMockGameRoomInstanceStorage(mockCtrl),
+		mockports.NewMockRuntime(mockCtrl),
+		eventsService,
+		disabledRoomTimeline(),
+		RoomManagerConfig{},
+	)
+	gameRoom := &game_room.GameRoom{ID: "test-room", SchedulerID: "test-scheduler", Status: game_room.GameStatusReady, PingStatus: game_room.GameRoomPingStatusReady}
+
+	t.Run("forwards the room ping status", func(t *testing.T) {
+		eventsService.EXPECT().ProduceEvent(context.Background(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, event *events.Event) error {
+				require.Equal(t, gameRoom.ID, event.RoomID)
+				require.Equal(t, "ready", event.Attributes["pingType"])
+				return nil
+			},
+		)
+
+		err := roomManager.ForwardRoomStatus(context.Background(), gameRoom)
+		require.NoError(t, err)
+	})
+
+	t.Run("returns error when the event is not forwarded", func(t *testing.T) {
+		eventsService.EXPECT().ProduceEvent(context.Background(), gomock.Any()).Return(porterrors.ErrUnexpected)
+
+		err := roomManager.ForwardRoomStatus(context.Background(), gameRoom)
+		require.Error(t, err)
+	})
+}
+
 func TestRoomManager_ListRoomsWithDeletionPriority(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 
@@ -460,6 +498,7 @@ func TestRoomManager_ListRoomsWithDeletionPriority(t *testing.T) {
 		nil,
 		runtime,
 		eventsService,
+		disabledRoomTimeline(),
 		RoomManagerConfig{RoomPingTimeout: time.Hour},
 	)
 	roomsBeingReplaced := &sync.Map{}
@@ -687,6 +726,65 @@ func TestRoomManager_ListRoomsWithDeletionPriority(t *testing.T) {
 	})
 }
 
+func TestRoomManager_ListRoomsByVersion(t *testing.T) {
+	schedulerName := "test-scheduler"
+
+	setup := func(mockCtrl *gomock.Controller) (ports.RoomManager, *mockports.MockRoomStorage) {
+		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
+		roomManager := New(
+			clockmock.NewFakeClock(time.Now()),
+			mockports.NewMockPortAllocator(mockCtrl),
+			roomStorage,
+			mockports.NewMockGameRoomInstanceStorage(mockCtrl),
+			mockports.NewMockRuntime(mockCtrl),
+			mockports.NewMockEventsService(mockCtrl),
+			disabledRoomTimeline(),
+			RoomManagerConfig{},
+		)
+		return roomManager, roomStorage
+	}
+
+	t.Run("returns only the rooms of the version", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		roomManager, roomStorage := setup(mockCtrl)
+		ctx := context.Background()
+		room1 := &game_room.GameRoom{ID: "room-1", SchedulerID: schedulerName, Version: "v2.0.0"}
+		room2 := &game_room.GameRoom{ID: "room-2", SchedulerID: schedulerName, Version: "v1.0.0"}
+
+		roomStorage.EXPECT().GetAllRoomIDs(ctx, schedulerName).Return([]string{"room-1", "room-2", "room-3"}, nil)
+		roomStorage.EXPECT().GetRoom(ctx, schedulerName, "room-1").Return(room1, nil)
+		roomStorage.EXPECT().GetRoom(ctx, schedulerName, "room-2").Return(room2, nil)
+		roomStorage.EXPECT().GetRoom(ctx, schedulerName, "room-3").Return(nil, porterrors.NewErrNotFound("room not found"))
+
+		rooms, err := roomManager.ListRoomsByVersion(ctx, schedulerName, "v2.0.0")
+		require.NoError(t, err)
+		require.Equal(t, []*game_room.GameRoom{room1}, rooms)
+	})
+
+	t.Run("returns error when it fails to list the rooms", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		roomManager, roomStorage := setup(mockCtrl)
+		ctx := context.Background()
+
+		roomStorage.EXPECT().GetAllRoomIDs(ctx, schedulerName).Return(nil, porterrors.NewErrUnexpected("error"))
+
+		_, err := roomManager.ListRoomsByVersion(ctx, schedulerName, "v2.0.0")
+		require.ErrorIs(t, err, porterrors.ErrUnexpected)
+	})
+
+	t.Run("returns error when it fails to fetch a room", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		roomManager, roomStorage := setup(mockCtrl)
+		ctx := context.Background()
+
+		roomStorage.EXPECT().GetAllRoomIDs(ctx, schedulerName).Return([]string{"room-1"}, nil)
+		roomStorage.EXPECT().GetRoom(ctx, schedulerName, "room-1").Return(nil, porterrors.NewErrUnexpected("error"))
+
+		_, err := roomManager.ListRoomsByVersion(ctx, schedulerName, "v2.0.0")
+		require.ErrorIs(t, err, porterrors.ErrUnexpected)
+	})
+}
+
 func TestRoomManager_UpdateRoomInstance(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 
@@ -703,6 +801,7 @@ func TestRoomManager_UpdateRoomInstance(t *testing.T) {
 		instanceStorage,
 		runtime,
 		eventsService,
+		disabledRoomTimeline(),
 		config,
 	)
 	currentGameRoom := &game_room.GameRoom{ID: "test-room", SchedulerID: "test-scheduler", Status: game_room.GameStatusReady, PingStatus: game_room.GameRoomPingStatusReady, LastPingAt: clock.Now(), Metadata: map[string]interface{}{}}
@@ -747,6 +846,7 @@ func TestRoomManager_CleanRoomState(t *testing.T) {
 		instanceStorage,
 		runtime,
 		eventsService,
+		disabledRoomTimeline(),
 		config,
 	)
 	schedulerName := "scheduler-name"
@@ -803,6 +903,7 @@ func TestSchedulerMaxSurge(t *testing.T) {
 			mockports.NewMockGameRoomInstanceStorage(mockCtrl),
 			mockports.NewMockRuntime(mockCtrl),
 			mockports.NewMockEventsService(mockCtrl),
+			disabledRoomTimeline(),
 			RoomManagerConfig{},
 		)
 
@@ -890,6 +991,75 @@ func TestSchedulerMaxSurge(t *testing.T) {
 	})
 }
 
+func TestSchedulerMaxUnavailable(t *testing.T) {
+	setupRoomStorage := func(mockCtrl *gomock.Controller) (*mockports.MockRoomStorage, ports.RoomManager) {
+		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
+		roomManager := New(
+			clockmock.NewFakeClock(time.Now()),
+			mockports.NewMockPortAllocator(mockCtrl),
+			roomStorage,
+			mockports.NewMockGameRoomInstanceStorage(mockCtrl),
+			mockports.NewMockRuntime(mockCtrl),
+			mockports.NewMockEventsService(mockCtrl),
+			disabledRoomTimeline(),
+			RoomManagerConfig{},
+		)
+
+		return roomStorage, roomManager
+	}
+
+	t.Run("max unavailable is empty, returns zero without error", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		_, roomManager := setupRoomStorage(mockCtrl)
+		scheduler := &entities.Scheduler{Name: "test", MaxUnavailable: ""}
+
+		maxUnavailableValue, err := roomManager.SchedulerMaxUnavailable(context.Background(), scheduler)
+		require.NoError(t, err)
+		require.Equal(t, 0, maxUnavailableValue)
+	})
+
+	t.Run("max unavailable uses absolute number, returns value without error", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		_, roomManager := setupRoomStorage(mockCtrl)
+		scheduler := &entities.Scheduler{Name: "test", MaxUnavailable: "10"}
+
+		maxUnavailableValue, err := roomManager.SchedulerMaxUnavailable(context.Background(), scheduler)
+		require.NoError(t, err)
+		require.Equal(t, 10, maxUnavailableValue)
+	})
+
+	t.Run("max unavailable uses relative number and there are rooms, returns value rounded down without error", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		roomStorage, roomManager := setupRoomStorage(mockCtrl)
+		scheduler := &entities.Scheduler{Name: "test", MaxUnavailable: "25%"}
+
+		roomStorage.EXPECT().GetRoomCount(gomock.Any(), scheduler.Name).Return(10, nil)
+
+		maxUnavailableValue, err := roomManager.SchedulerMaxUnavailable(context.Background(), scheduler)
+		require.NoError(t, err)
+		require.Equal(t, 2, maxUnavailableValue)
+	})
+
+	t.Run("max unavailable uses relative number and failed to retrieve rooms count, returns error", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		roomStorage, roomManager := setupRoomStorage(mockCtrl)
+		scheduler := &entities.Scheduler{Name: "test", MaxUnavailable: "10%"}
+
+		roomStorage.EXPECT().GetRoomCount(gomock.Any(), scheduler.Name).Return(0, porterrors.ErrUnexpected)
+
+		_, err := roomManager.SchedulerMaxUnavailable(context.Background(), scheduler)
+		require.Error(t, err)
+	})
+
+	t.Run("max unavailable is invalid, returns error", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		_, roomManager := setupRoomStorage(mockCtrl)
+		scheduler := &entities.Scheduler{Name: "test", MaxUnavailable: "a%"}
+
+		_, err := roomManager.SchedulerMaxUnavailable(context.Background(), scheduler)
+		require.Error(t, err)
+	})
+}
 func TestRoomManager_WaitRoomStatus(t *testing.T) {
 
 	t.Run("return one of the desired states and no error when the desired status is reached after some time", func(t *testing.T) {
@@ -904,6 +1074,7 @@ func TestRoomManager_WaitRoomStatus(t *testing.T) {
 			mockports.NewMockGameRoomInstanceStorage(mockCtrl),
 			mockports.NewMockRuntime(mockCtrl),
 			mockports.NewMockEventsService(mockCtrl),
+			disabledRoomTimeline(),
 			RoomManagerConfig{},
 		)
 
@@ -968,6 +1139,7 @@ func TestRoomManager_WaitRoomStatus(t *testing.T) {
 			mockports.NewMockGameRoomInstanceStorage(mockCtrl),
 			mockports.NewMockRuntime(mockCtrl),
 			mockports.NewMockEventsService(mockCtrl),
+			disabledRoomTimeline(),
 			RoomManagerConfig{},
 		)
 
@@ -995,6 +1167,7 @@ func TestRoomManager_WaitRoomStatus(t *testing.T) {
 			mockports.NewMockGameRoomInstanceStorage(mockCtrl),
 			mockports.NewMockRuntime(mockCtrl),
 			mockports.NewMockEventsService(mockCtrl),
+			disabledRoomTimeline(),
 			RoomManagerConfig{},
 		)
 
@@ -1020,6 +1193,7 @@ func TestRoomManager_WaitRoomStatus(t *testing.T) {
 			mockports.NewMockGameRoomInstanceStorage(mockCtrl),
 			mockports.NewMockRuntime(mockCtrl),
 			mockports.NewMockEventsService(mockCtrl),
+			disabledRoomTimeline(),
 			RoomManagerConfig{},
 		)
 
@@ -1047,6 +1221,7 @@ func TestRoomManager_WaitRoomStatus(t *testing.T) {
 			mockports.NewMockGameRoomInstanceStorage(mockCtrl),
 			mockports.NewMockRuntime(mockCtrl),
 			mockports.NewMockEventsService(mockCtrl),
+			disabledRoomTimeline(),
 			RoomManagerConfig{},
 		)
 
@@ -1092,6 +1267,7 @@ func TestRoomManager_WaitRoomStatus(t *testing.T) {
 			mockports.NewMockGameRoomInstanceStorage(mockCtrl),
 			mockports.NewMockRuntime(mockCtrl),
 			mockports.NewMockEventsService(mockCtrl),
+			disabledRoomTimeline(),
 			RoomManagerConfig{},
 		)
 
@@ -1136,6 +1312,7 @@ func TestUpdateGameRoomStatus(t *testing.T) {
 			instanceStorage,
 			mockports.NewMockRuntime(mockCtrl),
 			eventsService,
+			disabledRoomTimeline(),
 			RoomManagerConfig{},
 		)
 
@@ -1277,6 +1454,7 @@ func TestRoomManager_GetRoomInstance(t *testing.T) {
 			instanceStorage,
 			mockports.NewMockRuntime(mockCtrl),
 			eventsService,
+			disabledRoomTimeline(),
 			RoomManagerConfig{},
 		)
 
@@ -1338,8 +1516,124 @@ func testSetup(t *testing.T) (
 		instanceStorage,
 		runtime,
 		eventsService,
+		disabledRoomTimeline(),
 		config,
 	)
 
 	return roomManager, config, roomStorage, instanceStorage, runtime, eventsService, roomStorageStatusWatcher
 }
+
+func TestRoomManager_RoomTimeline(t *testing.T) {
+	setup := func(t *testing.T) (ports.RoomManager, *mockports.MockRoomStorage, *mockports.MockGameRoomInstanceStorage, *mockports.MockRuntime, *mockports.MockEventsService, *mockports.MockRoomTimelineManager) {
+		mockCtrl := gomock.NewController(t)
+		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
+		instanceStorage := mockports.NewMockGameRoomInstanceStorage(mockCtrl)
+		runtime := mockports.NewMockRuntime(mockCtrl)
+		eventsService := mockports.NewMockEventsService(mockCtrl)
+		roomTimeline := mockports.NewMockRoomTimelineManager(mockCtrl)
+		roomManager := New(
+			clockmock.NewFakeClock(time.Now()),
+			mockports.NewMockPortAllocator(mockCtrl),
+			roomStorage,
+			instanceStorage,
+			runtime,
+			eventsService,
+			roomTimeline,
+			RoomManagerConfig{},
+		)
+		return roomManager, roomStorage, instanceStorage, runtime, eventsService, roomTimeline
+	}
+
+	t.Run("records the status change caused by the room ping", func(t *testing.T) {
+		roomManager, roomStorage, instanceStorage, _, eventsService, roomTimeline := setup(t)
+		gameRoom := &game_room.GameRoom{ID: "test-room", SchedulerID: "test-scheduler", Status: game_room.GameStatusReady, PingStatus: game_room.GameRoomPingStatusOccupied, Metadata: map[string]interface{}{}}
+		instance := &game_room.Instance{ID: "test-room", SchedulerID: "test-scheduler", Status: game_room.InstanceStatus{Type: game_room.InstanceReady}}
+
+		roomStorage.EXPECT().UpdateRoom(gomock.Any(), gameRoom).Return(nil)
+		roomStorage.EXPECT().GetRoom(gomock.Any(), gameRoom.SchedulerID, gameRoom.ID).Return(gameRoom, nil)
+		instanceStorage.EXPECT().GetInstance(gomock.Any(), gameRoom.SchedulerID, gameRoom.ID).Return(instance, nil)
+		roomStorage.EXPECT().UpdateRoomStatus(gomock.Any(), gameRoom.SchedulerID, gameRoom.ID, game_room.GameStatusOccupied).Return(nil)
+		roomTimeline.EXPECT().RecordRoomTimelineEntry(gomock.Any(), gameRoom.SchedulerID, gameRoom.ID, &game_room.TimelineEntry{
+			Kind:           game_room.TimelineStatusChanged,
+			Source:         game_room.TimelineSourcePing,
+			PreviousStatus: "ready",
+			Status:         "occupied",
+		})
+		eventsService.EXPECT().ProduceEvent(gomock.Any(), gomock.Any()).Return(nil)
+
+		err := roomManager.UpdateRoom(context.Background(), gameRoom)
+		require.NoError(t, err)
+	})
+
+	t.Run("records the status change caused by the runtime", func(t *testing.T) {
+		roomManager, roomStorage, instanceStorage, _, _, roomTimeline := setup(t)
+		gameRoom := &game_room.GameRoom{ID: "test-room", SchedulerID: "test-scheduler", Status: game_room.GameStatusReady, PingStatus: game_room.GameRoomPingStatusReady}
+		instance := &game_room.Instance{ID: "test-room", SchedulerID: "test-scheduler", Status: game_room.InstanceStatus{Type: game_room.InstanceError}}
+
+		instanceStorage.EXPECT().UpsertInstance(gomock.Any(), instance).Return(nil)
+		instanceStorage.EXPECT().GetInstance(gomock.Any(), instance.SchedulerID, instance.ID).Return(instance, nil)
+		roomStorage.EXPECT().GetRoom(gomock.Any(), instance.SchedulerID, instance.ID).Return(gameRoom, nil)
+		roomStorage.EXPECT().UpdateRoomStatus(gomock.Any(), instance.SchedulerID, instance.ID, game_room.GameStatusError).Return(nil)
+		roomTimeline.EXPECT().RecordRoomTimelineEntry(gomock.Any(), instance.SchedulerID, instance.ID, &game_room.TimelineEntry{
+			Kind:           game_room.TimelineStatusChanged,
+			Source:         game_room.TimelineSourceRuntime,
+			PreviousStatus: "ready",
+			Status:         "error",
+		})
+
+		err := roomManager.UpdateRoomInstance(context.Background(), instance)
+		require.NoError(t, err)
+	})
+
+	t.Run("doesn't record the status change when the status isn't updated", func(t *testing.T) {
+		roomManager, roomStorage, instanceStorage, _, _, _ := setup(t)
+		gameRoom := &game_room.GameRoom{ID: "test-room", SchedulerID: "test-scheduler", Status: game_room.GameStatusReady, PingStatus: game_room.GameRoomPingStatusReady}
+		instance := &game_room.Instance{ID: "test-room", SchedulerID: "test-scheduler", Status: game_room.InstanceStatus{Type: game_room.InstanceError}}
+
+		instanceStorage.EXPECT().GetInstance(gomock.Any(), instance.SchedulerID, instance.ID).Return(instance, nil)
+		roomStorage.EXPECT().GetRoom(gomock.Any(), instance.SchedulerID, instance.ID).Return(gameRoom, nil)
+		roomStorage.EXPECT().UpdateRoomStatus(gomock.Any(), instance.SchedulerID, instance.ID, game_room.GameStatusError).Return(errors.New("some error"))
+
+		err := roomManager.UpdateGameRoomStatus(context.Background(), instance.SchedulerID, instance.ID)
+		require.Error(t, err)
+	})
+
+	t.Run("records the room deletion reason", func(t *testing.T) {
+		roomManager, roomStorage, instanceStorage, runtime, eventsService, roomTimeline := setup(t)
+		gameRoom := &game_room.GameRoom{ID: "test-room", SchedulerID: "test-scheduler", Status: game_room.GameStatusReady}
+		instance := &game_room.Instance{ID: "test-instance"}
+
+		instanceStorage.EXPECT().GetInstance(gomock.Any(), gameRoom.SchedulerID, gameRoom.ID).Return(instance, nil)
+		runtime.EXPECT().DeleteGameRoomInstance(gomock.Any(), instance, "ping_timeout").Return(nil)
+		roomStorage.EXPECT().UpdateRoomStatus(gomock.Any(), gameRoom.SchedulerID, gameRoom.ID, game_room.GameStatusTerminating).Return(nil)
+		eventsService.EXPECT().ProduceEvent(gomock.Any(), gomock.Any()).Return(nil)
+		roomTimeline.EXPECT().RecordRoomTimelineEntry(gomock.Any(), gameRoom.SchedulerID, gameRoom.ID, &game_room.TimelineEntry{
+			Kind:   game_room.TimelineDeleted,
+			Reason: "ping_timeout",
+		})
+
+		err := roomManager.DeleteRoom(context.Background(), gameRoom, "ping_timeout")
+		require.NoError(t, err)
+	})
+
+	t.Run("records the room deletion reason when the instance is already gone", func(t *testing.T) {
+		roomManager, roomStorage, instanceStorage, _, _, roomTimeline := setup(t)
+		gameRoom := &game_room.GameRoom{ID: "test-room", SchedulerID: "test-scheduler", Status: game_room.GameStatusReady}
+
+		instanceStorage.EXPECT().GetInstance(gomock.Any(), gameRoom.SchedulerID, gameRoom.ID).Return(nil, porterrors.NewErrNotFound("not found"))
+		roomStorage.EXPECT().DeleteRoom(gomock.Any(), gameRoom.SchedulerID, gameRoom.ID).Return(nil)
+		roomTimeline.EXPECT().RecordRoomTimelineEntry(gomock.Any(), gameRoom.SchedulerID, gameRoom.ID, &game_room.TimelineEntry{
+			Kind:   game_room.TimelineDeleted,
+			Reason: "scheduler_removed",
+		})
+
+		err := roomManager.DeleteRoom(context.Background(), gameRoom, "scheduler_removed")
+		require.NoError(t, err)
+	})
+}
+
+// disabledRoomTimeline doesn't record the rooms timeline, so the tests that
+// aren't about it don't need to expect the entries.
+func disabledRoomTimeline() ports.RoomTimelineManager {
+	return NewRoomTimelineManager(nil, nil, nil, RoomTimelineConfig{})
+}
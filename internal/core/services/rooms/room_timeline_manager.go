@@ -0,0 +1,85 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package rooms
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/topfreegames/maestro/internal/core/entities/game_room"
+	"github.com/topfreegames/maestro/internal/core/logs"
+	"github.com/topfreegames/maestro/internal/core/ports"
+)
+
+var _ ports.RoomTimelineManager = (*RoomTimelineManager)(nil)
+
+// RoomTimelineManager records and reads the rooms timeline. When the timeline
+// isn't enabled nothing is recorded and the timelines are empty.
+type RoomTimelineManager struct {
+	timelineStorage  ports.RoomTimelineStorage
+	schedulerStorage ports.SchedulerStorage
+	clock            ports.Clock
+	config           RoomTimelineConfig
+	logger           *zap.Logger
+}
+
+func NewRoomTimelineManager(timelineStorage ports.RoomTimelineStorage, schedulerStorage ports.SchedulerStorage, clock ports.Clock, config RoomTimelineConfig) ports.RoomTimelineManager {
+	return &RoomTimelineManager{
+		timelineStorage:  timelineStorage,
+		schedulerStorage: schedulerStorage,
+		clock:            clock,
+		config:           config,
+		logger:           zap.L().With(zap.String(logs.LogFieldComponent, "service"), zap.String(logs.LogFieldServiceName, "room_timeline_manager")),
+	}
+}
+
+func (m *RoomTimelineManager) RecordRoomTimelineEntry(ctx context.Context, schedulerName, roomID string, entry *game_room.TimelineEntry) {
+	if !m.config.Enabled {
+		return
+	}
+
+	entry.Timestamp = m.clock.Now()
+	err := m.timelineStorage.AddRoomTimelineEntry(ctx, schedulerName, roomID, entry)
+	if err != nil {
+		m.logger.Warn(
+			"failed to record room timeline entry",
+			zap.String(logs.LogFieldSchedulerName, schedulerName),
+			zap.String(logs.LogFieldRoomID, roomID),
+			zap.String("kind", string(entry.Kind)),
+			zap.Error(err),
+		)
+	}
+}
+
+func (m *RoomTimelineManager) GetRoomTimeline(ctx context.Context, schedulerName, roomID string) ([]*game_room.TimelineEntry, error) {
+	_, err := m.schedulerStorage.GetScheduler(ctx, schedulerName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !m.config.Enabled {
+		return []*game_room.TimelineEntry{}, nil
+	}
+	return m.timelineStorage.GetRoomTimeline(ctx, schedulerName, roomID)
+}
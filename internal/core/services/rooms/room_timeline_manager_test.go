@@ -0,0 +1,132 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build unit
+// +build unit
+
+package rooms
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/topfreegames/maestro/internal/core/entities"
+	"github.com/topfreegames/maestro/internal/core/entities/game_room"
+	clockmock "github.com/topfreegames/maestro/internal/core/ports/clock_mock.go"
+	porterrors "github.com/topfreegames/maestro/internal/core/ports/errors"
+	mockports "github.com/topfreegames/maestro/internal/core/ports/mock"
+)
+
+func TestRoomTimelineManager_RecordRoomTimelineEntry(t *testing.T) {
+	now := time.Now()
+
+	t.Run("adds the entry with the current time to the room timeline", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		timelineStorage := mockports.NewMockRoomTimelineStorage(mockCtrl)
+		manager := NewRoomTimelineManager(timelineStorage, nil, clockmock.NewFakeClock(now), RoomTimelineConfig{Enabled: true})
+
+		entry := &game_room.TimelineEntry{Kind: game_room.TimelineDeleted, Reason: "ping_timeout"}
+		timelineStorage.EXPECT().AddRoomTimelineEntry(gomock.Any(), "scheduler", "room", &game_room.TimelineEntry{
+			Kind:      game_room.TimelineDeleted,
+			Timestamp: now,
+			Reason:    "ping_timeout",
+		}).Return(nil)
+
+		manager.RecordRoomTimelineEntry(context.Background(), "scheduler", "room", entry)
+	})
+
+	t.Run("doesn't fail when the entry can't be added", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		timelineStorage := mockports.NewMockRoomTimelineStorage(mockCtrl)
+		manager := NewRoomTimelineManager(timelineStorage, nil, clockmock.NewFakeClock(now), RoomTimelineConfig{Enabled: true})
+
+		timelineStorage.EXPECT().AddRoomTimelineEntry(gomock.Any(), "scheduler", "room", gomock.Any()).Return(porterrors.NewErrUnexpected("redis down"))
+
+		manager.RecordRoomTimelineEntry(context.Background(), "scheduler", "room", &game_room.TimelineEntry{Kind: game_room.TimelineDeleted})
+	})
+
+	t.Run("doesn't record when the timeline is disabled", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		timelineStorage := mockports.NewMockRoomTimelineStorage(mockCtrl)
+		manager := NewRoomTimelineManager(timelineStorage, nil, clockmock.NewFakeClock(now), RoomTimelineConfig{})
+
+		manager.RecordRoomTimelineEntry(context.Background(), "scheduler", "room", &game_room.TimelineEntry{Kind: game_room.TimelineDeleted})
+	})
+}
+
+func TestRoomTimelineManager_GetRoomTimeline(t *testing.T) {
+	setup := func(t *testing.T, config RoomTimelineConfig) (*mockports.MockRoomTimelineStorage, *mockports.MockSchedulerStorage, *RoomTimelineManager) {
+		mockCtrl := gomock.NewController(t)
+		timelineStorage := mockports.NewMockRoomTimelineStorage(mockCtrl)
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		manager := NewRoomTimelineManager(timelineStorage, schedulerStorage, clockmock.NewFakeClock(time.Now()), config)
+		return timelineStorage, schedulerStorage, manager.(*RoomTimelineManager)
+	}
+
+	t.Run("returns the room timeline", func(t *testing.T) {
+		timelineStorage, schedulerStorage, manager := setup(t, RoomTimelineConfig{Enabled: true})
+		timeline := []*game_room.TimelineEntry{
+			{Kind: game_room.TimelineStatusChanged, Source: game_room.TimelineSourcePing, PreviousStatus: "ready", Status: "occupied"},
+		}
+
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), "scheduler").Return(&entities.Scheduler{Name: "scheduler"}, nil)
+		timelineStorage.EXPECT().GetRoomTimeline(gomock.Any(), "scheduler", "room").Return(timeline, nil)
+
+		result, err := manager.GetRoomTimeline(context.Background(), "scheduler", "room")
+		require.NoError(t, err)
+		require.Equal(t, timeline, result)
+	})
+
+	t.Run("returns an empty timeline when the timeline is disabled", func(t *testing.T) {
+		_, schedulerStorage, manager := setup(t, RoomTimelineConfig{})
+
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), "scheduler").Return(&entities.Scheduler{Name: "scheduler"}, nil)
+
+		result, err := manager.GetRoomTimeline(context.Background(), "scheduler", "room")
+		require.NoError(t, err)
+		require.Empty(t, result)
+	})
+
+	t.Run("fails when the scheduler doesn't exist", func(t *testing.T) {
+		_, schedulerStorage, manager := setup(t, RoomTimelineConfig{Enabled: true})
+
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), "scheduler").Return(nil, porterrors.NewErrNotFound("scheduler not found"))
+
+		_, err := manager.GetRoomTimeline(context.Background(), "scheduler", "room")
+		require.True(t, errors.Is(err, porterrors.ErrNotFound))
+	})
+
+	t.Run("fails when the timeline can't be read", func(t *testing.T) {
+		timelineStorage, schedulerStorage, manager := setup(t, RoomTimelineConfig{Enabled: true})
+
+		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), "scheduler").Return(&entities.Scheduler{Name: "scheduler"}, nil)
+		timelineStorage.EXPECT().GetRoomTimeline(gomock.Any(), "scheduler", "room").Return(nil, porterrors.NewErrUnexpected("redis down"))
+
+		_, err := manager.GetRoomTimeline(context.Background(), "scheduler", "room")
+		require.True(t, errors.Is(err, porterrors.ErrUnexpected))
+	})
+}
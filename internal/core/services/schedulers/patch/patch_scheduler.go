@@ -28,6 +28,7 @@ import (
 
 	"github.com/topfreegames/maestro/internal/core/entities/autoscaling"
 	"github.com/topfreegames/maestro/internal/core/entities/port"
+	"github.com/topfreegames/maestro/internal/core/entities/rollout"
 
 	"github.com/topfreegames/maestro/internal/core/entities"
 	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
@@ -43,12 +44,16 @@ const (
 	LabelSchedulerPortRange = "port_range"
 	// LabelSchedulerMaxSurge is the max surge key in the patch map.
 	LabelSchedulerMaxSurge = "max_surge"
+	// LabelSchedulerMaxUnavailable is the max unavailable key in the patch map.
+	LabelSchedulerMaxUnavailable = "max_unavailable"
 	// LabelSchedulerRoomsReplicas is the rooms replicas key in the patch map.
 	LabelSchedulerRoomsReplicas = "rooms_replicas"
 	// LabelAutoscaling is the key to autoscaling in the patch map.
 	LabelAutoscaling = "autoscaling"
 	// LabelSchedulerForwarders is the forwarders key in the patch map.
 	LabelSchedulerForwarders = "forwarders"
+	// LabelRolloutStrategy is the rollout strategy key in the patch map.
+	LabelRolloutStrategy = "rollout_strategy"
 
 	// LabelSpecTerminationGracePeriod is the termination grace period key in the patch map.
 	LabelSpecTerminationGracePeriod = "termination_grace_period"
@@ -104,6 +109,10 @@ func PatchScheduler(scheduler entities.Scheduler, patchMap map[string]interface{
 		scheduler.MaxSurge = fmt.Sprint(patchMap[LabelSchedulerMaxSurge])
 	}
 
+	if _, ok := patchMap[LabelSchedulerMaxUnavailable]; ok {
+		scheduler.MaxUnavailable = fmt.Sprint(patchMap[LabelSchedulerMaxUnavailable])
+	}
+
 	if _, ok := patchMap[LabelSchedulerRoomsReplicas]; ok {
 		scheduler.RoomsReplicas = (patchMap[LabelSchedulerRoomsReplicas]).(int)
 	}
@@ -151,6 +160,12 @@ func PatchScheduler(scheduler entities.Scheduler, patchMap map[string]interface{
 		}
 	}
 
+	if _, ok := patchMap[LabelRolloutStrategy]; ok {
+		if scheduler.RolloutStrategy, ok = patchMap[LabelRolloutStrategy].(*rollout.Strategy); !ok {
+			return nil, fmt.Errorf("error parsing scheduler: rollout strategy malformed")
+		}
+	}
+
 	return &scheduler, nil
 }
 
@@ -40,6 +40,7 @@ import (
 	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
 	"github.com/topfreegames/maestro/internal/core/entities/game_room"
 	"github.com/topfreegames/maestro/internal/core/entities/port"
+	"github.com/topfreegames/maestro/internal/core/entities/rollout"
 )
 
 func TestPatchScheduler(t *testing.T) {
@@ -85,6 +86,24 @@ func TestPatchScheduler(t *testing.T) {
 				Error: nil,
 			},
 		},
+		{
+			Title: "Have max unavailable return scheduler with changed MaxUnavailable",
+			Input: Input{
+				Scheduler: basicSchedulerToPatchSchedulerTests(),
+				PatchMap: map[string]interface{}{
+					patch.LabelSchedulerMaxUnavailable: "20%",
+				},
+			},
+			Output: Output{
+				ChangeSchedulerFunc: func() *entities.Scheduler {
+					scheduler := basicSchedulerToPatchSchedulerTests()
+					scheduler.MaxUnavailable = "20%"
+
+					return scheduler
+				},
+				Error: nil,
+			},
+		},
 		{
 			Title: "Have annotation return scheduler with changed annotations",
 			Input: Input{
@@ -121,6 +140,30 @@ func TestPatchScheduler(t *testing.T) {
 				Error: nil,
 			},
 		},
+		{
+			Title: "Have rollout strategy return scheduler with changed RolloutStrategy",
+			Input: Input{
+				Scheduler: basicSchedulerToPatchSchedulerTests(),
+				PatchMap: map[string]interface{}{
+					patch.LabelRolloutStrategy: &rollout.Strategy{
+						Type:   rollout.Canary,
+						Canary: &rollout.CanaryParams{Percentage: 10, BakeDuration: time.Minute, FailureThreshold: 0.1},
+					},
+				},
+			},
+			Output: Output{
+				ChangeSchedulerFunc: func() *entities.Scheduler {
+					scheduler := basicSchedulerToPatchSchedulerTests()
+					scheduler.RolloutStrategy = &rollout.Strategy{
+						Type:   rollout.Canary,
+						Canary: &rollout.CanaryParams{Percentage: 10, BakeDuration: time.Minute, FailureThreshold: 0.1},
+					}
+
+					return scheduler
+				},
+				Error: nil,
+			},
+		},
 		{
 			Title: "Have rooms replicas return scheduler with changed RoomsReplicas",
 			Input: Input{
@@ -212,6 +255,21 @@ func TestPatchScheduler(t *testing.T) {
 				Error: fmt.Errorf("error parsing scheduler: port range malformed"),
 			},
 		},
+		{
+			Title: "Have wrong rollout strategy return error",
+			Input: Input{
+				Scheduler: basicSchedulerToPatchSchedulerTests(),
+				PatchMap: map[string]interface{}{
+					patch.LabelRolloutStrategy: "wrong rollout strategy",
+				},
+			},
+			Output: Output{
+				ChangeSchedulerFunc: func() *entities.Scheduler {
+					return basicSchedulerToPatchSchedulerTests()
+				},
+				Error: fmt.Errorf("error parsing scheduler: rollout strategy malformed"),
+			},
+		},
 		{
 			Title: "Have wrong forwarders return error",
 			Input: Input{
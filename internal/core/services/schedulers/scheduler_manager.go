@@ -26,7 +26,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
+	"github.com/topfreegames/maestro/internal/core/operations"
+	"github.com/topfreegames/maestro/internal/core/operations/schedulers/bluegreen"
+	"github.com/topfreegames/maestro/internal/core/operations/schedulers/canary"
 	newversion "github.com/topfreegames/maestro/internal/core/operations/schedulers/newversion"
 	"github.com/topfreegames/maestro/internal/core/operations/schedulers/switchversion"
 	"github.com/topfreegames/maestro/internal/core/services/schedulers/patch"
@@ -48,17 +52,19 @@ type SchedulerManager struct {
 	schedulerCache   ports.SchedulerCache
 	operationManager ports.OperationManager
 	roomStorage      ports.RoomStorage
+	runtime          ports.Runtime
 	logger           *zap.Logger
 }
 
 var _ ports.SchedulerManager = (*SchedulerManager)(nil)
 
-func NewSchedulerManager(schedulerStorage ports.SchedulerStorage, schedulerCache ports.SchedulerCache, operationManager ports.OperationManager, roomStorage ports.RoomStorage) *SchedulerManager {
+func NewSchedulerManager(schedulerStorage ports.SchedulerStorage, schedulerCache ports.SchedulerCache, operationManager ports.OperationManager, roomStorage ports.RoomStorage, runtime ports.Runtime) *SchedulerManager {
 	return &SchedulerManager{
 		schedulerStorage: schedulerStorage,
 		operationManager: operationManager,
 		schedulerCache:   schedulerCache,
 		roomStorage:      roomStorage,
+		runtime:          runtime,
 		logger:           zap.L().With(zap.String(logs.LogFieldComponent, "service"), zap.String(logs.LogFieldServiceName, "scheduler_manager")),
 	}
 }
@@ -89,7 +95,16 @@ func (s *SchedulerManager) CreateScheduler(ctx context.Context, scheduler *entit
 		return nil, fmt.Errorf("failing in creating schedule: %w", err)
 	}
 
-	err = s.schedulerStorage.CreateScheduler(ctx, scheduler)
+	_, err = s.createScheduler(ctx, scheduler)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.schedulerStorage.GetScheduler(ctx, scheduler.Name)
+}
+
+func (s *SchedulerManager) createScheduler(ctx context.Context, scheduler *entities.Scheduler) (*operation.Operation, error) {
+	err := s.schedulerStorage.CreateScheduler(ctx, scheduler)
 	if err != nil {
 		return nil, err
 	}
@@ -101,7 +116,7 @@ func (s *SchedulerManager) CreateScheduler(ctx context.Context, scheduler *entit
 
 	s.logger.Info("scheduler enqueued to be created", zap.String("scheduler", scheduler.Name), zap.String("operation", op.ID))
 
-	return s.schedulerStorage.GetScheduler(ctx, scheduler.Name)
+	return op, nil
 }
 
 func (s *SchedulerManager) CreateNewSchedulerVersion(ctx context.Context, scheduler *entities.Scheduler) error {
@@ -129,7 +144,7 @@ func (s *SchedulerManager) CreateNewSchedulerVersionAndEnqueueSwitchVersion(ctx
 			return err
 		}
 
-		op, err := s.EnqueueSwitchActiveVersionOperation(ctx, scheduler.Name, scheduler.Spec.Version)
+		op, err := s.enqueueSwitchActiveVersionOperation(ctx, scheduler.Name, scheduler.Spec.Version)
 		if err != nil {
 			return fmt.Errorf("error enqueuing switch active version operation: %w", err)
 		}
@@ -143,7 +158,46 @@ func (s *SchedulerManager) CreateNewSchedulerVersionAndEnqueueSwitchVersion(ctx
 	return opID, nil
 }
 
-func (s *SchedulerManager) PatchSchedulerAndCreateNewSchedulerVersionOperation(ctx context.Context, schedulerName string, patchMap map[string]interface{}) (*operation.Operation, error) {
+// CreateNewSchedulerVersionAndEnqueueCanaryRollout creates the new scheduler
+// version without activating it and enqueues the canary rollout operation,
+// which switches to the new version only if the canary succeeds.
+func (s *SchedulerManager) CreateNewSchedulerVersionAndEnqueueCanaryRollout(ctx context.Context, scheduler *entities.Scheduler) (string, error) {
+	return s.createNewSchedulerVersionAndEnqueueOperation(ctx, scheduler, &canary.Definition{NewVersion: scheduler.Spec.Version})
+}
+
+// CreateNewSchedulerVersionAndEnqueueBlueGreenSwitch creates the new scheduler
+// version without activating it and enqueues the blue/green switch operation,
+// which creates every room on the new version before activating it.
+func (s *SchedulerManager) CreateNewSchedulerVersionAndEnqueueBlueGreenSwitch(ctx context.Context, scheduler *entities.Scheduler) (string, error) {
+	return s.createNewSchedulerVersionAndEnqueueOperation(ctx, scheduler, &bluegreen.Definition{NewVersion: scheduler.Spec.Version})
+}
+
+func (s *SchedulerManager) createNewSchedulerVersionAndEnqueueOperation(ctx context.Context, scheduler *entities.Scheduler, opDef operations.Definition) (opID string, err error) {
+	err = scheduler.Validate()
+	if err != nil {
+		return "", fmt.Errorf("failing in creating schedule: %w", err)
+	}
+
+	err = s.schedulerStorage.RunWithTransaction(ctx, func(transactionId ports.TransactionID) error {
+		err := s.schedulerStorage.CreateSchedulerVersion(ctx, transactionId, scheduler)
+		if err != nil {
+			return err
+		}
+
+		op, err := s.operationManager.CreateOperation(ctx, scheduler.Name, opDef)
+		if err != nil {
+			return fmt.Errorf("failed to schedule %s operation: %w", opDef.Name(), err)
+		}
+		opID = op.ID
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return opID, nil
+}
+
+func (s *SchedulerManager) PatchSchedulerAndCreateNewSchedulerVersionOperation(ctx context.Context, schedulerName string, patchMap map[string]interface{}, idempotencyKey string) (*operation.Operation, error) {
 	scheduler, err := s.schedulerStorage.GetScheduler(ctx, schedulerName)
 	if err != nil {
 		if errors.Is(err, portsErrors.ErrNotFound) {
@@ -164,14 +218,45 @@ func (s *SchedulerManager) PatchSchedulerAndCreateNewSchedulerVersionOperation(c
 
 	opDef := &newversion.Definition{NewScheduler: scheduler}
 
-	op, err := s.operationManager.CreateOperation(ctx, scheduler.Name, opDef)
+	op, err := s.operationManager.CreateOperationWithIdempotencyKey(ctx, scheduler.Name, idempotencyKey, opDef)
 	if err != nil {
+		if errors.Is(err, portsErrors.ErrConflict) {
+			return nil, err
+		}
 		return nil, portsErrors.NewErrUnexpected("failed to schedule %s operation: %s", opDef.Name(), err.Error())
 	}
 
 	return op, nil
 }
 
+// DryRunPatchScheduler applies the patch to the scheduler and validates the
+// result, including a dry run of its game room on the runtime, without creating
+// the new version nor any operation. It returns the changes the patch makes.
+func (s *SchedulerManager) DryRunPatchScheduler(ctx context.Context, schedulerName string, patchMap map[string]interface{}) (*entities.SchedulerDiff, error) {
+	currentScheduler, err := s.schedulerStorage.GetScheduler(ctx, schedulerName)
+	if err != nil {
+		if errors.Is(err, portsErrors.ErrNotFound) {
+			return nil, portsErrors.NewErrNotFound("no scheduler found, can not create new version for inexistent scheduler: %s", err.Error())
+		}
+
+		return nil, portsErrors.NewErrUnexpected("unexpected error getting scheduler to patch: %s", err.Error())
+	}
+
+	// the patch changes nested fields (e.g. containers) in place, so it is
+	// applied to another copy of the scheduler to keep the current one intact.
+	scheduler, err := s.schedulerStorage.GetScheduler(ctx, schedulerName)
+	if err != nil {
+		return nil, portsErrors.NewErrUnexpected("unexpected error getting scheduler to patch: %s", err.Error())
+	}
+
+	scheduler, err = patch.PatchScheduler(*scheduler, patchMap)
+	if err != nil {
+		return nil, portsErrors.NewErrInvalidArgument("error patching scheduler: %s", err.Error())
+	}
+
+	return s.dryRunSchedulerVersion(ctx, currentScheduler, scheduler)
+}
+
 func (s *SchedulerManager) GetSchedulersWithFilter(ctx context.Context, schedulerFilter *filters.SchedulerFilter) ([]*entities.Scheduler, error) {
 	return s.schedulerStorage.GetSchedulersWithFilter(ctx, schedulerFilter)
 }
@@ -187,7 +272,51 @@ func (s *SchedulerManager) GetSchedulerVersions(ctx context.Context, schedulerNa
 	return s.schedulerStorage.GetSchedulerVersions(ctx, schedulerName)
 }
 
-func (s *SchedulerManager) EnqueueNewSchedulerVersionOperation(ctx context.Context, scheduler *entities.Scheduler) (*operation.Operation, error) {
+// PatchSchedulerVersion updates the pinned and blocked flags of a scheduler
+// version, only the flags that are not nil are changed. The active version
+// can't be blocked.
+func (s *SchedulerManager) PatchSchedulerVersion(ctx context.Context, schedulerName, version string, pinned, blocked *bool) (*entities.SchedulerVersion, error) {
+	schedulerVersion, err := s.findSchedulerVersion(ctx, schedulerName, version)
+	if err != nil {
+		return nil, err
+	}
+
+	if pinned != nil {
+		schedulerVersion.Pinned = *pinned
+	}
+
+	if blocked != nil {
+		if *blocked && schedulerVersion.IsActive {
+			return nil, portsErrors.NewErrInvalidArgument("scheduler %s version %s is active and can not be blocked", schedulerName, version)
+		}
+		schedulerVersion.Blocked = *blocked
+	}
+
+	err = s.schedulerStorage.UpdateSchedulerVersion(ctx, schedulerName, schedulerVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update scheduler version: %w", err)
+	}
+
+	return schedulerVersion, nil
+}
+
+// GetSchedulerVersionsDiff fetches both versions of the scheduler and returns
+// the changes from one version to the other.
+func (s *SchedulerManager) GetSchedulerVersionsDiff(ctx context.Context, schedulerName, fromVersion, toVersion string) (*entities.SchedulerDiff, error) {
+	fromScheduler, err := s.GetScheduler(ctx, schedulerName, fromVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch scheduler version %s: %w", fromVersion, err)
+	}
+
+	toScheduler, err := s.GetScheduler(ctx, schedulerName, toVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch scheduler version %s: %w", toVersion, err)
+	}
+
+	return fromScheduler.Diff(toScheduler), nil
+}
+
+func (s *SchedulerManager) EnqueueNewSchedulerVersionOperation(ctx context.Context, scheduler *entities.Scheduler, idempotencyKey string) (*operation.Operation, error) {
 	currentScheduler, err := s.schedulerStorage.GetScheduler(ctx, scheduler.Name)
 	if err != nil {
 		return nil, fmt.Errorf("no scheduler found, can not create new version for inexistent scheduler: %w", err)
@@ -201,7 +330,7 @@ func (s *SchedulerManager) EnqueueNewSchedulerVersionOperation(ctx context.Conte
 
 	opDef := &newversion.Definition{NewScheduler: scheduler}
 
-	op, err := s.operationManager.CreateOperation(ctx, scheduler.Name, opDef)
+	op, err := s.operationManager.CreateOperationWithIdempotencyKey(ctx, scheduler.Name, idempotencyKey, opDef)
 	if err != nil {
 		return nil, fmt.Errorf("failed to schedule %s operation: %w", opDef.Name(), err)
 	}
@@ -209,7 +338,54 @@ func (s *SchedulerManager) EnqueueNewSchedulerVersionOperation(ctx context.Conte
 	return op, nil
 }
 
+// DryRunNewSchedulerVersion validates the new scheduler version, including a
+// dry run of its game room on the runtime, without creating the version nor
+// any operation. It returns the changes from the current version.
+func (s *SchedulerManager) DryRunNewSchedulerVersion(ctx context.Context, scheduler *entities.Scheduler) (*entities.SchedulerDiff, error) {
+	currentScheduler, err := s.schedulerStorage.GetScheduler(ctx, scheduler.Name)
+	if err != nil {
+		return nil, fmt.Errorf("no scheduler found, can not create new version for inexistent scheduler: %w", err)
+	}
+
+	scheduler.Spec.Version = currentScheduler.Spec.Version
+	return s.dryRunSchedulerVersion(ctx, currentScheduler, scheduler)
+}
+
+func (s *SchedulerManager) dryRunSchedulerVersion(ctx context.Context, currentScheduler, scheduler *entities.Scheduler) (*entities.SchedulerDiff, error) {
+	if err := scheduler.Validate(); err != nil {
+		return nil, portsErrors.NewErrInvalidArgument("invalid scheduler: %s", err.Error())
+	}
+
+	// the management API runs without a runtime when Kubernetes is not
+	// configured, in which case the game room can't be dry run.
+	if s.runtime == nil {
+		return nil, portsErrors.NewErrUnavailable("no runtime configured to dry run the game room")
+	}
+
+	if err := s.runtime.DryRunGameRoomInstance(ctx, scheduler, scheduler.Spec); err != nil {
+		return nil, fmt.Errorf("failed to dry run game room on the runtime: %w", err)
+	}
+
+	return currentScheduler.Diff(scheduler), nil
+}
+
+// EnqueueSwitchActiveVersionOperation enqueues the operation that activates
+// the given version, refusing versions that are marked as blocked. The
+// operation checks it again when executed.
 func (s *SchedulerManager) EnqueueSwitchActiveVersionOperation(ctx context.Context, schedulerName, newVersion string) (*operation.Operation, error) {
+	schedulerVersion, err := s.findSchedulerVersion(ctx, schedulerName, newVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	if schedulerVersion.Blocked {
+		return nil, portsErrors.NewErrInvalidArgument("scheduler %s version %s is blocked and can not be activated", schedulerName, newVersion)
+	}
+
+	return s.enqueueSwitchActiveVersionOperation(ctx, schedulerName, newVersion)
+}
+
+func (s *SchedulerManager) enqueueSwitchActiveVersionOperation(ctx context.Context, schedulerName, newVersion string) (*operation.Operation, error) {
 	opDef := &switchversion.Definition{NewActiveVersion: newVersion}
 	op, err := s.operationManager.CreateOperation(ctx, schedulerName, opDef)
 	if err != nil {
@@ -237,6 +413,111 @@ func (s *SchedulerManager) EnqueueDeleteSchedulerOperation(ctx context.Context,
 	return op, nil
 }
 
+// PlanSchedulersApply compares the desired schedulers of a game, usually kept
+// as manifests, to the stored ones. It returns the steps needed to reach the
+// desired state: schedulers without a stored version are created, the ones that
+// differ get a new version, and, when pruning, the stored ones missing from the
+// desired set are deleted.
+func (s *SchedulerManager) PlanSchedulersApply(ctx context.Context, game string, schedulers []*entities.Scheduler, prune bool) ([]*entities.SchedulerApplyStep, error) {
+	// an empty set would delete every game scheduler when pruning, which is
+	// more likely a broken manifest than the intent.
+	if len(schedulers) == 0 {
+		return nil, portsErrors.NewErrInvalidArgument("no scheduler declared for game %s", game)
+	}
+
+	desiredSchedulers := map[string]*entities.Scheduler{}
+	for _, scheduler := range schedulers {
+		if scheduler.Game != game {
+			return nil, portsErrors.NewErrInvalidArgument("scheduler %s belongs to game %s, not %s", scheduler.Name, scheduler.Game, game)
+		}
+		if _, ok := desiredSchedulers[scheduler.Name]; ok {
+			return nil, portsErrors.NewErrInvalidArgument("scheduler %s is declared more than once", scheduler.Name)
+		}
+		if err := scheduler.Validate(); err != nil {
+			return nil, portsErrors.NewErrInvalidArgument("invalid scheduler %s: %s", scheduler.Name, err.Error())
+		}
+		desiredSchedulers[scheduler.Name] = scheduler
+	}
+
+	currentSchedulers, err := s.schedulerStorage.GetSchedulersWithFilter(ctx, &filters.SchedulerFilter{Game: game})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list game %s schedulers: %w", game, err)
+	}
+
+	steps := []*entities.SchedulerApplyStep{}
+	storedSchedulers := map[string]bool{}
+	for _, currentScheduler := range currentSchedulers {
+		storedSchedulers[currentScheduler.Name] = true
+		desiredScheduler, ok := desiredSchedulers[currentScheduler.Name]
+		if !ok {
+			if prune {
+				steps = append(steps, &entities.SchedulerApplyStep{SchedulerName: currentScheduler.Name, Action: entities.SchedulerApplyActionDelete})
+			}
+			continue
+		}
+
+		// the fields maestro sets can't be declared on the manifests, so they
+		// are kept from the stored scheduler.
+		desiredScheduler.Spec.Version = currentScheduler.Spec.Version
+		desiredScheduler.RollbackVersion = currentScheduler.RollbackVersion
+		desiredScheduler.Template = currentScheduler.Template
+		desiredScheduler.LastDownscaleAt = currentScheduler.LastDownscaleAt
+		desiredScheduler.RolloutHealth = currentScheduler.RolloutHealth
+		step := &entities.SchedulerApplyStep{SchedulerName: desiredScheduler.Name, Action: entities.SchedulerApplyActionNone, Scheduler: desiredScheduler}
+		if diff := currentScheduler.Diff(desiredScheduler); len(diff.Changes) > 0 {
+			step.Action = entities.SchedulerApplyActionUpdate
+			step.Diff = diff
+		}
+		steps = append(steps, step)
+	}
+
+	for _, desiredScheduler := range desiredSchedulers {
+		if storedSchedulers[desiredScheduler.Name] {
+			continue
+		}
+		steps = append(steps, &entities.SchedulerApplyStep{SchedulerName: desiredScheduler.Name, Action: entities.SchedulerApplyActionCreate, Scheduler: desiredScheduler})
+	}
+
+	sort.Slice(steps, func(i, j int) bool {
+		return steps[i].SchedulerName < steps[j].SchedulerName
+	})
+
+	return steps, nil
+}
+
+// ApplySchedulers plans the schedulers apply and executes it, enqueueing the
+// create, new version and delete operations of each step. A step failing
+// doesn't stop the others, its error is reported on the step itself.
+func (s *SchedulerManager) ApplySchedulers(ctx context.Context, game string, schedulers []*entities.Scheduler, prune bool) ([]*entities.SchedulerApplyStep, error) {
+	steps, err := s.PlanSchedulersApply(ctx, game, schedulers, prune)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, step := range steps {
+		var op *operation.Operation
+		switch step.Action {
+		case entities.SchedulerApplyActionCreate:
+			op, err = s.createScheduler(ctx, step.Scheduler)
+		case entities.SchedulerApplyActionUpdate:
+			op, err = s.EnqueueNewSchedulerVersionOperation(ctx, step.Scheduler, "")
+		case entities.SchedulerApplyActionDelete:
+			op, err = s.EnqueueDeleteSchedulerOperation(ctx, step.SchedulerName)
+		default:
+			continue
+		}
+
+		if err != nil {
+			s.logger.Error("failed to apply scheduler", zap.String(logs.LogFieldSchedulerName, step.SchedulerName), zap.String("action", string(step.Action)), zap.Error(err))
+			step.Error = err.Error()
+			continue
+		}
+		step.OperationID = op.ID
+	}
+
+	return steps, nil
+}
+
 func (s *SchedulerManager) UpdateScheduler(ctx context.Context, scheduler *entities.Scheduler) error {
 	err := scheduler.Validate()
 	if err != nil {
@@ -287,6 +568,21 @@ func (s *SchedulerManager) DeleteScheduler(ctx context.Context, schedulerName st
 	return nil
 }
 
+func (s *SchedulerManager) findSchedulerVersion(ctx context.Context, schedulerName, version string) (*entities.SchedulerVersion, error) {
+	schedulerVersions, err := s.schedulerStorage.GetSchedulerVersions(ctx, schedulerName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch scheduler versions: %w", err)
+	}
+
+	for _, schedulerVersion := range schedulerVersions {
+		if schedulerVersion.Version == version {
+			return schedulerVersion, nil
+		}
+	}
+
+	return nil, portsErrors.NewErrNotFound("scheduler %s version %s not found", schedulerName, version)
+}
+
 func (s *SchedulerManager) getScheduler(ctx context.Context, schedulerName string) (*entities.Scheduler, error) {
 	scheduler, err := s.schedulerCache.GetScheduler(ctx, schedulerName)
 	if err != nil || scheduler == nil {
@@ -316,6 +612,11 @@ func (s *SchedulerManager) newSchedulerInfo(ctx context.Context, scheduler *enti
 		return nil, fmt.Errorf("failing in couting game rooms in %s state: %s", game_room.GameStatusTerminating, err)
 	}
 
+	operationsPause, err := s.operationManager.GetSchedulerOperationsPause(ctx, scheduler.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failing in fetching scheduler operations pause: %s", err)
+	}
+
 	return entities.NewSchedulerInfo(
 		entities.WithName(scheduler.Name),
 		entities.WithGame(scheduler.Game),
@@ -326,5 +627,6 @@ func (s *SchedulerManager) newSchedulerInfo(ctx context.Context, scheduler *enti
 		entities.WithRoomsPending(pending),
 		entities.WithRoomsTerminating(terminating),
 		entities.WithAutoscalingInfo(scheduler.Autoscaling),
+		entities.WithOperationsPause(operationsPause),
 	), nil
 }
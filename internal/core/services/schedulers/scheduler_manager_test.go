@@ -31,6 +31,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/topfreegames/maestro/internal/core/operations/schedulers/bluegreen"
+	"github.com/topfreegames/maestro/internal/core/operations/schedulers/canary"
 	"github.com/topfreegames/maestro/internal/core/operations/schedulers/newversion"
 	"github.com/topfreegames/maestro/internal/core/services/schedulers/patch"
 
@@ -49,6 +51,7 @@ import (
 	"github.com/topfreegames/maestro/internal/core/entities"
 	"github.com/topfreegames/maestro/internal/core/entities/game_room"
 	"github.com/topfreegames/maestro/internal/core/entities/port"
+	"github.com/topfreegames/maestro/internal/core/entities/rollout"
 	"github.com/topfreegames/maestro/internal/core/filters"
 	"github.com/topfreegames/maestro/internal/core/ports/errors"
 	portsErrors "github.com/topfreegames/maestro/internal/core/ports/errors"
@@ -69,7 +72,7 @@ func TestCreateScheduler(t *testing.T) {
 	operationManager := mock.NewMockOperationManager(mockCtrl)
 	roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 	schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-	schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+	schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
 	t.Run("with valid scheduler it returns no error when creating it", func(t *testing.T) {
 		scheduler := newValidScheduler()
@@ -128,7 +131,7 @@ func TestCreateNewSchedulerVersion(t *testing.T) {
 	operationManager := mock.NewMockOperationManager(mockCtrl)
 	roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 	schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-	schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+	schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
 	t.Run("with valid scheduler it returns no error when creating it", func(t *testing.T) {
 		scheduler := newValidScheduler()
@@ -157,6 +160,95 @@ func TestCreateNewSchedulerVersion(t *testing.T) {
 
 }
 
+func TestCreateNewSchedulerVersionAndEnqueueCanaryRollout(t *testing.T) {
+	err := validations.RegisterValidations()
+	if err != nil {
+		t.Errorf("unexpected error %d'", err)
+	}
+
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+
+	schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+	operationManager := mock.NewMockOperationManager(mockCtrl)
+	roomStorage := mockports.NewMockRoomStorage(mockCtrl)
+	schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
+	schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
+
+	runInTransaction := func(ctx context.Context, transactionFunc func(transactionId ports.TransactionID) error) error {
+		return transactionFunc(ports.TransactionID("transaction-id"))
+	}
+
+	t.Run("creates the new version and enqueues the canary rollout operation", func(t *testing.T) {
+		scheduler := newValidScheduler()
+
+		schedulerStorage.EXPECT().RunWithTransaction(ctx, gomock.Any()).DoAndReturn(runInTransaction)
+		schedulerStorage.EXPECT().CreateSchedulerVersion(ctx, ports.TransactionID("transaction-id"), scheduler).Return(nil)
+		operationManager.EXPECT().CreateOperation(ctx, scheduler.Name, &canary.Definition{NewVersion: scheduler.Spec.Version}).Return(&operation.Operation{ID: "canary-op-id"}, nil)
+
+		opID, err := schedulerManager.CreateNewSchedulerVersionAndEnqueueCanaryRollout(ctx, scheduler)
+		require.NoError(t, err)
+		require.Equal(t, "canary-op-id", opID)
+	})
+
+	t.Run("returns error when enqueueing the canary rollout operation fails", func(t *testing.T) {
+		scheduler := newValidScheduler()
+
+		schedulerStorage.EXPECT().RunWithTransaction(ctx, gomock.Any()).DoAndReturn(runInTransaction)
+		schedulerStorage.EXPECT().CreateSchedulerVersion(ctx, ports.TransactionID("transaction-id"), scheduler).Return(nil)
+		operationManager.EXPECT().CreateOperation(ctx, scheduler.Name, gomock.Any()).Return(nil, errors.NewErrUnexpected("storage offline"))
+
+		_, err := schedulerManager.CreateNewSchedulerVersionAndEnqueueCanaryRollout(ctx, scheduler)
+		require.ErrorIs(t, err, errors.ErrUnexpected)
+	})
+
+	t.Run("with invalid scheduler it return invalid scheduler error", func(t *testing.T) {
+		scheduler := newInvalidScheduler()
+
+		_, err := schedulerManager.CreateNewSchedulerVersionAndEnqueueCanaryRollout(ctx, scheduler)
+		require.Error(t, err)
+	})
+}
+
+func TestCreateNewSchedulerVersionAndEnqueueBlueGreenSwitch(t *testing.T) {
+	err := validations.RegisterValidations()
+	if err != nil {
+		t.Errorf("unexpected error %d'", err)
+	}
+
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+
+	schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+	operationManager := mock.NewMockOperationManager(mockCtrl)
+	roomStorage := mockports.NewMockRoomStorage(mockCtrl)
+	schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
+	schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
+
+	runInTransaction := func(ctx context.Context, transactionFunc func(transactionId ports.TransactionID) error) error {
+		return transactionFunc(ports.TransactionID("transaction-id"))
+	}
+
+	t.Run("creates the new version and enqueues the blue/green switch operation", func(t *testing.T) {
+		scheduler := newValidScheduler()
+
+		schedulerStorage.EXPECT().RunWithTransaction(ctx, gomock.Any()).DoAndReturn(runInTransaction)
+		schedulerStorage.EXPECT().CreateSchedulerVersion(ctx, ports.TransactionID("transaction-id"), scheduler).Return(nil)
+		operationManager.EXPECT().CreateOperation(ctx, scheduler.Name, &bluegreen.Definition{NewVersion: scheduler.Spec.Version}).Return(&operation.Operation{ID: "blue-green-op-id"}, nil)
+
+		opID, err := schedulerManager.CreateNewSchedulerVersionAndEnqueueBlueGreenSwitch(ctx, scheduler)
+		require.NoError(t, err)
+		require.Equal(t, "blue-green-op-id", opID)
+	})
+
+	t.Run("with invalid scheduler it return invalid scheduler error", func(t *testing.T) {
+		scheduler := newInvalidScheduler()
+
+		_, err := schedulerManager.CreateNewSchedulerVersionAndEnqueueBlueGreenSwitch(ctx, scheduler)
+		require.Error(t, err)
+	})
+}
+
 func TestEnqueueNewSchedulerVersionOperation(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 
@@ -174,12 +266,12 @@ func TestEnqueueNewSchedulerVersionOperation(t *testing.T) {
 		operationManager := mock.NewMockOperationManager(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
-		operationManager.EXPECT().CreateOperation(ctx, scheduler.Name, gomock.Any()).Return(&operation.Operation{}, nil)
+		operationManager.EXPECT().CreateOperationWithIdempotencyKey(ctx, scheduler.Name, "", gomock.Any()).Return(&operation.Operation{}, nil)
 		schedulerStorage.EXPECT().GetScheduler(ctx, scheduler.Name).Return(scheduler, nil)
 
-		op, err := schedulerManager.EnqueueNewSchedulerVersionOperation(ctx, scheduler)
+		op, err := schedulerManager.EnqueueNewSchedulerVersionOperation(ctx, scheduler, "")
 		require.NoError(t, err)
 		require.NotNil(t, op)
 		require.NotNil(t, op.ID)
@@ -195,11 +287,11 @@ func TestEnqueueNewSchedulerVersionOperation(t *testing.T) {
 		operationManager := mock.NewMockOperationManager(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
 		schedulerStorage.EXPECT().GetScheduler(ctx, scheduler.Name).Return(scheduler, nil)
 
-		_, err := schedulerManager.EnqueueNewSchedulerVersionOperation(ctx, scheduler)
+		_, err := schedulerManager.EnqueueNewSchedulerVersionOperation(ctx, scheduler, "")
 		require.Error(t, err)
 
 	})
@@ -213,11 +305,11 @@ func TestEnqueueNewSchedulerVersionOperation(t *testing.T) {
 		operationManager := mock.NewMockOperationManager(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
 		schedulerStorage.EXPECT().GetScheduler(ctx, scheduler.Name).Return(nil, errors.NewErrUnexpected("some_error"))
 
-		_, err := schedulerManager.EnqueueNewSchedulerVersionOperation(ctx, scheduler)
+		_, err := schedulerManager.EnqueueNewSchedulerVersionOperation(ctx, scheduler, "")
 		require.Error(t, err)
 	})
 
@@ -230,17 +322,140 @@ func TestEnqueueNewSchedulerVersionOperation(t *testing.T) {
 		operationManager := mock.NewMockOperationManager(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
 		schedulerStorage.EXPECT().GetScheduler(ctx, scheduler.Name).Return(scheduler, nil)
-		operationManager.EXPECT().CreateOperation(ctx, scheduler.Name, gomock.Any()).Return(nil, errors.NewErrUnexpected("storage offline"))
+		operationManager.EXPECT().CreateOperationWithIdempotencyKey(ctx, scheduler.Name, "", gomock.Any()).Return(nil, errors.NewErrUnexpected("storage offline"))
 
-		op, err := schedulerManager.EnqueueNewSchedulerVersionOperation(ctx, scheduler)
+		op, err := schedulerManager.EnqueueNewSchedulerVersionOperation(ctx, scheduler, "")
 		require.Nil(t, op)
 		require.ErrorIs(t, err, errors.ErrUnexpected)
 	})
 }
 
+func TestDryRunNewSchedulerVersion(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+
+	err := validations.RegisterValidations()
+	if err != nil {
+		t.Errorf("unexpected error %d'", err)
+	}
+
+	t.Run("return the changes without creating the operation", func(t *testing.T) {
+		currentScheduler := newValidScheduler()
+		currentScheduler.PortRange = &port.PortRange{Start: 0, End: 1}
+		scheduler := newValidScheduler()
+		scheduler.PortRange = &port.PortRange{Start: 0, End: 1}
+		scheduler.Spec.Containers[0].Image = "new-image"
+
+		ctx := context.Background()
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		operationManager := mock.NewMockOperationManager(mockCtrl)
+		runtime := mockports.NewMockRuntime(mockCtrl)
+		schedulerManager := NewSchedulerManager(schedulerStorage, nil, operationManager, nil, runtime)
+
+		schedulerStorage.EXPECT().GetScheduler(ctx, scheduler.Name).Return(currentScheduler, nil)
+		runtime.EXPECT().DryRunGameRoomInstance(ctx, scheduler, scheduler.Spec).Return(nil)
+		operationManager.EXPECT().CreateOperationWithIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		diff, err := schedulerManager.DryRunNewSchedulerVersion(ctx, scheduler)
+		require.NoError(t, err)
+		require.True(t, diff.IsMajor)
+		require.Equal(t, []*entities.SchedulerFieldChange{{Path: "Spec.Containers[0].Image", OldValue: currentScheduler.Spec.Containers[0].Image, NewValue: "new-image"}}, diff.Changes)
+	})
+
+	t.Run("return invalid argument when the scheduler is invalid", func(t *testing.T) {
+		scheduler := newInvalidScheduler()
+		scheduler.PortRange = &port.PortRange{Start: 0, End: 1}
+
+		ctx := context.Background()
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		runtime := mockports.NewMockRuntime(mockCtrl)
+		schedulerManager := NewSchedulerManager(schedulerStorage, nil, nil, nil, runtime)
+
+		schedulerStorage.EXPECT().GetScheduler(ctx, scheduler.Name).Return(newValidScheduler(), nil)
+		runtime.EXPECT().DryRunGameRoomInstance(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := schedulerManager.DryRunNewSchedulerVersion(ctx, scheduler)
+		require.ErrorIs(t, err, errors.ErrInvalidArgument)
+	})
+
+	t.Run("return error when the runtime rejects the game room", func(t *testing.T) {
+		scheduler := newValidScheduler()
+		scheduler.PortRange = &port.PortRange{Start: 0, End: 1}
+
+		ctx := context.Background()
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		runtime := mockports.NewMockRuntime(mockCtrl)
+		schedulerManager := NewSchedulerManager(schedulerStorage, nil, nil, nil, runtime)
+
+		schedulerStorage.EXPECT().GetScheduler(ctx, scheduler.Name).Return(newValidScheduler(), nil)
+		runtime.EXPECT().DryRunGameRoomInstance(ctx, scheduler, scheduler.Spec).Return(errors.NewErrInvalidArgument("invalid game room spec"))
+
+		_, err := schedulerManager.DryRunNewSchedulerVersion(ctx, scheduler)
+		require.ErrorIs(t, err, errors.ErrInvalidArgument)
+	})
+
+	t.Run("return unavailable when there is no runtime", func(t *testing.T) {
+		scheduler := newValidScheduler()
+		scheduler.PortRange = &port.PortRange{Start: 0, End: 1}
+
+		ctx := context.Background()
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		schedulerManager := NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
+
+		schedulerStorage.EXPECT().GetScheduler(ctx, scheduler.Name).Return(newValidScheduler(), nil)
+
+		_, err := schedulerManager.DryRunNewSchedulerVersion(ctx, scheduler)
+		require.ErrorIs(t, err, errors.ErrUnavailable)
+	})
+}
+
+func TestDryRunPatchScheduler(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+
+	err := validations.RegisterValidations()
+	if err != nil {
+		t.Errorf("unexpected error %d'", err)
+	}
+
+	t.Run("return the changes made by the patch without creating the operation", func(t *testing.T) {
+		ctx := context.Background()
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		operationManager := mock.NewMockOperationManager(mockCtrl)
+		runtime := mockports.NewMockRuntime(mockCtrl)
+		schedulerManager := NewSchedulerManager(schedulerStorage, nil, operationManager, nil, runtime)
+
+		schedulerStorage.EXPECT().GetScheduler(ctx, "scheduler").DoAndReturn(func(_ context.Context, _ string) (*entities.Scheduler, error) {
+			return newValidScheduler(), nil
+		}).Times(2)
+		runtime.EXPECT().DryRunGameRoomInstance(ctx, gomock.Any(), gomock.Any()).Return(nil)
+		operationManager.EXPECT().CreateOperationWithIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		diff, err := schedulerManager.DryRunPatchScheduler(ctx, "scheduler", map[string]interface{}{
+			patch.LabelSchedulerSpec: map[string]interface{}{
+				patch.LabelSpecContainers: []map[string]interface{}{{patch.LabelContainerImage: "new-image"}},
+			},
+		})
+		require.NoError(t, err)
+		require.True(t, diff.IsMajor)
+		require.Len(t, diff.Changes, 1)
+		require.Equal(t, "Spec.Containers[0].Image", diff.Changes[0].Path)
+		require.Equal(t, "new-image", diff.Changes[0].NewValue)
+	})
+
+	t.Run("return not found when the scheduler doesn't exist", func(t *testing.T) {
+		ctx := context.Background()
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		schedulerManager := NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
+
+		schedulerStorage.EXPECT().GetScheduler(ctx, "scheduler").Return(nil, errors.NewErrNotFound("scheduler not found"))
+
+		_, err := schedulerManager.DryRunPatchScheduler(ctx, "scheduler", map[string]interface{}{patch.LabelSchedulerMaxSurge: "12%"})
+		require.ErrorIs(t, err, errors.ErrNotFound)
+	})
+}
+
 func TestEnqueueSwitchActiveVersionOperation(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 
@@ -262,8 +477,9 @@ func TestEnqueueSwitchActiveVersionOperation(t *testing.T) {
 		operationManager := mock.NewMockOperationManager(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
+		schedulerStorage.EXPECT().GetSchedulerVersions(ctx, scheduler.Name).Return([]*entities.SchedulerVersion{{Version: scheduler.Spec.Version}}, nil)
 		operationManager.EXPECT().CreateOperation(ctx, scheduler.Name, gomock.Any()).Return(&operation.Operation{}, nil)
 
 		op, err := schedulerManager.EnqueueSwitchActiveVersionOperation(ctx, scheduler.Name, scheduler.Spec.Version)
@@ -285,8 +501,9 @@ func TestEnqueueSwitchActiveVersionOperation(t *testing.T) {
 		operationManager := mock.NewMockOperationManager(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
+		schedulerStorage.EXPECT().GetSchedulerVersions(ctx, scheduler.Name).Return([]*entities.SchedulerVersion{{Version: scheduler.Spec.Version}}, nil)
 		operationManager.EXPECT().CreateOperation(ctx, scheduler.Name, gomock.Any()).Return(nil, errors.NewErrUnexpected("storage offline"))
 
 		op, err := schedulerManager.EnqueueSwitchActiveVersionOperation(ctx, scheduler.Name, scheduler.Spec.Version)
@@ -294,6 +511,94 @@ func TestEnqueueSwitchActiveVersionOperation(t *testing.T) {
 		require.ErrorIs(t, err, errors.ErrUnexpected)
 		require.Contains(t, err.Error(), "failed to schedule switch_active_version operation:")
 	})
+
+	t.Run("return error when the version is blocked", func(t *testing.T) {
+		scheduler := newValidScheduler()
+
+		ctx := context.Background()
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		operationManager := mock.NewMockOperationManager(mockCtrl)
+		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
+		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
+		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
+
+		schedulerStorage.EXPECT().GetSchedulerVersions(ctx, scheduler.Name).Return([]*entities.SchedulerVersion{{Version: scheduler.Spec.Version, Blocked: true}}, nil)
+
+		op, err := schedulerManager.EnqueueSwitchActiveVersionOperation(ctx, scheduler.Name, scheduler.Spec.Version)
+		require.Nil(t, op)
+		require.ErrorIs(t, err, errors.ErrInvalidArgument)
+	})
+
+	t.Run("return error when the version does not exist", func(t *testing.T) {
+		scheduler := newValidScheduler()
+
+		ctx := context.Background()
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		operationManager := mock.NewMockOperationManager(mockCtrl)
+		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
+		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
+		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
+
+		schedulerStorage.EXPECT().GetSchedulerVersions(ctx, scheduler.Name).Return([]*entities.SchedulerVersion{{Version: "v99"}}, nil)
+
+		op, err := schedulerManager.EnqueueSwitchActiveVersionOperation(ctx, scheduler.Name, scheduler.Spec.Version)
+		require.Nil(t, op)
+		require.ErrorIs(t, err, errors.ErrNotFound)
+	})
+}
+
+func TestPatchSchedulerVersion(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	ctx := context.Background()
+	pinned := true
+	blocked := true
+
+	t.Run("update only the given flags", func(t *testing.T) {
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		schedulerManager := NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
+
+		schedulerStorage.EXPECT().GetSchedulerVersions(ctx, "scheduler").Return([]*entities.SchedulerVersion{
+			{Version: "v2", IsActive: true},
+			{Version: "v1", Blocked: true},
+		}, nil)
+		schedulerStorage.EXPECT().UpdateSchedulerVersion(ctx, "scheduler", &entities.SchedulerVersion{Version: "v1", Pinned: true, Blocked: true}).Return(nil)
+
+		schedulerVersion, err := schedulerManager.PatchSchedulerVersion(ctx, "scheduler", "v1", &pinned, nil)
+		require.NoError(t, err)
+		require.True(t, schedulerVersion.Pinned)
+		require.True(t, schedulerVersion.Blocked)
+	})
+
+	t.Run("return error when blocking the active version", func(t *testing.T) {
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		schedulerManager := NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
+
+		schedulerStorage.EXPECT().GetSchedulerVersions(ctx, "scheduler").Return([]*entities.SchedulerVersion{{Version: "v1", IsActive: true}}, nil)
+
+		_, err := schedulerManager.PatchSchedulerVersion(ctx, "scheduler", "v1", nil, &blocked)
+		require.ErrorIs(t, err, errors.ErrInvalidArgument)
+	})
+
+	t.Run("return error when the version does not exist", func(t *testing.T) {
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		schedulerManager := NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
+
+		schedulerStorage.EXPECT().GetSchedulerVersions(ctx, "scheduler").Return([]*entities.SchedulerVersion{{Version: "v1", IsActive: true}}, nil)
+
+		_, err := schedulerManager.PatchSchedulerVersion(ctx, "scheduler", "v2", &pinned, nil)
+		require.ErrorIs(t, err, errors.ErrNotFound)
+	})
+
+	t.Run("return error when the update fails", func(t *testing.T) {
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		schedulerManager := NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
+
+		schedulerStorage.EXPECT().GetSchedulerVersions(ctx, "scheduler").Return([]*entities.SchedulerVersion{{Version: "v1"}}, nil)
+		schedulerStorage.EXPECT().UpdateSchedulerVersion(ctx, "scheduler", gomock.Any()).Return(errors.NewErrUnexpected("storage offline"))
+
+		_, err := schedulerManager.PatchSchedulerVersion(ctx, "scheduler", "v1", &pinned, nil)
+		require.ErrorIs(t, err, errors.ErrUnexpected)
+	})
 }
 
 func TestDeleteSchedulerOperation(t *testing.T) {
@@ -314,7 +619,7 @@ func TestDeleteSchedulerOperation(t *testing.T) {
 		operationManager := mock.NewMockOperationManager(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
 		operationManager.EXPECT().CreateOperation(ctx, scheduler.Name, opDef).Return(&operation.Operation{}, nil)
 		schedulerCache.EXPECT().GetScheduler(ctx, scheduler.Name).Return(scheduler, nil)
@@ -335,7 +640,7 @@ func TestDeleteSchedulerOperation(t *testing.T) {
 		operationManager := mock.NewMockOperationManager(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
 		operationManager.EXPECT().CreateOperation(ctx, scheduler.Name, opDef).Return(&operation.Operation{}, nil)
 		schedulerCache.EXPECT().GetScheduler(ctx, scheduler.Name).Return(nil, errors.ErrNotFound)
@@ -357,7 +662,7 @@ func TestDeleteSchedulerOperation(t *testing.T) {
 		operationManager := mock.NewMockOperationManager(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
 		operationManager.EXPECT().CreateOperation(ctx, scheduler.Name, opDef).Return(nil, errors.NewErrUnexpected("storage offline"))
 		schedulerCache.EXPECT().GetScheduler(ctx, scheduler.Name).Return(scheduler, nil)
@@ -377,7 +682,7 @@ func TestDeleteSchedulerOperation(t *testing.T) {
 		operationManager := mock.NewMockOperationManager(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
 		schedulerCache.EXPECT().GetScheduler(ctx, scheduler.Name).Return(nil, errors.ErrNotFound)
 		schedulerStorage.EXPECT().GetScheduler(ctx, scheduler.Name).Return(nil, errors.ErrNotFound)
@@ -401,7 +706,7 @@ func TestGetSchedulerVersions(t *testing.T) {
 		operationManager := mock.NewMockOperationManager(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
 		schedulerStorage.EXPECT().GetSchedulerVersions(ctx, scheduler.Name).Return(schedulerVersionList, nil)
 
@@ -419,7 +724,7 @@ func TestGetSchedulerVersions(t *testing.T) {
 		operationManager := mock.NewMockOperationManager(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
 		schedulerStorage.EXPECT().GetSchedulerVersions(ctx, scheduler.Name).Return(nil, errors.NewErrNotFound("scheduler not found"))
 
@@ -440,7 +745,7 @@ func TestGetSchedulerByVersion(t *testing.T) {
 		operationManager := mock.NewMockOperationManager(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
 		schedulerStorage.EXPECT().GetSchedulerWithFilter(ctx, &filters.SchedulerFilter{
 			Name:    scheduler.Name,
@@ -460,7 +765,7 @@ func TestGetSchedulerByVersion(t *testing.T) {
 		operationManager := mock.NewMockOperationManager(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
 		schedulerStorage.EXPECT().GetSchedulerWithFilter(ctx, &filters.SchedulerFilter{
 			Name:    scheduler.Name,
@@ -473,6 +778,45 @@ func TestGetSchedulerByVersion(t *testing.T) {
 	})
 }
 
+func TestGetSchedulerVersionsDiff(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+
+	t.Run("with success", func(t *testing.T) {
+		fromScheduler := newValidScheduler()
+		fromScheduler.Spec.Version = "v1.0.0"
+		toScheduler := newValidScheduler()
+		toScheduler.Spec.Version = "v1.1.0"
+		toScheduler.MaxSurge = "50%"
+
+		ctx := context.Background()
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		schedulerManager := NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
+
+		schedulerStorage.EXPECT().GetSchedulerWithFilter(ctx, &filters.SchedulerFilter{Name: fromScheduler.Name, Version: "v1.0.0"}).Return(fromScheduler, nil)
+		schedulerStorage.EXPECT().GetSchedulerWithFilter(ctx, &filters.SchedulerFilter{Name: fromScheduler.Name, Version: "v1.1.0"}).Return(toScheduler, nil)
+
+		diff, err := schedulerManager.GetSchedulerVersionsDiff(ctx, fromScheduler.Name, "v1.0.0", "v1.1.0")
+		require.NoError(t, err)
+		require.False(t, diff.IsMajor)
+		require.Equal(t, []*entities.SchedulerFieldChange{{Path: "MaxSurge", OldValue: fromScheduler.MaxSurge, NewValue: "50%"}}, diff.Changes)
+	})
+
+	t.Run("fails when a version is not found", func(t *testing.T) {
+		scheduler := newValidScheduler()
+
+		ctx := context.Background()
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		schedulerManager := NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
+
+		schedulerStorage.EXPECT().GetSchedulerWithFilter(ctx, gomock.Any()).Return(scheduler, nil)
+		schedulerStorage.EXPECT().GetSchedulerWithFilter(ctx, gomock.Any()).Return(nil, errors.NewErrNotFound("scheduler not found"))
+
+		diff, err := schedulerManager.GetSchedulerVersionsDiff(ctx, scheduler.Name, "v1.0.0", "v1.1.0")
+		require.ErrorIs(t, err, errors.ErrNotFound)
+		require.Nil(t, diff)
+	})
+}
+
 func TestGetScheduler(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 
@@ -484,7 +828,7 @@ func TestGetScheduler(t *testing.T) {
 		operationManager := mock.NewMockOperationManager(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
 		schedulerFilter := &filters.SchedulerFilter{
 			Name:    scheduler.Name,
@@ -506,7 +850,7 @@ func TestGetScheduler(t *testing.T) {
 		operationManager := mock.NewMockOperationManager(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
 		schedulerFilter := &filters.SchedulerFilter{
 			Name:    scheduler.Name,
@@ -533,7 +877,7 @@ func TestGetSchedulersWithFilter(t *testing.T) {
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulers := []*entities.Scheduler{scheduler}
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
 		schedulerStorage.EXPECT().GetSchedulersWithFilter(ctx, gomock.Any()).Return(schedulers, nil)
 
@@ -550,7 +894,7 @@ func TestGetSchedulersWithFilter(t *testing.T) {
 		operationManager := mock.NewMockOperationManager(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
 		schedulerStorage.EXPECT().GetSchedulersWithFilter(ctx, gomock.Any()).Return(nil, errors.NewErrUnexpected("some error"))
 
@@ -576,7 +920,7 @@ func TestUpdateScheduler(t *testing.T) {
 		operationManager := mock.NewMockOperationManager(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
 		schedulerStorage.EXPECT().UpdateScheduler(ctx, scheduler).Return(nil)
 		schedulerCache.EXPECT().DeleteScheduler(ctx, scheduler.Name).Return(nil)
@@ -594,7 +938,7 @@ func TestUpdateScheduler(t *testing.T) {
 		operationManager := mock.NewMockOperationManager(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
 		schedulerStorage.EXPECT().UpdateScheduler(ctx, scheduler).Return(nil)
 		schedulerCache.EXPECT().DeleteScheduler(ctx, scheduler.Name).Return(errors.NewErrUnexpected("error"))
@@ -612,7 +956,7 @@ func TestUpdateScheduler(t *testing.T) {
 		operationManager := mock.NewMockOperationManager(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
 		schedulerStorage.EXPECT().UpdateScheduler(ctx, scheduler).Return(errors.NewErrUnexpected("error"))
 
@@ -627,7 +971,7 @@ func TestUpdateScheduler(t *testing.T) {
 		operationManager := mock.NewMockOperationManager(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
 		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, nil)
 
 		err := schedulerManager.CreateNewSchedulerVersion(ctx, scheduler)
 
@@ -641,7 +985,8 @@ func TestGetSchedulersInfo(t *testing.T) {
 		ctx := context.Background()
 		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, nil, nil, roomStorage)
+		operationManager := mockports.NewMockOperationManager(mockCtrl)
+		schedulerManager := NewSchedulerManager(schedulerStorage, nil, operationManager, roomStorage, nil)
 		schedulerFilter := filters.SchedulerFilter{Game: "Tennis-Clash"}
 		scheduler := newValidScheduler()
 		schedulers := []*entities.Scheduler{scheduler}
@@ -650,6 +995,7 @@ func TestGetSchedulersInfo(t *testing.T) {
 		roomStorage.EXPECT().GetRoomCountByStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(10, nil)
 		roomStorage.EXPECT().GetRoomCountByStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(15, nil)
 		roomStorage.EXPECT().GetRoomCountByStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(20, nil)
+		operationManager.EXPECT().GetSchedulerOperationsPause(gomock.Any(), scheduler.Name).Return(nil, nil)
 
 		schedulersInfo, err := schedulerManager.GetSchedulersInfo(ctx, &schedulerFilter)
 
@@ -666,7 +1012,7 @@ func TestGetSchedulersInfo(t *testing.T) {
 		ctx := context.Background()
 		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, nil, nil, roomStorage)
+		schedulerManager := NewSchedulerManager(schedulerStorage, nil, nil, roomStorage, nil)
 		schedulerFilter := filters.SchedulerFilter{Game: "Tennis-Clash"}
 		schedulerStorage.EXPECT().GetSchedulersWithFilter(gomock.Any(), gomock.Any()).Return(nil, errors.NewErrNotFound("err"))
 
@@ -682,7 +1028,7 @@ func TestGetSchedulersInfo(t *testing.T) {
 		ctx := context.Background()
 		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, nil, nil, roomStorage)
+		schedulerManager := NewSchedulerManager(schedulerStorage, nil, nil, roomStorage, nil)
 		schedulerFilter := filters.SchedulerFilter{Game: "Tennis-Clash"}
 		scheduler := newValidScheduler()
 		schedulers := []*entities.Scheduler{scheduler}
@@ -701,12 +1047,14 @@ func TestNewSchedulerInfo(t *testing.T) {
 	t.Run("with valid request it returns a scheduler and game rooms information (no autoscaling)", func(t *testing.T) {
 		ctx := context.Background()
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
-		schedulerManager := NewSchedulerManager(nil, nil, nil, roomStorage)
+		operationManager := mockports.NewMockOperationManager(mockCtrl)
+		schedulerManager := NewSchedulerManager(nil, nil, operationManager, roomStorage, nil)
 		roomStorage.EXPECT().GetRoomCountByStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(5, nil)
 		roomStorage.EXPECT().GetRoomCountByStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(10, nil)
 		roomStorage.EXPECT().GetRoomCountByStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(15, nil)
 		roomStorage.EXPECT().GetRoomCountByStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(20, nil)
 		scheduler := newValidScheduler()
+		operationManager.EXPECT().GetSchedulerOperationsPause(gomock.Any(), scheduler.Name).Return(nil, nil)
 
 		schedulersInfo, err := schedulerManager.newSchedulerInfo(ctx, scheduler)
 
@@ -716,17 +1064,51 @@ func TestNewSchedulerInfo(t *testing.T) {
 		require.Equal(t, 15, schedulersInfo.RoomsOccupied)
 		require.Equal(t, 20, schedulersInfo.RoomsTerminating)
 		require.Nil(t, schedulersInfo.Autoscaling)
+		require.Nil(t, schedulersInfo.OperationsPause)
+	})
+
+	t.Run("with valid request it returns a scheduler information with operations paused", func(t *testing.T) {
+		ctx := context.Background()
+		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
+		operationManager := mockports.NewMockOperationManager(mockCtrl)
+		schedulerManager := NewSchedulerManager(nil, nil, operationManager, roomStorage, nil)
+		roomStorage.EXPECT().GetRoomCountByStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(5, nil).Times(4)
+		scheduler := newValidScheduler()
+		pause := &operation.OperationsPause{Reason: "incident", PausedAt: time.Now()}
+		operationManager.EXPECT().GetSchedulerOperationsPause(gomock.Any(), scheduler.Name).Return(pause, nil)
+
+		schedulersInfo, err := schedulerManager.newSchedulerInfo(ctx, scheduler)
+
+		require.NoError(t, err)
+		require.Equal(t, pause, schedulersInfo.OperationsPause)
+	})
+
+	t.Run("it returns with error when couldn't get scheduler operations pause", func(t *testing.T) {
+		ctx := context.Background()
+		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
+		operationManager := mockports.NewMockOperationManager(mockCtrl)
+		schedulerManager := NewSchedulerManager(nil, nil, operationManager, roomStorage, nil)
+		roomStorage.EXPECT().GetRoomCountByStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(5, nil).Times(4)
+		scheduler := newValidScheduler()
+		operationManager.EXPECT().GetSchedulerOperationsPause(gomock.Any(), scheduler.Name).Return(nil, errors.NewErrUnexpected("err"))
+
+		schedulersInfo, err := schedulerManager.newSchedulerInfo(ctx, scheduler)
+
+		require.Error(t, err)
+		require.Nil(t, schedulersInfo)
 	})
 
 	t.Run("with valid request it returns a scheduler and game rooms information and autoscaling", func(t *testing.T) {
 		ctx := context.Background()
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
-		schedulerManager := NewSchedulerManager(nil, nil, nil, roomStorage)
+		operationManager := mockports.NewMockOperationManager(mockCtrl)
+		schedulerManager := NewSchedulerManager(nil, nil, operationManager, roomStorage, nil)
 		roomStorage.EXPECT().GetRoomCountByStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(5, nil)
 		roomStorage.EXPECT().GetRoomCountByStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(10, nil)
 		roomStorage.EXPECT().GetRoomCountByStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(15, nil)
 		roomStorage.EXPECT().GetRoomCountByStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(20, nil)
 		scheduler := newValidScheduler()
+		operationManager.EXPECT().GetSchedulerOperationsPause(gomock.Any(), scheduler.Name).Return(nil, nil)
 
 		scheduler.Autoscaling = &autoscaling.Autoscaling{
 			Enabled: true,
@@ -750,7 +1132,7 @@ func TestNewSchedulerInfo(t *testing.T) {
 	t.Run("it returns with error when couldn't get game rooms information in ready state", func(t *testing.T) {
 		ctx := context.Background()
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
-		schedulerManager := NewSchedulerManager(nil, nil, nil, roomStorage)
+		schedulerManager := NewSchedulerManager(nil, nil, nil, roomStorage, nil)
 		roomStorage.EXPECT().GetRoomCountByStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(0, errors.NewErrUnexpected("err"))
 		scheduler := newValidScheduler()
 
@@ -763,7 +1145,7 @@ func TestNewSchedulerInfo(t *testing.T) {
 	t.Run("it returns with error when couldn't get game rooms information in pending state", func(t *testing.T) {
 		ctx := context.Background()
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
-		schedulerManager := NewSchedulerManager(nil, nil, nil, roomStorage)
+		schedulerManager := NewSchedulerManager(nil, nil, nil, roomStorage, nil)
 		roomStorage.EXPECT().GetRoomCountByStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(5, nil)
 		roomStorage.EXPECT().GetRoomCountByStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(0, errors.NewErrUnexpected("err"))
 		scheduler := newValidScheduler()
@@ -777,7 +1159,7 @@ func TestNewSchedulerInfo(t *testing.T) {
 	t.Run("it returns with error when couldn't get game rooms information in occupied state", func(t *testing.T) {
 		ctx := context.Background()
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
-		schedulerManager := NewSchedulerManager(nil, nil, nil, roomStorage)
+		schedulerManager := NewSchedulerManager(nil, nil, nil, roomStorage, nil)
 		roomStorage.EXPECT().GetRoomCountByStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(5, nil)
 		roomStorage.EXPECT().GetRoomCountByStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(5, nil)
 		roomStorage.EXPECT().GetRoomCountByStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(0, errors.NewErrUnexpected("err"))
@@ -792,7 +1174,7 @@ func TestNewSchedulerInfo(t *testing.T) {
 	t.Run("it returns with error when couldn't get game rooms information in terminating state", func(t *testing.T) {
 		ctx := context.Background()
 		roomStorage := mockports.NewMockRoomStorage(mockCtrl)
-		schedulerManager := NewSchedulerManager(nil, nil, nil, roomStorage)
+		schedulerManager := NewSchedulerManager(nil, nil, nil, roomStorage, nil)
 		roomStorage.EXPECT().GetRoomCountByStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(5, nil)
 		roomStorage.EXPECT().GetRoomCountByStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(5, nil)
 		roomStorage.EXPECT().GetRoomCountByStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(5, nil)
@@ -813,7 +1195,7 @@ func TestDeleteScheduler(t *testing.T) {
 		scheduler := newValidScheduler()
 		ctx := context.Background()
 		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, nil, nil, nil)
+		schedulerManager := NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
 		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), schedulerName).Return(scheduler, nil)
 		schedulerStorage.EXPECT().DeleteScheduler(gomock.Any(), ports.TransactionID(""), scheduler).Return(nil)
 
@@ -826,7 +1208,7 @@ func TestDeleteScheduler(t *testing.T) {
 		schedulerName := "scheduler-name"
 		ctx := context.Background()
 		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, nil, nil, nil)
+		schedulerManager := NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
 		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), schedulerName).Return(nil, errors.NewErrNotFound("err"))
 
 		err := schedulerManager.DeleteScheduler(ctx, schedulerName)
@@ -841,7 +1223,7 @@ func TestDeleteScheduler(t *testing.T) {
 		scheduler := newValidScheduler()
 		ctx := context.Background()
 		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
-		schedulerManager := NewSchedulerManager(schedulerStorage, nil, nil, nil)
+		schedulerManager := NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
 		schedulerStorage.EXPECT().GetScheduler(gomock.Any(), schedulerName).Return(scheduler, nil)
 		schedulerStorage.EXPECT().DeleteScheduler(gomock.Any(), ports.TransactionID(""), scheduler).Return(errors.NewErrUnexpected("err"))
 
@@ -1013,11 +1395,11 @@ func TestPatchSchedulerAndSwitchActiveVersionOperation(t *testing.T) {
 			mockCtrl := gomock.NewController(t)
 			mockOperationManager := mock.NewMockOperationManager(mockCtrl)
 			mockSchedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
-			schedulerManager := NewSchedulerManager(mockSchedulerStorage, nil, mockOperationManager, nil)
+			schedulerManager := NewSchedulerManager(mockSchedulerStorage, nil, mockOperationManager, nil, nil)
 
 			mockSchedulerStorage.EXPECT().GetScheduler(gomock.Any(), scheduler.Name).Return(scheduler, testCase.ExpectedMock.GetSchedulerError)
 			mockOperationManager.EXPECT().
-				CreateOperation(gomock.Any(), scheduler.Name, gomock.Any()).Do(func(_ context.Context, _ string, op operations.Definition) {
+				CreateOperationWithIdempotencyKey(gomock.Any(), scheduler.Name, "", gomock.Any()).Do(func(_ context.Context, _, _ string, op operations.Definition) {
 				newSchedulerVersion, ok := op.(*newversion.Definition)
 				assert.True(t, ok)
 				assert.EqualValues(t, testCase.ExpectedMock.ChangedSchedulerFunction(), newSchedulerVersion.NewScheduler)
@@ -1025,7 +1407,7 @@ func TestPatchSchedulerAndSwitchActiveVersionOperation(t *testing.T) {
 				Return(testCase.ExpectedMock.CreateOperationReturn, testCase.ExpectedMock.CreateOperationError).
 				AnyTimes()
 
-			op, err := schedulerManager.PatchSchedulerAndCreateNewSchedulerVersionOperation(ctx, scheduler.Name, testCase.Input.PatchMap)
+			op, err := schedulerManager.PatchSchedulerAndCreateNewSchedulerVersionOperation(ctx, scheduler.Name, testCase.Input.PatchMap, "")
 			if testCase.Output.Err != nil {
 				assert.ErrorContains(t, err, testCase.Output.Err.Error())
 
@@ -1134,3 +1516,181 @@ func newValidSchedulerVersionList() []*entities.SchedulerVersion {
 	}
 	return listSchedulerVersions
 }
+
+func TestPlanSchedulersApply(t *testing.T) {
+	err := validations.RegisterValidations()
+	require.NoError(t, err)
+
+	newNamedScheduler := func(name string) *entities.Scheduler {
+		scheduler := newValidScheduler()
+		scheduler.Name = name
+		scheduler.RollbackVersion = ""
+		return scheduler
+	}
+
+	t.Run("plans the create, update and delete steps", func(t *testing.T) {
+		ctx := context.Background()
+		mockCtrl := gomock.NewController(t)
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		schedulerManager := NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
+
+		unchangedScheduler := newNamedScheduler("unchanged")
+		changedScheduler := newNamedScheduler("changed")
+		changedScheduler.RoomsReplicas = 10
+		newScheduler := newNamedScheduler("new")
+		schedulerStorage.EXPECT().GetSchedulersWithFilter(ctx, &filters.SchedulerFilter{Game: "game"}).Return([]*entities.Scheduler{
+			newNamedScheduler("unchanged"),
+			newNamedScheduler("changed"),
+			newNamedScheduler("removed"),
+		}, nil)
+
+		steps, err := schedulerManager.PlanSchedulersApply(ctx, "game", []*entities.Scheduler{unchangedScheduler, changedScheduler, newScheduler}, true)
+		require.NoError(t, err)
+		require.Len(t, steps, 4)
+
+		require.Equal(t, "changed", steps[0].SchedulerName)
+		require.Equal(t, entities.SchedulerApplyActionUpdate, steps[0].Action)
+		require.Equal(t, []*entities.SchedulerFieldChange{{Path: "RoomsReplicas", OldValue: "2", NewValue: "10"}}, steps[0].Diff.Changes)
+
+		require.Equal(t, "new", steps[1].SchedulerName)
+		require.Equal(t, entities.SchedulerApplyActionCreate, steps[1].Action)
+		require.Equal(t, newScheduler, steps[1].Scheduler)
+
+		require.Equal(t, "removed", steps[2].SchedulerName)
+		require.Equal(t, entities.SchedulerApplyActionDelete, steps[2].Action)
+
+		require.Equal(t, "unchanged", steps[3].SchedulerName)
+		require.Equal(t, entities.SchedulerApplyActionNone, steps[3].Action)
+	})
+
+	t.Run("doesn't delete the schedulers missing from the desired set without prune", func(t *testing.T) {
+		ctx := context.Background()
+		mockCtrl := gomock.NewController(t)
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		schedulerManager := NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
+
+		schedulerStorage.EXPECT().GetSchedulersWithFilter(ctx, &filters.SchedulerFilter{Game: "game"}).Return([]*entities.Scheduler{
+			newNamedScheduler("unchanged"),
+			newNamedScheduler("removed"),
+		}, nil)
+
+		steps, err := schedulerManager.PlanSchedulersApply(ctx, "game", []*entities.Scheduler{newNamedScheduler("unchanged")}, false)
+		require.NoError(t, err)
+		require.Len(t, steps, 1)
+		require.Equal(t, "unchanged", steps[0].SchedulerName)
+		require.Equal(t, entities.SchedulerApplyActionNone, steps[0].Action)
+	})
+
+	t.Run("ignores the fields set by maestro on the stored schedulers", func(t *testing.T) {
+		ctx := context.Background()
+		mockCtrl := gomock.NewController(t)
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		schedulerManager := NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
+
+		storedScheduler := newNamedScheduler("scheduler")
+		storedScheduler.Spec.Version = "v3.1.0"
+		storedScheduler.RollbackVersion = "v3.0.0"
+		storedScheduler.Template = &entities.SchedulerTemplateRef{Name: "template"}
+		storedScheduler.LastDownscaleAt = time.Now()
+		storedScheduler.RolloutHealth = &rollout.Health{Version: "v3.1.0", Rooms: map[string]bool{"room": false}}
+		schedulerStorage.EXPECT().GetSchedulersWithFilter(ctx, &filters.SchedulerFilter{Game: "game"}).Return([]*entities.Scheduler{storedScheduler}, nil)
+
+		steps, err := schedulerManager.PlanSchedulersApply(ctx, "game", []*entities.Scheduler{newNamedScheduler("scheduler")}, true)
+		require.NoError(t, err)
+		require.Len(t, steps, 1)
+		require.Equal(t, entities.SchedulerApplyActionNone, steps[0].Action)
+		require.Nil(t, steps[0].Diff)
+	})
+
+	t.Run("fails when no scheduler is declared", func(t *testing.T) {
+		schedulerManager := NewSchedulerManager(nil, nil, nil, nil, nil)
+
+		_, err := schedulerManager.PlanSchedulersApply(context.Background(), "game", []*entities.Scheduler{}, true)
+		require.ErrorIs(t, err, portsErrors.ErrInvalidArgument)
+	})
+
+	t.Run("fails when a scheduler belongs to another game", func(t *testing.T) {
+		schedulerManager := NewSchedulerManager(nil, nil, nil, nil, nil)
+		scheduler := newNamedScheduler("scheduler")
+		scheduler.Game = "other-game"
+
+		_, err := schedulerManager.PlanSchedulersApply(context.Background(), "game", []*entities.Scheduler{scheduler}, true)
+		require.ErrorIs(t, err, portsErrors.ErrInvalidArgument)
+	})
+
+	t.Run("fails when a scheduler is declared twice", func(t *testing.T) {
+		schedulerManager := NewSchedulerManager(nil, nil, nil, nil, nil)
+
+		_, err := schedulerManager.PlanSchedulersApply(context.Background(), "game", []*entities.Scheduler{newNamedScheduler("scheduler"), newNamedScheduler("scheduler")}, true)
+		require.ErrorIs(t, err, portsErrors.ErrInvalidArgument)
+	})
+
+	t.Run("fails when a scheduler is invalid", func(t *testing.T) {
+		schedulerManager := NewSchedulerManager(nil, nil, nil, nil, nil)
+		scheduler := newNamedScheduler("scheduler")
+		scheduler.MaxSurge = ""
+
+		_, err := schedulerManager.PlanSchedulersApply(context.Background(), "game", []*entities.Scheduler{scheduler}, true)
+		require.ErrorIs(t, err, portsErrors.ErrInvalidArgument)
+	})
+}
+
+func TestApplySchedulers(t *testing.T) {
+	err := validations.RegisterValidations()
+	require.NoError(t, err)
+
+	t.Run("enqueues the operations of each step and reports the failures", func(t *testing.T) {
+		ctx := context.Background()
+		mockCtrl := gomock.NewController(t)
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		schedulerCache := mockports.NewMockSchedulerCache(mockCtrl)
+		operationManager := mock.NewMockOperationManager(mockCtrl)
+		schedulerManager := NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, nil, nil)
+
+		storedScheduler := newValidScheduler()
+		storedScheduler.Name = "changed"
+		changedScheduler := newValidScheduler()
+		changedScheduler.Name = "changed"
+		changedScheduler.RoomsReplicas = 10
+		newScheduler := newValidScheduler()
+		newScheduler.Name = "new"
+		removedScheduler := newValidScheduler()
+		removedScheduler.Name = "removed"
+
+		schedulerStorage.EXPECT().GetSchedulersWithFilter(ctx, &filters.SchedulerFilter{Game: "game"}).Return([]*entities.Scheduler{storedScheduler, removedScheduler}, nil)
+
+		schedulerStorage.EXPECT().GetScheduler(ctx, "changed").Return(storedScheduler, nil)
+		operationManager.EXPECT().CreateOperationWithIdempotencyKey(ctx, "changed", "", gomock.Any()).Return(&operation.Operation{ID: "update-op"}, nil)
+
+		schedulerStorage.EXPECT().CreateScheduler(ctx, newScheduler).Return(portsErrors.NewErrAlreadyExists("error creating scheduler new: name already exists"))
+
+		schedulerCache.EXPECT().GetScheduler(ctx, "removed").Return(removedScheduler, nil)
+		operationManager.EXPECT().CreateOperation(ctx, "removed", &delete.Definition{}).Return(&operation.Operation{ID: "delete-op"}, nil)
+
+		steps, err := schedulerManager.ApplySchedulers(ctx, "game", []*entities.Scheduler{changedScheduler, newScheduler}, true)
+		require.NoError(t, err)
+		require.Len(t, steps, 3)
+
+		require.Equal(t, entities.SchedulerApplyActionUpdate, steps[0].Action)
+		require.Equal(t, "update-op", steps[0].OperationID)
+
+		require.Equal(t, entities.SchedulerApplyActionCreate, steps[1].Action)
+		require.Empty(t, steps[1].OperationID)
+		require.Equal(t, "error creating scheduler new: name already exists", steps[1].Error)
+
+		require.Equal(t, entities.SchedulerApplyActionDelete, steps[2].Action)
+		require.Equal(t, "delete-op", steps[2].OperationID)
+	})
+
+	t.Run("doesn't enqueue anything when the plan fails", func(t *testing.T) {
+		ctx := context.Background()
+		mockCtrl := gomock.NewController(t)
+		schedulerStorage := mockports.NewMockSchedulerStorage(mockCtrl)
+		schedulerManager := NewSchedulerManager(schedulerStorage, nil, nil, nil, nil)
+
+		schedulerStorage.EXPECT().GetSchedulersWithFilter(ctx, gomock.Any()).Return(nil, errors.NewErrUnexpected("some error"))
+
+		_, err := schedulerManager.ApplySchedulers(ctx, "game", []*entities.Scheduler{newValidScheduler()}, true)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,211 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package schedulertemplates
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/topfreegames/maestro/internal/core/entities"
+	"github.com/topfreegames/maestro/internal/core/entities/operation"
+	"github.com/topfreegames/maestro/internal/core/filters"
+	"github.com/topfreegames/maestro/internal/core/logs"
+	"github.com/topfreegames/maestro/internal/core/ports"
+	portsErrors "github.com/topfreegames/maestro/internal/core/ports/errors"
+)
+
+type SchedulerTemplateManager struct {
+	templateStorage  ports.SchedulerTemplateStorage
+	schedulerManager ports.SchedulerManager
+	logger           *zap.Logger
+}
+
+var _ ports.SchedulerTemplateManager = (*SchedulerTemplateManager)(nil)
+
+func NewSchedulerTemplateManager(templateStorage ports.SchedulerTemplateStorage, schedulerManager ports.SchedulerManager) *SchedulerTemplateManager {
+	return &SchedulerTemplateManager{
+		templateStorage:  templateStorage,
+		schedulerManager: schedulerManager,
+		logger:           zap.L().With(zap.String(logs.LogFieldComponent, "service"), zap.String(logs.LogFieldServiceName, "scheduler_template_manager")),
+	}
+}
+
+func (m *SchedulerTemplateManager) GetSchedulerTemplate(ctx context.Context, name string) (*entities.SchedulerTemplate, error) {
+	return m.templateStorage.GetSchedulerTemplate(ctx, name)
+}
+
+func (m *SchedulerTemplateManager) GetAllSchedulerTemplates(ctx context.Context) ([]*entities.SchedulerTemplate, error) {
+	return m.templateStorage.GetAllSchedulerTemplates(ctx)
+}
+
+func (m *SchedulerTemplateManager) CreateSchedulerTemplate(ctx context.Context, template *entities.SchedulerTemplate) (*entities.SchedulerTemplate, error) {
+	if err := template.Validate(); err != nil {
+		return nil, portsErrors.NewErrInvalidArgument("invalid scheduler template: %s", err.Error())
+	}
+
+	err := m.templateStorage.CreateSchedulerTemplate(ctx, template)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.templateStorage.GetSchedulerTemplate(ctx, template.Name)
+}
+
+// UpdateSchedulerTemplate replaces the template configuration. The schedulers
+// derived from it are not changed, use PropagateSchedulerTemplate to apply the
+// changes to them.
+func (m *SchedulerTemplateManager) UpdateSchedulerTemplate(ctx context.Context, template *entities.SchedulerTemplate) (*entities.SchedulerTemplate, error) {
+	if err := template.Validate(); err != nil {
+		return nil, portsErrors.NewErrInvalidArgument("invalid scheduler template: %s", err.Error())
+	}
+
+	err := m.templateStorage.UpdateSchedulerTemplate(ctx, template)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.templateStorage.GetSchedulerTemplate(ctx, template.Name)
+}
+
+// CreateSchedulerFromTemplate derives a new scheduler from the template and
+// creates it like any other scheduler.
+func (m *SchedulerTemplateManager) CreateSchedulerFromTemplate(ctx context.Context, templateName, schedulerName, game string, overrides []*entities.TemplateContainerOverride) (*entities.Scheduler, error) {
+	template, err := m.templateStorage.GetSchedulerTemplate(ctx, templateName)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduler, err := template.NewScheduler(schedulerName, game, overrides)
+	if err != nil {
+		return nil, portsErrors.NewErrInvalidArgument("error deriving scheduler from template: %s", err.Error())
+	}
+
+	return m.schedulerManager.CreateScheduler(ctx, scheduler)
+}
+
+// PatchSchedulerFromTemplate derives the scheduler again from a template and
+// enqueues its new version. When the template name is empty the scheduler's
+// current template is used, and when no overrides are given the current ones
+// are kept.
+func (m *SchedulerTemplateManager) PatchSchedulerFromTemplate(ctx context.Context, schedulerName, templateName string, overrides []*entities.TemplateContainerOverride) (*operation.Operation, error) {
+	currentScheduler, err := m.schedulerManager.GetActiveScheduler(ctx, schedulerName)
+	if err != nil {
+		return nil, err
+	}
+
+	if currentScheduler.Template != nil {
+		if templateName == "" {
+			templateName = currentScheduler.Template.Name
+		}
+		if overrides == nil {
+			overrides = currentScheduler.Template.Containers
+		}
+	}
+
+	if templateName == "" {
+		return nil, portsErrors.NewErrInvalidArgument("scheduler %s is not derived from a template, the template name is required", schedulerName)
+	}
+
+	template, err := m.templateStorage.GetSchedulerTemplate(ctx, templateName)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduler, err := template.NewScheduler(currentScheduler.Name, currentScheduler.Game, overrides)
+	if err != nil {
+		return nil, portsErrors.NewErrInvalidArgument("error deriving scheduler from template: %s", err.Error())
+	}
+
+	scheduler.State = currentScheduler.State
+	return m.schedulerManager.EnqueueNewSchedulerVersionOperation(ctx, scheduler, "")
+}
+
+// GetDerivedSchedulers lists the schedulers derived from the template, telling
+// which ones don't reflect the current template configuration.
+func (m *SchedulerTemplateManager) GetDerivedSchedulers(ctx context.Context, templateName string) ([]*entities.DerivedScheduler, error) {
+	template, err := m.templateStorage.GetSchedulerTemplate(ctx, templateName)
+	if err != nil {
+		return nil, err
+	}
+
+	schedulers, err := m.schedulerManager.GetSchedulersWithFilter(ctx, &filters.SchedulerFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schedulers: %w", err)
+	}
+
+	derivedSchedulers := []*entities.DerivedScheduler{}
+	for _, scheduler := range schedulers {
+		if scheduler.Template == nil || scheduler.Template.Name != templateName {
+			continue
+		}
+
+		expectedScheduler, err := template.NewScheduler(scheduler.Name, scheduler.Game, scheduler.Template.Containers)
+		if err != nil {
+			return nil, portsErrors.NewErrInvalidArgument("error deriving scheduler %s from template: %s", scheduler.Name, err.Error())
+		}
+
+		// the rollback version isn't part of the template, so it is kept out
+		// of the diff.
+		expectedScheduler.RollbackVersion = scheduler.RollbackVersion
+		diff := scheduler.Diff(expectedScheduler)
+		derivedSchedulers = append(derivedSchedulers, &entities.DerivedScheduler{
+			SchedulerName: scheduler.Name,
+			Outdated:      len(diff.Changes) > 0,
+			Diff:          diff,
+		})
+	}
+
+	return derivedSchedulers, nil
+}
+
+// PropagateSchedulerTemplate enqueues a new_scheduler_version operation for
+// every outdated scheduler derived from the template. A scheduler failing
+// doesn't stop the others, its error is reported on its own result.
+func (m *SchedulerTemplateManager) PropagateSchedulerTemplate(ctx context.Context, templateName string) ([]*entities.SchedulerTemplatePropagation, error) {
+	derivedSchedulers, err := m.GetDerivedSchedulers(ctx, templateName)
+	if err != nil {
+		return nil, err
+	}
+
+	propagations := []*entities.SchedulerTemplatePropagation{}
+	for _, derivedScheduler := range derivedSchedulers {
+		if !derivedScheduler.Outdated {
+			continue
+		}
+
+		propagation := &entities.SchedulerTemplatePropagation{SchedulerName: derivedScheduler.SchedulerName}
+		op, err := m.PatchSchedulerFromTemplate(ctx, derivedScheduler.SchedulerName, templateName, nil)
+		if err != nil {
+			m.logger.Error("failed to propagate template to scheduler", zap.String(logs.LogFieldSchedulerName, derivedScheduler.SchedulerName), zap.Error(err))
+			propagation.Error = err.Error()
+		} else {
+			propagation.OperationID = op.ID
+		}
+
+		propagations = append(propagations, propagation)
+	}
+
+	return propagations, nil
+}
@@ -0,0 +1,340 @@
+// MIT License
+//
+// Copyright (c) 2021 TFG Co
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build unit
+// +build unit
+
+package schedulertemplates
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/topfreegames/maestro/internal/core/entities"
+	"github.com/topfreegames/maestro/internal/core/entities/game_room"
+	"github.com/topfreegames/maestro/internal/core/entities/operation"
+	"github.com/topfreegames/maestro/internal/core/entities/port"
+	"github.com/topfreegames/maestro/internal/core/filters"
+	portsErrors "github.com/topfreegames/maestro/internal/core/ports/errors"
+	mockports "github.com/topfreegames/maestro/internal/core/ports/mock"
+	"github.com/topfreegames/maestro/internal/validations"
+)
+
+func TestCreateSchedulerTemplate(t *testing.T) {
+	err := validations.RegisterValidations()
+	require.NoError(t, err)
+
+	t.Run("creates the template when it is valid", func(t *testing.T) {
+		manager, templateStorage, _ := newTestManager(t)
+		template := newValidTemplate()
+
+		templateStorage.EXPECT().CreateSchedulerTemplate(gomock.Any(), template).Return(nil)
+		templateStorage.EXPECT().GetSchedulerTemplate(gomock.Any(), template.Name).Return(template, nil)
+
+		createdTemplate, err := manager.CreateSchedulerTemplate(context.Background(), template)
+		require.NoError(t, err)
+		require.Equal(t, template, createdTemplate)
+	})
+
+	t.Run("fails when the template is invalid", func(t *testing.T) {
+		manager, _, _ := newTestManager(t)
+		template := newValidTemplate()
+		template.MaxSurge = ""
+
+		_, err := manager.CreateSchedulerTemplate(context.Background(), template)
+		require.ErrorIs(t, err, portsErrors.ErrInvalidArgument)
+	})
+
+	t.Run("fails when the storage fails", func(t *testing.T) {
+		manager, templateStorage, _ := newTestManager(t)
+		template := newValidTemplate()
+
+		templateStorage.EXPECT().CreateSchedulerTemplate(gomock.Any(), template).Return(portsErrors.NewErrAlreadyExists("already exists"))
+
+		_, err := manager.CreateSchedulerTemplate(context.Background(), template)
+		require.ErrorIs(t, err, portsErrors.ErrAlreadyExists)
+	})
+}
+
+func TestUpdateSchedulerTemplate(t *testing.T) {
+	err := validations.RegisterValidations()
+	require.NoError(t, err)
+
+	t.Run("updates the template when it is valid", func(t *testing.T) {
+		manager, templateStorage, _ := newTestManager(t)
+		template := newValidTemplate()
+
+		templateStorage.EXPECT().UpdateSchedulerTemplate(gomock.Any(), template).Return(nil)
+		templateStorage.EXPECT().GetSchedulerTemplate(gomock.Any(), template.Name).Return(template, nil)
+
+		updatedTemplate, err := manager.UpdateSchedulerTemplate(context.Background(), template)
+		require.NoError(t, err)
+		require.Equal(t, template, updatedTemplate)
+	})
+
+	t.Run("fails when the template doesn't exist", func(t *testing.T) {
+		manager, templateStorage, _ := newTestManager(t)
+		template := newValidTemplate()
+
+		templateStorage.EXPECT().UpdateSchedulerTemplate(gomock.Any(), template).Return(portsErrors.NewErrNotFound("not found"))
+
+		_, err := manager.UpdateSchedulerTemplate(context.Background(), template)
+		require.ErrorIs(t, err, portsErrors.ErrNotFound)
+	})
+}
+
+func TestCreateSchedulerFromTemplate(t *testing.T) {
+	err := validations.RegisterValidations()
+	require.NoError(t, err)
+
+	t.Run("creates the scheduler derived from the template", func(t *testing.T) {
+		manager, templateStorage, schedulerManager := newTestManager(t)
+		overrides := []*entities.TemplateContainerOverride{{Name: "default", Image: "image:v2"}}
+
+		templateStorage.EXPECT().GetSchedulerTemplate(gomock.Any(), "template").Return(newValidTemplate(), nil)
+		schedulerManager.EXPECT().CreateScheduler(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, scheduler *entities.Scheduler) (*entities.Scheduler, error) {
+				require.Equal(t, "scheduler", scheduler.Name)
+				require.Equal(t, "game", scheduler.Game)
+				require.Equal(t, "image:v2", scheduler.Spec.Containers[0].Image)
+				require.Equal(t, &entities.SchedulerTemplateRef{Name: "template", Containers: overrides}, scheduler.Template)
+				return scheduler, nil
+			},
+		)
+
+		scheduler, err := manager.CreateSchedulerFromTemplate(context.Background(), "template", "scheduler", "game", overrides)
+		require.NoError(t, err)
+		require.Equal(t, "scheduler", scheduler.Name)
+	})
+
+	t.Run("fails when the template doesn't exist", func(t *testing.T) {
+		manager, templateStorage, _ := newTestManager(t)
+
+		templateStorage.EXPECT().GetSchedulerTemplate(gomock.Any(), "template").Return(nil, portsErrors.NewErrNotFound("not found"))
+
+		_, err := manager.CreateSchedulerFromTemplate(context.Background(), "template", "scheduler", "game", nil)
+		require.ErrorIs(t, err, portsErrors.ErrNotFound)
+	})
+
+	t.Run("fails when the overrides don't match the template", func(t *testing.T) {
+		manager, templateStorage, _ := newTestManager(t)
+		overrides := []*entities.TemplateContainerOverride{{Name: "other", Image: "image:v2"}}
+
+		templateStorage.EXPECT().GetSchedulerTemplate(gomock.Any(), "template").Return(newValidTemplate(), nil)
+
+		_, err := manager.CreateSchedulerFromTemplate(context.Background(), "template", "scheduler", "game", overrides)
+		require.ErrorIs(t, err, portsErrors.ErrInvalidArgument)
+	})
+}
+
+func TestPatchSchedulerFromTemplate(t *testing.T) {
+	err := validations.RegisterValidations()
+	require.NoError(t, err)
+
+	t.Run("keeps the current template and overrides when none are given", func(t *testing.T) {
+		manager, templateStorage, schedulerManager := newTestManager(t)
+		overrides := []*entities.TemplateContainerOverride{{Name: "default", Image: "image:v2"}}
+		currentScheduler := newDerivedScheduler(t, "scheduler", overrides)
+		currentScheduler.State = entities.StateInSync
+		template := newValidTemplate()
+		template.RoomsReplicas = 10
+		op := &operation.Operation{ID: "op"}
+
+		schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), "scheduler").Return(currentScheduler, nil)
+		templateStorage.EXPECT().GetSchedulerTemplate(gomock.Any(), "template").Return(template, nil)
+		schedulerManager.EXPECT().EnqueueNewSchedulerVersionOperation(gomock.Any(), gomock.Any(), "").DoAndReturn(
+			func(_ context.Context, scheduler *entities.Scheduler, _ string) (*operation.Operation, error) {
+				require.Equal(t, 10, scheduler.RoomsReplicas)
+				require.Equal(t, "image:v2", scheduler.Spec.Containers[0].Image)
+				require.Equal(t, entities.StateInSync, scheduler.State)
+				return op, nil
+			},
+		)
+
+		createdOp, err := manager.PatchSchedulerFromTemplate(context.Background(), "scheduler", "", nil)
+		require.NoError(t, err)
+		require.Equal(t, op, createdOp)
+	})
+
+	t.Run("derives a scheduler that has no template when the template name is given", func(t *testing.T) {
+		manager, templateStorage, schedulerManager := newTestManager(t)
+		currentScheduler := newDerivedScheduler(t, "scheduler", nil)
+		currentScheduler.Template = nil
+
+		schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), "scheduler").Return(currentScheduler, nil)
+		templateStorage.EXPECT().GetSchedulerTemplate(gomock.Any(), "template").Return(newValidTemplate(), nil)
+		schedulerManager.EXPECT().EnqueueNewSchedulerVersionOperation(gomock.Any(), gomock.Any(), "").DoAndReturn(
+			func(_ context.Context, scheduler *entities.Scheduler, _ string) (*operation.Operation, error) {
+				require.Equal(t, "template", scheduler.Template.Name)
+				return &operation.Operation{ID: "op"}, nil
+			},
+		)
+
+		_, err := manager.PatchSchedulerFromTemplate(context.Background(), "scheduler", "template", nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("fails when the scheduler has no template and none is given", func(t *testing.T) {
+		manager, _, schedulerManager := newTestManager(t)
+		currentScheduler := newDerivedScheduler(t, "scheduler", nil)
+		currentScheduler.Template = nil
+
+		schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), "scheduler").Return(currentScheduler, nil)
+
+		_, err := manager.PatchSchedulerFromTemplate(context.Background(), "scheduler", "", nil)
+		require.ErrorIs(t, err, portsErrors.ErrInvalidArgument)
+	})
+}
+
+func TestGetDerivedSchedulers(t *testing.T) {
+	err := validations.RegisterValidations()
+	require.NoError(t, err)
+
+	t.Run("reports only the derived schedulers and which ones are outdated", func(t *testing.T) {
+		manager, templateStorage, schedulerManager := newTestManager(t)
+		upToDateScheduler := newDerivedScheduler(t, "up-to-date", nil)
+		outdatedScheduler := newDerivedScheduler(t, "outdated", nil)
+		outdatedScheduler.RoomsReplicas = 5
+		otherScheduler := newDerivedScheduler(t, "other", nil)
+		otherScheduler.Template = nil
+
+		templateStorage.EXPECT().GetSchedulerTemplate(gomock.Any(), "template").Return(newValidTemplate(), nil)
+		schedulerManager.EXPECT().GetSchedulersWithFilter(gomock.Any(), &filters.SchedulerFilter{}).Return([]*entities.Scheduler{upToDateScheduler, outdatedScheduler, otherScheduler}, nil)
+
+		derivedSchedulers, err := manager.GetDerivedSchedulers(context.Background(), "template")
+		require.NoError(t, err)
+		require.Len(t, derivedSchedulers, 2)
+
+		require.Equal(t, "up-to-date", derivedSchedulers[0].SchedulerName)
+		require.False(t, derivedSchedulers[0].Outdated)
+		require.Empty(t, derivedSchedulers[0].Diff.Changes)
+
+		require.Equal(t, "outdated", derivedSchedulers[1].SchedulerName)
+		require.True(t, derivedSchedulers[1].Outdated)
+		require.Equal(t, []*entities.SchedulerFieldChange{{Path: "RoomsReplicas", OldValue: "5", NewValue: "0"}}, derivedSchedulers[1].Diff.Changes)
+	})
+
+	t.Run("fails when listing the schedulers fails", func(t *testing.T) {
+		manager, templateStorage, schedulerManager := newTestManager(t)
+
+		templateStorage.EXPECT().GetSchedulerTemplate(gomock.Any(), "template").Return(newValidTemplate(), nil)
+		schedulerManager.EXPECT().GetSchedulersWithFilter(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+
+		_, err := manager.GetDerivedSchedulers(context.Background(), "template")
+		require.Error(t, err)
+	})
+}
+
+func TestPropagateSchedulerTemplate(t *testing.T) {
+	err := validations.RegisterValidations()
+	require.NoError(t, err)
+
+	t.Run("enqueues new versions only for the outdated schedulers", func(t *testing.T) {
+		manager, templateStorage, schedulerManager := newTestManager(t)
+		upToDateScheduler := newDerivedScheduler(t, "up-to-date", nil)
+		outdatedScheduler := newDerivedScheduler(t, "outdated", nil)
+		outdatedScheduler.RoomsReplicas = 5
+		failingScheduler := newDerivedScheduler(t, "failing", nil)
+		failingScheduler.RoomsReplicas = 5
+
+		templateStorage.EXPECT().GetSchedulerTemplate(gomock.Any(), "template").Return(newValidTemplate(), nil).Times(3)
+		schedulerManager.EXPECT().GetSchedulersWithFilter(gomock.Any(), gomock.Any()).Return([]*entities.Scheduler{upToDateScheduler, outdatedScheduler, failingScheduler}, nil)
+		schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), "outdated").Return(outdatedScheduler, nil)
+		schedulerManager.EXPECT().GetActiveScheduler(gomock.Any(), "failing").Return(failingScheduler, nil)
+		schedulerManager.EXPECT().EnqueueNewSchedulerVersionOperation(gomock.Any(), gomock.Any(), "").DoAndReturn(
+			func(_ context.Context, scheduler *entities.Scheduler, _ string) (*operation.Operation, error) {
+				if scheduler.Name == "failing" {
+					return nil, errors.New("some error")
+				}
+				return &operation.Operation{ID: "op", SchedulerName: scheduler.Name}, nil
+			},
+		).Times(2)
+
+		propagations, err := manager.PropagateSchedulerTemplate(context.Background(), "template")
+		require.NoError(t, err)
+		require.Equal(t, []*entities.SchedulerTemplatePropagation{
+			{SchedulerName: "outdated", OperationID: "op"},
+			{SchedulerName: "failing", Error: "some error"},
+		}, propagations)
+	})
+
+	t.Run("fails when the template doesn't exist", func(t *testing.T) {
+		manager, templateStorage, _ := newTestManager(t)
+
+		templateStorage.EXPECT().GetSchedulerTemplate(gomock.Any(), "template").Return(nil, portsErrors.NewErrNotFound("not found"))
+
+		_, err := manager.PropagateSchedulerTemplate(context.Background(), "template")
+		require.ErrorIs(t, err, portsErrors.ErrNotFound)
+	})
+}
+
+func newTestManager(t *testing.T) (*SchedulerTemplateManager, *mockports.MockSchedulerTemplateStorage, *mockports.MockSchedulerManager) {
+	mockCtrl := gomock.NewController(t)
+	templateStorage := mockports.NewMockSchedulerTemplateStorage(mockCtrl)
+	schedulerManager := mockports.NewMockSchedulerManager(mockCtrl)
+
+	return NewSchedulerTemplateManager(templateStorage, schedulerManager), templateStorage, schedulerManager
+}
+
+func newDerivedScheduler(t *testing.T, name string, overrides []*entities.TemplateContainerOverride) *entities.Scheduler {
+	scheduler, err := newValidTemplate().NewScheduler(name, "game", overrides)
+	require.NoError(t, err)
+
+	return scheduler
+}
+
+func newValidTemplate() *entities.SchedulerTemplate {
+	return &entities.SchedulerTemplate{
+		Name:     "template",
+		MaxSurge: "10%",
+		Spec: game_room.Spec{
+			Version:                "v1.0.0",
+			TerminationGracePeriod: 60,
+			Containers: []game_room.Container{
+				{
+					Name:            "default",
+					Image:           "image:v1",
+					ImagePullPolicy: "IfNotPresent",
+					Ports: []game_room.ContainerPort{
+						{Name: "tcp", Protocol: "tcp", Port: 80},
+					},
+					Requests: game_room.ContainerResources{
+						CPU:    "10m",
+						Memory: "100Mi",
+					},
+					Limits: game_room.ContainerResources{
+						CPU:    "10m",
+						Memory: "100Mi",
+					},
+				},
+			},
+		},
+		PortRange: &port.PortRange{
+			Start: 40000,
+			End:   60000,
+		},
+	}
+}
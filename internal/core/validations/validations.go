@@ -32,6 +32,8 @@ import (
 	"github.com/Masterminds/semver"
 )
 
+var brokerTopicRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]{0,248}$`)
+
 func RequiredIfTypeRoomOccupancy(isParameterNil bool, policyType string) bool {
 	if policyType == "roomOccupancy" {
 		return !isParameterNil
@@ -39,6 +41,13 @@ func RequiredIfTypeRoomOccupancy(isParameterNil bool, policyType string) bool {
 	return true
 }
 
+func RequiredIfTypeCanary(isParameterNil bool, strategyType string) bool {
+	if strategyType == "canary" {
+		return !isParameterNil
+	}
+	return true
+}
+
 func IsAutoscalingMinMaxValid(min int, max int) bool {
 	if max >= 0 && min > max {
 		return false
@@ -74,6 +83,23 @@ func IsMaxSurgeValid(maxSurge string) bool {
 	return true
 }
 
+// IsMaxUnavailableValid check if MaxUnavailable is valid. A MaxUnavailable valid is a number greater than or equal to zero
+// or a number between zero and 100 with suffix '%'
+func IsMaxUnavailableValid(maxUnavailable string) bool {
+	if maxUnavailable == "" {
+		return false
+	}
+	relativeSymbol := "%"
+
+	isRelative := strings.HasSuffix(maxUnavailable, relativeSymbol)
+	maxUnavailableInt, err := strconv.Atoi(strings.TrimSuffix(maxUnavailable, relativeSymbol))
+	if err != nil || maxUnavailableInt < 0 {
+		return false
+	}
+
+	return !isRelative || maxUnavailableInt <= 100
+}
+
 // IsImagePullPolicySupported check if received policy is supported by maestro
 func IsImagePullPolicySupported(policy string) bool {
 	policies := []string{"Always", "Never", "IfNotPresent"}
@@ -130,9 +156,31 @@ func IsKubeResourceNameValid(name string) bool {
 	return true
 }
 
+// IsForwarderAddressValid check if the address is valid for the forwarder type.
+// Broker forwarders have a topic name as address, with letters, numbers, '.',
+// '_' and '-' only.
+func IsForwarderAddressValid(forwarderType, address string) bool {
+	if forwarderType != string(forwarder.TypeBroker) {
+		return true
+	}
+
+	return brokerTopicRegex.MatchString(address)
+}
+
+// IsForwarderAttributeValid checks that a forwarder static attribute doesn't
+// replace a key used to build the events.
+func IsForwarderAttributeValid(key string) bool {
+	for _, reserved := range forwarder.ReservedAttributes {
+		if reserved == key {
+			return false
+		}
+	}
+	return true
+}
+
 // IsForwarderTypeSupported check if received forwarder type is supported by Maestro
 func IsForwarderTypeSupported(forwarderType string) bool {
-	types := []string{string(forwarder.TypeGrpc)}
+	types := []string{string(forwarder.TypeGrpc), string(forwarder.TypeHTTP), string(forwarder.TypeBroker)}
 	for _, item := range types {
 		if item == forwarderType {
 			return true
@@ -54,6 +54,28 @@ func TestIsMaxSurgeValid(t *testing.T) {
 	})
 }
 
+func TestIsMaxUnavailableValid(t *testing.T) {
+	t.Run("with success when max unavailable is a number greater than or equal to zero", func(t *testing.T) {
+		assert.True(t, IsMaxUnavailableValid("0"))
+		assert.True(t, IsMaxUnavailableValid("5"))
+	})
+
+	t.Run("with success when max unavailable is a percentage up to 100", func(t *testing.T) {
+		assert.True(t, IsMaxUnavailableValid("25%"))
+		assert.True(t, IsMaxUnavailableValid("100%"))
+	})
+
+	t.Run("fails when max unavailable is empty", func(t *testing.T) {
+		assert.False(t, IsMaxUnavailableValid(""))
+	})
+
+	t.Run("fails when max unavailable is negative, above 100% or not a number", func(t *testing.T) {
+		assert.False(t, IsMaxUnavailableValid("-1"))
+		assert.False(t, IsMaxUnavailableValid("101%"))
+		assert.False(t, IsMaxUnavailableValid("a"))
+	})
+}
+
 func TestIsImagePullPolicySupported(t *testing.T) {
 	t.Run("with success when policy is supported by maestro", func(t *testing.T) {
 		supported := IsImagePullPolicySupported("Always")
@@ -138,6 +160,16 @@ func TestIsForwarderTypeSupported(t *testing.T) {
 		assert.True(t, supported)
 	})
 
+	t.Run("with success when type is http", func(t *testing.T) {
+		supported := IsForwarderTypeSupported("http")
+		assert.True(t, supported)
+	})
+
+	t.Run("with success when type is broker", func(t *testing.T) {
+		supported := IsForwarderTypeSupported("broker")
+		assert.True(t, supported)
+	})
+
 	t.Run("fails when type is not supported by maestro", func(t *testing.T) {
 		wrongType := "unsupported"
 		supported := IsForwarderTypeSupported(wrongType)
@@ -145,6 +177,37 @@ func TestIsForwarderTypeSupported(t *testing.T) {
 	})
 }
 
+func TestIsForwarderAddressValid(t *testing.T) {
+	t.Run("with success when broker topic has only allowed characters", func(t *testing.T) {
+		assert.True(t, IsForwarderAddressValid("broker", "maestro.room-events_v1"))
+	})
+
+	t.Run("fails when broker topic has other characters", func(t *testing.T) {
+		assert.False(t, IsForwarderAddressValid("broker", "operations:scheduler"))
+		assert.False(t, IsForwarderAddressValid("broker", "room events"))
+		assert.False(t, IsForwarderAddressValid("broker", "-room-events"))
+		assert.False(t, IsForwarderAddressValid("broker", ""))
+	})
+
+	t.Run("with success for addresses of other types", func(t *testing.T) {
+		assert.True(t, IsForwarderAddressValid("http", "https://webhook.example.com/events"))
+		assert.True(t, IsForwarderAddressValid("gRPC", "matchmaker:8080"))
+	})
+}
+
+func TestIsForwarderAttributeValid(t *testing.T) {
+	t.Run("with success for keys not used to build the events", func(t *testing.T) {
+		assert.True(t, IsForwarderAttributeValid("team"))
+	})
+
+	t.Run("fails for keys used to build the events", func(t *testing.T) {
+		assert.False(t, IsForwarderAttributeValid("eventType"))
+		assert.False(t, IsForwarderAttributeValid("pingType"))
+		assert.False(t, IsForwarderAttributeValid("roomEvent"))
+		assert.False(t, IsForwarderAttributeValid("playerId"))
+	})
+}
+
 func TestIsAutoscalingMinMaxValid(t *testing.T) {
 
 	t.Run("return false when min is bigger than max and max is not -1", func(t *testing.T) {
@@ -192,3 +255,19 @@ func TestRequiredIfTypeRoomOccupancy(t *testing.T) {
 		assert.False(t, valid)
 	})
 }
+
+func TestRequiredIfTypeCanary(t *testing.T) {
+
+	t.Run("return true when strategy type is canary and the parameter is not nil", func(t *testing.T) {
+		valid := RequiredIfTypeCanary(false, "canary")
+		assert.True(t, valid)
+	})
+	t.Run("return true when strategy type is not canary and parameter is nil", func(t *testing.T) {
+		valid := RequiredIfTypeCanary(true, "rollingUpdate")
+		assert.True(t, valid)
+	})
+	t.Run("return false when strategy type is canary and the parameter is nil", func(t *testing.T) {
+		valid := RequiredIfTypeCanary(true, "canary")
+		assert.False(t, valid)
+	})
+}
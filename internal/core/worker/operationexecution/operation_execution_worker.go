@@ -32,6 +32,7 @@ import (
 	"github.com/m-lab/go/memoryless"
 	"github.com/topfreegames/maestro/internal/core/logs"
 	"github.com/topfreegames/maestro/internal/core/operations/healthcontroller"
+	deletescheduler "github.com/topfreegames/maestro/internal/core/operations/schedulers/delete"
 	"github.com/topfreegames/maestro/internal/core/operations/storagecleanup"
 	workererrors "github.com/topfreegames/maestro/internal/core/worker/errors"
 
@@ -46,6 +47,14 @@ import (
 
 var _ worker.Worker = (*OperationExecutionWorker)(nil)
 
+// operationsAllowedWhilePaused are the operations executed even when the
+// scheduler operations processing is paused, the others are held until it is
+// resumed.
+var operationsAllowedWhilePaused = map[string]bool{
+	deletescheduler.OperationName: true,
+	storagecleanup.OperationName:  true,
+}
+
 const (
 	WorkerName = "operation_execution"
 	// Size of channel storing operations to be aborted
@@ -138,6 +147,11 @@ func (w *OperationExecutionWorker) Start(ctx context.Context) error {
 				w.logger.Error("Error executing operation", zap.Error(err))
 			}
 		case <-healthControllerTicker.C:
+			if w.isPaused(w.workerContext) {
+				w.logger.Debug("scheduler operations are paused, skipping health_controller operation")
+				continue
+			}
+
 			err := w.createOperation(w.workerContext, &healthcontroller.Definition{})
 			if err != nil {
 				w.logger.Error("Error enqueueing new health_controller operation", zap.Error(err))
@@ -173,6 +187,10 @@ func (w *OperationExecutionWorker) executeOperationFlow(operationID string) erro
 		return nil
 	}
 
+	if w.shouldHoldOperation(op, def, loopLogger) {
+		return nil
+	}
+
 	operationContext, operationCancellationFunction, err := w.prepareExecutionAndLease(op, def, loopLogger)
 	defer operationCancellationFunction()
 
@@ -305,6 +323,48 @@ func (w *OperationExecutionWorker) shouldEvictOperation(op *operation.Operation,
 	return false
 }
 
+func (w *OperationExecutionWorker) shouldHoldOperation(op *operation.Operation, def operations.Definition, loopLogger *zap.Logger) bool {
+	if operationsAllowedWhilePaused[def.Name()] {
+		return false
+	}
+
+	pause, err := w.operationManager.GetSchedulerOperationsPause(w.workerContext, w.scheduler.Name)
+	if err != nil {
+		loopLogger.Error("failed to check if scheduler operations are paused, executing operation", zap.Error(err))
+		return false
+	}
+
+	if pause == nil {
+		return false
+	}
+
+	held, err := w.operationManager.HoldOperation(w.workerContext, op)
+	if err != nil {
+		loopLogger.Error("failed to hold operation, executing it", zap.Error(err))
+		return false
+	}
+
+	if !held {
+		loopLogger.Info("scheduler operations resumed while holding operation, executing it")
+		return false
+	}
+
+	loopLogger.Info("operation held, scheduler operations are paused")
+	w.operationManager.AppendOperationEventToExecutionHistory(w.workerContext, op, fmt.Sprintf("Operation held until scheduler operations are resumed, paused reason: %s", pause.Reason))
+
+	return true
+}
+
+func (w *OperationExecutionWorker) isPaused(ctx context.Context) bool {
+	pause, err := w.operationManager.GetSchedulerOperationsPause(ctx, w.scheduler.Name)
+	if err != nil {
+		w.logger.Error("failed to check if scheduler operations are paused", zap.Error(err))
+		return false
+	}
+
+	return pause != nil
+}
+
 func (w *OperationExecutionWorker) createOperation(ctx context.Context, operationDefinition operations.Definition) error {
 	_, err := w.operationManager.CreateOperation(ctx, w.scheduler.Name, operationDefinition)
 	if err != nil {
@@ -88,6 +88,7 @@ func TestSchedulerOperationsExecutionLoop(t *testing.T) {
 		operationManager.EXPECT().GetOperation(gomock.Any(), scheduler.Name, expectedOperation.ID).Return(expectedOperation, operationDefinition, nil)
 		operationManager.EXPECT().PendingOperationsChan(gomock.Any(), expectedOperation.SchedulerName).Return(pendingOpsChan)
 		operationDefinition.EXPECT().ShouldExecute(gomock.Any(), []*operation.Operation{}).Return(true)
+		operationManager.EXPECT().GetSchedulerOperationsPause(gomock.Any(), scheduler.Name).Return(nil, nil)
 		operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), expectedOperation, "Starting operation")
 		operationManager.EXPECT().GrantLease(gomock.AssignableToTypeOf(workerContext), expectedOperation)
 		operationManager.EXPECT().StartOperation(gomock.AssignableToTypeOf(operationContext), expectedOperation, gomock.Any())
@@ -153,6 +154,7 @@ func TestSchedulerOperationsExecutionLoop(t *testing.T) {
 		operationManager.EXPECT().GetOperation(gomock.Any(), scheduler.Name, expectedOperation.ID).Return(expectedOperation, operationDefinition, nil)
 		operationManager.EXPECT().PendingOperationsChan(gomock.Any(), expectedOperation.SchedulerName).Return(pendingOpsChan)
 		operationDefinition.EXPECT().ShouldExecute(gomock.Any(), []*operation.Operation{}).Return(true)
+		operationManager.EXPECT().GetSchedulerOperationsPause(gomock.Any(), scheduler.Name).Return(nil, nil)
 		operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), expectedOperation, "Starting operation")
 		operationManager.EXPECT().GrantLease(gomock.AssignableToTypeOf(workerContext), expectedOperation)
 		operationManager.EXPECT().StartOperation(gomock.AssignableToTypeOf(operationContext), expectedOperation, gomock.Any())
@@ -224,6 +226,7 @@ func TestSchedulerOperationsExecutionLoop(t *testing.T) {
 		operationManager.EXPECT().GetOperation(gomock.Any(), scheduler.Name, expectedOperation.ID).Return(expectedOperation, operationDefinition, nil)
 		operationManager.EXPECT().PendingOperationsChan(gomock.Any(), expectedOperation.SchedulerName).Return(pendingOpsChan)
 		operationDefinition.EXPECT().ShouldExecute(gomock.Any(), []*operation.Operation{}).Return(true)
+		operationManager.EXPECT().GetSchedulerOperationsPause(gomock.Any(), scheduler.Name).Return(nil, nil)
 		operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), expectedOperation, "Starting operation")
 		operationManager.EXPECT().GrantLease(gomock.AssignableToTypeOf(workerContext), expectedOperation)
 		operationManager.EXPECT().StartOperation(gomock.AssignableToTypeOf(operationContext), expectedOperation, gomock.Any())
@@ -395,6 +398,7 @@ func TestSchedulerOperationsExecutionLoop(t *testing.T) {
 		workerService := NewOperationExecutionWorker(scheduler, worker.ProvideWorkerOptions(operationManager, executors, nil, nil, config))
 
 		operationDefinition.EXPECT().ShouldExecute(gomock.Any(), []*operation.Operation{}).Return(true)
+		operationManager.EXPECT().GetSchedulerOperationsPause(gomock.Any(), scheduler.Name).Return(nil, nil)
 		pendingOpsChan := make(chan string)
 
 		operationManager.EXPECT().GetOperation(gomock.Any(), scheduler.Name, expectedOperation.ID).Return(expectedOperation, operationDefinition, nil)
@@ -459,6 +463,7 @@ func TestSchedulerOperationsExecutionLoop(t *testing.T) {
 		operationManager.EXPECT().GetOperation(gomock.Any(), scheduler.Name, expectedOperation.ID).Return(expectedOperation, operationDefinition, nil)
 		operationManager.EXPECT().PendingOperationsChan(gomock.Any(), expectedOperation.SchedulerName).Return(pendingOpsChan)
 		operationDefinition.EXPECT().ShouldExecute(gomock.Any(), []*operation.Operation{}).Return(true)
+		operationManager.EXPECT().GetSchedulerOperationsPause(gomock.Any(), scheduler.Name).Return(nil, nil)
 		operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), expectedOperation, "Starting operation")
 		operationManager.EXPECT().GrantLease(gomock.AssignableToTypeOf(workerContext), expectedOperation).Return(errors.New("some error granting lease"))
 		operationManager.EXPECT().FinishOperation(gomock.Any(), expectedOperation, operationDefinition)
@@ -603,6 +608,7 @@ func TestSchedulerOperationsExecutionLoop(t *testing.T) {
 		pendingOpsChan := make(chan string)
 
 		operationManager.EXPECT().PendingOperationsChan(gomock.Any(), gomock.Any()).Return(pendingOpsChan)
+		operationManager.EXPECT().GetSchedulerOperationsPause(gomock.Any(), scheduler.Name).Return(nil, nil).MaxTimes(5)
 		operationManager.EXPECT().CreateOperation(gomock.Any(), scheduler.Name, &healthcontroller.Definition{}).Return(&operation.Operation{}, nil).MaxTimes(5)
 
 		ctx, cancel := context.WithCancel(context.Background())
@@ -646,6 +652,7 @@ func TestSchedulerOperationsExecutionLoop(t *testing.T) {
 		pendingOpsChan := make(chan string)
 
 		operationManager.EXPECT().PendingOperationsChan(gomock.Any(), gomock.Any()).Return(pendingOpsChan)
+		operationManager.EXPECT().GetSchedulerOperationsPause(gomock.Any(), scheduler.Name).Return(nil, nil).MaxTimes(5)
 		operationManager.EXPECT().CreateOperation(gomock.Any(), scheduler.Name, &healthcontroller.Definition{}).Return(nil, fmt.Errorf("Error on creating operation")).MaxTimes(5)
 
 		ctx, cancel := context.WithCancel(context.Background())
@@ -744,4 +751,198 @@ func TestSchedulerOperationsExecutionLoop(t *testing.T) {
 		err = workerService.Start(ctx)
 		require.NoError(t, err)
 	})
+
+	t.Run("hold operation when scheduler operations are paused", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+
+		operationManager := mock.NewMockOperationManager(mockCtrl)
+		ctx := context.Background()
+		operationName := "test_operation"
+		operationDefinition := mockoperation.NewMockDefinition(mockCtrl)
+		operationExecutor := mockoperation.NewMockExecutor(mockCtrl)
+		operationDefinition.EXPECT().Name().Return(operationName).AnyTimes()
+
+		scheduler := &entities.Scheduler{Name: "random-scheduler"}
+		expectedOperation := &operation.Operation{
+			ID:             "random-operation-id",
+			SchedulerName:  scheduler.Name,
+			Status:         operation.StatusPending,
+			DefinitionName: operationName,
+		}
+
+		executors := map[string]operations.Executor{}
+		executors[operationName] = operationExecutor
+		config := worker.Configuration{
+			HealthControllerExecutionInterval: duration,
+			StorageCleanupExecutionInterval:   duration,
+		}
+
+		workerService := NewOperationExecutionWorker(scheduler, worker.ProvideWorkerOptions(operationManager, executors, nil, nil, config))
+		pendingOpsChan := make(chan string)
+
+		operationManager.EXPECT().GetOperation(gomock.Any(), scheduler.Name, expectedOperation.ID).Return(expectedOperation, operationDefinition, nil)
+		operationManager.EXPECT().PendingOperationsChan(gomock.Any(), expectedOperation.SchedulerName).Return(pendingOpsChan)
+		operationDefinition.EXPECT().ShouldExecute(gomock.Any(), []*operation.Operation{}).Return(true)
+		operationManager.EXPECT().GetSchedulerOperationsPause(gomock.Any(), scheduler.Name).Return(&operation.OperationsPause{Reason: "incident", PausedAt: time.Now()}, nil)
+		operationManager.EXPECT().HoldOperation(gomock.Any(), expectedOperation).Return(true, nil)
+		operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), expectedOperation, "Operation held until scheduler operations are resumed, paused reason: incident")
+
+		go func() {
+			pendingOpsChan <- expectedOperation.ID
+
+			// We need to wait for the goroutine to pick up the operation from the channel
+			// hence this sleep to guarantee it will read from it and process
+			time.Sleep(10 * time.Millisecond)
+			workerService.Stop(context.Background())
+			require.False(t, workerService.IsRunning())
+		}()
+
+		err := workerService.Start(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("execute allowed operation when scheduler operations are paused", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+
+		operationManager := mock.NewMockOperationManager(mockCtrl)
+		ctx := context.Background()
+		operationName := storagecleanup.OperationName
+		operationDefinition := mockoperation.NewMockDefinition(mockCtrl)
+		operationExecutor := mockoperation.NewMockExecutor(mockCtrl)
+		operationDefinition.EXPECT().Name().Return(operationName).AnyTimes()
+
+		scheduler := &entities.Scheduler{Name: "random-scheduler"}
+		expectedOperation := &operation.Operation{
+			ID:             "random-operation-id",
+			SchedulerName:  scheduler.Name,
+			Status:         operation.StatusPending,
+			DefinitionName: operationName,
+		}
+
+		executors := map[string]operations.Executor{}
+		executors[operationName] = operationExecutor
+		config := worker.Configuration{
+			HealthControllerExecutionInterval: duration,
+			StorageCleanupExecutionInterval:   duration,
+		}
+
+		workerService := NewOperationExecutionWorker(scheduler, worker.ProvideWorkerOptions(operationManager, executors, nil, nil, config))
+		pendingOpsChan := make(chan string)
+
+		operationManager.EXPECT().GetOperation(gomock.Any(), scheduler.Name, expectedOperation.ID).Return(expectedOperation, operationDefinition, nil)
+		operationManager.EXPECT().PendingOperationsChan(gomock.Any(), expectedOperation.SchedulerName).Return(pendingOpsChan)
+		operationDefinition.EXPECT().ShouldExecute(gomock.Any(), []*operation.Operation{}).Return(true)
+		operationManager.EXPECT().GetSchedulerOperationsPause(gomock.Any(), gomock.Any()).Times(0)
+		operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), expectedOperation, "Starting operation")
+		operationManager.EXPECT().GrantLease(gomock.Any(), expectedOperation)
+		operationManager.EXPECT().StartOperation(gomock.Any(), expectedOperation, gomock.Any())
+		operationManager.EXPECT().StartLeaseRenewGoRoutine(gomock.Any(), expectedOperation)
+		operationManager.EXPECT().FinishOperation(gomock.Any(), expectedOperation, operationDefinition)
+		operationManager.EXPECT().RevokeLease(gomock.Any(), expectedOperation)
+		operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), expectedOperation, "Operation finished")
+		operationExecutor.EXPECT().Execute(gomock.Any(), expectedOperation, operationDefinition).Return(nil)
+
+		go func() {
+			pendingOpsChan <- expectedOperation.ID
+
+			// We need to wait for the goroutine to pick up the operation from the channel
+			// hence this sleep to guarantee it will read from it and process
+			time.Sleep(10 * time.Millisecond)
+			workerService.Stop(context.Background())
+			require.False(t, workerService.IsRunning())
+		}()
+
+		err := workerService.Start(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("execute operation when scheduler operations are resumed while holding it", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+
+		operationManager := mock.NewMockOperationManager(mockCtrl)
+		ctx := context.Background()
+		operationName := "test_operation"
+		operationDefinition := mockoperation.NewMockDefinition(mockCtrl)
+		operationExecutor := mockoperation.NewMockExecutor(mockCtrl)
+		operationDefinition.EXPECT().Name().Return(operationName).AnyTimes()
+
+		scheduler := &entities.Scheduler{Name: "random-scheduler"}
+		expectedOperation := &operation.Operation{
+			ID:             "random-operation-id",
+			SchedulerName:  scheduler.Name,
+			Status:         operation.StatusPending,
+			DefinitionName: operationName,
+		}
+
+		executors := map[string]operations.Executor{}
+		executors[operationName] = operationExecutor
+		config := worker.Configuration{
+			HealthControllerExecutionInterval: duration,
+			StorageCleanupExecutionInterval:   duration,
+		}
+
+		workerService := NewOperationExecutionWorker(scheduler, worker.ProvideWorkerOptions(operationManager, executors, nil, nil, config))
+		pendingOpsChan := make(chan string)
+
+		operationManager.EXPECT().GetOperation(gomock.Any(), scheduler.Name, expectedOperation.ID).Return(expectedOperation, operationDefinition, nil)
+		operationManager.EXPECT().PendingOperationsChan(gomock.Any(), expectedOperation.SchedulerName).Return(pendingOpsChan)
+		operationDefinition.EXPECT().ShouldExecute(gomock.Any(), []*operation.Operation{}).Return(true)
+		operationManager.EXPECT().GetSchedulerOperationsPause(gomock.Any(), scheduler.Name).Return(&operation.OperationsPause{Reason: "incident", PausedAt: time.Now()}, nil)
+		operationManager.EXPECT().HoldOperation(gomock.Any(), expectedOperation).Return(false, nil)
+		operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), expectedOperation, "Starting operation")
+		operationManager.EXPECT().GrantLease(gomock.Any(), expectedOperation)
+		operationManager.EXPECT().StartOperation(gomock.Any(), expectedOperation, gomock.Any())
+		operationManager.EXPECT().StartLeaseRenewGoRoutine(gomock.Any(), expectedOperation)
+		operationManager.EXPECT().FinishOperation(gomock.Any(), expectedOperation, operationDefinition)
+		operationManager.EXPECT().RevokeLease(gomock.Any(), expectedOperation)
+		operationManager.EXPECT().AppendOperationEventToExecutionHistory(gomock.Any(), expectedOperation, "Operation finished")
+		operationExecutor.EXPECT().Execute(gomock.Any(), expectedOperation, operationDefinition).Return(nil)
+
+		go func() {
+			pendingOpsChan <- expectedOperation.ID
+
+			// We need to wait for the goroutine to pick up the operation from the channel
+			// hence this sleep to guarantee it will read from it and process
+			time.Sleep(10 * time.Millisecond)
+			workerService.Stop(context.Background())
+			require.False(t, workerService.IsRunning())
+		}()
+
+		err := workerService.Start(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("when healthcontroller ticks and scheduler operations are paused, do not create a new health_controller operation", func(t *testing.T) {
+		duration, err := time.ParseDuration("1ms")
+		require.NoError(t, err)
+
+		longDuration, err := time.ParseDuration("10m")
+		require.NoError(t, err)
+
+		mockCtrl := gomock.NewController(t)
+		operationManager := mock.NewMockOperationManager(mockCtrl)
+		scheduler := &entities.Scheduler{Name: "random-scheduler"}
+
+		config := worker.Configuration{
+			HealthControllerExecutionInterval: duration,
+			StorageCleanupExecutionInterval:   longDuration,
+		}
+
+		workerService := NewOperationExecutionWorker(scheduler, worker.ProvideWorkerOptions(operationManager, map[string]operations.Executor{}, nil, nil, config))
+		pendingOpsChan := make(chan string)
+
+		operationManager.EXPECT().PendingOperationsChan(gomock.Any(), gomock.Any()).Return(pendingOpsChan)
+		operationManager.EXPECT().GetSchedulerOperationsPause(gomock.Any(), scheduler.Name).Return(&operation.OperationsPause{Reason: "incident"}, nil).MinTimes(1)
+		operationManager.EXPECT().CreateOperation(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		go func() {
+			time.Sleep(5 * time.Millisecond)
+			cancel()
+		}()
+
+		err = workerService.Start(ctx)
+		require.NoError(t, err)
+	})
 }
@@ -24,6 +24,8 @@ package service
 
 import (
 	"fmt"
+	"net/http"
+	"os"
 	"strings"
 	"time"
 
@@ -34,16 +36,22 @@ import (
 	clockTime "github.com/topfreegames/maestro/internal/adapters/clock/time"
 	eventsadapters "github.com/topfreegames/maestro/internal/adapters/events"
 	"github.com/topfreegames/maestro/internal/adapters/flow/redis/operation"
+	"github.com/topfreegames/maestro/internal/adapters/healthcheck"
 	operation2 "github.com/topfreegames/maestro/internal/adapters/lease/redis/operation"
 	portAllocatorRandom "github.com/topfreegames/maestro/internal/adapters/portallocator/random"
+	eventsPublisherRedis "github.com/topfreegames/maestro/internal/adapters/publisher/redis/events"
 	kubernetesRuntime "github.com/topfreegames/maestro/internal/adapters/runtime/kubernetes"
 	"github.com/topfreegames/maestro/internal/adapters/storage/postgres/scheduler"
+	"github.com/topfreegames/maestro/internal/adapters/storage/postgres/schedulertemplate"
+	forwarderStorageRedis "github.com/topfreegames/maestro/internal/adapters/storage/redis/forwarder"
 	instanceStorageRedis "github.com/topfreegames/maestro/internal/adapters/storage/redis/instance"
 	redis2 "github.com/topfreegames/maestro/internal/adapters/storage/redis/operation"
+	outboxStorageRedis "github.com/topfreegames/maestro/internal/adapters/storage/redis/outbox"
 	roomStorageRedis "github.com/topfreegames/maestro/internal/adapters/storage/redis/room"
 	"github.com/topfreegames/maestro/internal/adapters/tracing"
 	"github.com/topfreegames/maestro/internal/config"
 	"github.com/topfreegames/maestro/internal/core/entities/autoscaling"
+	"github.com/topfreegames/maestro/internal/core/entities/forwarder"
 	"github.com/topfreegames/maestro/internal/core/entities/port"
 	"github.com/topfreegames/maestro/internal/core/operations"
 	"github.com/topfreegames/maestro/internal/core/operations/healthcontroller"
@@ -56,7 +64,9 @@ import (
 	operationservice "github.com/topfreegames/maestro/internal/core/services/operations"
 	"github.com/topfreegames/maestro/internal/core/services/rooms"
 	"github.com/topfreegames/maestro/internal/core/services/schedulers"
+	"github.com/topfreegames/maestro/internal/core/services/schedulertemplates"
 	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.uber.org/zap"
 	"google.golang.org/grpc/keepalive"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -86,6 +96,25 @@ const (
 	instanceStorageRedisScanSizePath = "adapters.instanceStorage.redis.scanSize"
 	// Redis operation flow
 	operationFlowRedisURLPath = "adapters.operationFlow.redis.url"
+	// Redis events publisher
+	eventsPublisherRedisURLPath    = "adapters.eventsPublisher.redis.url"
+	eventsPublisherRedisMaxLenPath = "adapters.eventsPublisher.redis.maxLen"
+	// Redis events outbox
+	eventsOutboxRedisURLPath               = "adapters.eventsOutbox.redis.url"
+	eventsOutboxRedisDeadLettersMaxLenPath = "adapters.eventsOutbox.redis.deadLettersMaxLen"
+	// Redis forwarder health storage
+	forwarderHealthStorageRedisURLPath = "adapters.forwarderHealthStorage.redis.url"
+	// Redis room timeline storage
+	roomTimelineStorageRedisURLPath        = "adapters.roomTimelineStorage.redis.url"
+	roomTimelineStorageRedisMaxEntriesPath = "adapters.roomTimelineStorage.redis.maxEntries"
+	roomTimelineStorageRedisTTLPath        = "adapters.roomTimelineStorage.redis.ttl"
+	// Events forwarder circuit breaker
+	circuitBreakerEnabledPath          = "adapters.eventsForwarder.circuitBreaker.enabled"
+	circuitBreakerFailureThresholdPath = "adapters.eventsForwarder.circuitBreaker.failureThreshold"
+	circuitBreakerOpenTimeoutPath      = "adapters.eventsForwarder.circuitBreaker.openTimeout"
+	circuitBreakerHalfOpenMaxCallsPath = "adapters.eventsForwarder.circuitBreaker.halfOpenMaxCalls"
+	// Events forwarder secrets, referenced by name by the forwarders options
+	eventsForwarderSecretsPath = "adapters.eventsForwarder.secrets"
 	// Redis configs
 	redisPoolSizePath = "adapters.redis.poolSize"
 	// Random port allocator
@@ -98,8 +127,13 @@ const (
 )
 
 // NewSchedulerManager instantiates a new scheduler manager.
-func NewSchedulerManager(schedulerStorage ports.SchedulerStorage, schedulerCache ports.SchedulerCache, operationManager ports.OperationManager, roomStorage ports.RoomStorage) ports.SchedulerManager {
-	return schedulers.NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage)
+func NewSchedulerManager(schedulerStorage ports.SchedulerStorage, schedulerCache ports.SchedulerCache, operationManager ports.OperationManager, roomStorage ports.RoomStorage, runtime ports.Runtime) ports.SchedulerManager {
+	return schedulers.NewSchedulerManager(schedulerStorage, schedulerCache, operationManager, roomStorage, runtime)
+}
+
+// NewSchedulerTemplateManager instantiates a new scheduler template manager.
+func NewSchedulerTemplateManager(templateStorage ports.SchedulerTemplateStorage, schedulerManager ports.SchedulerManager) ports.SchedulerTemplateManager {
+	return schedulertemplates.NewSchedulerTemplateManager(templateStorage, schedulerManager)
 }
 
 // NewOperationManager instantiates a new operation manager
@@ -108,18 +142,96 @@ func NewOperationManager(flow ports.OperationFlow, storage ports.OperationStorag
 }
 
 // NewRoomManager instantiates a room manager.
-func NewRoomManager(clock ports.Clock, portAllocator ports.PortAllocator, roomStorage ports.RoomStorage, instanceStorage ports.GameRoomInstanceStorage, runtime ports.Runtime, eventsService ports.EventsService, config rooms.RoomManagerConfig) ports.RoomManager {
-	return rooms.New(clock, portAllocator, roomStorage, instanceStorage, runtime, eventsService, config)
+func NewRoomManager(clock ports.Clock, portAllocator ports.PortAllocator, roomStorage ports.RoomStorage, instanceStorage ports.GameRoomInstanceStorage, runtime ports.Runtime, eventsService ports.EventsService, roomTimeline ports.RoomTimelineManager, config rooms.RoomManagerConfig) ports.RoomManager {
+	return rooms.New(clock, portAllocator, roomStorage, instanceStorage, runtime, eventsService, roomTimeline, config)
 }
 
-// NewEventsForwarder instantiates GRPC as events forwarder.
+// NewRoomTimelineManager instantiates a new room timeline manager.
+func NewRoomTimelineManager(timelineStorage ports.RoomTimelineStorage, schedulerStorage ports.SchedulerStorage, clock ports.Clock, config rooms.RoomTimelineConfig) ports.RoomTimelineManager {
+	return rooms.NewRoomTimelineManager(timelineStorage, schedulerStorage, clock, config)
+}
+
+// NewEventsForwarder instantiates the events forwarder, using GRPC or HTTP
+// depending on each scheduler forwarder type, behind a circuit breaker per
+// forwarder address when it is enabled.
 func NewEventsForwarder(c config.Config) (ports.EventsForwarder, error) {
+	forwarders := map[forwarder.ForwardType]ports.EventsForwarder{
+		forwarder.TypeGrpc: eventsadapters.NewEventsForwarder(NewForwarderClient(c)),
+		forwarder.TypeHTTP: eventsadapters.NewHTTPEventsForwarder(&http.Client{}, newEventsForwarderSecretResolver(c)),
+	}
+
+	// Broker forwarders are only available when a publisher is configured.
+	if publisherURL := c.GetString(eventsPublisherRedisURLPath); publisherURL != "" {
+		client, err := createRedisClient(c, publisherURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize Redis events publisher: %w", err)
+		}
+		publisher := eventsPublisherRedis.NewRedisEventsPublisher(client, int64(c.GetInt(eventsPublisherRedisMaxLenPath)))
+		forwarders[forwarder.TypeBroker] = eventsadapters.NewBrokerEventsForwarder(publisher)
+	}
+
+	typedForwarder := eventsadapters.NewTypedEventsForwarder(forwarders)
+	if !c.GetBool(circuitBreakerEnabledPath) {
+		return typedForwarder, nil
+	}
+
+	healthStorage, err := NewForwarderHealthStorageRedis(c)
+	if err != nil {
+		return nil, err
+	}
+	circuitBreakerConfig := eventsadapters.CircuitBreakerConfig{
+		FailureThreshold: c.GetInt(circuitBreakerFailureThresholdPath),
+		OpenTimeout:      c.GetDuration(circuitBreakerOpenTimeoutPath),
+		HalfOpenMaxCalls: c.GetInt(circuitBreakerHalfOpenMaxCallsPath),
+	}
+	return eventsadapters.NewCircuitBreakerEventsForwarder(typedForwarder, healthStorage, NewClockTime(), circuitBreakerConfig), nil
+}
+
+// NewForwarderTestClient instantiates the client of the gRPC forwarders
+// connectivity tests, which doesn't keep the connections.
+func NewForwarderTestClient(c config.Config) ports.ForwarderClient {
+	keepAliveCfg := keepalive.ClientParameters{
+		Time:    c.GetDuration(grpcKeepAliveTimePath),
+		Timeout: c.GetDuration(grpcKeepAliveTimeoutPath),
+	}
+	return eventsadapters.NewUncachedForwarderClient(keepAliveCfg, newEventsForwarderSecretResolver(c))
+}
+
+// NewForwarderClient instantiates the client of the gRPC forwarders.
+func NewForwarderClient(c config.Config) ports.ForwarderClient {
 	keepAliveCfg := keepalive.ClientParameters{
 		Time:    c.GetDuration(grpcKeepAliveTimePath),
 		Timeout: c.GetDuration(grpcKeepAliveTimeoutPath),
 	}
-	forwarderGrpc := eventsadapters.NewForwarderClient(keepAliveCfg)
-	return eventsadapters.NewEventsForwarder(forwarderGrpc), nil
+	return eventsadapters.NewForwarderClient(keepAliveCfg, newEventsForwarderSecretResolver(c))
+}
+
+// newEventsForwarderSecretResolver resolves the forwarders secrets from the
+// config, either set as a value or as a file (e.g. a mounted Kubernetes
+// secret). Each secret is only available to the games listed on it.
+func newEventsForwarderSecretResolver(c config.Config) eventsadapters.SecretResolver {
+	return func(game, ref string) ([]byte, error) {
+		secretPath := fmt.Sprintf("%s.%s", eventsForwarderSecretsPath, ref)
+		if !isSecretAllowed(c.GetStringSlice(secretPath+".games"), game) {
+			return nil, fmt.Errorf("secret \"%s\" not allowed for game \"%s\"", ref, game)
+		}
+		if value := c.GetString(secretPath + ".value"); value != "" {
+			return []byte(value), nil
+		}
+		if file := c.GetString(secretPath + ".file"); file != "" {
+			return os.ReadFile(file)
+		}
+		return nil, fmt.Errorf("secret \"%s\" not configured", ref)
+	}
+}
+
+func isSecretAllowed(games []string, game string) bool {
+	for _, allowedGame := range games {
+		if allowedGame == game {
+			return true
+		}
+	}
+	return false
 }
 
 // NewRuntimeKubernetes instantiates kubernetes as runtime.
@@ -149,6 +261,19 @@ func NewRuntimeKubernetes(c config.Config) (ports.Runtime, error) {
 	return kubernetesRuntime.New(clientSet), nil
 }
 
+// NewOptionalRuntimeKubernetes instantiates kubernetes as runtime for the
+// components that can run without it, returning a nil runtime when the
+// kubernetes client can't be configured.
+func NewOptionalRuntimeKubernetes(c config.Config) ports.Runtime {
+	runtime, err := NewRuntimeKubernetes(c)
+	if err != nil {
+		zap.L().With(zap.Error(err)).Warn("running without Kubernetes runtime, the features that need it are unavailable")
+		return nil
+	}
+
+	return runtime
+}
+
 // NewOperationStorageRedis instantiates redis as operation storage.
 func NewOperationStorageRedis(clock ports.Clock, operationDefinitionProviders map[string]operations.DefinitionConstructor, c config.Config) (ports.OperationStorage, error) {
 	client, err := createRedisClient(c, c.GetString(operationStorageRedisURLPath))
@@ -206,11 +331,58 @@ func NewSchedulerCacheRedis(c config.Config) (ports.SchedulerCache, error) {
 	return schedulerredis.NewRedisSchedulerCache(client), nil
 }
 
+// NewEventsOutboxRedis instantiates redis as events outbox. The host name
+// identifies the dispatcher reading the outbox.
+func NewEventsOutboxRedis(c config.Config) (ports.EventsOutbox, error) {
+	client, err := createRedisClient(c, c.GetString(eventsOutboxRedisURLPath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize Redis events outbox: %w", err)
+	}
+
+	consumer, err := os.Hostname()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize Redis events outbox: %w", err)
+	}
+
+	return outboxStorageRedis.NewRedisEventsOutbox(client, consumer, int64(c.GetInt(eventsOutboxRedisDeadLettersMaxLenPath))), nil
+}
+
+// NewForwarderHealthStorageRedis instantiates redis as forwarder health
+// storage. The host name identifies the instance saving the health.
+func NewForwarderHealthStorageRedis(c config.Config) (ports.ForwarderHealthStorage, error) {
+	client, err := createRedisClient(c, c.GetString(forwarderHealthStorageRedisURLPath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize Redis forwarder health storage: %w", err)
+	}
+
+	instance, err := os.Hostname()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize Redis forwarder health storage: %w", err)
+	}
+
+	return forwarderStorageRedis.NewRedisForwarderHealthStorage(client, instance), nil
+}
+
+// NewRoomTimelineStorageRedis instantiates redis as room timeline storage.
+func NewRoomTimelineStorageRedis(c config.Config) (ports.RoomTimelineStorage, error) {
+	client, err := createRedisClient(c, c.GetString(roomTimelineStorageRedisURLPath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize Redis room timeline storage: %w", err)
+	}
+
+	return roomStorageRedis.NewRedisRoomTimelineStorage(client, int64(c.GetInt(roomTimelineStorageRedisMaxEntriesPath)), c.GetDuration(roomTimelineStorageRedisTTLPath)), nil
+}
+
 // NewClockTime instantiates a new clock.
 func NewClockTime() ports.Clock {
 	return clockTime.NewClock()
 }
 
+// NewRoomHealthChecker instantiates a new health checker used to validate game rooms.
+func NewRoomHealthChecker() ports.RoomHealthChecker {
+	return healthcheck.NewRoomHealthChecker()
+}
+
 // NewPortAllocatorRandom instantiates a new port allocator.
 func NewPortAllocatorRandom(c config.Config) (ports.PortAllocator, error) {
 	portRange, err := port.ParsePortRange(c.GetString(portAllocatorRandomRangePath))
@@ -237,6 +409,23 @@ func NewSchedulerStoragePg(c config.Config) (ports.SchedulerStorage, error) {
 	return pgStorage, nil
 }
 
+// NewSchedulerTemplateStoragePg instantiates a postgres connection as scheduler
+// template storage. Templates are kept on the scheduler storage database.
+func NewSchedulerTemplateStoragePg(c config.Config) (ports.SchedulerTemplateStorage, error) {
+	opts, err := connectToPostgres(GetSchedulerStoragePostgresURL(c))
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize postgres scheduler template storage: %w", err)
+	}
+
+	pgStorage := schedulertemplate.NewSchedulerTemplateStorage(opts)
+
+	if tracing.IsTracingEnabled(c) {
+		pgStorage.EnableTracing()
+	}
+
+	return pgStorage, nil
+}
+
 // GetSchedulerStoragePostgresURL get scheduler storage postgres URL.
 func GetSchedulerStoragePostgresURL(c config.Config) string {
 	return c.GetString(schedulerStoragePostgresURLPath)
@@ -38,6 +38,7 @@ import (
 	"github.com/stretchr/testify/require"
 	configmock "github.com/topfreegames/maestro/internal/config/mock"
 	"github.com/topfreegames/maestro/internal/core/entities/autoscaling"
+	"github.com/topfreegames/maestro/internal/core/entities/operation"
 	"github.com/topfreegames/maestro/internal/core/operations"
 	"github.com/topfreegames/maestro/internal/core/ports/errors"
 	"github.com/topfreegames/maestro/internal/core/ports/mock"
@@ -277,7 +278,7 @@ func TestOperationFlowRedis(t *testing.T) {
 		operationFlow, err := NewOperationFlowRedis(config)
 		require.NoError(t, err)
 
-		err = operationFlow.InsertOperationID(context.Background(), "", "")
+		err = operationFlow.InsertOperationID(context.Background(), "", "", operation.PriorityNormal)
 		require.NoError(t, err)
 	})
 
@@ -293,7 +294,7 @@ func TestOperationFlowRedis(t *testing.T) {
 		operationFlow, err := NewOperationFlowRedis(config)
 		require.NoError(t, err)
 
-		err = operationFlow.InsertOperationID(context.Background(), "", "")
+		err = operationFlow.InsertOperationID(context.Background(), "", "", operation.PriorityNormal)
 		require.ErrorIs(t, err, errors.ErrUnexpected)
 	})
 
@@ -26,7 +26,10 @@ import (
 	"time"
 
 	"github.com/topfreegames/maestro/internal/core/operations/rooms/add"
+	"github.com/topfreegames/maestro/internal/core/operations/schedulers/bluegreen"
+	"github.com/topfreegames/maestro/internal/core/operations/schedulers/canary"
 	"github.com/topfreegames/maestro/internal/core/operations/schedulers/newversion"
+	"github.com/topfreegames/maestro/internal/core/operations/storagecleanup"
 	"github.com/topfreegames/maestro/internal/core/services/events"
 	operationmanager "github.com/topfreegames/maestro/internal/core/services/operations"
 	roommanager "github.com/topfreegames/maestro/internal/core/services/rooms"
@@ -45,9 +48,18 @@ const (
 	roomRoomValidationAttemptsConfigPath        = "services.roomManager.roomValidationAttempts"
 	roomPingTimeoutMillisConfigPath             = "services.roomManager.roomPingTimeoutMillis"
 	roomDeletionTimeoutMillisConfigPath         = "services.roomManager.roomDeletionTimeoutMillis"
+	roomTimelineEnabledConfigPath               = "services.roomTimeline.enabled"
 	operationLeaseTTLMillisConfigPath           = "services.operationManager.operationLeaseTTLMillis"
+	operationIdempotencyKeyTTLConfigPath        = "services.operationManager.idempotencyKeyTTL"
 	schedulerCacheTTLMillisConfigPath           = "services.eventsForwarder.schedulerCacheTTLMillis"
+	eventsOutboxEnabledConfigPath               = "services.eventsForwarder.outbox.enabled"
+	eventsOutboxMaxAttemptsConfigPath           = "services.eventsForwarder.outbox.maxAttempts"
+	eventsOutboxInitialBackoffConfigPath        = "services.eventsForwarder.outbox.initialBackoff"
+	eventsOutboxMaxBackoffConfigPath            = "services.eventsForwarder.outbox.maxBackoff"
+	forwardersTesterAllowedAddressesConfigPath  = "services.forwardersTester.allowedAddresses"
 	operationsRoomsAddLimitConfigPath           = "operations.rooms.add.limit"
+	operationsCanaryCheckIntervalConfigPath     = "operations.schedulers.canary.checkInterval"
+	storageCleanupKeepLastVersionsConfigPath    = "operations.storageCleanup.keepLastVersions"
 )
 
 // NewCreateSchedulerVersionConfig instantiate a new CreateSchedulerVersionConfig to be used by the NewSchedulerVersion operation to customize its configuration.
@@ -81,6 +93,40 @@ func NewHealthControllerConfig(c config.Config) healthcontroller.Config {
 	return config
 }
 
+// NewCanaryRolloutConfig instantiate a new canary.Config to be used by the canary rollout operation.
+func NewCanaryRolloutConfig(c config.Config) canary.Config {
+	checkInterval := c.GetDuration(operationsCanaryCheckIntervalConfigPath)
+	if checkInterval <= 0 {
+		checkInterval = 10 * time.Second
+	}
+
+	config := canary.Config{
+		CheckInterval: checkInterval,
+	}
+
+	return config
+}
+
+// NewBlueGreenSwitchConfig instantiate a new bluegreen.Config to be used by the blue/green switch operation.
+func NewBlueGreenSwitchConfig(c config.Config) bluegreen.Config {
+	initializationTimeout := time.Duration(c.GetInt(roomInitializationTimeoutMillisConfigPath)) * time.Millisecond
+
+	config := bluegreen.Config{
+		RoomInitializationTimeout: initializationTimeout,
+	}
+
+	return config
+}
+
+// NewStorageCleanupConfig instantiate a new storagecleanup.Config to be used by the storage clean up operation.
+func NewStorageCleanupConfig(c config.Config) storagecleanup.Config {
+	config := storagecleanup.Config{
+		KeepLastVersions: c.GetInt(storageCleanupKeepLastVersionsConfigPath),
+	}
+
+	return config
+}
+
 // NewOperationRoomsAddConfig instantiate a new add.Config to be used by the rooms add operation.
 func NewOperationRoomsAddConfig(c config.Config) add.Config {
 	operationsRoomsAddLimit := int32(c.GetInt(operationsRoomsAddLimitConfigPath))
@@ -105,6 +151,13 @@ func NewRoomManagerConfig(c config.Config) (roommanager.RoomManagerConfig, error
 	return roomManagerConfig, nil
 }
 
+// NewRoomTimelineConfig instantiate a new RoomTimelineConfig to be used by the RoomTimelineManager to customize its configuration.
+func NewRoomTimelineConfig(c config.Config) roommanager.RoomTimelineConfig {
+	return roommanager.RoomTimelineConfig{
+		Enabled: c.GetBool(roomTimelineEnabledConfigPath),
+	}
+}
+
 // NewWorkersConfig instantiate a new workers Config stucture to be used by the workers to customize them from the config package.
 func NewWorkersConfig(c config.Config) (worker.Configuration, error) {
 	healthControllerExecutionInterval := c.GetDuration(healthControllerExecutionIntervalConfigPath)
@@ -123,8 +176,14 @@ func NewWorkersConfig(c config.Config) (worker.Configuration, error) {
 func NewOperationManagerConfig(c config.Config) (operationmanager.OperationManagerConfig, error) {
 	operationLeaseTTL := time.Duration(c.GetInt(operationLeaseTTLMillisConfigPath)) * time.Millisecond
 
+	idempotencyKeyTTL := c.GetDuration(operationIdempotencyKeyTTLConfigPath)
+	if idempotencyKeyTTL <= 0 {
+		idempotencyKeyTTL = time.Hour * 24
+	}
+
 	operationManagerConfig := operationmanager.OperationManagerConfig{
 		OperationLeaseTtl: operationLeaseTTL,
+		IdempotencyKeyTtl: idempotencyKeyTTL,
 	}
 
 	return operationManagerConfig, nil
@@ -141,9 +200,34 @@ func NewEventsForwarderServiceConfig(c config.Config) (events.EventsForwarderCon
 		schedulerCacheTTL = defaultSchedulerCacheTTL
 	}
 
+	outboxConfig := events.OutboxConfig{
+		Enabled:        c.GetBool(eventsOutboxEnabledConfigPath),
+		MaxAttempts:    c.GetInt(eventsOutboxMaxAttemptsConfigPath),
+		InitialBackoff: c.GetDuration(eventsOutboxInitialBackoffConfigPath),
+		MaxBackoff:     c.GetDuration(eventsOutboxMaxBackoffConfigPath),
+	}
+	if outboxConfig.MaxAttempts < 1 {
+		outboxConfig.MaxAttempts = 5
+	}
+	if outboxConfig.InitialBackoff <= 0 {
+		outboxConfig.InitialBackoff = time.Second
+	}
+	if outboxConfig.MaxBackoff < outboxConfig.InitialBackoff {
+		outboxConfig.MaxBackoff = 5 * time.Minute
+	}
+
 	eventsForwarderConfig := events.EventsForwarderConfig{
 		SchedulerCacheTtl: schedulerCacheTTL,
+		Outbox:            outboxConfig,
 	}
 
 	return eventsForwarderConfig, nil
 }
+
+// NewForwardersTesterConfig instantiate a new ForwardersTesterConfig with the
+// addresses the forwarders that aren't on a scheduler can be tested at.
+func NewForwardersTesterConfig(c config.Config) events.ForwardersTesterConfig {
+	return events.ForwardersTesterConfig{
+		AllowedAddresses: c.GetStringSlice(forwardersTesterAllowedAddressesConfigPath),
+	}
+}
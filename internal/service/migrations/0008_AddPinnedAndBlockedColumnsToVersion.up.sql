@@ -0,0 +1,10 @@
+-- maestro
+-- https://github.com/topfreegames/maestro
+--
+-- Licensed under the MIT license:
+-- http://www.opensource.org/licenses/mit-license
+-- Copyright © 2018 Top Free Games <backend@tfgco.com>
+
+
+ALTER TABLE scheduler_versions ADD COLUMN IF NOT EXISTS pinned boolean NOT NULL DEFAULT false;
+ALTER TABLE scheduler_versions ADD COLUMN IF NOT EXISTS blocked boolean NOT NULL DEFAULT false;
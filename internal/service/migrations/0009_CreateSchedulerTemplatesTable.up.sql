@@ -0,0 +1,16 @@
+-- maestro
+-- https://github.com/topfreegames/maestro
+--
+-- Licensed under the MIT license:
+-- http://www.opensource.org/licenses/mit-license
+-- Copyright © 2018 Top Free Games <backend@tfgco.com>
+
+CREATE TABLE IF NOT EXISTS scheduler_templates (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name varchar(255) NOT NULL,
+    yaml TEXT NOT NULL,
+    created_at timestamp WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at timestamp WITH TIME ZONE NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS scheduler_templates_name_unique ON scheduler_templates (name);
@@ -24,6 +24,7 @@ package validations
 
 import (
 	"errors"
+	"reflect"
 
 	"github.com/go-playground/locales/en"
 	ut "github.com/go-playground/universal-translator"
@@ -75,12 +76,24 @@ func RegisterValidations() error {
 	}
 	addTranslation(Validate, "required_for_room_occupancy", "{0} must not be nil for RoomOccupancy policy type")
 
+	err = Validate.RegisterValidation("required_for_canary", canaryParameterValidate, true)
+	if err != nil {
+		return errors.New("could not register canaryParameterValidate")
+	}
+	addTranslation(Validate, "required_for_canary", "{0} must not be nil for Canary rollout strategy type")
+
 	err = Validate.RegisterValidation("max_surge", maxSurgeValidate)
 	if err != nil {
 		return errors.New("could not register maxSurgeValidate")
 	}
 	addTranslation(Validate, "max_surge", "{0} must be a number greater than zero or a number greater than zero with suffix '%'")
 
+	err = Validate.RegisterValidation("max_unavailable", maxUnavailableValidate)
+	if err != nil {
+		return errors.New("could not register maxUnavailableValidate")
+	}
+	addTranslation(Validate, "max_unavailable", "{0} must be a number greater than or equal to zero or a percentage up to 100 with suffix '%'")
+
 	err = Validate.RegisterValidation("kube_resource_name", kubeResourceNameValidate)
 	if err != nil {
 		return errors.New("could not register kubeResourceNameValidate")
@@ -91,7 +104,19 @@ func RegisterValidations() error {
 	if err != nil {
 		return errors.New("could not register forwarderTypeValidate")
 	}
-	addTranslation(Validate, "forwarder_type", "{0} must be one of the following options: gRPC")
+	addTranslation(Validate, "forwarder_type", "{0} must be one of the following options: gRPC, http, broker")
+
+	err = Validate.RegisterValidation("forwarder_address", forwarderAddressValidate)
+	if err != nil {
+		return errors.New("could not register forwarderAddressValidate")
+	}
+	addTranslation(Validate, "forwarder_address", "{0} of broker forwarders must be a topic with only letters, numbers, '.', '_' and '-'")
+
+	err = Validate.RegisterValidation("forwarder_attribute", forwarderAttributeValidate)
+	if err != nil {
+		return errors.New("could not register forwarderAttributeValidate")
+	}
+	addTranslation(Validate, "forwarder_attribute", "{0} can't be one of the keys used to build the events: eventType, pingType, roomEvent, playerId")
 
 	if Validate == nil {
 		return errors.New("it was not possible to register validations")
@@ -136,10 +161,25 @@ func roomOccupancyParameterValidate(fl validator.FieldLevel) bool {
 	return validations.RequiredIfTypeRoomOccupancy(field.IsNil(), topField.String())
 }
 
+func canaryParameterValidate(fl validator.FieldLevel) bool {
+	field := fl.Field()
+
+	topField, topKind, _, ok := fl.GetStructFieldOK2()
+	if !ok || topKind != reflect.String {
+		return false
+	}
+
+	return validations.RequiredIfTypeCanary(field.IsNil(), topField.String())
+}
+
 func maxSurgeValidate(fl validator.FieldLevel) bool {
 	return validations.IsMaxSurgeValid(fl.Field().String())
 }
 
+func maxUnavailableValidate(fl validator.FieldLevel) bool {
+	return validations.IsMaxUnavailableValid(fl.Field().String())
+}
+
 func semanticValidate(fl validator.FieldLevel) bool {
 	return validations.IsVersionValid(fl.Field().String())
 }
@@ -152,6 +192,19 @@ func forwarderTypeValidate(fl validator.FieldLevel) bool {
 	return validations.IsForwarderTypeSupported(fl.Field().String())
 }
 
+func forwarderAddressValidate(fl validator.FieldLevel) bool {
+	topField, topKind, _, ok := fl.GetStructFieldOK2()
+	if !ok || topKind != reflect.String {
+		return false
+	}
+
+	return validations.IsForwarderAddressValid(topField.String(), fl.Field().String())
+}
+
+func forwarderAttributeValidate(fl validator.FieldLevel) bool {
+	return validations.IsForwarderAttributeValid(fl.Field().String())
+}
+
 func addTranslation(validate *validator.Validate, tag string, errMessage string) {
 	registerFn := func(ut ut.Translator) error {
 		return ut.Add(tag, errMessage, false)
@@ -0,0 +1,267 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.17.3
+// source: api/v1/events.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	EventsService_ListDeadLetterEvents_FullMethodName    = "/api.v1.EventsService/ListDeadLetterEvents"
+	EventsService_ReplayDeadLetterEvents_FullMethodName  = "/api.v1.EventsService/ReplayDeadLetterEvents"
+	EventsService_ListForwardersHealth_FullMethodName    = "/api.v1.EventsService/ListForwardersHealth"
+	EventsService_TestForwarders_FullMethodName          = "/api.v1.EventsService/TestForwarders"
+	EventsService_TestSchedulerForwarders_FullMethodName = "/api.v1.EventsService/TestSchedulerForwarders"
+)
+
+// EventsServiceClient is the client API for EventsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EventsServiceClient interface {
+	// List the scheduler dead-letter events.
+	ListDeadLetterEvents(ctx context.Context, in *ListDeadLetterEventsRequest, opts ...grpc.CallOption) (*ListDeadLetterEventsResponse, error)
+	// Replay the scheduler dead-letter events, forwarding them again with their attempts reset.
+	ReplayDeadLetterEvents(ctx context.Context, in *ReplayDeadLetterEventsRequest, opts ...grpc.CallOption) (*ReplayDeadLetterEventsResponse, error)
+	// List the circuit breaker health of the scheduler forwarders.
+	ListForwardersHealth(ctx context.Context, in *ListForwardersHealthRequest, opts ...grpc.CallOption) (*ListForwardersHealthResponse, error)
+	// Test the connectivity of forwarders, sending them a synthetic room event and player event.
+	TestForwarders(ctx context.Context, in *TestForwardersRequest, opts ...grpc.CallOption) (*TestForwardersResponse, error)
+	// Test the connectivity of the scheduler forwarders, sending them a synthetic room event and player event.
+	TestSchedulerForwarders(ctx context.Context, in *TestSchedulerForwardersRequest, opts ...grpc.CallOption) (*TestForwardersResponse, error)
+}
+
+type eventsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventsServiceClient(cc grpc.ClientConnInterface) EventsServiceClient {
+	return &eventsServiceClient{cc}
+}
+
+func (c *eventsServiceClient) ListDeadLetterEvents(ctx context.Context, in *ListDeadLetterEventsRequest, opts ...grpc.CallOption) (*ListDeadLetterEventsResponse, error) {
+	out := new(ListDeadLetterEventsResponse)
+	err := c.cc.Invoke(ctx, EventsService_ListDeadLetterEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) ReplayDeadLetterEvents(ctx context.Context, in *ReplayDeadLetterEventsRequest, opts ...grpc.CallOption) (*ReplayDeadLetterEventsResponse, error) {
+	out := new(ReplayDeadLetterEventsResponse)
+	err := c.cc.Invoke(ctx, EventsService_ReplayDeadLetterEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) ListForwardersHealth(ctx context.Context, in *ListForwardersHealthRequest, opts ...grpc.CallOption) (*ListForwardersHealthResponse, error) {
+	out := new(ListForwardersHealthResponse)
+	err := c.cc.Invoke(ctx, EventsService_ListForwardersHealth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) TestForwarders(ctx context.Context, in *TestForwardersRequest, opts ...grpc.CallOption) (*TestForwardersResponse, error) {
+	out := new(TestForwardersResponse)
+	err := c.cc.Invoke(ctx, EventsService_TestForwarders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) TestSchedulerForwarders(ctx context.Context, in *TestSchedulerForwardersRequest, opts ...grpc.CallOption) (*TestForwardersResponse, error) {
+	out := new(TestForwardersResponse)
+	err := c.cc.Invoke(ctx, EventsService_TestSchedulerForwarders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventsServiceServer is the server API for EventsService service.
+// All implementations must embed UnimplementedEventsServiceServer
+// for forward compatibility
+type EventsServiceServer interface {
+	// List the scheduler dead-letter events.
+	ListDeadLetterEvents(context.Context, *ListDeadLetterEventsRequest) (*ListDeadLetterEventsResponse, error)
+	// Replay the scheduler dead-letter events, forwarding them again with their attempts reset.
+	ReplayDeadLetterEvents(context.Context, *ReplayDeadLetterEventsRequest) (*ReplayDeadLetterEventsResponse, error)
+	// List the circuit breaker health of the scheduler forwarders.
+	ListForwardersHealth(context.Context, *ListForwardersHealthRequest) (*ListForwardersHealthResponse, error)
+	// Test the connectivity of forwarders, sending them a synthetic room event and player event.
+	TestForwarders(context.Context, *TestForwardersRequest) (*TestForwardersResponse, error)
+	// Test the connectivity of the scheduler forwarders, sending them a synthetic room event and player event.
+	TestSchedulerForwarders(context.Context, *TestSchedulerForwardersRequest) (*TestForwardersResponse, error)
+	mustEmbedUnimplementedEventsServiceServer()
+}
+
+// UnimplementedEventsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedEventsServiceServer struct {
+}
+
+func (UnimplementedEventsServiceServer) ListDeadLetterEvents(context.Context, *ListDeadLetterEventsRequest) (*ListDeadLetterEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetterEvents not implemented")
+}
+func (UnimplementedEventsServiceServer) ReplayDeadLetterEvents(context.Context, *ReplayDeadLetterEventsRequest) (*ReplayDeadLetterEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLetterEvents not implemented")
+}
+func (UnimplementedEventsServiceServer) ListForwardersHealth(context.Context, *ListForwardersHealthRequest) (*ListForwardersHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListForwardersHealth not implemented")
+}
+func (UnimplementedEventsServiceServer) TestForwarders(context.Context, *TestForwardersRequest) (*TestForwardersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestForwarders not implemented")
+}
+func (UnimplementedEventsServiceServer) TestSchedulerForwarders(context.Context, *TestSchedulerForwardersRequest) (*TestForwardersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestSchedulerForwarders not implemented")
+}
+func (UnimplementedEventsServiceServer) mustEmbedUnimplementedEventsServiceServer() {}
+
+// UnsafeEventsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EventsServiceServer will
+// result in compilation errors.
+type UnsafeEventsServiceServer interface {
+	mustEmbedUnimplementedEventsServiceServer()
+}
+
+func RegisterEventsServiceServer(s grpc.ServiceRegistrar, srv EventsServiceServer) {
+	s.RegisterService(&EventsService_ServiceDesc, srv)
+}
+
+func _EventsService_ListDeadLetterEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLetterEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).ListDeadLetterEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_ListDeadLetterEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).ListDeadLetterEvents(ctx, req.(*ListDeadLetterEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_ReplayDeadLetterEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLetterEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).ReplayDeadLetterEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_ReplayDeadLetterEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).ReplayDeadLetterEvents(ctx, req.(*ReplayDeadLetterEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_ListForwardersHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListForwardersHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).ListForwardersHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_ListForwardersHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).ListForwardersHealth(ctx, req.(*ListForwardersHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_TestForwarders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestForwardersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).TestForwarders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_TestForwarders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).TestForwarders(ctx, req.(*TestForwardersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_TestSchedulerForwarders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestSchedulerForwardersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).TestSchedulerForwarders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_TestSchedulerForwarders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).TestSchedulerForwarders(ctx, req.(*TestSchedulerForwardersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EventsService_ServiceDesc is the grpc.ServiceDesc for EventsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EventsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.v1.EventsService",
+	HandlerType: (*EventsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDeadLetterEvents",
+			Handler:    _EventsService_ListDeadLetterEvents_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetterEvents",
+			Handler:    _EventsService_ReplayDeadLetterEvents_Handler,
+		},
+		{
+			MethodName: "ListForwardersHealth",
+			Handler:    _EventsService_ListForwardersHealth_Handler,
+		},
+		{
+			MethodName: "TestForwarders",
+			Handler:    _EventsService_TestForwarders_Handler,
+		},
+		{
+			MethodName: "TestSchedulerForwarders",
+			Handler:    _EventsService_TestSchedulerForwarders_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/events.proto",
+}
@@ -19,9 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	OperationsService_ListOperations_FullMethodName  = "/api.v1.OperationsService/ListOperations"
-	OperationsService_CancelOperation_FullMethodName = "/api.v1.OperationsService/CancelOperation"
-	OperationsService_GetOperation_FullMethodName    = "/api.v1.OperationsService/GetOperation"
+	OperationsService_ListOperations_FullMethodName            = "/api.v1.OperationsService/ListOperations"
+	OperationsService_CancelOperation_FullMethodName           = "/api.v1.OperationsService/CancelOperation"
+	OperationsService_GetOperation_FullMethodName              = "/api.v1.OperationsService/GetOperation"
+	OperationsService_WatchOperation_FullMethodName            = "/api.v1.OperationsService/WatchOperation"
+	OperationsService_WatchSchedulerOperations_FullMethodName  = "/api.v1.OperationsService/WatchSchedulerOperations"
+	OperationsService_PauseSchedulerOperations_FullMethodName  = "/api.v1.OperationsService/PauseSchedulerOperations"
+	OperationsService_ResumeSchedulerOperations_FullMethodName = "/api.v1.OperationsService/ResumeSchedulerOperations"
 )
 
 // OperationsServiceClient is the client API for OperationsService service.
@@ -34,6 +38,18 @@ type OperationsServiceClient interface {
 	CancelOperation(ctx context.Context, in *CancelOperationRequest, opts ...grpc.CallOption) (*CancelOperationResponse, error)
 	// Get operation based on scheduler name and operation ID
 	GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*GetOperationResponse, error)
+	// Watch an operation, streaming it every time its status, execution history or progress changes.
+	// The stream ends when the operation reaches a final status.
+	// NOTE: On http protocol, it is served as Server-Sent Events on `GET /schedulers/{scheduler_name}/operations/{operation_id}/watch`.
+	WatchOperation(ctx context.Context, in *WatchOperationRequest, opts ...grpc.CallOption) (OperationsService_WatchOperationClient, error)
+	// Watch the operations of a scheduler, streaming them every time they are created or changed.
+	// NOTE: On http protocol, it is served as Server-Sent Events on `GET /schedulers/{scheduler_name}/operations/watch`.
+	WatchSchedulerOperations(ctx context.Context, in *WatchSchedulerOperationsRequest, opts ...grpc.CallOption) (OperationsService_WatchSchedulerOperationsClient, error)
+	// Pause the operations processing of a scheduler. While paused, only a few operations (e.g. scheduler deletion) are
+	// executed, the others are held until the processing is resumed, and no health controller operation is created.
+	PauseSchedulerOperations(ctx context.Context, in *PauseSchedulerOperationsRequest, opts ...grpc.CallOption) (*PauseSchedulerOperationsResponse, error)
+	// Resume the operations processing of a scheduler, executing the operations held while it was paused first.
+	ResumeSchedulerOperations(ctx context.Context, in *ResumeSchedulerOperationsRequest, opts ...grpc.CallOption) (*ResumeSchedulerOperationsResponse, error)
 }
 
 type operationsServiceClient struct {
@@ -71,6 +87,88 @@ func (c *operationsServiceClient) GetOperation(ctx context.Context, in *GetOpera
 	return out, nil
 }
 
+func (c *operationsServiceClient) WatchOperation(ctx context.Context, in *WatchOperationRequest, opts ...grpc.CallOption) (OperationsService_WatchOperationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OperationsService_ServiceDesc.Streams[0], OperationsService_WatchOperation_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &operationsServiceWatchOperationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OperationsService_WatchOperationClient interface {
+	Recv() (*WatchOperationResponse, error)
+	grpc.ClientStream
+}
+
+type operationsServiceWatchOperationClient struct {
+	grpc.ClientStream
+}
+
+func (x *operationsServiceWatchOperationClient) Recv() (*WatchOperationResponse, error) {
+	m := new(WatchOperationResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *operationsServiceClient) WatchSchedulerOperations(ctx context.Context, in *WatchSchedulerOperationsRequest, opts ...grpc.CallOption) (OperationsService_WatchSchedulerOperationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OperationsService_ServiceDesc.Streams[1], OperationsService_WatchSchedulerOperations_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &operationsServiceWatchSchedulerOperationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OperationsService_WatchSchedulerOperationsClient interface {
+	Recv() (*WatchSchedulerOperationsResponse, error)
+	grpc.ClientStream
+}
+
+type operationsServiceWatchSchedulerOperationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *operationsServiceWatchSchedulerOperationsClient) Recv() (*WatchSchedulerOperationsResponse, error) {
+	m := new(WatchSchedulerOperationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *operationsServiceClient) PauseSchedulerOperations(ctx context.Context, in *PauseSchedulerOperationsRequest, opts ...grpc.CallOption) (*PauseSchedulerOperationsResponse, error) {
+	out := new(PauseSchedulerOperationsResponse)
+	err := c.cc.Invoke(ctx, OperationsService_PauseSchedulerOperations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operationsServiceClient) ResumeSchedulerOperations(ctx context.Context, in *ResumeSchedulerOperationsRequest, opts ...grpc.CallOption) (*ResumeSchedulerOperationsResponse, error) {
+	out := new(ResumeSchedulerOperationsResponse)
+	err := c.cc.Invoke(ctx, OperationsService_ResumeSchedulerOperations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OperationsServiceServer is the server API for OperationsService service.
 // All implementations must embed UnimplementedOperationsServiceServer
 // for forward compatibility
@@ -81,6 +179,18 @@ type OperationsServiceServer interface {
 	CancelOperation(context.Context, *CancelOperationRequest) (*CancelOperationResponse, error)
 	// Get operation based on scheduler name and operation ID
 	GetOperation(context.Context, *GetOperationRequest) (*GetOperationResponse, error)
+	// Watch an operation, streaming it every time its status, execution history or progress changes.
+	// The stream ends when the operation reaches a final status.
+	// NOTE: On http protocol, it is served as Server-Sent Events on `GET /schedulers/{scheduler_name}/operations/{operation_id}/watch`.
+	WatchOperation(*WatchOperationRequest, OperationsService_WatchOperationServer) error
+	// Watch the operations of a scheduler, streaming them every time they are created or changed.
+	// NOTE: On http protocol, it is served as Server-Sent Events on `GET /schedulers/{scheduler_name}/operations/watch`.
+	WatchSchedulerOperations(*WatchSchedulerOperationsRequest, OperationsService_WatchSchedulerOperationsServer) error
+	// Pause the operations processing of a scheduler. While paused, only a few operations (e.g. scheduler deletion) are
+	// executed, the others are held until the processing is resumed, and no health controller operation is created.
+	PauseSchedulerOperations(context.Context, *PauseSchedulerOperationsRequest) (*PauseSchedulerOperationsResponse, error)
+	// Resume the operations processing of a scheduler, executing the operations held while it was paused first.
+	ResumeSchedulerOperations(context.Context, *ResumeSchedulerOperationsRequest) (*ResumeSchedulerOperationsResponse, error)
 	mustEmbedUnimplementedOperationsServiceServer()
 }
 
@@ -97,6 +207,18 @@ func (UnimplementedOperationsServiceServer) CancelOperation(context.Context, *Ca
 func (UnimplementedOperationsServiceServer) GetOperation(context.Context, *GetOperationRequest) (*GetOperationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOperation not implemented")
 }
+func (UnimplementedOperationsServiceServer) WatchOperation(*WatchOperationRequest, OperationsService_WatchOperationServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOperation not implemented")
+}
+func (UnimplementedOperationsServiceServer) WatchSchedulerOperations(*WatchSchedulerOperationsRequest, OperationsService_WatchSchedulerOperationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSchedulerOperations not implemented")
+}
+func (UnimplementedOperationsServiceServer) PauseSchedulerOperations(context.Context, *PauseSchedulerOperationsRequest) (*PauseSchedulerOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseSchedulerOperations not implemented")
+}
+func (UnimplementedOperationsServiceServer) ResumeSchedulerOperations(context.Context, *ResumeSchedulerOperationsRequest) (*ResumeSchedulerOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSchedulerOperations not implemented")
+}
 func (UnimplementedOperationsServiceServer) mustEmbedUnimplementedOperationsServiceServer() {}
 
 // UnsafeOperationsServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +286,84 @@ func _OperationsService_GetOperation_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OperationsService_WatchOperation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchOperationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OperationsServiceServer).WatchOperation(m, &operationsServiceWatchOperationServer{stream})
+}
+
+type OperationsService_WatchOperationServer interface {
+	Send(*WatchOperationResponse) error
+	grpc.ServerStream
+}
+
+type operationsServiceWatchOperationServer struct {
+	grpc.ServerStream
+}
+
+func (x *operationsServiceWatchOperationServer) Send(m *WatchOperationResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _OperationsService_WatchSchedulerOperations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSchedulerOperationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OperationsServiceServer).WatchSchedulerOperations(m, &operationsServiceWatchSchedulerOperationsServer{stream})
+}
+
+type OperationsService_WatchSchedulerOperationsServer interface {
+	Send(*WatchSchedulerOperationsResponse) error
+	grpc.ServerStream
+}
+
+type operationsServiceWatchSchedulerOperationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *operationsServiceWatchSchedulerOperationsServer) Send(m *WatchSchedulerOperationsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _OperationsService_PauseSchedulerOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseSchedulerOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationsServiceServer).PauseSchedulerOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperationsService_PauseSchedulerOperations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationsServiceServer).PauseSchedulerOperations(ctx, req.(*PauseSchedulerOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperationsService_ResumeSchedulerOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeSchedulerOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationsServiceServer).ResumeSchedulerOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperationsService_ResumeSchedulerOperations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationsServiceServer).ResumeSchedulerOperations(ctx, req.(*ResumeSchedulerOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OperationsService_ServiceDesc is the grpc.ServiceDesc for OperationsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,7 +383,26 @@ var OperationsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetOperation",
 			Handler:    _OperationsService_GetOperation_Handler,
 		},
+		{
+			MethodName: "PauseSchedulerOperations",
+			Handler:    _OperationsService_PauseSchedulerOperations_Handler,
+		},
+		{
+			MethodName: "ResumeSchedulerOperations",
+			Handler:    _OperationsService_ResumeSchedulerOperations_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchOperation",
+			Handler:       _OperationsService_WatchOperation_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchSchedulerOperations",
+			Handler:       _OperationsService_WatchSchedulerOperations_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/operations.proto",
 }
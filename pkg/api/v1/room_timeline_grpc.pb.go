@@ -0,0 +1,111 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.17.3
+// source: api/v1/room_timeline.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	RoomTimelineService_GetRoomTimeline_FullMethodName = "/api.v1.RoomTimelineService/GetRoomTimeline"
+)
+
+// RoomTimelineServiceClient is the client API for RoomTimelineService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RoomTimelineServiceClient interface {
+	// Get the room timeline, with its status changes, forwarded events and deletion.
+	GetRoomTimeline(ctx context.Context, in *GetRoomTimelineRequest, opts ...grpc.CallOption) (*GetRoomTimelineResponse, error)
+}
+
+type roomTimelineServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRoomTimelineServiceClient(cc grpc.ClientConnInterface) RoomTimelineServiceClient {
+	return &roomTimelineServiceClient{cc}
+}
+
+func (c *roomTimelineServiceClient) GetRoomTimeline(ctx context.Context, in *GetRoomTimelineRequest, opts ...grpc.CallOption) (*GetRoomTimelineResponse, error) {
+	out := new(GetRoomTimelineResponse)
+	err := c.cc.Invoke(ctx, RoomTimelineService_GetRoomTimeline_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RoomTimelineServiceServer is the server API for RoomTimelineService service.
+// All implementations must embed UnimplementedRoomTimelineServiceServer
+// for forward compatibility
+type RoomTimelineServiceServer interface {
+	// Get the room timeline, with its status changes, forwarded events and deletion.
+	GetRoomTimeline(context.Context, *GetRoomTimelineRequest) (*GetRoomTimelineResponse, error)
+	mustEmbedUnimplementedRoomTimelineServiceServer()
+}
+
+// UnimplementedRoomTimelineServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRoomTimelineServiceServer struct {
+}
+
+func (UnimplementedRoomTimelineServiceServer) GetRoomTimeline(context.Context, *GetRoomTimelineRequest) (*GetRoomTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoomTimeline not implemented")
+}
+func (UnimplementedRoomTimelineServiceServer) mustEmbedUnimplementedRoomTimelineServiceServer() {}
+
+// UnsafeRoomTimelineServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RoomTimelineServiceServer will
+// result in compilation errors.
+type UnsafeRoomTimelineServiceServer interface {
+	mustEmbedUnimplementedRoomTimelineServiceServer()
+}
+
+func RegisterRoomTimelineServiceServer(s grpc.ServiceRegistrar, srv RoomTimelineServiceServer) {
+	s.RegisterService(&RoomTimelineService_ServiceDesc, srv)
+}
+
+func _RoomTimelineService_GetRoomTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoomTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomTimelineServiceServer).GetRoomTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomTimelineService_GetRoomTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomTimelineServiceServer).GetRoomTimeline(ctx, req.(*GetRoomTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RoomTimelineService_ServiceDesc is the grpc.ServiceDesc for RoomTimelineService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RoomTimelineService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.v1.RoomTimelineService",
+	HandlerType: (*RoomTimelineServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRoomTimeline",
+			Handler:    _RoomTimelineService_GetRoomTimeline_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/room_timeline.proto",
+}
@@ -0,0 +1,385 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.17.3
+// source: api/v1/scheduler_templates.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	SchedulerTemplatesService_ListSchedulerTemplates_FullMethodName      = "/api.v1.SchedulerTemplatesService/ListSchedulerTemplates"
+	SchedulerTemplatesService_GetSchedulerTemplate_FullMethodName        = "/api.v1.SchedulerTemplatesService/GetSchedulerTemplate"
+	SchedulerTemplatesService_CreateSchedulerTemplate_FullMethodName     = "/api.v1.SchedulerTemplatesService/CreateSchedulerTemplate"
+	SchedulerTemplatesService_UpdateSchedulerTemplate_FullMethodName     = "/api.v1.SchedulerTemplatesService/UpdateSchedulerTemplate"
+	SchedulerTemplatesService_CreateSchedulerFromTemplate_FullMethodName = "/api.v1.SchedulerTemplatesService/CreateSchedulerFromTemplate"
+	SchedulerTemplatesService_PatchSchedulerFromTemplate_FullMethodName  = "/api.v1.SchedulerTemplatesService/PatchSchedulerFromTemplate"
+	SchedulerTemplatesService_ListDerivedSchedulers_FullMethodName       = "/api.v1.SchedulerTemplatesService/ListDerivedSchedulers"
+	SchedulerTemplatesService_PropagateSchedulerTemplate_FullMethodName  = "/api.v1.SchedulerTemplatesService/PropagateSchedulerTemplate"
+)
+
+// SchedulerTemplatesServiceClient is the client API for SchedulerTemplatesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SchedulerTemplatesServiceClient interface {
+	// Lists all scheduler templates.
+	ListSchedulerTemplates(ctx context.Context, in *ListSchedulerTemplatesRequest, opts ...grpc.CallOption) (*ListSchedulerTemplatesResponse, error)
+	// Get a specific scheduler template.
+	GetSchedulerTemplate(ctx context.Context, in *GetSchedulerTemplateRequest, opts ...grpc.CallOption) (*GetSchedulerTemplateResponse, error)
+	// Create a scheduler template.
+	CreateSchedulerTemplate(ctx context.Context, in *CreateSchedulerTemplateRequest, opts ...grpc.CallOption) (*CreateSchedulerTemplateResponse, error)
+	// Update a scheduler template. The derived schedulers are only changed when the template is propagated.
+	UpdateSchedulerTemplate(ctx context.Context, in *UpdateSchedulerTemplateRequest, opts ...grpc.CallOption) (*UpdateSchedulerTemplateResponse, error)
+	// Create a scheduler derived from the template.
+	CreateSchedulerFromTemplate(ctx context.Context, in *CreateSchedulerFromTemplateRequest, opts ...grpc.CallOption) (*CreateSchedulerFromTemplateResponse, error)
+	// Derive an existing scheduler from a template again, creating a new scheduler version.
+	PatchSchedulerFromTemplate(ctx context.Context, in *PatchSchedulerFromTemplateRequest, opts ...grpc.CallOption) (*PatchSchedulerFromTemplateResponse, error)
+	// List the schedulers derived from the template, reporting the ones that are outdated.
+	ListDerivedSchedulers(ctx context.Context, in *ListDerivedSchedulersRequest, opts ...grpc.CallOption) (*ListDerivedSchedulersResponse, error)
+	// Apply the template changes to every outdated derived scheduler, creating a new version for each one.
+	PropagateSchedulerTemplate(ctx context.Context, in *PropagateSchedulerTemplateRequest, opts ...grpc.CallOption) (*PropagateSchedulerTemplateResponse, error)
+}
+
+type schedulerTemplatesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSchedulerTemplatesServiceClient(cc grpc.ClientConnInterface) SchedulerTemplatesServiceClient {
+	return &schedulerTemplatesServiceClient{cc}
+}
+
+func (c *schedulerTemplatesServiceClient) ListSchedulerTemplates(ctx context.Context, in *ListSchedulerTemplatesRequest, opts ...grpc.CallOption) (*ListSchedulerTemplatesResponse, error) {
+	out := new(ListSchedulerTemplatesResponse)
+	err := c.cc.Invoke(ctx, SchedulerTemplatesService_ListSchedulerTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerTemplatesServiceClient) GetSchedulerTemplate(ctx context.Context, in *GetSchedulerTemplateRequest, opts ...grpc.CallOption) (*GetSchedulerTemplateResponse, error) {
+	out := new(GetSchedulerTemplateResponse)
+	err := c.cc.Invoke(ctx, SchedulerTemplatesService_GetSchedulerTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerTemplatesServiceClient) CreateSchedulerTemplate(ctx context.Context, in *CreateSchedulerTemplateRequest, opts ...grpc.CallOption) (*CreateSchedulerTemplateResponse, error) {
+	out := new(CreateSchedulerTemplateResponse)
+	err := c.cc.Invoke(ctx, SchedulerTemplatesService_CreateSchedulerTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerTemplatesServiceClient) UpdateSchedulerTemplate(ctx context.Context, in *UpdateSchedulerTemplateRequest, opts ...grpc.CallOption) (*UpdateSchedulerTemplateResponse, error) {
+	out := new(UpdateSchedulerTemplateResponse)
+	err := c.cc.Invoke(ctx, SchedulerTemplatesService_UpdateSchedulerTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerTemplatesServiceClient) CreateSchedulerFromTemplate(ctx context.Context, in *CreateSchedulerFromTemplateRequest, opts ...grpc.CallOption) (*CreateSchedulerFromTemplateResponse, error) {
+	out := new(CreateSchedulerFromTemplateResponse)
+	err := c.cc.Invoke(ctx, SchedulerTemplatesService_CreateSchedulerFromTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerTemplatesServiceClient) PatchSchedulerFromTemplate(ctx context.Context, in *PatchSchedulerFromTemplateRequest, opts ...grpc.CallOption) (*PatchSchedulerFromTemplateResponse, error) {
+	out := new(PatchSchedulerFromTemplateResponse)
+	err := c.cc.Invoke(ctx, SchedulerTemplatesService_PatchSchedulerFromTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerTemplatesServiceClient) ListDerivedSchedulers(ctx context.Context, in *ListDerivedSchedulersRequest, opts ...grpc.CallOption) (*ListDerivedSchedulersResponse, error) {
+	out := new(ListDerivedSchedulersResponse)
+	err := c.cc.Invoke(ctx, SchedulerTemplatesService_ListDerivedSchedulers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerTemplatesServiceClient) PropagateSchedulerTemplate(ctx context.Context, in *PropagateSchedulerTemplateRequest, opts ...grpc.CallOption) (*PropagateSchedulerTemplateResponse, error) {
+	out := new(PropagateSchedulerTemplateResponse)
+	err := c.cc.Invoke(ctx, SchedulerTemplatesService_PropagateSchedulerTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SchedulerTemplatesServiceServer is the server API for SchedulerTemplatesService service.
+// All implementations must embed UnimplementedSchedulerTemplatesServiceServer
+// for forward compatibility
+type SchedulerTemplatesServiceServer interface {
+	// Lists all scheduler templates.
+	ListSchedulerTemplates(context.Context, *ListSchedulerTemplatesRequest) (*ListSchedulerTemplatesResponse, error)
+	// Get a specific scheduler template.
+	GetSchedulerTemplate(context.Context, *GetSchedulerTemplateRequest) (*GetSchedulerTemplateResponse, error)
+	// Create a scheduler template.
+	CreateSchedulerTemplate(context.Context, *CreateSchedulerTemplateRequest) (*CreateSchedulerTemplateResponse, error)
+	// Update a scheduler template. The derived schedulers are only changed when the template is propagated.
+	UpdateSchedulerTemplate(context.Context, *UpdateSchedulerTemplateRequest) (*UpdateSchedulerTemplateResponse, error)
+	// Create a scheduler derived from the template.
+	CreateSchedulerFromTemplate(context.Context, *CreateSchedulerFromTemplateRequest) (*CreateSchedulerFromTemplateResponse, error)
+	// Derive an existing scheduler from a template again, creating a new scheduler version.
+	PatchSchedulerFromTemplate(context.Context, *PatchSchedulerFromTemplateRequest) (*PatchSchedulerFromTemplateResponse, error)
+	// List the schedulers derived from the template, reporting the ones that are outdated.
+	ListDerivedSchedulers(context.Context, *ListDerivedSchedulersRequest) (*ListDerivedSchedulersResponse, error)
+	// Apply the template changes to every outdated derived scheduler, creating a new version for each one.
+	PropagateSchedulerTemplate(context.Context, *PropagateSchedulerTemplateRequest) (*PropagateSchedulerTemplateResponse, error)
+	mustEmbedUnimplementedSchedulerTemplatesServiceServer()
+}
+
+// UnimplementedSchedulerTemplatesServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSchedulerTemplatesServiceServer struct {
+}
+
+func (UnimplementedSchedulerTemplatesServiceServer) ListSchedulerTemplates(context.Context, *ListSchedulerTemplatesRequest) (*ListSchedulerTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchedulerTemplates not implemented")
+}
+func (UnimplementedSchedulerTemplatesServiceServer) GetSchedulerTemplate(context.Context, *GetSchedulerTemplateRequest) (*GetSchedulerTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedulerTemplate not implemented")
+}
+func (UnimplementedSchedulerTemplatesServiceServer) CreateSchedulerTemplate(context.Context, *CreateSchedulerTemplateRequest) (*CreateSchedulerTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedulerTemplate not implemented")
+}
+func (UnimplementedSchedulerTemplatesServiceServer) UpdateSchedulerTemplate(context.Context, *UpdateSchedulerTemplateRequest) (*UpdateSchedulerTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSchedulerTemplate not implemented")
+}
+func (UnimplementedSchedulerTemplatesServiceServer) CreateSchedulerFromTemplate(context.Context, *CreateSchedulerFromTemplateRequest) (*CreateSchedulerFromTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedulerFromTemplate not implemented")
+}
+func (UnimplementedSchedulerTemplatesServiceServer) PatchSchedulerFromTemplate(context.Context, *PatchSchedulerFromTemplateRequest) (*PatchSchedulerFromTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchSchedulerFromTemplate not implemented")
+}
+func (UnimplementedSchedulerTemplatesServiceServer) ListDerivedSchedulers(context.Context, *ListDerivedSchedulersRequest) (*ListDerivedSchedulersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDerivedSchedulers not implemented")
+}
+func (UnimplementedSchedulerTemplatesServiceServer) PropagateSchedulerTemplate(context.Context, *PropagateSchedulerTemplateRequest) (*PropagateSchedulerTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PropagateSchedulerTemplate not implemented")
+}
+func (UnimplementedSchedulerTemplatesServiceServer) mustEmbedUnimplementedSchedulerTemplatesServiceServer() {
+}
+
+// UnsafeSchedulerTemplatesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SchedulerTemplatesServiceServer will
+// result in compilation errors.
+type UnsafeSchedulerTemplatesServiceServer interface {
+	mustEmbedUnimplementedSchedulerTemplatesServiceServer()
+}
+
+func RegisterSchedulerTemplatesServiceServer(s grpc.ServiceRegistrar, srv SchedulerTemplatesServiceServer) {
+	s.RegisterService(&SchedulerTemplatesService_ServiceDesc, srv)
+}
+
+func _SchedulerTemplatesService_ListSchedulerTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchedulerTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerTemplatesServiceServer).ListSchedulerTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerTemplatesService_ListSchedulerTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerTemplatesServiceServer).ListSchedulerTemplates(ctx, req.(*ListSchedulerTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerTemplatesService_GetSchedulerTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchedulerTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerTemplatesServiceServer).GetSchedulerTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerTemplatesService_GetSchedulerTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerTemplatesServiceServer).GetSchedulerTemplate(ctx, req.(*GetSchedulerTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerTemplatesService_CreateSchedulerTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSchedulerTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerTemplatesServiceServer).CreateSchedulerTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerTemplatesService_CreateSchedulerTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerTemplatesServiceServer).CreateSchedulerTemplate(ctx, req.(*CreateSchedulerTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerTemplatesService_UpdateSchedulerTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSchedulerTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerTemplatesServiceServer).UpdateSchedulerTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerTemplatesService_UpdateSchedulerTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerTemplatesServiceServer).UpdateSchedulerTemplate(ctx, req.(*UpdateSchedulerTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerTemplatesService_CreateSchedulerFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSchedulerFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerTemplatesServiceServer).CreateSchedulerFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerTemplatesService_CreateSchedulerFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerTemplatesServiceServer).CreateSchedulerFromTemplate(ctx, req.(*CreateSchedulerFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerTemplatesService_PatchSchedulerFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchSchedulerFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerTemplatesServiceServer).PatchSchedulerFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerTemplatesService_PatchSchedulerFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerTemplatesServiceServer).PatchSchedulerFromTemplate(ctx, req.(*PatchSchedulerFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerTemplatesService_ListDerivedSchedulers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDerivedSchedulersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerTemplatesServiceServer).ListDerivedSchedulers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerTemplatesService_ListDerivedSchedulers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerTemplatesServiceServer).ListDerivedSchedulers(ctx, req.(*ListDerivedSchedulersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerTemplatesService_PropagateSchedulerTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PropagateSchedulerTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerTemplatesServiceServer).PropagateSchedulerTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerTemplatesService_PropagateSchedulerTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerTemplatesServiceServer).PropagateSchedulerTemplate(ctx, req.(*PropagateSchedulerTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SchedulerTemplatesService_ServiceDesc is the grpc.ServiceDesc for SchedulerTemplatesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SchedulerTemplatesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.v1.SchedulerTemplatesService",
+	HandlerType: (*SchedulerTemplatesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSchedulerTemplates",
+			Handler:    _SchedulerTemplatesService_ListSchedulerTemplates_Handler,
+		},
+		{
+			MethodName: "GetSchedulerTemplate",
+			Handler:    _SchedulerTemplatesService_GetSchedulerTemplate_Handler,
+		},
+		{
+			MethodName: "CreateSchedulerTemplate",
+			Handler:    _SchedulerTemplatesService_CreateSchedulerTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateSchedulerTemplate",
+			Handler:    _SchedulerTemplatesService_UpdateSchedulerTemplate_Handler,
+		},
+		{
+			MethodName: "CreateSchedulerFromTemplate",
+			Handler:    _SchedulerTemplatesService_CreateSchedulerFromTemplate_Handler,
+		},
+		{
+			MethodName: "PatchSchedulerFromTemplate",
+			Handler:    _SchedulerTemplatesService_PatchSchedulerFromTemplate_Handler,
+		},
+		{
+			MethodName: "ListDerivedSchedulers",
+			Handler:    _SchedulerTemplatesService_ListDerivedSchedulers_Handler,
+		},
+		{
+			MethodName: "PropagateSchedulerTemplate",
+			Handler:    _SchedulerTemplatesService_PropagateSchedulerTemplate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/scheduler_templates.proto",
+}
@@ -19,15 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	SchedulersService_ListSchedulers_FullMethodName       = "/api.v1.SchedulersService/ListSchedulers"
-	SchedulersService_GetScheduler_FullMethodName         = "/api.v1.SchedulersService/GetScheduler"
-	SchedulersService_CreateScheduler_FullMethodName      = "/api.v1.SchedulersService/CreateScheduler"
-	SchedulersService_NewSchedulerVersion_FullMethodName  = "/api.v1.SchedulersService/NewSchedulerVersion"
-	SchedulersService_PatchScheduler_FullMethodName       = "/api.v1.SchedulersService/PatchScheduler"
-	SchedulersService_GetSchedulerVersions_FullMethodName = "/api.v1.SchedulersService/GetSchedulerVersions"
-	SchedulersService_SwitchActiveVersion_FullMethodName  = "/api.v1.SchedulersService/SwitchActiveVersion"
-	SchedulersService_GetSchedulersInfo_FullMethodName    = "/api.v1.SchedulersService/GetSchedulersInfo"
-	SchedulersService_DeleteScheduler_FullMethodName      = "/api.v1.SchedulersService/DeleteScheduler"
+	SchedulersService_ListSchedulers_FullMethodName           = "/api.v1.SchedulersService/ListSchedulers"
+	SchedulersService_GetScheduler_FullMethodName             = "/api.v1.SchedulersService/GetScheduler"
+	SchedulersService_CreateScheduler_FullMethodName          = "/api.v1.SchedulersService/CreateScheduler"
+	SchedulersService_NewSchedulerVersion_FullMethodName      = "/api.v1.SchedulersService/NewSchedulerVersion"
+	SchedulersService_PatchScheduler_FullMethodName           = "/api.v1.SchedulersService/PatchScheduler"
+	SchedulersService_GetSchedulerVersions_FullMethodName     = "/api.v1.SchedulersService/GetSchedulerVersions"
+	SchedulersService_GetSchedulerVersionsDiff_FullMethodName = "/api.v1.SchedulersService/GetSchedulerVersionsDiff"
+	SchedulersService_PatchSchedulerVersion_FullMethodName    = "/api.v1.SchedulersService/PatchSchedulerVersion"
+	SchedulersService_SwitchActiveVersion_FullMethodName      = "/api.v1.SchedulersService/SwitchActiveVersion"
+	SchedulersService_GetSchedulersInfo_FullMethodName        = "/api.v1.SchedulersService/GetSchedulersInfo"
+	SchedulersService_DeleteScheduler_FullMethodName          = "/api.v1.SchedulersService/DeleteScheduler"
+	SchedulersService_ApplySchedulers_FullMethodName          = "/api.v1.SchedulersService/ApplySchedulers"
 )
 
 // SchedulersServiceClient is the client API for SchedulersService service.
@@ -46,12 +49,19 @@ type SchedulersServiceClient interface {
 	PatchScheduler(ctx context.Context, in *PatchSchedulerRequest, opts ...grpc.CallOption) (*PatchSchedulerResponse, error)
 	// Given a Scheduler, returns it's versions
 	GetSchedulerVersions(ctx context.Context, in *GetSchedulerVersionsRequest, opts ...grpc.CallOption) (*GetSchedulerVersionsResponse, error)
+	// Given a Scheduler and two of its versions, returns the changes between them
+	GetSchedulerVersionsDiff(ctx context.Context, in *GetSchedulerVersionsDiffRequest, opts ...grpc.CallOption) (*GetSchedulerVersionsDiffResponse, error)
+	// Pin, unpin, block or unblock a Scheduler version
+	PatchSchedulerVersion(ctx context.Context, in *PatchSchedulerVersionRequest, opts ...grpc.CallOption) (*PatchSchedulerVersionResponse, error)
 	// Switch Active Version to Scheduler
 	SwitchActiveVersion(ctx context.Context, in *SwitchActiveVersionRequest, opts ...grpc.CallOption) (*SwitchActiveVersionResponse, error)
 	// List Scheduler and Game Rooms info by Game
 	GetSchedulersInfo(ctx context.Context, in *GetSchedulersInfoRequest, opts ...grpc.CallOption) (*GetSchedulersInfoResponse, error)
 	// List Scheduler and Game Rooms info by Game
 	DeleteScheduler(ctx context.Context, in *DeleteSchedulerRequest, opts ...grpc.CallOption) (*DeleteSchedulerResponse, error)
+	// Reconcile the game schedulers with the full set of schedulers declared for it, creating, updating and deleting
+	// schedulers as needed.
+	ApplySchedulers(ctx context.Context, in *ApplySchedulersRequest, opts ...grpc.CallOption) (*ApplySchedulersResponse, error)
 }
 
 type schedulersServiceClient struct {
@@ -116,6 +126,24 @@ func (c *schedulersServiceClient) GetSchedulerVersions(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *schedulersServiceClient) GetSchedulerVersionsDiff(ctx context.Context, in *GetSchedulerVersionsDiffRequest, opts ...grpc.CallOption) (*GetSchedulerVersionsDiffResponse, error) {
+	out := new(GetSchedulerVersionsDiffResponse)
+	err := c.cc.Invoke(ctx, SchedulersService_GetSchedulerVersionsDiff_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulersServiceClient) PatchSchedulerVersion(ctx context.Context, in *PatchSchedulerVersionRequest, opts ...grpc.CallOption) (*PatchSchedulerVersionResponse, error) {
+	out := new(PatchSchedulerVersionResponse)
+	err := c.cc.Invoke(ctx, SchedulersService_PatchSchedulerVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *schedulersServiceClient) SwitchActiveVersion(ctx context.Context, in *SwitchActiveVersionRequest, opts ...grpc.CallOption) (*SwitchActiveVersionResponse, error) {
 	out := new(SwitchActiveVersionResponse)
 	err := c.cc.Invoke(ctx, SchedulersService_SwitchActiveVersion_FullMethodName, in, out, opts...)
@@ -143,6 +171,15 @@ func (c *schedulersServiceClient) DeleteScheduler(ctx context.Context, in *Delet
 	return out, nil
 }
 
+func (c *schedulersServiceClient) ApplySchedulers(ctx context.Context, in *ApplySchedulersRequest, opts ...grpc.CallOption) (*ApplySchedulersResponse, error) {
+	out := new(ApplySchedulersResponse)
+	err := c.cc.Invoke(ctx, SchedulersService_ApplySchedulers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SchedulersServiceServer is the server API for SchedulersService service.
 // All implementations must embed UnimplementedSchedulersServiceServer
 // for forward compatibility
@@ -159,12 +196,19 @@ type SchedulersServiceServer interface {
 	PatchScheduler(context.Context, *PatchSchedulerRequest) (*PatchSchedulerResponse, error)
 	// Given a Scheduler, returns it's versions
 	GetSchedulerVersions(context.Context, *GetSchedulerVersionsRequest) (*GetSchedulerVersionsResponse, error)
+	// Given a Scheduler and two of its versions, returns the changes between them
+	GetSchedulerVersionsDiff(context.Context, *GetSchedulerVersionsDiffRequest) (*GetSchedulerVersionsDiffResponse, error)
+	// Pin, unpin, block or unblock a Scheduler version
+	PatchSchedulerVersion(context.Context, *PatchSchedulerVersionRequest) (*PatchSchedulerVersionResponse, error)
 	// Switch Active Version to Scheduler
 	SwitchActiveVersion(context.Context, *SwitchActiveVersionRequest) (*SwitchActiveVersionResponse, error)
 	// List Scheduler and Game Rooms info by Game
 	GetSchedulersInfo(context.Context, *GetSchedulersInfoRequest) (*GetSchedulersInfoResponse, error)
 	// List Scheduler and Game Rooms info by Game
 	DeleteScheduler(context.Context, *DeleteSchedulerRequest) (*DeleteSchedulerResponse, error)
+	// Reconcile the game schedulers with the full set of schedulers declared for it, creating, updating and deleting
+	// schedulers as needed.
+	ApplySchedulers(context.Context, *ApplySchedulersRequest) (*ApplySchedulersResponse, error)
 	mustEmbedUnimplementedSchedulersServiceServer()
 }
 
@@ -190,6 +234,12 @@ func (UnimplementedSchedulersServiceServer) PatchScheduler(context.Context, *Pat
 func (UnimplementedSchedulersServiceServer) GetSchedulerVersions(context.Context, *GetSchedulerVersionsRequest) (*GetSchedulerVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSchedulerVersions not implemented")
 }
+func (UnimplementedSchedulersServiceServer) GetSchedulerVersionsDiff(context.Context, *GetSchedulerVersionsDiffRequest) (*GetSchedulerVersionsDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedulerVersionsDiff not implemented")
+}
+func (UnimplementedSchedulersServiceServer) PatchSchedulerVersion(context.Context, *PatchSchedulerVersionRequest) (*PatchSchedulerVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchSchedulerVersion not implemented")
+}
 func (UnimplementedSchedulersServiceServer) SwitchActiveVersion(context.Context, *SwitchActiveVersionRequest) (*SwitchActiveVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SwitchActiveVersion not implemented")
 }
@@ -199,6 +249,9 @@ func (UnimplementedSchedulersServiceServer) GetSchedulersInfo(context.Context, *
 func (UnimplementedSchedulersServiceServer) DeleteScheduler(context.Context, *DeleteSchedulerRequest) (*DeleteSchedulerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteScheduler not implemented")
 }
+func (UnimplementedSchedulersServiceServer) ApplySchedulers(context.Context, *ApplySchedulersRequest) (*ApplySchedulersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplySchedulers not implemented")
+}
 func (UnimplementedSchedulersServiceServer) mustEmbedUnimplementedSchedulersServiceServer() {}
 
 // UnsafeSchedulersServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -320,6 +373,42 @@ func _SchedulersService_GetSchedulerVersions_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SchedulersService_GetSchedulerVersionsDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchedulerVersionsDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersServiceServer).GetSchedulerVersionsDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulersService_GetSchedulerVersionsDiff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersServiceServer).GetSchedulerVersionsDiff(ctx, req.(*GetSchedulerVersionsDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulersService_PatchSchedulerVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchSchedulerVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersServiceServer).PatchSchedulerVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulersService_PatchSchedulerVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersServiceServer).PatchSchedulerVersion(ctx, req.(*PatchSchedulerVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SchedulersService_SwitchActiveVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SwitchActiveVersionRequest)
 	if err := dec(in); err != nil {
@@ -374,6 +463,24 @@ func _SchedulersService_DeleteScheduler_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SchedulersService_ApplySchedulers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplySchedulersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulersServiceServer).ApplySchedulers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulersService_ApplySchedulers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulersServiceServer).ApplySchedulers(ctx, req.(*ApplySchedulersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SchedulersService_ServiceDesc is the grpc.ServiceDesc for SchedulersService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -405,6 +512,14 @@ var SchedulersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSchedulerVersions",
 			Handler:    _SchedulersService_GetSchedulerVersions_Handler,
 		},
+		{
+			MethodName: "GetSchedulerVersionsDiff",
+			Handler:    _SchedulersService_GetSchedulerVersionsDiff_Handler,
+		},
+		{
+			MethodName: "PatchSchedulerVersion",
+			Handler:    _SchedulersService_PatchSchedulerVersion_Handler,
+		},
 		{
 			MethodName: "SwitchActiveVersion",
 			Handler:    _SchedulersService_SwitchActiveVersion_Handler,
@@ -417,6 +532,10 @@ var SchedulersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteScheduler",
 			Handler:    _SchedulersService_DeleteScheduler_Handler,
 		},
+		{
+			MethodName: "ApplySchedulers",
+			Handler:    _SchedulersService_ApplySchedulers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/schedulers.proto",